@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"echo-household-budget/config"
 	"echo-household-budget/internal/infrastructure/middleware"
 	"echo-household-budget/internal/setup"
@@ -28,7 +29,16 @@ func main() {
 	dependencies := setup.NewDependencies(appConfig)
 
 	// ルーティングの設定
-	setupRoutes(e, dependencies)
+	setupRoutes(e, dependencies, appConfig)
+
+	// 定期取引の登録を開始
+	dependencies.RecurringTransactionScheduler.Start(context.Background())
+
+	// Notion との同期を開始
+	dependencies.NotionSyncScheduler.Start(context.Background())
+
+	// 保持期間を過ぎたゴミ箱の記録の削除を開始
+	dependencies.TrashPurgeScheduler.Start(context.Background())
 
 	// ヘルスチェックエンドポイント
 	e.GET("/health", func(c echo.Context) error {
@@ -53,29 +63,107 @@ func setupMiddleware(e *echo.Echo, appConfig *config.AppConfig) {
 	e.Use(middleware.ErrorHandler())
 }
 
-func setupRoutes(e *echo.Echo, deps *setup.Dependencies) {
+func setupRoutes(e *echo.Echo, deps *setup.Dependencies, appConfig *config.AppConfig) {
 	// 買い物メモ関連のエンドポイント
 	kaimemo := e.Group("/kaimemo", middleware.AuthMiddleware(deps.SessionManager, deps.UserAccountRepository))
 	kaimemo.GET("", deps.KaimemoHandler.FetchKaimemo)
 	kaimemo.POST("", deps.KaimemoHandler.CreateKaimemo)
 	kaimemo.DELETE("/:id", deps.KaimemoHandler.RemoveKaimemo)
-	kaimemo.GET("/ws", deps.KaimemoHandler.WebsocketTelegraph)
 	kaimemo.GET("/summary", deps.KaimemoHandler.FetchKaimemoSummaryRecord)
 	kaimemo.POST("/summary", deps.KaimemoHandler.CreateKaimemoAmount)
 	kaimemo.DELETE("/summary/:id", deps.KaimemoHandler.RemoveKaimemoAmount)
 
 	// 家計簿関連のエンドポイント
-	houseHold := e.Group("/household", middleware.AuthMiddleware(deps.SessionManager, deps.UserAccountRepository))
-	houseHold.GET("/:id", deps.HouseHoldHandler.FetchHouseHold)
+	houseHold := e.Group("/household", middleware.AuthMiddleware(deps.SessionManager, deps.UserAccountRepository), middleware.HouseHoldMemberMiddleware(deps.HouseHoldRepository))
+	houseHold.GET("", deps.HouseHoldHandler.FetchHouseHolds)
+	houseHold.GET("/:householdID", deps.HouseHoldHandler.FetchHouseHold)
+	houseHold.PUT("/:householdID/default", deps.HouseHoldHandler.ChangeDefaultHouseHold)
 	houseHold.GET("/user/:id", deps.HouseHoldHandler.FetchHouseHoldUser)
 	houseHold.POST("/user/:id", deps.HouseHoldHandler.AddHouseHold)
-	houseHold.POST("/:householdID/share/:inviteUserID", deps.HouseHoldHandler.ShareHouseHold)
+	houseHold.POST("/restore", deps.HouseHoldBackupHandler.RestoreBackup)
+	houseHold.DELETE("/:householdID", deps.HouseHoldHandler.DeleteHouseHold)
+	houseHold.GET("/:householdID/backup", deps.HouseHoldBackupHandler.ExportBackup)
+	houseHold.GET("/:householdID/member", deps.HouseHoldHandler.FetchMembers)
+	houseHold.DELETE("/:householdID/member/:userID", deps.HouseHoldHandler.RemoveMember)
+	houseHold.PUT("/:householdID/member/:userID/role", deps.HouseHoldHandler.ChangeMemberRole)
+	houseHold.PUT("/:householdID/owner", deps.HouseHoldHandler.TransferOwnership)
+	houseHold.POST("/:householdID/leave", deps.HouseHoldHandler.LeaveHouseHold)
+	houseHold.POST("/:householdID/invitation", deps.HouseHoldInvitationHandler.CreateInvitation)
+	houseHold.GET("/:householdID/invitation", deps.HouseHoldInvitationHandler.FetchInvitations)
+	houseHold.DELETE("/:householdID/invitation/:invitationID", deps.HouseHoldInvitationHandler.RevokeInvitation)
+	houseHold.GET("/:householdID/category", deps.HouseHoldHandler.FetchHouseHoldCategories)
 	houseHold.POST("/:householdID/category", deps.HouseHoldHandler.AddHouseHoldCategory)
+	houseHold.PUT("/:householdID/category/order", deps.HouseHoldHandler.ReorderHouseHoldCategories)
+	houseHold.PUT("/:householdID/category/:categoryLimitID", deps.HouseHoldHandler.UpdateHouseHoldCategory)
+	houseHold.POST("/:householdID/category/:categoryLimitID/archive", deps.HouseHoldHandler.ArchiveHouseHoldCategory)
+	houseHold.POST("/:householdID/category/:categoryLimitID/unarchive", deps.HouseHoldHandler.UnarchiveHouseHoldCategory)
+	houseHold.DELETE("/:householdID/category/:categoryLimitID", deps.HouseHoldHandler.RemoveHouseHoldCategory)
+	houseHold.GET("/:householdID/budget", deps.HouseHoldHandler.FetchMonthlyBudgets)
+	houseHold.PUT("/:householdID/budget/:month/category/:categoryID", deps.HouseHoldHandler.SetMonthlyBudget)
+	houseHold.GET("/:householdID/income", deps.IncomeHandler.FetchIncomes)
+	houseHold.POST("/:householdID/income", deps.IncomeHandler.CreateIncome)
+	houseHold.GET("/:householdID/income/category", deps.IncomeHandler.FetchIncomeCategories)
+	houseHold.POST("/:householdID/income/category", deps.IncomeHandler.AddIncomeCategory)
+	houseHold.PUT("/:householdID/income/:incomeID", deps.IncomeHandler.UpdateIncome)
+	houseHold.DELETE("/:householdID/income/:incomeID", deps.IncomeHandler.RemoveIncome)
+	houseHold.GET("/:householdID/account", deps.PaymentMethodHandler.FetchPaymentMethods)
+	houseHold.POST("/:householdID/account", deps.PaymentMethodHandler.AddPaymentMethod)
+	houseHold.GET("/:householdID/account/balance", deps.PaymentMethodHandler.FetchBalances)
+	houseHold.PUT("/:householdID/account/:paymentMethodID", deps.PaymentMethodHandler.UpdatePaymentMethod)
+	houseHold.POST("/:householdID/account/:paymentMethodID/archive", deps.PaymentMethodHandler.ArchivePaymentMethod)
+	houseHold.POST("/:householdID/account/:paymentMethodID/unarchive", deps.PaymentMethodHandler.UnarchivePaymentMethod)
+	houseHold.GET("/:householdID/account/:paymentMethodID/ledger", deps.PaymentMethodHandler.FetchLedger)
+	houseHold.GET("/:householdID/account/:paymentMethodID/statement", deps.PaymentMethodHandler.FetchStatement)
+	houseHold.GET("/:householdID/tag", deps.TagHandler.FetchTags)
+	houseHold.POST("/:householdID/tag", deps.TagHandler.AddTag)
+	houseHold.GET("/:householdID/tag/summary", deps.TagHandler.SummarizeByTag)
+	houseHold.PUT("/:householdID/tag/:tagID", deps.TagHandler.RenameTag)
+	houseHold.DELETE("/:householdID/tag/:tagID", deps.TagHandler.RemoveTag)
+	houseHold.POST("/:householdID/tag/:tagID/merge", deps.TagHandler.MergeTag)
+	houseHold.GET("/:householdID/tag/:tagID/shopping/record", deps.TagHandler.FetchTaggedShoppingRecords)
+	houseHold.PUT("/:householdID/receipt/item/:receiptItemID/tag", deps.TagHandler.TagReceiptItem)
+	houseHold.DELETE("/:householdID/receipt/:receiptID", deps.ReceiptAnalyzeHandler.RemoveReceiptAnalyze)
+	houseHold.GET("/:householdID/report/trend", deps.ReportHandler.FetchTrendReport)
+	houseHold.GET("/:householdID/report/forecast", deps.ReportHandler.FetchForecast)
+	houseHold.GET("/:householdID/recurring", deps.RecurringTransactionHandler.FetchRecurringTransactions)
+	houseHold.POST("/:householdID/recurring", deps.RecurringTransactionHandler.CreateRecurringTransaction)
+	houseHold.GET("/:householdID/recurring/upcoming", deps.RecurringTransactionHandler.FetchUpcomingOccurrences)
+	houseHold.PUT("/:householdID/recurring/:recurringTransactionID", deps.RecurringTransactionHandler.UpdateRecurringTransaction)
+	houseHold.DELETE("/:householdID/recurring/:recurringTransactionID", deps.RecurringTransactionHandler.RemoveRecurringTransaction)
+	houseHold.POST("/:householdID/recurring/:recurringTransactionID/occurrence/:date/skip", deps.RecurringTransactionHandler.SkipOccurrence)
+	houseHold.PUT("/:householdID/recurring/:recurringTransactionID/occurrence/:date", deps.RecurringTransactionHandler.AdjustOccurrence)
+	houseHold.GET("/:householdID/settlement", deps.SettlementHandler.FetchSettleUpReport)
+	houseHold.POST("/:householdID/settlement", deps.SettlementHandler.RecordSettlement)
+	houseHold.DELETE("/:householdID/settlement/:settlementID", deps.SettlementHandler.RemoveSettlement)
+	houseHold.GET("/:householdID/notion-sync", deps.NotionSyncHandler.FetchNotionSyncSetting)
+	houseHold.PUT("/:householdID/notion-sync", deps.NotionSyncHandler.SaveNotionSyncSetting)
+	houseHold.POST("/:householdID/notion-sync/run", deps.NotionSyncHandler.SyncNotion)
+	houseHold.PUT("/:householdID/currency", deps.CurrencyHandler.ChangeBaseCurrency)
+	houseHold.GET("/:householdID/exchange-rate", deps.CurrencyHandler.FetchExchangeRates)
+	houseHold.POST("/:householdID/exchange-rate", deps.CurrencyHandler.AddExchangeRate)
+	houseHold.DELETE("/:householdID/exchange-rate/:exchangeRateID", deps.CurrencyHandler.RemoveExchangeRate)
+	houseHold.GET("/:householdID/kaimemo/ws", deps.KaimemoHandler.WebsocketTelegraph)
+	houseHold.GET("/:householdID/trash", deps.TrashHandler.FetchTrashItems)
+	houseHold.POST("/:householdID/trash/undo", deps.TrashHandler.UndoLastDelete)
+	houseHold.POST("/:householdID/trash/:itemType/:itemID/restore", deps.TrashHandler.RestoreTrashItem)
 	houseHold.GET("/:householdID/shopping/record", deps.HouseHoldHandler.FetchShoppingRecord)
+	houseHold.GET("/:householdID/shopping/record/search", deps.HouseHoldHandler.SearchShoppingRecord)
+	houseHold.GET("/:householdID/shopping/record/export", deps.ExportHandler.ExportShoppingRecords)
+	houseHold.GET("/:householdID/import/preset", deps.ImportHandler.FetchImportPresets)
+	houseHold.POST("/:householdID/import/preset", deps.ImportHandler.AddImportPreset)
+	houseHold.DELETE("/:householdID/import/preset/:presetID", deps.ImportHandler.RemoveImportPreset)
+	houseHold.POST("/:householdID/import/preview", deps.ImportHandler.PreviewImport)
+	houseHold.POST("/:householdID/import/commit", deps.ImportHandler.CommitImport)
 	houseHold.POST("/:householdID/shopping/record", deps.HouseHoldHandler.CreateShoppingRecord)
 	houseHold.PUT("/:householdID/shopping/record/:shoppingID", deps.HouseHoldHandler.UpdateShoppingRecord)
 	houseHold.DELETE("/:householdID/shopping/record/:shoppingID", deps.HouseHoldHandler.RemoveShoppingRecord)
 
+	// 家計簿招待関連のエンドポイント（招待された側が利用するため、家計簿の所属確認は行わない）
+	invitation := e.Group("/invitation", middleware.AuthMiddleware(deps.SessionManager, deps.UserAccountRepository))
+	invitation.GET("/:code", deps.HouseHoldInvitationHandler.FetchInvitation)
+	invitation.POST("/:code/accept", deps.HouseHoldInvitationHandler.AcceptInvitation)
+	invitation.POST("/:code/decline", deps.HouseHoldInvitationHandler.DeclineInvitation)
+
 	// LINE認証関連のエンドポイント
 	lineAuth := e.Group("/line")
 	lineAuth.GET("/login", deps.LineAuthHandler.Login)
@@ -84,9 +172,12 @@ func setupRoutes(e *echo.Echo, deps *setup.Dependencies) {
 	lineAuth.GET("/me", deps.LineAuthHandler.FetchMe)
 
 	// OpenAI関連のエンドポイント
-	openAI := e.Group("/openai/analyze")
+	openAI := e.Group("/openai/analyze", middleware.AuthMiddleware(deps.SessionManager, deps.UserAccountRepository), middleware.HouseHoldMemberMiddleware(deps.HouseHoldRepository))
 	openAI.POST("/:householdID/receipt/reception", deps.ReceiptAnalyzeHandler.CreateReceiptAnalyzeReception)
-	openAI.POST("/:householdID/receipt/result", deps.ReceiptAnalyzeHandler.CreateReceiptAnalyzeResult)
+
+	// レシート分析の結果はセッションを持たない分析ワーカーが登録するため、サービス用のトークンで認証する
+	receiptAnalyzeWorker := e.Group("/openai/analyze", middleware.ServiceTokenMiddleware(appConfig.ReceiptAnalyzeWorkerToken))
+	receiptAnalyzeWorker.POST("/:householdID/receipt/result", deps.ReceiptAnalyzeHandler.CreateReceiptAnalyzeResult)
 
 	// 管理系のエンドポイント
 	admin := e.Group("/admin", middleware.AuthMiddleware(deps.SessionManager, deps.UserAccountRepository))
@@ -103,6 +194,6 @@ func setupRoutes(e *echo.Echo, deps *setup.Dependencies) {
 	user.POST("/informations", deps.UpdateReadUserInformationHandler.Handle)
 
 	// チャット関連のエンドポイント
-	chat := e.Group("/chat", middleware.AuthMiddleware(deps.SessionManager, deps.UserAccountRepository))
+	chat := e.Group("/chat", middleware.AuthMiddleware(deps.SessionManager, deps.UserAccountRepository), middleware.HouseHoldMemberMiddleware(deps.HouseHoldRepository))
 	chat.GET("/messages/ws", deps.ChatMessageTelegraphHandler.WebSocketChat)
 }
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"echo-household-budget/config"
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/setup"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"text/tabwriter"
+)
+
+// Notion の買い物メモと支出を Postgres の家計簿に移行する。移行済みのページは移行しないため、何度実行してもよい
+//
+//	go run cmd/migrate-notion/main.go -mapping mapping.json -dry-run
+//
+// mapping.json の例
+//
+//	{
+//	  "users": {"tempUserID": {"lineUserID": "Uxxxxxxxx", "householdID": 1}},
+//	  "categories": {"食費": "食費", "日用品": "日用品"},
+//	  "defaultCategory": "未分類"
+//	}
+func main() {
+	mappingFile := flag.String("mapping", "", "tempUserID とタグの対応表の JSON ファイル")
+	dryRun := flag.Bool("dry-run", false, "書き込まずに移行の結果のみを表示する")
+	flag.Parse()
+
+	var data []byte
+	if *mappingFile != "" {
+		var err error
+		if data, err = os.ReadFile(*mappingFile); err != nil {
+			log.Fatalf("対応表の読み込みに失敗しました: %v", err)
+		}
+	}
+	mapping, err := domainmodel.ParseNotionMigrationMapping(data)
+	if err != nil {
+		log.Fatalf("対応表の読み込みに失敗しました: %v", err)
+	}
+
+	appConfig := config.LoadConfig()
+	dependencies := setup.NewDependencies(appConfig)
+
+	// Ctrl+C で Notion からの取得を中断する
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	report, err := dependencies.NotionMigrationUsecase.Migrate(ctx, mapping, *dryRun)
+	if err != nil {
+		log.Fatalf("Notion からの移行に失敗しました: %v", err)
+	}
+
+	printReport(os.Stdout, report)
+	if !report.Reconciled() {
+		os.Exit(1)
+	}
+}
+
+// printReport は移行の件数と家計簿ごとの突き合わせの結果を表示する
+func printReport(out io.Writer, report *domainmodel.NotionMigrationReport) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	if report.DryRun {
+		fmt.Fprintln(w, "※ dry-run のため書き込んでいません。移行済みの件数は前回までの実行の結果です")
+	}
+
+	fmt.Fprintln(w, "データベース\t取得\t移行\t移行済み\tスキップ")
+	for _, row := range []struct {
+		name  string
+		count domainmodel.NotionMigrationCount
+	}{
+		{"買い物メモ", report.Memos},
+		{"支出", report.Amounts},
+	} {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\n", row.name, row.count.Fetched, row.count.Migrated, row.count.AlreadyMigrated, row.count.Skipped)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "家計簿\tメモ(Notion)\tメモ(移行済み)\t支出(Notion)\t支出(移行済み)\t金額(Notion)\t金額(移行済み)\t結果")
+	for _, houseHold := range report.HouseHolds {
+		result := "OK"
+		if !houseHold.Matched() {
+			result = "不一致"
+		}
+		fmt.Fprintf(w, "%d\t%d\t%d\t%d\t%d\t%d\t%d\t%s\n",
+			houseHold.HouseHoldID,
+			houseHold.Notion.MemoCount, houseHold.Migrated.MemoCount,
+			houseHold.Notion.AmountCount, houseHold.Migrated.AmountCount,
+			houseHold.Notion.Amount, houseHold.Migrated.Amount,
+			result,
+		)
+	}
+
+	if len(report.CreatedCategories) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "作成したカテゴリ")
+		for _, category := range report.CreatedCategories {
+			fmt.Fprintf(w, "  家計簿 %d\t%s\n", category.HouseHoldID, category.Name)
+		}
+	}
+
+	if len(report.UnmappedUsers) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "移行先が見つからない tempUserID")
+		for _, user := range report.UnmappedUsers {
+			fmt.Fprintf(w, "  %s\t%d ページ\t%s\n", user.TempUserID, user.Pages, user.Reason)
+		}
+	}
+
+	if len(report.Failures) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "移行できなかったページ")
+		for _, failure := range report.Failures {
+			fmt.Fprintf(w, "  %s\t%s\t%s\n", failure.PageID, failure.Source, failure.Reason)
+		}
+	}
+}
@@ -4,6 +4,10 @@ import (
 	"echo-household-budget/internal/shared/errors"
 	"fmt"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"golang.org/x/oauth2"
 	"gorm.io/driver/postgres"
@@ -87,9 +91,14 @@ type AppConfig struct {
 	NotionAPIKey                         string
 	NotionKaimemoDatabaseInputID         string
 	NotionKaimemoDatabaseSummaryRecordID string
+	KaimemoRepository                    string
 	AllowOrigins                         []string
 	LINEConfig                           *oauth2.Config
 	LINELoginFrontendCallbackURL         string
+	InvitationURL                        string
+	BudgetAlertThresholds                []int
+	TrashRetention                       time.Duration
+	ReceiptAnalyzeWorkerToken            string
 	DatabaseConfig                       *DatabaseConfig
 	S3Config                             *S3Config
 }
@@ -134,14 +143,57 @@ func LoadConfig() *AppConfig {
 		NotionAPIKey:                         getEnvWithDefault("NOTION_API_KEY", ""),
 		NotionKaimemoDatabaseInputID:         getEnvWithDefault("NOTION_KAIMEMO_DB_INPUT_ID", ""),
 		NotionKaimemoDatabaseSummaryRecordID: getEnvWithDefault("NOTION_KAIMEMO_DB_SUMMARY_ID", ""),
+		KaimemoRepository:                    getEnvWithDefault("KAIMEMO_REPOSITORY", KaimemoRepositoryNotion),
 		AllowOrigins:                         []string{os.Getenv("ALLOW_ORIGINS"), "https://access.line.me/oauth2/v2.1/authorize"},
 		LINEConfig:                           lineConfig,
 		LINELoginFrontendCallbackURL:         os.Getenv("LINE_LOGIN_FRONTEND_CALLBACK_URL"),
+		InvitationURL:                        os.Getenv("INVITATION_URL"),
+		BudgetAlertThresholds:                parseBudgetAlertThresholds(getEnvWithDefault("BUDGET_ALERT_THRESHOLDS", defaultBudgetAlertThresholds)),
+		TrashRetention:                       parseTrashRetention(getEnvWithDefault("TRASH_RETENTION_DAYS", defaultTrashRetentionDays)),
+		ReceiptAnalyzeWorkerToken:            os.Getenv("RECEIPT_ANALYZE_WORKER_TOKEN"),
 		DatabaseConfig:                       dbConfig,
 		S3Config:                             s3Config,
 	}
 }
 
+// 買い物メモの保存先
+const (
+	KaimemoRepositoryNotion   = "notion"
+	KaimemoRepositoryPostgres = "postgres"
+)
+
+// defaultBudgetAlertThresholds は予算アラートを通知する消化率（%）の既定値
+// 予算の80%と100%に達した時点で通知する
+const defaultBudgetAlertThresholds = "80,100"
+
+// parseBudgetAlertThresholds はカンマ区切りの消化率を昇順で返す。正の整数以外は無視する
+func parseBudgetAlertThresholds(value string) []int {
+	thresholds := []int{}
+	seen := map[int]bool{}
+	for _, v := range strings.Split(value, ",") {
+		threshold, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil || threshold <= 0 || seen[threshold] {
+			continue
+		}
+		seen[threshold] = true
+		thresholds = append(thresholds, threshold)
+	}
+	sort.Ints(thresholds)
+	return thresholds
+}
+
+// defaultTrashRetentionDays はゴミ箱に移した記録を物理削除するまでの日数の既定値
+const defaultTrashRetentionDays = "30"
+
+// parseTrashRetention はゴミ箱の保持日数を期間に変換する。正の整数以外は既定値とする
+func parseTrashRetention(value string) time.Duration {
+	days, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || days <= 0 {
+		days, _ = strconv.Atoi(defaultTrashRetentionDays)
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
 func getEnvWithDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
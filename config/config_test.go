@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -49,6 +50,7 @@ func TestLoadConfig_Success(t *testing.T) {
 	assert.Equal(t, "test-api-key", config.NotionAPIKey)
 	assert.Equal(t, "test-database-input-id", config.NotionKaimemoDatabaseInputID)
 	assert.Equal(t, "test-database-summary-id", config.NotionKaimemoDatabaseSummaryRecordID)
+	assert.Equal(t, KaimemoRepositoryNotion, config.KaimemoRepository)
 	assert.Contains(t, config.AllowOrigins, "https://example.com")
 
 	// LINE設定の検証
@@ -161,3 +163,25 @@ func TestNewDBConnection(t *testing.T) {
 		})
 	}
 }
+
+func TestParseBudgetAlertThresholds(t *testing.T) {
+	assert.Equal(t, []int{80, 100}, parseBudgetAlertThresholds("80,100"))
+	assert.Equal(t, []int{50, 90, 120}, parseBudgetAlertThresholds(" 120, 50 ,90,90"))
+	assert.Equal(t, []int{100}, parseBudgetAlertThresholds("abc,0,-10,100"))
+	assert.Equal(t, []int{}, parseBudgetAlertThresholds(""))
+}
+
+func TestLoadConfig_KaimemoRepository(t *testing.T) {
+	setEnv("KAIMEMO_REPOSITORY", "postgres")
+	defer unsetEnv("KAIMEMO_REPOSITORY")
+
+	config := LoadConfig()
+	assert.Equal(t, KaimemoRepositoryPostgres, config.KaimemoRepository)
+}
+
+func TestParseTrashRetention(t *testing.T) {
+	assert.Equal(t, 30*24*time.Hour, parseTrashRetention(defaultTrashRetentionDays))
+	assert.Equal(t, 7*24*time.Hour, parseTrashRetention(" 7 "))
+	assert.Equal(t, 30*24*time.Hour, parseTrashRetention("0"))
+	assert.Equal(t, 30*24*time.Hour, parseTrashRetention("abc"))
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: backup.go
+//
+// Generated by this command:
+//
+//	mockgen -source=backup.go -destination=../mock/domainmodel/mock_backup.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBackupRepository is a mock of BackupRepository interface.
+type MockBackupRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackupRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBackupRepositoryMockRecorder is the mock recorder for MockBackupRepository.
+type MockBackupRepositoryMockRecorder struct {
+	mock *MockBackupRepository
+}
+
+// NewMockBackupRepository creates a new mock instance.
+func NewMockBackupRepository(ctrl *gomock.Controller) *MockBackupRepository {
+	mock := &MockBackupRepository{ctrl: ctrl}
+	mock.recorder = &MockBackupRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBackupRepository) EXPECT() *MockBackupRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindHouseHoldBackup mocks base method.
+func (m *MockBackupRepository) FindHouseHoldBackup(houseHoldID domainmodel.HouseHoldID) (*domainmodel.HouseHoldBackup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindHouseHoldBackup", houseHoldID)
+	ret0, _ := ret[0].(*domainmodel.HouseHoldBackup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindHouseHoldBackup indicates an expected call of FindHouseHoldBackup.
+func (mr *MockBackupRepositoryMockRecorder) FindHouseHoldBackup(houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindHouseHoldBackup", reflect.TypeOf((*MockBackupRepository)(nil).FindHouseHoldBackup), houseHoldID)
+}
+
+// RestoreHouseHoldBackup mocks base method.
+func (m *MockBackupRepository) RestoreHouseHoldBackup(backup *domainmodel.HouseHoldBackup, input *domainmodel.RestoreBackupInput, images map[string]string) (*domainmodel.RestoreBackupResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreHouseHoldBackup", backup, input, images)
+	ret0, _ := ret[0].(*domainmodel.RestoreBackupResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreHouseHoldBackup indicates an expected call of RestoreHouseHoldBackup.
+func (mr *MockBackupRepositoryMockRecorder) RestoreHouseHoldBackup(backup, input, images any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreHouseHoldBackup", reflect.TypeOf((*MockBackupRepository)(nil).RestoreHouseHoldBackup), backup, input, images)
+}
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: budget.go
+//
+// Generated by this command:
+//
+//	mockgen -source=budget.go -destination=../mock/domainmodel/mock_budget.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMonthlyBudgetRepository is a mock of MonthlyBudgetRepository interface.
+type MockMonthlyBudgetRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMonthlyBudgetRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMonthlyBudgetRepositoryMockRecorder is the mock recorder for MockMonthlyBudgetRepository.
+type MockMonthlyBudgetRepositoryMockRecorder struct {
+	mock *MockMonthlyBudgetRepository
+}
+
+// NewMockMonthlyBudgetRepository creates a new mock instance.
+func NewMockMonthlyBudgetRepository(ctrl *gomock.Controller) *MockMonthlyBudgetRepository {
+	mock := &MockMonthlyBudgetRepository{ctrl: ctrl}
+	mock.recorder = &MockMonthlyBudgetRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMonthlyBudgetRepository) EXPECT() *MockMonthlyBudgetRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateMonthlyBudgets mocks base method.
+func (m *MockMonthlyBudgetRepository) CreateMonthlyBudgets(budgets []*domainmodel.MonthlyBudget) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMonthlyBudgets", budgets)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMonthlyBudgets indicates an expected call of CreateMonthlyBudgets.
+func (mr *MockMonthlyBudgetRepositoryMockRecorder) CreateMonthlyBudgets(budgets any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMonthlyBudgets", reflect.TypeOf((*MockMonthlyBudgetRepository)(nil).CreateMonthlyBudgets), budgets)
+}
+
+// FindByHouseHoldID mocks base method.
+func (m *MockMonthlyBudgetRepository) FindByHouseHoldID(houseHoldID domainmodel.HouseHoldID, untilMonth string) ([]*domainmodel.MonthlyBudget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHouseHoldID", houseHoldID, untilMonth)
+	ret0, _ := ret[0].([]*domainmodel.MonthlyBudget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHouseHoldID indicates an expected call of FindByHouseHoldID.
+func (mr *MockMonthlyBudgetRepositoryMockRecorder) FindByHouseHoldID(houseHoldID, untilMonth any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHouseHoldID", reflect.TypeOf((*MockMonthlyBudgetRepository)(nil).FindByHouseHoldID), houseHoldID, untilMonth)
+}
+
+// SaveMonthlyBudget mocks base method.
+func (m *MockMonthlyBudgetRepository) SaveMonthlyBudget(budget *domainmodel.MonthlyBudget) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveMonthlyBudget", budget)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveMonthlyBudget indicates an expected call of SaveMonthlyBudget.
+func (mr *MockMonthlyBudgetRepositoryMockRecorder) SaveMonthlyBudget(budget any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMonthlyBudget", reflect.TypeOf((*MockMonthlyBudgetRepository)(nil).SaveMonthlyBudget), budget)
+}
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: budget_alert.go
+//
+// Generated by this command:
+//
+//	mockgen -source=budget_alert.go -destination=../mock/domainmodel/mock_budget_alert.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBudgetAlertRepository is a mock of BudgetAlertRepository interface.
+type MockBudgetAlertRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBudgetAlertRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBudgetAlertRepositoryMockRecorder is the mock recorder for MockBudgetAlertRepository.
+type MockBudgetAlertRepositoryMockRecorder struct {
+	mock *MockBudgetAlertRepository
+}
+
+// NewMockBudgetAlertRepository creates a new mock instance.
+func NewMockBudgetAlertRepository(ctrl *gomock.Controller) *MockBudgetAlertRepository {
+	mock := &MockBudgetAlertRepository{ctrl: ctrl}
+	mock.recorder = &MockBudgetAlertRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBudgetAlertRepository) EXPECT() *MockBudgetAlertRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateIfNotExists mocks base method.
+func (m *MockBudgetAlertRepository) CreateIfNotExists(alert *domainmodel.BudgetAlert) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIfNotExists", alert)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIfNotExists indicates an expected call of CreateIfNotExists.
+func (mr *MockBudgetAlertRepositoryMockRecorder) CreateIfNotExists(alert any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIfNotExists", reflect.TypeOf((*MockBudgetAlertRepository)(nil).CreateIfNotExists), alert)
+}
+
+// MockBudgetAlertNotifier is a mock of BudgetAlertNotifier interface.
+type MockBudgetAlertNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockBudgetAlertNotifierMockRecorder
+	isgomock struct{}
+}
+
+// MockBudgetAlertNotifierMockRecorder is the mock recorder for MockBudgetAlertNotifier.
+type MockBudgetAlertNotifierMockRecorder struct {
+	mock *MockBudgetAlertNotifier
+}
+
+// NewMockBudgetAlertNotifier creates a new mock instance.
+func NewMockBudgetAlertNotifier(ctrl *gomock.Controller) *MockBudgetAlertNotifier {
+	mock := &MockBudgetAlertNotifier{ctrl: ctrl}
+	mock.recorder = &MockBudgetAlertNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBudgetAlertNotifier) EXPECT() *MockBudgetAlertNotifierMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockBudgetAlertNotifier) Notify(alert *domainmodel.BudgetAlert) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", alert)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockBudgetAlertNotifierMockRecorder) Notify(alert any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockBudgetAlertNotifier)(nil).Notify), alert)
+}
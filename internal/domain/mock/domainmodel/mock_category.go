@@ -12,6 +12,7 @@ package mock
 import (
 	domainmodel "echo-household-budget/internal/domain/model"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -40,6 +41,20 @@ func (m *MockCategoryRepository) EXPECT() *MockCategoryRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ArchiveHouseHoldCategory mocks base method.
+func (m *MockCategoryRepository) ArchiveHouseHoldCategory(houseHoldID domainmodel.HouseHoldID, categoryLimitID domainmodel.CategoryLimitID, archivedAt *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveHouseHoldCategory", houseHoldID, categoryLimitID, archivedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveHouseHoldCategory indicates an expected call of ArchiveHouseHoldCategory.
+func (mr *MockCategoryRepositoryMockRecorder) ArchiveHouseHoldCategory(houseHoldID, categoryLimitID, archivedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveHouseHoldCategory", reflect.TypeOf((*MockCategoryRepository)(nil).ArchiveHouseHoldCategory), houseHoldID, categoryLimitID, archivedAt)
+}
+
 // CreateHouseHoldCategory mocks base method.
 func (m *MockCategoryRepository) CreateHouseHoldCategory(categoryLimit *domainmodel.CategoryLimit) error {
 	m.ctrl.T.Helper()
@@ -69,17 +84,17 @@ func (mr *MockCategoryRepositoryMockRecorder) CreateMasterCategory(category any)
 }
 
 // DeleteHouseHoldCategory mocks base method.
-func (m *MockCategoryRepository) DeleteHouseHoldCategory(categoryLimitID domainmodel.CategoryLimitID) error {
+func (m *MockCategoryRepository) DeleteHouseHoldCategory(houseHoldID domainmodel.HouseHoldID, categoryLimitID domainmodel.CategoryLimitID, deletion *domainmodel.Deletion) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteHouseHoldCategory", categoryLimitID)
+	ret := m.ctrl.Call(m, "DeleteHouseHoldCategory", houseHoldID, categoryLimitID, deletion)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteHouseHoldCategory indicates an expected call of DeleteHouseHoldCategory.
-func (mr *MockCategoryRepositoryMockRecorder) DeleteHouseHoldCategory(categoryLimitID any) *gomock.Call {
+func (mr *MockCategoryRepositoryMockRecorder) DeleteHouseHoldCategory(houseHoldID, categoryLimitID, deletion any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHouseHoldCategory", reflect.TypeOf((*MockCategoryRepository)(nil).DeleteHouseHoldCategory), categoryLimitID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHouseHoldCategory", reflect.TypeOf((*MockCategoryRepository)(nil).DeleteHouseHoldCategory), houseHoldID, categoryLimitID, deletion)
 }
 
 // DeleteMasterCategory mocks base method.
@@ -96,6 +111,21 @@ func (mr *MockCategoryRepositoryMockRecorder) DeleteMasterCategory(id any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMasterCategory", reflect.TypeOf((*MockCategoryRepository)(nil).DeleteMasterCategory), id)
 }
 
+// FindHouseHoldCategories mocks base method.
+func (m *MockCategoryRepository) FindHouseHoldCategories(houseHoldID domainmodel.HouseHoldID, includeArchived bool) ([]*domainmodel.CategoryLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindHouseHoldCategories", houseHoldID, includeArchived)
+	ret0, _ := ret[0].([]*domainmodel.CategoryLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindHouseHoldCategories indicates an expected call of FindHouseHoldCategories.
+func (mr *MockCategoryRepositoryMockRecorder) FindHouseHoldCategories(houseHoldID, includeArchived any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindHouseHoldCategories", reflect.TypeOf((*MockCategoryRepository)(nil).FindHouseHoldCategories), houseHoldID, includeArchived)
+}
+
 // FindHouseHoldCategoryByHouseHoldID mocks base method.
 func (m *MockCategoryRepository) FindHouseHoldCategoryByHouseHoldID(categoryLimitID domainmodel.CategoryLimitID) (*domainmodel.CategoryLimit, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +170,20 @@ func (mr *MockCategoryRepositoryMockRecorder) UpdateHouseHoldCategory(categoryLi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHouseHoldCategory", reflect.TypeOf((*MockCategoryRepository)(nil).UpdateHouseHoldCategory), categoryLimit)
 }
 
+// UpdateHouseHoldCategorySortOrders mocks base method.
+func (m *MockCategoryRepository) UpdateHouseHoldCategorySortOrders(houseHoldID domainmodel.HouseHoldID, categoryLimitIDs []domainmodel.CategoryLimitID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHouseHoldCategorySortOrders", houseHoldID, categoryLimitIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateHouseHoldCategorySortOrders indicates an expected call of UpdateHouseHoldCategorySortOrders.
+func (mr *MockCategoryRepositoryMockRecorder) UpdateHouseHoldCategorySortOrders(houseHoldID, categoryLimitIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHouseHoldCategorySortOrders", reflect.TypeOf((*MockCategoryRepository)(nil).UpdateHouseHoldCategorySortOrders), houseHoldID, categoryLimitIDs)
+}
+
 // UpdateMasterCategory mocks base method.
 func (m *MockCategoryRepository) UpdateMasterCategory(category *domainmodel.Category) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: exchange_rate.go
+//
+// Generated by this command:
+//
+//	mockgen -source=exchange_rate.go -destination=../mock/domainmodel/mock_exchange_rate.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExchangeRateRepository is a mock of ExchangeRateRepository interface.
+type MockExchangeRateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRateRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockExchangeRateRepositoryMockRecorder is the mock recorder for MockExchangeRateRepository.
+type MockExchangeRateRepositoryMockRecorder struct {
+	mock *MockExchangeRateRepository
+}
+
+// NewMockExchangeRateRepository creates a new mock instance.
+func NewMockExchangeRateRepository(ctrl *gomock.Controller) *MockExchangeRateRepository {
+	mock := &MockExchangeRateRepository{ctrl: ctrl}
+	mock.recorder = &MockExchangeRateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRateRepository) EXPECT() *MockExchangeRateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteExchangeRate mocks base method.
+func (m *MockExchangeRateRepository) DeleteExchangeRate(houseHoldID domainmodel.HouseHoldID, id domainmodel.ExchangeRateID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExchangeRate", houseHoldID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExchangeRate indicates an expected call of DeleteExchangeRate.
+func (mr *MockExchangeRateRepositoryMockRecorder) DeleteExchangeRate(houseHoldID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExchangeRate", reflect.TypeOf((*MockExchangeRateRepository)(nil).DeleteExchangeRate), houseHoldID, id)
+}
+
+// FindEffectiveExchangeRate mocks base method.
+func (m *MockExchangeRateRepository) FindEffectiveExchangeRate(houseHoldID domainmodel.HouseHoldID, currency domainmodel.Currency, date string) (*domainmodel.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEffectiveExchangeRate", houseHoldID, currency, date)
+	ret0, _ := ret[0].(*domainmodel.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEffectiveExchangeRate indicates an expected call of FindEffectiveExchangeRate.
+func (mr *MockExchangeRateRepositoryMockRecorder) FindEffectiveExchangeRate(houseHoldID, currency, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEffectiveExchangeRate", reflect.TypeOf((*MockExchangeRateRepository)(nil).FindEffectiveExchangeRate), houseHoldID, currency, date)
+}
+
+// FindExchangeRates mocks base method.
+func (m *MockExchangeRateRepository) FindExchangeRates(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExchangeRates", houseHoldID)
+	ret0, _ := ret[0].([]*domainmodel.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExchangeRates indicates an expected call of FindExchangeRates.
+func (mr *MockExchangeRateRepositoryMockRecorder) FindExchangeRates(houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExchangeRates", reflect.TypeOf((*MockExchangeRateRepository)(nil).FindExchangeRates), houseHoldID)
+}
+
+// SaveExchangeRate mocks base method.
+func (m *MockExchangeRateRepository) SaveExchangeRate(rate *domainmodel.ExchangeRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveExchangeRate", rate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveExchangeRate indicates an expected call of SaveExchangeRate.
+func (mr *MockExchangeRateRepositoryMockRecorder) SaveExchangeRate(rate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveExchangeRate", reflect.TypeOf((*MockExchangeRateRepository)(nil).SaveExchangeRate), rate)
+}
@@ -69,11 +69,12 @@ func (mr *MockHouseHoldRepositoryMockRecorder) CreateUserHouseHold(userHouseHold
 }
 
 // Delete mocks base method.
-func (m *MockHouseHoldRepository) Delete(houseHoldID domainmodel.HouseHoldID) error {
+func (m *MockHouseHoldRepository) Delete(houseHoldID domainmodel.HouseHoldID) ([]string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Delete", houseHoldID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Delete indicates an expected call of Delete.
@@ -82,6 +83,20 @@ func (mr *MockHouseHoldRepositoryMockRecorder) Delete(houseHoldID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockHouseHoldRepository)(nil).Delete), houseHoldID)
 }
 
+// DeleteUserHouseHold mocks base method.
+func (m *MockHouseHoldRepository) DeleteUserHouseHold(houseHoldID domainmodel.HouseHoldID, userID domainmodel.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserHouseHold", houseHoldID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserHouseHold indicates an expected call of DeleteUserHouseHold.
+func (mr *MockHouseHoldRepositoryMockRecorder) DeleteUserHouseHold(houseHoldID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserHouseHold", reflect.TypeOf((*MockHouseHoldRepository)(nil).DeleteUserHouseHold), houseHoldID, userID)
+}
+
 // FindByHouseHoldID mocks base method.
 func (m *MockHouseHoldRepository) FindByHouseHoldID(houseHoldID domainmodel.HouseHoldID) (*domainmodel.HouseHold, error) {
 	m.ctrl.T.Helper()
@@ -98,10 +113,10 @@ func (mr *MockHouseHoldRepositoryMockRecorder) FindByHouseHoldID(houseHoldID any
 }
 
 // FindByUserID mocks base method.
-func (m *MockHouseHoldRepository) FindByUserID(userID domainmodel.UserID) (*domainmodel.HouseHold, error) {
+func (m *MockHouseHoldRepository) FindByUserID(userID domainmodel.UserID) ([]*domainmodel.BelongingHouseHold, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FindByUserID", userID)
-	ret0, _ := ret[0].(*domainmodel.HouseHold)
+	ret0, _ := ret[0].([]*domainmodel.BelongingHouseHold)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -112,6 +127,50 @@ func (mr *MockHouseHoldRepositoryMockRecorder) FindByUserID(userID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockHouseHoldRepository)(nil).FindByUserID), userID)
 }
 
+// FindMembers mocks base method.
+func (m *MockHouseHoldRepository) FindMembers(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.HouseHoldMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMembers", houseHoldID)
+	ret0, _ := ret[0].([]*domainmodel.HouseHoldMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMembers indicates an expected call of FindMembers.
+func (mr *MockHouseHoldRepositoryMockRecorder) FindMembers(houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMembers", reflect.TypeOf((*MockHouseHoldRepository)(nil).FindMembers), houseHoldID)
+}
+
+// FindUserHouseHold mocks base method.
+func (m *MockHouseHoldRepository) FindUserHouseHold(userID domainmodel.UserID, houseHoldID domainmodel.HouseHoldID) (*domainmodel.UserHouseHold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUserHouseHold", userID, houseHoldID)
+	ret0, _ := ret[0].(*domainmodel.UserHouseHold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUserHouseHold indicates an expected call of FindUserHouseHold.
+func (mr *MockHouseHoldRepositoryMockRecorder) FindUserHouseHold(userID, houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUserHouseHold", reflect.TypeOf((*MockHouseHoldRepository)(nil).FindUserHouseHold), userID, houseHoldID)
+}
+
+// TransferOwnership mocks base method.
+func (m *MockHouseHoldRepository) TransferOwnership(houseHoldID domainmodel.HouseHoldID, fromUserID, toUserID domainmodel.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferOwnership", houseHoldID, fromUserID, toUserID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferOwnership indicates an expected call of TransferOwnership.
+func (mr *MockHouseHoldRepositoryMockRecorder) TransferOwnership(houseHoldID, fromUserID, toUserID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferOwnership", reflect.TypeOf((*MockHouseHoldRepository)(nil).TransferOwnership), houseHoldID, fromUserID, toUserID)
+}
+
 // Update mocks base method.
 func (m *MockHouseHoldRepository) Update(houseHold *domainmodel.HouseHold) error {
 	m.ctrl.T.Helper()
@@ -125,3 +184,31 @@ func (mr *MockHouseHoldRepositoryMockRecorder) Update(houseHold any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockHouseHoldRepository)(nil).Update), houseHold)
 }
+
+// UpdateBaseCurrency mocks base method.
+func (m *MockHouseHoldRepository) UpdateBaseCurrency(houseHoldID domainmodel.HouseHoldID, currency domainmodel.Currency) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBaseCurrency", houseHoldID, currency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBaseCurrency indicates an expected call of UpdateBaseCurrency.
+func (mr *MockHouseHoldRepositoryMockRecorder) UpdateBaseCurrency(houseHoldID, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBaseCurrency", reflect.TypeOf((*MockHouseHoldRepository)(nil).UpdateBaseCurrency), houseHoldID, currency)
+}
+
+// UpdateUserHouseHoldRole mocks base method.
+func (m *MockHouseHoldRepository) UpdateUserHouseHoldRole(houseHoldID domainmodel.HouseHoldID, userID domainmodel.UserID, role domainmodel.HouseHoldRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserHouseHoldRole", houseHoldID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserHouseHoldRole indicates an expected call of UpdateUserHouseHoldRole.
+func (mr *MockHouseHoldRepositoryMockRecorder) UpdateUserHouseHoldRole(houseHoldID, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserHouseHoldRole", reflect.TypeOf((*MockHouseHoldRepository)(nil).UpdateUserHouseHoldRole), houseHoldID, userID, role)
+}
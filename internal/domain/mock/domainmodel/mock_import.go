@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: import.go
+//
+// Generated by this command:
+//
+//	mockgen -source=import.go -destination=../mock/domainmodel/mock_import.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockImportPresetRepository is a mock of ImportPresetRepository interface.
+type MockImportPresetRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportPresetRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockImportPresetRepositoryMockRecorder is the mock recorder for MockImportPresetRepository.
+type MockImportPresetRepositoryMockRecorder struct {
+	mock *MockImportPresetRepository
+}
+
+// NewMockImportPresetRepository creates a new mock instance.
+func NewMockImportPresetRepository(ctrl *gomock.Controller) *MockImportPresetRepository {
+	mock := &MockImportPresetRepository{ctrl: ctrl}
+	mock.recorder = &MockImportPresetRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportPresetRepository) EXPECT() *MockImportPresetRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockImportPresetRepository) Create(preset *domainmodel.ImportPreset) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", preset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockImportPresetRepositoryMockRecorder) Create(preset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockImportPresetRepository)(nil).Create), preset)
+}
+
+// Delete mocks base method.
+func (m *MockImportPresetRepository) Delete(houseHoldID domainmodel.HouseHoldID, id domainmodel.ImportPresetID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", houseHoldID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockImportPresetRepositoryMockRecorder) Delete(houseHoldID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockImportPresetRepository)(nil).Delete), houseHoldID, id)
+}
+
+// FindByHouseHoldID mocks base method.
+func (m *MockImportPresetRepository) FindByHouseHoldID(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.ImportPreset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHouseHoldID", houseHoldID)
+	ret0, _ := ret[0].([]*domainmodel.ImportPreset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHouseHoldID indicates an expected call of FindByHouseHoldID.
+func (mr *MockImportPresetRepositoryMockRecorder) FindByHouseHoldID(houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHouseHoldID", reflect.TypeOf((*MockImportPresetRepository)(nil).FindByHouseHoldID), houseHoldID)
+}
+
+// FindByID mocks base method.
+func (m *MockImportPresetRepository) FindByID(houseHoldID domainmodel.HouseHoldID, id domainmodel.ImportPresetID) (*domainmodel.ImportPreset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", houseHoldID, id)
+	ret0, _ := ret[0].(*domainmodel.ImportPreset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockImportPresetRepositoryMockRecorder) FindByID(houseHoldID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockImportPresetRepository)(nil).FindByID), houseHoldID, id)
+}
@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: income.go
+//
+// Generated by this command:
+//
+//	mockgen -source=income.go -destination=../mock/domainmodel/mock_income.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIncomeRepository is a mock of IncomeRepository interface.
+type MockIncomeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIncomeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIncomeRepositoryMockRecorder is the mock recorder for MockIncomeRepository.
+type MockIncomeRepositoryMockRecorder struct {
+	mock *MockIncomeRepository
+}
+
+// NewMockIncomeRepository creates a new mock instance.
+func NewMockIncomeRepository(ctrl *gomock.Controller) *MockIncomeRepository {
+	mock := &MockIncomeRepository{ctrl: ctrl}
+	mock.recorder = &MockIncomeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIncomeRepository) EXPECT() *MockIncomeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateIncome mocks base method.
+func (m *MockIncomeRepository) CreateIncome(income *domainmodel.Income) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIncome", income)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateIncome indicates an expected call of CreateIncome.
+func (mr *MockIncomeRepositoryMockRecorder) CreateIncome(income any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIncome", reflect.TypeOf((*MockIncomeRepository)(nil).CreateIncome), income)
+}
+
+// CreateIncomeCategories mocks base method.
+func (m *MockIncomeRepository) CreateIncomeCategories(categories []*domainmodel.IncomeCategory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIncomeCategories", categories)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateIncomeCategories indicates an expected call of CreateIncomeCategories.
+func (mr *MockIncomeRepositoryMockRecorder) CreateIncomeCategories(categories any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIncomeCategories", reflect.TypeOf((*MockIncomeRepository)(nil).CreateIncomeCategories), categories)
+}
+
+// DeleteIncome mocks base method.
+func (m *MockIncomeRepository) DeleteIncome(houseHoldID domainmodel.HouseHoldID, incomeID domainmodel.IncomeID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIncome", houseHoldID, incomeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIncome indicates an expected call of DeleteIncome.
+func (mr *MockIncomeRepositoryMockRecorder) DeleteIncome(houseHoldID, incomeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIncome", reflect.TypeOf((*MockIncomeRepository)(nil).DeleteIncome), houseHoldID, incomeID)
+}
+
+// FindIncomeCategories mocks base method.
+func (m *MockIncomeRepository) FindIncomeCategories(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.IncomeCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIncomeCategories", houseHoldID)
+	ret0, _ := ret[0].([]*domainmodel.IncomeCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIncomeCategories indicates an expected call of FindIncomeCategories.
+func (mr *MockIncomeRepositoryMockRecorder) FindIncomeCategories(houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIncomeCategories", reflect.TypeOf((*MockIncomeRepository)(nil).FindIncomeCategories), houseHoldID)
+}
+
+// FindIncomes mocks base method.
+func (m *MockIncomeRepository) FindIncomes(houseHoldID domainmodel.HouseHoldID, month string) ([]*domainmodel.Income, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIncomes", houseHoldID, month)
+	ret0, _ := ret[0].([]*domainmodel.Income)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIncomes indicates an expected call of FindIncomes.
+func (mr *MockIncomeRepositoryMockRecorder) FindIncomes(houseHoldID, month any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIncomes", reflect.TypeOf((*MockIncomeRepository)(nil).FindIncomes), houseHoldID, month)
+}
+
+// UpdateIncome mocks base method.
+func (m *MockIncomeRepository) UpdateIncome(income *domainmodel.Income) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIncome", income)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIncome indicates an expected call of UpdateIncome.
+func (mr *MockIncomeRepositoryMockRecorder) UpdateIncome(income any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIncome", reflect.TypeOf((*MockIncomeRepository)(nil).UpdateIncome), income)
+}
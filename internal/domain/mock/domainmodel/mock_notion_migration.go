@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notion_migration.go
+//
+// Generated by this command:
+//
+//	mockgen -source=notion_migration.go -destination=../mock/domainmodel/mock_notion_migration.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotionKaimemoSource is a mock of NotionKaimemoSource interface.
+type MockNotionKaimemoSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotionKaimemoSourceMockRecorder
+	isgomock struct{}
+}
+
+// MockNotionKaimemoSourceMockRecorder is the mock recorder for MockNotionKaimemoSource.
+type MockNotionKaimemoSourceMockRecorder struct {
+	mock *MockNotionKaimemoSource
+}
+
+// NewMockNotionKaimemoSource creates a new mock instance.
+func NewMockNotionKaimemoSource(ctrl *gomock.Controller) *MockNotionKaimemoSource {
+	mock := &MockNotionKaimemoSource{ctrl: ctrl}
+	mock.recorder = &MockNotionKaimemoSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotionKaimemoSource) EXPECT() *MockNotionKaimemoSourceMockRecorder {
+	return m.recorder
+}
+
+// FetchKaimemoAmountPages mocks base method.
+func (m *MockNotionKaimemoSource) FetchKaimemoAmountPages(ctx context.Context) ([]*domainmodel.NotionKaimemoAmountPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchKaimemoAmountPages", ctx)
+	ret0, _ := ret[0].([]*domainmodel.NotionKaimemoAmountPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchKaimemoAmountPages indicates an expected call of FetchKaimemoAmountPages.
+func (mr *MockNotionKaimemoSourceMockRecorder) FetchKaimemoAmountPages(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchKaimemoAmountPages", reflect.TypeOf((*MockNotionKaimemoSource)(nil).FetchKaimemoAmountPages), ctx)
+}
+
+// FetchKaimemoPages mocks base method.
+func (m *MockNotionKaimemoSource) FetchKaimemoPages(ctx context.Context) ([]*domainmodel.NotionKaimemoPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchKaimemoPages", ctx)
+	ret0, _ := ret[0].([]*domainmodel.NotionKaimemoPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchKaimemoPages indicates an expected call of FetchKaimemoPages.
+func (mr *MockNotionKaimemoSourceMockRecorder) FetchKaimemoPages(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchKaimemoPages", reflect.TypeOf((*MockNotionKaimemoSource)(nil).FetchKaimemoPages), ctx)
+}
+
+// MockNotionMigrationRepository is a mock of NotionMigrationRepository interface.
+type MockNotionMigrationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotionMigrationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockNotionMigrationRepositoryMockRecorder is the mock recorder for MockNotionMigrationRepository.
+type MockNotionMigrationRepositoryMockRecorder struct {
+	mock *MockNotionMigrationRepository
+}
+
+// NewMockNotionMigrationRepository creates a new mock instance.
+func NewMockNotionMigrationRepository(ctrl *gomock.Controller) *MockNotionMigrationRepository {
+	mock := &MockNotionMigrationRepository{ctrl: ctrl}
+	mock.recorder = &MockNotionMigrationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotionMigrationRepository) EXPECT() *MockNotionMigrationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindMigratedPageIDs mocks base method.
+func (m *MockNotionMigrationRepository) FindMigratedPageIDs() (map[string]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMigratedPageIDs")
+	ret0, _ := ret[0].(map[string]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMigratedPageIDs indicates an expected call of FindMigratedPageIDs.
+func (mr *MockNotionMigrationRepositoryMockRecorder) FindMigratedPageIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMigratedPageIDs", reflect.TypeOf((*MockNotionMigrationRepository)(nil).FindMigratedPageIDs))
+}
+
+// RegisterMigratedShoppingAmount mocks base method.
+func (m *MockNotionMigrationRepository) RegisterMigratedShoppingAmount(pageID string, amount *domainmodel.ShoppingAmount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterMigratedShoppingAmount", pageID, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterMigratedShoppingAmount indicates an expected call of RegisterMigratedShoppingAmount.
+func (mr *MockNotionMigrationRepositoryMockRecorder) RegisterMigratedShoppingAmount(pageID, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterMigratedShoppingAmount", reflect.TypeOf((*MockNotionMigrationRepository)(nil).RegisterMigratedShoppingAmount), pageID, amount)
+}
+
+// RegisterMigratedShoppingMemo mocks base method.
+func (m *MockNotionMigrationRepository) RegisterMigratedShoppingMemo(pageID string, memo *domainmodel.ShoppingMemo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterMigratedShoppingMemo", pageID, memo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterMigratedShoppingMemo indicates an expected call of RegisterMigratedShoppingMemo.
+func (mr *MockNotionMigrationRepositoryMockRecorder) RegisterMigratedShoppingMemo(pageID, memo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterMigratedShoppingMemo", reflect.TypeOf((*MockNotionMigrationRepository)(nil).RegisterMigratedShoppingMemo), pageID, memo)
+}
+
+// SaveKaimemoUserMapping mocks base method.
+func (m *MockNotionMigrationRepository) SaveKaimemoUserMapping(mapping *domainmodel.KaimemoUserMapping) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveKaimemoUserMapping", mapping)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveKaimemoUserMapping indicates an expected call of SaveKaimemoUserMapping.
+func (mr *MockNotionMigrationRepositoryMockRecorder) SaveKaimemoUserMapping(mapping any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveKaimemoUserMapping", reflect.TypeOf((*MockNotionMigrationRepository)(nil).SaveKaimemoUserMapping), mapping)
+}
+
+// SummarizeMigratedRecords mocks base method.
+func (m *MockNotionMigrationRepository) SummarizeMigratedRecords(houseHoldID domainmodel.HouseHoldID) (*domainmodel.NotionMigrationTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummarizeMigratedRecords", houseHoldID)
+	ret0, _ := ret[0].(*domainmodel.NotionMigrationTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummarizeMigratedRecords indicates an expected call of SummarizeMigratedRecords.
+func (mr *MockNotionMigrationRepositoryMockRecorder) SummarizeMigratedRecords(houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummarizeMigratedRecords", reflect.TypeOf((*MockNotionMigrationRepository)(nil).SummarizeMigratedRecords), houseHoldID)
+}
@@ -0,0 +1,213 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notion_sync.go
+//
+// Generated by this command:
+//
+//	mockgen -source=notion_sync.go -destination=../mock/domainmodel/mock_notion_sync.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotionSyncRepository is a mock of NotionSyncRepository interface.
+type MockNotionSyncRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotionSyncRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockNotionSyncRepositoryMockRecorder is the mock recorder for MockNotionSyncRepository.
+type MockNotionSyncRepositoryMockRecorder struct {
+	mock *MockNotionSyncRepository
+}
+
+// NewMockNotionSyncRepository creates a new mock instance.
+func NewMockNotionSyncRepository(ctrl *gomock.Controller) *MockNotionSyncRepository {
+	mock := &MockNotionSyncRepository{ctrl: ctrl}
+	mock.recorder = &MockNotionSyncRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotionSyncRepository) EXPECT() *MockNotionSyncRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ApplyNotionSync mocks base method.
+func (m *MockNotionSyncRepository) ApplyNotionSync(houseHoldID domainmodel.HouseHoldID, plan *domainmodel.NotionSyncPlan, syncedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyNotionSync", houseHoldID, plan, syncedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyNotionSync indicates an expected call of ApplyNotionSync.
+func (mr *MockNotionSyncRepositoryMockRecorder) ApplyNotionSync(houseHoldID, plan, syncedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyNotionSync", reflect.TypeOf((*MockNotionSyncRepository)(nil).ApplyNotionSync), houseHoldID, plan, syncedAt)
+}
+
+// FindEnabledNotionSyncSettings mocks base method.
+func (m *MockNotionSyncRepository) FindEnabledNotionSyncSettings() ([]*domainmodel.NotionSyncSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEnabledNotionSyncSettings")
+	ret0, _ := ret[0].([]*domainmodel.NotionSyncSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEnabledNotionSyncSettings indicates an expected call of FindEnabledNotionSyncSettings.
+func (mr *MockNotionSyncRepositoryMockRecorder) FindEnabledNotionSyncSettings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEnabledNotionSyncSettings", reflect.TypeOf((*MockNotionSyncRepository)(nil).FindEnabledNotionSyncSettings))
+}
+
+// FindNotionSyncLinks mocks base method.
+func (m *MockNotionSyncRepository) FindNotionSyncLinks(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.NotionSyncLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNotionSyncLinks", houseHoldID)
+	ret0, _ := ret[0].([]*domainmodel.NotionSyncLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNotionSyncLinks indicates an expected call of FindNotionSyncLinks.
+func (mr *MockNotionSyncRepositoryMockRecorder) FindNotionSyncLinks(houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNotionSyncLinks", reflect.TypeOf((*MockNotionSyncRepository)(nil).FindNotionSyncLinks), houseHoldID)
+}
+
+// FindNotionSyncRecords mocks base method.
+func (m *MockNotionSyncRepository) FindNotionSyncRecords(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.NotionSyncRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNotionSyncRecords", houseHoldID)
+	ret0, _ := ret[0].([]*domainmodel.NotionSyncRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNotionSyncRecords indicates an expected call of FindNotionSyncRecords.
+func (mr *MockNotionSyncRepositoryMockRecorder) FindNotionSyncRecords(houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNotionSyncRecords", reflect.TypeOf((*MockNotionSyncRepository)(nil).FindNotionSyncRecords), houseHoldID)
+}
+
+// FindNotionSyncSetting mocks base method.
+func (m *MockNotionSyncRepository) FindNotionSyncSetting(houseHoldID domainmodel.HouseHoldID) (*domainmodel.NotionSyncSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNotionSyncSetting", houseHoldID)
+	ret0, _ := ret[0].(*domainmodel.NotionSyncSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNotionSyncSetting indicates an expected call of FindNotionSyncSetting.
+func (mr *MockNotionSyncRepositoryMockRecorder) FindNotionSyncSetting(houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNotionSyncSetting", reflect.TypeOf((*MockNotionSyncRepository)(nil).FindNotionSyncSetting), houseHoldID)
+}
+
+// SaveNotionSyncSetting mocks base method.
+func (m *MockNotionSyncRepository) SaveNotionSyncSetting(setting *domainmodel.NotionSyncSetting) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveNotionSyncSetting", setting)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveNotionSyncSetting indicates an expected call of SaveNotionSyncSetting.
+func (mr *MockNotionSyncRepositoryMockRecorder) SaveNotionSyncSetting(setting any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveNotionSyncSetting", reflect.TypeOf((*MockNotionSyncRepository)(nil).SaveNotionSyncSetting), setting)
+}
+
+// MockNotionSyncClient is a mock of NotionSyncClient interface.
+type MockNotionSyncClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotionSyncClientMockRecorder
+	isgomock struct{}
+}
+
+// MockNotionSyncClientMockRecorder is the mock recorder for MockNotionSyncClient.
+type MockNotionSyncClientMockRecorder struct {
+	mock *MockNotionSyncClient
+}
+
+// NewMockNotionSyncClient creates a new mock instance.
+func NewMockNotionSyncClient(ctrl *gomock.Controller) *MockNotionSyncClient {
+	mock := &MockNotionSyncClient{ctrl: ctrl}
+	mock.recorder = &MockNotionSyncClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotionSyncClient) EXPECT() *MockNotionSyncClientMockRecorder {
+	return m.recorder
+}
+
+// ArchivePage mocks base method.
+func (m *MockNotionSyncClient) ArchivePage(ctx context.Context, pageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchivePage", ctx, pageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchivePage indicates an expected call of ArchivePage.
+func (mr *MockNotionSyncClientMockRecorder) ArchivePage(ctx, pageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchivePage", reflect.TypeOf((*MockNotionSyncClient)(nil).ArchivePage), ctx, pageID)
+}
+
+// CreatePage mocks base method.
+func (m *MockNotionSyncClient) CreatePage(ctx context.Context, databaseID string, values domainmodel.NotionSyncValues) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePage", ctx, databaseID, values)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePage indicates an expected call of CreatePage.
+func (mr *MockNotionSyncClientMockRecorder) CreatePage(ctx, databaseID, values any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePage", reflect.TypeOf((*MockNotionSyncClient)(nil).CreatePage), ctx, databaseID, values)
+}
+
+// FetchPages mocks base method.
+func (m *MockNotionSyncClient) FetchPages(ctx context.Context, databaseID string) ([]*domainmodel.NotionSyncPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchPages", ctx, databaseID)
+	ret0, _ := ret[0].([]*domainmodel.NotionSyncPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchPages indicates an expected call of FetchPages.
+func (mr *MockNotionSyncClientMockRecorder) FetchPages(ctx, databaseID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchPages", reflect.TypeOf((*MockNotionSyncClient)(nil).FetchPages), ctx, databaseID)
+}
+
+// UpdatePage mocks base method.
+func (m *MockNotionSyncClient) UpdatePage(ctx context.Context, pageID string, values domainmodel.NotionSyncValues) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePage", ctx, pageID, values)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePage indicates an expected call of UpdatePage.
+func (mr *MockNotionSyncClientMockRecorder) UpdatePage(ctx, pageID, values any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePage", reflect.TypeOf((*MockNotionSyncClient)(nil).UpdatePage), ctx, pageID, values)
+}
@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_method.go
+//
+// Generated by this command:
+//
+//	mockgen -source=payment_method.go -destination=../mock/domainmodel/mock_payment_method.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentMethodRepository is a mock of PaymentMethodRepository interface.
+type MockPaymentMethodRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentMethodRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentMethodRepositoryMockRecorder is the mock recorder for MockPaymentMethodRepository.
+type MockPaymentMethodRepositoryMockRecorder struct {
+	mock *MockPaymentMethodRepository
+}
+
+// NewMockPaymentMethodRepository creates a new mock instance.
+func NewMockPaymentMethodRepository(ctrl *gomock.Controller) *MockPaymentMethodRepository {
+	mock := &MockPaymentMethodRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentMethodRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentMethodRepository) EXPECT() *MockPaymentMethodRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Archive mocks base method.
+func (m *MockPaymentMethodRepository) Archive(houseHoldID domainmodel.HouseHoldID, id domainmodel.PaymentMethodID, archivedAt *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", houseHoldID, id, archivedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockPaymentMethodRepositoryMockRecorder) Archive(houseHoldID, id, archivedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockPaymentMethodRepository)(nil).Archive), houseHoldID, id, archivedAt)
+}
+
+// Create mocks base method.
+func (m *MockPaymentMethodRepository) Create(method *domainmodel.PaymentMethod) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", method)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPaymentMethodRepositoryMockRecorder) Create(method any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPaymentMethodRepository)(nil).Create), method)
+}
+
+// FindByHouseHoldID mocks base method.
+func (m *MockPaymentMethodRepository) FindByHouseHoldID(houseHoldID domainmodel.HouseHoldID, includeArchived bool) ([]*domainmodel.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHouseHoldID", houseHoldID, includeArchived)
+	ret0, _ := ret[0].([]*domainmodel.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHouseHoldID indicates an expected call of FindByHouseHoldID.
+func (mr *MockPaymentMethodRepositoryMockRecorder) FindByHouseHoldID(houseHoldID, includeArchived any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHouseHoldID", reflect.TypeOf((*MockPaymentMethodRepository)(nil).FindByHouseHoldID), houseHoldID, includeArchived)
+}
+
+// FindByID mocks base method.
+func (m *MockPaymentMethodRepository) FindByID(houseHoldID domainmodel.HouseHoldID, id domainmodel.PaymentMethodID) (*domainmodel.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", houseHoldID, id)
+	ret0, _ := ret[0].(*domainmodel.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPaymentMethodRepositoryMockRecorder) FindByID(houseHoldID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPaymentMethodRepository)(nil).FindByID), houseHoldID, id)
+}
+
+// FindTransactions mocks base method.
+func (m *MockPaymentMethodRepository) FindTransactions(houseHoldID domainmodel.HouseHoldID, id domainmodel.PaymentMethodID, from, to string) ([]*domainmodel.PaymentMethodTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTransactions", houseHoldID, id, from, to)
+	ret0, _ := ret[0].([]*domainmodel.PaymentMethodTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTransactions indicates an expected call of FindTransactions.
+func (mr *MockPaymentMethodRepositoryMockRecorder) FindTransactions(houseHoldID, id, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTransactions", reflect.TypeOf((*MockPaymentMethodRepository)(nil).FindTransactions), houseHoldID, id, from, to)
+}
+
+// SummarizeDailyAmounts mocks base method.
+func (m *MockPaymentMethodRepository) SummarizeDailyAmounts(houseHoldID domainmodel.HouseHoldID, until string) ([]*domainmodel.PaymentMethodDailyAmount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummarizeDailyAmounts", houseHoldID, until)
+	ret0, _ := ret[0].([]*domainmodel.PaymentMethodDailyAmount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummarizeDailyAmounts indicates an expected call of SummarizeDailyAmounts.
+func (mr *MockPaymentMethodRepositoryMockRecorder) SummarizeDailyAmounts(houseHoldID, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummarizeDailyAmounts", reflect.TypeOf((*MockPaymentMethodRepository)(nil).SummarizeDailyAmounts), houseHoldID, until)
+}
+
+// Update mocks base method.
+func (m *MockPaymentMethodRepository) Update(method *domainmodel.PaymentMethod) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", method)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPaymentMethodRepositoryMockRecorder) Update(method any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPaymentMethodRepository)(nil).Update), method)
+}
@@ -0,0 +1,172 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: recurring_transaction.go
+//
+// Generated by this command:
+//
+//	mockgen -source=recurring_transaction.go -destination=../mock/domainmodel/mock_recurring_transaction.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRecurringTransactionRepository is a mock of RecurringTransactionRepository interface.
+type MockRecurringTransactionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecurringTransactionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRecurringTransactionRepositoryMockRecorder is the mock recorder for MockRecurringTransactionRepository.
+type MockRecurringTransactionRepositoryMockRecorder struct {
+	mock *MockRecurringTransactionRepository
+}
+
+// NewMockRecurringTransactionRepository creates a new mock instance.
+func NewMockRecurringTransactionRepository(ctrl *gomock.Controller) *MockRecurringTransactionRepository {
+	mock := &MockRecurringTransactionRepository{ctrl: ctrl}
+	mock.recorder = &MockRecurringTransactionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecurringTransactionRepository) EXPECT() *MockRecurringTransactionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AdvanceMaterializedUntil mocks base method.
+func (m *MockRecurringTransactionRepository) AdvanceMaterializedUntil(id domainmodel.RecurringTransactionID, from *string, until string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdvanceMaterializedUntil", id, from, until)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdvanceMaterializedUntil indicates an expected call of AdvanceMaterializedUntil.
+func (mr *MockRecurringTransactionRepositoryMockRecorder) AdvanceMaterializedUntil(id, from, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvanceMaterializedUntil", reflect.TypeOf((*MockRecurringTransactionRepository)(nil).AdvanceMaterializedUntil), id, from, until)
+}
+
+// Create mocks base method.
+func (m *MockRecurringTransactionRepository) Create(recurringTransaction *domainmodel.RecurringTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", recurringTransaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRecurringTransactionRepositoryMockRecorder) Create(recurringTransaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRecurringTransactionRepository)(nil).Create), recurringTransaction)
+}
+
+// Delete mocks base method.
+func (m *MockRecurringTransactionRepository) Delete(houseHoldID domainmodel.HouseHoldID, id domainmodel.RecurringTransactionID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", houseHoldID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRecurringTransactionRepositoryMockRecorder) Delete(houseHoldID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRecurringTransactionRepository)(nil).Delete), houseHoldID, id)
+}
+
+// FindByHouseHoldID mocks base method.
+func (m *MockRecurringTransactionRepository) FindByHouseHoldID(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHouseHoldID", houseHoldID)
+	ret0, _ := ret[0].([]*domainmodel.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHouseHoldID indicates an expected call of FindByHouseHoldID.
+func (mr *MockRecurringTransactionRepositoryMockRecorder) FindByHouseHoldID(houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHouseHoldID", reflect.TypeOf((*MockRecurringTransactionRepository)(nil).FindByHouseHoldID), houseHoldID)
+}
+
+// FindByID mocks base method.
+func (m *MockRecurringTransactionRepository) FindByID(houseHoldID domainmodel.HouseHoldID, id domainmodel.RecurringTransactionID) (*domainmodel.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", houseHoldID, id)
+	ret0, _ := ret[0].(*domainmodel.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockRecurringTransactionRepositoryMockRecorder) FindByID(houseHoldID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockRecurringTransactionRepository)(nil).FindByID), houseHoldID, id)
+}
+
+// FindDue mocks base method.
+func (m *MockRecurringTransactionRepository) FindDue(today string) ([]*domainmodel.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDue", today)
+	ret0, _ := ret[0].([]*domainmodel.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDue indicates an expected call of FindDue.
+func (mr *MockRecurringTransactionRepositoryMockRecorder) FindDue(today any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDue", reflect.TypeOf((*MockRecurringTransactionRepository)(nil).FindDue), today)
+}
+
+// FindOverrides mocks base method.
+func (m *MockRecurringTransactionRepository) FindOverrides(ids []domainmodel.RecurringTransactionID, from, to string) ([]*domainmodel.RecurringOccurrenceOverride, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOverrides", ids, from, to)
+	ret0, _ := ret[0].([]*domainmodel.RecurringOccurrenceOverride)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOverrides indicates an expected call of FindOverrides.
+func (mr *MockRecurringTransactionRepositoryMockRecorder) FindOverrides(ids, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOverrides", reflect.TypeOf((*MockRecurringTransactionRepository)(nil).FindOverrides), ids, from, to)
+}
+
+// SaveOverride mocks base method.
+func (m *MockRecurringTransactionRepository) SaveOverride(override *domainmodel.RecurringOccurrenceOverride) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveOverride", override)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveOverride indicates an expected call of SaveOverride.
+func (mr *MockRecurringTransactionRepositoryMockRecorder) SaveOverride(override any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOverride", reflect.TypeOf((*MockRecurringTransactionRepository)(nil).SaveOverride), override)
+}
+
+// Update mocks base method.
+func (m *MockRecurringTransactionRepository) Update(recurringTransaction *domainmodel.RecurringTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", recurringTransaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRecurringTransactionRepositoryMockRecorder) Update(recurringTransaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRecurringTransactionRepository)(nil).Update), recurringTransaction)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: report.go
+//
+// Generated by this command:
+//
+//	mockgen -source=report.go -destination=../mock/domainmodel/mock_report.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReportRepository is a mock of ReportRepository interface.
+type MockReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReportRepositoryMockRecorder is the mock recorder for MockReportRepository.
+type MockReportRepositoryMockRecorder struct {
+	mock *MockReportRepository
+}
+
+// NewMockReportRepository creates a new mock instance.
+func NewMockReportRepository(ctrl *gomock.Controller) *MockReportRepository {
+	mock := &MockReportRepository{ctrl: ctrl}
+	mock.recorder = &MockReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportRepository) EXPECT() *MockReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SummarizeCategoryTrends mocks base method.
+func (m *MockReportRepository) SummarizeCategoryTrends(condition *domainmodel.TrendCondition) ([]*domainmodel.CategoryMonthlyTrend, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummarizeCategoryTrends", condition)
+	ret0, _ := ret[0].([]*domainmodel.CategoryMonthlyTrend)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummarizeCategoryTrends indicates an expected call of SummarizeCategoryTrends.
+func (mr *MockReportRepositoryMockRecorder) SummarizeCategoryTrends(condition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummarizeCategoryTrends", reflect.TypeOf((*MockReportRepository)(nil).SummarizeCategoryTrends), condition)
+}
+
+// SummarizeDayOfMonthSplits mocks base method.
+func (m *MockReportRepository) SummarizeDayOfMonthSplits(houseHoldID domainmodel.HouseHoldID, fromMonth, toMonth string, day int) ([]*domainmodel.DayOfMonthSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummarizeDayOfMonthSplits", houseHoldID, fromMonth, toMonth, day)
+	ret0, _ := ret[0].([]*domainmodel.DayOfMonthSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummarizeDayOfMonthSplits indicates an expected call of SummarizeDayOfMonthSplits.
+func (mr *MockReportRepositoryMockRecorder) SummarizeDayOfMonthSplits(houseHoldID, fromMonth, toMonth, day any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummarizeDayOfMonthSplits", reflect.TypeOf((*MockReportRepository)(nil).SummarizeDayOfMonthSplits), houseHoldID, fromMonth, toMonth, day)
+}
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: settlement.go
+//
+// Generated by this command:
+//
+//	mockgen -source=settlement.go -destination=../mock/domainmodel/mock_settlement.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSettlementRepository is a mock of SettlementRepository interface.
+type MockSettlementRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSettlementRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSettlementRepositoryMockRecorder is the mock recorder for MockSettlementRepository.
+type MockSettlementRepositoryMockRecorder struct {
+	mock *MockSettlementRepository
+}
+
+// NewMockSettlementRepository creates a new mock instance.
+func NewMockSettlementRepository(ctrl *gomock.Controller) *MockSettlementRepository {
+	mock := &MockSettlementRepository{ctrl: ctrl}
+	mock.recorder = &MockSettlementRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSettlementRepository) EXPECT() *MockSettlementRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateSettlement mocks base method.
+func (m *MockSettlementRepository) CreateSettlement(settlement *domainmodel.Settlement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSettlement", settlement)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSettlement indicates an expected call of CreateSettlement.
+func (mr *MockSettlementRepositoryMockRecorder) CreateSettlement(settlement any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSettlement", reflect.TypeOf((*MockSettlementRepository)(nil).CreateSettlement), settlement)
+}
+
+// DeleteSettlement mocks base method.
+func (m *MockSettlementRepository) DeleteSettlement(houseHoldID domainmodel.HouseHoldID, id domainmodel.SettlementID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSettlement", houseHoldID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSettlement indicates an expected call of DeleteSettlement.
+func (mr *MockSettlementRepositoryMockRecorder) DeleteSettlement(houseHoldID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSettlement", reflect.TypeOf((*MockSettlementRepository)(nil).DeleteSettlement), houseHoldID, id)
+}
+
+// FindSettlements mocks base method.
+func (m *MockSettlementRepository) FindSettlements(houseHoldID domainmodel.HouseHoldID, from, to string) ([]*domainmodel.Settlement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSettlements", houseHoldID, from, to)
+	ret0, _ := ret[0].([]*domainmodel.Settlement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSettlements indicates an expected call of FindSettlements.
+func (mr *MockSettlementRepositoryMockRecorder) FindSettlements(houseHoldID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSettlements", reflect.TypeOf((*MockSettlementRepository)(nil).FindSettlements), houseHoldID, from, to)
+}
@@ -42,31 +42,31 @@ func (m *MockShoppingRepository) EXPECT() *MockShoppingRepositoryMockRecorder {
 }
 
 // DeleteShoppingAmount mocks base method.
-func (m *MockShoppingRepository) DeleteShoppingAmount(id domainmodel.ShoppingID) error {
+func (m *MockShoppingRepository) DeleteShoppingAmount(householdID domainmodel.HouseHoldID, id domainmodel.ShoppingID, deletion *domainmodel.Deletion) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteShoppingAmount", id)
+	ret := m.ctrl.Call(m, "DeleteShoppingAmount", householdID, id, deletion)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteShoppingAmount indicates an expected call of DeleteShoppingAmount.
-func (mr *MockShoppingRepositoryMockRecorder) DeleteShoppingAmount(id any) *gomock.Call {
+func (mr *MockShoppingRepositoryMockRecorder) DeleteShoppingAmount(householdID, id, deletion any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShoppingAmount", reflect.TypeOf((*MockShoppingRepository)(nil).DeleteShoppingAmount), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShoppingAmount", reflect.TypeOf((*MockShoppingRepository)(nil).DeleteShoppingAmount), householdID, id, deletion)
 }
 
 // DeleteShoppingMemo mocks base method.
-func (m *MockShoppingRepository) DeleteShoppingMemo(id domainmodel.ShoppingID) error {
+func (m *MockShoppingRepository) DeleteShoppingMemo(householdID domainmodel.HouseHoldID, id domainmodel.ShoppingID, deletion *domainmodel.Deletion) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteShoppingMemo", id)
+	ret := m.ctrl.Call(m, "DeleteShoppingMemo", householdID, id, deletion)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteShoppingMemo indicates an expected call of DeleteShoppingMemo.
-func (mr *MockShoppingRepositoryMockRecorder) DeleteShoppingMemo(id any) *gomock.Call {
+func (mr *MockShoppingRepositoryMockRecorder) DeleteShoppingMemo(householdID, id, deletion any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShoppingMemo", reflect.TypeOf((*MockShoppingRepository)(nil).DeleteShoppingMemo), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShoppingMemo", reflect.TypeOf((*MockShoppingRepository)(nil).DeleteShoppingMemo), householdID, id, deletion)
 }
 
 // FetchShoppingAmountItemByHouseholdID mocks base method.
@@ -99,6 +99,36 @@ func (mr *MockShoppingRepositoryMockRecorder) FetchShoppingMemoItem(householdID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchShoppingMemoItem", reflect.TypeOf((*MockShoppingRepository)(nil).FetchShoppingMemoItem), householdID)
 }
 
+// FindSharedShoppingAmounts mocks base method.
+func (m *MockShoppingRepository) FindSharedShoppingAmounts(householdID domainmodel.HouseHoldID, from, to string) (domainmodel.ShoppingAmounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSharedShoppingAmounts", householdID, from, to)
+	ret0, _ := ret[0].(domainmodel.ShoppingAmounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSharedShoppingAmounts indicates an expected call of FindSharedShoppingAmounts.
+func (mr *MockShoppingRepositoryMockRecorder) FindSharedShoppingAmounts(householdID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSharedShoppingAmounts", reflect.TypeOf((*MockShoppingRepository)(nil).FindSharedShoppingAmounts), householdID, from, to)
+}
+
+// FindShoppingAmounts mocks base method.
+func (m *MockShoppingRepository) FindShoppingAmounts(householdID domainmodel.HouseHoldID, from, to string) (domainmodel.ShoppingAmounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindShoppingAmounts", householdID, from, to)
+	ret0, _ := ret[0].(domainmodel.ShoppingAmounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindShoppingAmounts indicates an expected call of FindShoppingAmounts.
+func (mr *MockShoppingRepositoryMockRecorder) FindShoppingAmounts(householdID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindShoppingAmounts", reflect.TypeOf((*MockShoppingRepository)(nil).FindShoppingAmounts), householdID, from, to)
+}
+
 // RegisterShoppingAmount mocks base method.
 func (m *MockShoppingRepository) RegisterShoppingAmount(shopping *models.ShoppingAmount) error {
 	m.ctrl.T.Helper()
@@ -113,6 +143,20 @@ func (mr *MockShoppingRepositoryMockRecorder) RegisterShoppingAmount(shopping an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterShoppingAmount", reflect.TypeOf((*MockShoppingRepository)(nil).RegisterShoppingAmount), shopping)
 }
 
+// RegisterShoppingAmounts mocks base method.
+func (m *MockShoppingRepository) RegisterShoppingAmounts(shoppings []*models.ShoppingAmount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterShoppingAmounts", shoppings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterShoppingAmounts indicates an expected call of RegisterShoppingAmounts.
+func (mr *MockShoppingRepositoryMockRecorder) RegisterShoppingAmounts(shoppings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterShoppingAmounts", reflect.TypeOf((*MockShoppingRepository)(nil).RegisterShoppingAmounts), shoppings)
+}
+
 // RegisterShoppingMemo mocks base method.
 func (m *MockShoppingRepository) RegisterShoppingMemo(shopping *domainmodel.ShoppingMemo) error {
 	m.ctrl.T.Helper()
@@ -126,3 +170,47 @@ func (mr *MockShoppingRepositoryMockRecorder) RegisterShoppingMemo(shopping any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterShoppingMemo", reflect.TypeOf((*MockShoppingRepository)(nil).RegisterShoppingMemo), shopping)
 }
+
+// SearchShoppingAmounts mocks base method.
+func (m *MockShoppingRepository) SearchShoppingAmounts(condition *domainmodel.ShoppingSearchCondition) (domainmodel.ShoppingAmounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchShoppingAmounts", condition)
+	ret0, _ := ret[0].(domainmodel.ShoppingAmounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchShoppingAmounts indicates an expected call of SearchShoppingAmounts.
+func (mr *MockShoppingRepositoryMockRecorder) SearchShoppingAmounts(condition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchShoppingAmounts", reflect.TypeOf((*MockShoppingRepository)(nil).SearchShoppingAmounts), condition)
+}
+
+// SummarizeShoppingAmountByMonth mocks base method.
+func (m *MockShoppingRepository) SummarizeShoppingAmountByMonth(householdID domainmodel.HouseHoldID, untilMonth string) ([]*domainmodel.MonthlyCategoryAmount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummarizeShoppingAmountByMonth", householdID, untilMonth)
+	ret0, _ := ret[0].([]*domainmodel.MonthlyCategoryAmount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummarizeShoppingAmountByMonth indicates an expected call of SummarizeShoppingAmountByMonth.
+func (mr *MockShoppingRepositoryMockRecorder) SummarizeShoppingAmountByMonth(householdID, untilMonth any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummarizeShoppingAmountByMonth", reflect.TypeOf((*MockShoppingRepository)(nil).SummarizeShoppingAmountByMonth), householdID, untilMonth)
+}
+
+// UpdateShoppingAmount mocks base method.
+func (m *MockShoppingRepository) UpdateShoppingAmount(shopping *models.ShoppingAmount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShoppingAmount", shopping)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateShoppingAmount indicates an expected call of UpdateShoppingAmount.
+func (mr *MockShoppingRepositoryMockRecorder) UpdateShoppingAmount(shopping any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShoppingAmount", reflect.TypeOf((*MockShoppingRepository)(nil).UpdateShoppingAmount), shopping)
+}
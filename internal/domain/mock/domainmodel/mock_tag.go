@@ -0,0 +1,201 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tag.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tag.go -destination=../mock/domainmodel/mock_tag.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTagRepository is a mock of TagRepository interface.
+type MockTagRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTagRepositoryMockRecorder is the mock recorder for MockTagRepository.
+type MockTagRepositoryMockRecorder struct {
+	mock *MockTagRepository
+}
+
+// NewMockTagRepository creates a new mock instance.
+func NewMockTagRepository(ctrl *gomock.Controller) *MockTagRepository {
+	mock := &MockTagRepository{ctrl: ctrl}
+	mock.recorder = &MockTagRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagRepository) EXPECT() *MockTagRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTagRepository) Create(tag *domainmodel.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTagRepositoryMockRecorder) Create(tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTagRepository)(nil).Create), tag)
+}
+
+// Delete mocks base method.
+func (m *MockTagRepository) Delete(houseHoldID domainmodel.HouseHoldID, id domainmodel.TagID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", houseHoldID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTagRepositoryMockRecorder) Delete(houseHoldID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTagRepository)(nil).Delete), houseHoldID, id)
+}
+
+// FindByHouseHoldID mocks base method.
+func (m *MockTagRepository) FindByHouseHoldID(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHouseHoldID", houseHoldID)
+	ret0, _ := ret[0].([]*domainmodel.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHouseHoldID indicates an expected call of FindByHouseHoldID.
+func (mr *MockTagRepositoryMockRecorder) FindByHouseHoldID(houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHouseHoldID", reflect.TypeOf((*MockTagRepository)(nil).FindByHouseHoldID), houseHoldID)
+}
+
+// FindByIDs mocks base method.
+func (m *MockTagRepository) FindByIDs(houseHoldID domainmodel.HouseHoldID, ids []domainmodel.TagID) ([]*domainmodel.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", houseHoldID, ids)
+	ret0, _ := ret[0].([]*domainmodel.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockTagRepositoryMockRecorder) FindByIDs(houseHoldID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockTagRepository)(nil).FindByIDs), houseHoldID, ids)
+}
+
+// FindByName mocks base method.
+func (m *MockTagRepository) FindByName(houseHoldID domainmodel.HouseHoldID, name string) (*domainmodel.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByName", houseHoldID, name)
+	ret0, _ := ret[0].(*domainmodel.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByName indicates an expected call of FindByName.
+func (mr *MockTagRepositoryMockRecorder) FindByName(houseHoldID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByName", reflect.TypeOf((*MockTagRepository)(nil).FindByName), houseHoldID, name)
+}
+
+// FindShoppingAmounts mocks base method.
+func (m *MockTagRepository) FindShoppingAmounts(houseHoldID domainmodel.HouseHoldID, id domainmodel.TagID, from, to string) (domainmodel.ShoppingAmounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindShoppingAmounts", houseHoldID, id, from, to)
+	ret0, _ := ret[0].(domainmodel.ShoppingAmounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindShoppingAmounts indicates an expected call of FindShoppingAmounts.
+func (mr *MockTagRepositoryMockRecorder) FindShoppingAmounts(houseHoldID, id, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindShoppingAmounts", reflect.TypeOf((*MockTagRepository)(nil).FindShoppingAmounts), houseHoldID, id, from, to)
+}
+
+// Merge mocks base method.
+func (m *MockTagRepository) Merge(houseHoldID domainmodel.HouseHoldID, sourceID, targetID domainmodel.TagID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", houseHoldID, sourceID, targetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockTagRepositoryMockRecorder) Merge(houseHoldID, sourceID, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockTagRepository)(nil).Merge), houseHoldID, sourceID, targetID)
+}
+
+// Rename mocks base method.
+func (m *MockTagRepository) Rename(tag *domainmodel.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rename indicates an expected call of Rename.
+func (mr *MockTagRepositoryMockRecorder) Rename(tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockTagRepository)(nil).Rename), tag)
+}
+
+// ReplaceReceiptItemTags mocks base method.
+func (m *MockTagRepository) ReplaceReceiptItemTags(houseHoldID domainmodel.HouseHoldID, receiptItemID uint, tagIDs []domainmodel.TagID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceReceiptItemTags", houseHoldID, receiptItemID, tagIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceReceiptItemTags indicates an expected call of ReplaceReceiptItemTags.
+func (mr *MockTagRepositoryMockRecorder) ReplaceReceiptItemTags(houseHoldID, receiptItemID, tagIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceReceiptItemTags", reflect.TypeOf((*MockTagRepository)(nil).ReplaceReceiptItemTags), houseHoldID, receiptItemID, tagIDs)
+}
+
+// SummarizeReceiptItems mocks base method.
+func (m *MockTagRepository) SummarizeReceiptItems(houseHoldID domainmodel.HouseHoldID, from, to string) ([]*domainmodel.TagTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummarizeReceiptItems", houseHoldID, from, to)
+	ret0, _ := ret[0].([]*domainmodel.TagTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummarizeReceiptItems indicates an expected call of SummarizeReceiptItems.
+func (mr *MockTagRepositoryMockRecorder) SummarizeReceiptItems(houseHoldID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummarizeReceiptItems", reflect.TypeOf((*MockTagRepository)(nil).SummarizeReceiptItems), houseHoldID, from, to)
+}
+
+// SummarizeShoppingAmounts mocks base method.
+func (m *MockTagRepository) SummarizeShoppingAmounts(houseHoldID domainmodel.HouseHoldID, from, to string) ([]*domainmodel.TagTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummarizeShoppingAmounts", houseHoldID, from, to)
+	ret0, _ := ret[0].([]*domainmodel.TagTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummarizeShoppingAmounts indicates an expected call of SummarizeShoppingAmounts.
+func (mr *MockTagRepositoryMockRecorder) SummarizeShoppingAmounts(houseHoldID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummarizeShoppingAmounts", reflect.TypeOf((*MockTagRepository)(nil).SummarizeShoppingAmounts), houseHoldID, from, to)
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: trash.go
+//
+// Generated by this command:
+//
+//	mockgen -source=trash.go -destination=../mock/domainmodel/mock_trash.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTrashRepository is a mock of TrashRepository interface.
+type MockTrashRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTrashRepositoryMockRecorder is the mock recorder for MockTrashRepository.
+type MockTrashRepositoryMockRecorder struct {
+	mock *MockTrashRepository
+}
+
+// NewMockTrashRepository creates a new mock instance.
+func NewMockTrashRepository(ctrl *gomock.Controller) *MockTrashRepository {
+	mock := &MockTrashRepository{ctrl: ctrl}
+	mock.recorder = &MockTrashRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrashRepository) EXPECT() *MockTrashRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindTrashItems mocks base method.
+func (m *MockTrashRepository) FindTrashItems(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.TrashItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTrashItems", houseHoldID)
+	ret0, _ := ret[0].([]*domainmodel.TrashItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTrashItems indicates an expected call of FindTrashItems.
+func (mr *MockTrashRepositoryMockRecorder) FindTrashItems(houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTrashItems", reflect.TypeOf((*MockTrashRepository)(nil).FindTrashItems), houseHoldID)
+}
+
+// PurgeTrashItems mocks base method.
+func (m *MockTrashRepository) PurgeTrashItems(deletedBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTrashItems", deletedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTrashItems indicates an expected call of PurgeTrashItems.
+func (mr *MockTrashRepositoryMockRecorder) PurgeTrashItems(deletedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTrashItems", reflect.TypeOf((*MockTrashRepository)(nil).PurgeTrashItems), deletedBefore)
+}
+
+// RestoreDeleteBatch mocks base method.
+func (m *MockTrashRepository) RestoreDeleteBatch(houseHoldID domainmodel.HouseHoldID, batchID domainmodel.DeleteBatchID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreDeleteBatch", houseHoldID, batchID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreDeleteBatch indicates an expected call of RestoreDeleteBatch.
+func (mr *MockTrashRepositoryMockRecorder) RestoreDeleteBatch(houseHoldID, batchID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDeleteBatch", reflect.TypeOf((*MockTrashRepository)(nil).RestoreDeleteBatch), houseHoldID, batchID)
+}
+
+// RestoreTrashItem mocks base method.
+func (m *MockTrashRepository) RestoreTrashItem(houseHoldID domainmodel.HouseHoldID, itemType domainmodel.TrashItemType, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTrashItem", houseHoldID, itemType, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreTrashItem indicates an expected call of RestoreTrashItem.
+func (mr *MockTrashRepositoryMockRecorder) RestoreTrashItem(houseHoldID, itemType, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTrashItem", reflect.TypeOf((*MockTrashRepository)(nil).RestoreTrashItem), houseHoldID, itemType, id)
+}
@@ -112,3 +112,17 @@ func (mr *MockUserAccountRepositoryMockRecorder) FindByLINEUserID(userID any) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByLINEUserID", reflect.TypeOf((*MockUserAccountRepository)(nil).FindByLINEUserID), userID)
 }
+
+// UpdateDefaultHouseHold mocks base method.
+func (m *MockUserAccountRepository) UpdateDefaultHouseHold(userID domainmodel.UserID, houseHoldID domainmodel.HouseHoldID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDefaultHouseHold", userID, houseHoldID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDefaultHouseHold indicates an expected call of UpdateDefaultHouseHold.
+func (mr *MockUserAccountRepositoryMockRecorder) UpdateDefaultHouseHold(userID, houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDefaultHouseHold", reflect.TypeOf((*MockUserAccountRepository)(nil).UpdateDefaultHouseHold), userID, houseHoldID)
+}
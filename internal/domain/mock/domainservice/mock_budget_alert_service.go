@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: budget_alert_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=budget_alert_service.go -destination=../mock/domainservice/mock_budget_alert_service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBudgetAlertService is a mock of BudgetAlertService interface.
+type MockBudgetAlertService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBudgetAlertServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockBudgetAlertServiceMockRecorder is the mock recorder for MockBudgetAlertService.
+type MockBudgetAlertServiceMockRecorder struct {
+	mock *MockBudgetAlertService
+}
+
+// NewMockBudgetAlertService creates a new mock instance.
+func NewMockBudgetAlertService(ctrl *gomock.Controller) *MockBudgetAlertService {
+	mock := &MockBudgetAlertService{ctrl: ctrl}
+	mock.recorder = &MockBudgetAlertServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBudgetAlertService) EXPECT() *MockBudgetAlertServiceMockRecorder {
+	return m.recorder
+}
+
+// NotifyReachedThresholds mocks base method.
+func (m *MockBudgetAlertService) NotifyReachedThresholds(houseHoldID domainmodel.HouseHoldID, month string, budget *domainmodel.CategoryBudget) ([]*domainmodel.BudgetAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyReachedThresholds", houseHoldID, month, budget)
+	ret0, _ := ret[0].([]*domainmodel.BudgetAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotifyReachedThresholds indicates an expected call of NotifyReachedThresholds.
+func (mr *MockBudgetAlertServiceMockRecorder) NotifyReachedThresholds(houseHoldID, month, budget any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyReachedThresholds", reflect.TypeOf((*MockBudgetAlertService)(nil).NotifyReachedThresholds), houseHoldID, month, budget)
+}
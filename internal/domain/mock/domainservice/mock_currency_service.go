@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: currency_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=currency_service.go -destination=../mock/domainservice/mock_currency_service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCurrencyService is a mock of CurrencyService interface.
+type MockCurrencyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCurrencyServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCurrencyServiceMockRecorder is the mock recorder for MockCurrencyService.
+type MockCurrencyServiceMockRecorder struct {
+	mock *MockCurrencyService
+}
+
+// NewMockCurrencyService creates a new mock instance.
+func NewMockCurrencyService(ctrl *gomock.Controller) *MockCurrencyService {
+	mock := &MockCurrencyService{ctrl: ctrl}
+	mock.recorder = &MockCurrencyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCurrencyService) EXPECT() *MockCurrencyServiceMockRecorder {
+	return m.recorder
+}
+
+// AddExchangeRate mocks base method.
+func (m *MockCurrencyService) AddExchangeRate(houseHoldID domainmodel.HouseHoldID, currency, rate, effectiveDate string) (*domainmodel.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddExchangeRate", houseHoldID, currency, rate, effectiveDate)
+	ret0, _ := ret[0].(*domainmodel.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddExchangeRate indicates an expected call of AddExchangeRate.
+func (mr *MockCurrencyServiceMockRecorder) AddExchangeRate(houseHoldID, currency, rate, effectiveDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddExchangeRate", reflect.TypeOf((*MockCurrencyService)(nil).AddExchangeRate), houseHoldID, currency, rate, effectiveDate)
+}
+
+// ChangeBaseCurrency mocks base method.
+func (m *MockCurrencyService) ChangeBaseCurrency(houseHoldID domainmodel.HouseHoldID, currency string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeBaseCurrency", houseHoldID, currency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeBaseCurrency indicates an expected call of ChangeBaseCurrency.
+func (mr *MockCurrencyServiceMockRecorder) ChangeBaseCurrency(houseHoldID, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeBaseCurrency", reflect.TypeOf((*MockCurrencyService)(nil).ChangeBaseCurrency), houseHoldID, currency)
+}
+
+// FetchExchangeRates mocks base method.
+func (m *MockCurrencyService) FetchExchangeRates(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchExchangeRates", houseHoldID)
+	ret0, _ := ret[0].([]*domainmodel.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchExchangeRates indicates an expected call of FetchExchangeRates.
+func (mr *MockCurrencyServiceMockRecorder) FetchExchangeRates(houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchExchangeRates", reflect.TypeOf((*MockCurrencyService)(nil).FetchExchangeRates), houseHoldID)
+}
+
+// RemoveExchangeRate mocks base method.
+func (m *MockCurrencyService) RemoveExchangeRate(houseHoldID domainmodel.HouseHoldID, id domainmodel.ExchangeRateID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveExchangeRate", houseHoldID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveExchangeRate indicates an expected call of RemoveExchangeRate.
+func (mr *MockCurrencyServiceMockRecorder) RemoveExchangeRate(houseHoldID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveExchangeRate", reflect.TypeOf((*MockCurrencyService)(nil).RemoveExchangeRate), houseHoldID, id)
+}
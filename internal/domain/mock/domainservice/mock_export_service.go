@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: export_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=export_service.go -destination=../mock/domainservice/mock_export_service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExportService is a mock of ExportService interface.
+type MockExportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockExportServiceMockRecorder is the mock recorder for MockExportService.
+type MockExportServiceMockRecorder struct {
+	mock *MockExportService
+}
+
+// NewMockExportService creates a new mock instance.
+func NewMockExportService(ctrl *gomock.Controller) *MockExportService {
+	mock := &MockExportService{ctrl: ctrl}
+	mock.recorder = &MockExportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportService) EXPECT() *MockExportServiceMockRecorder {
+	return m.recorder
+}
+
+// ExportShoppingAmounts mocks base method.
+func (m *MockExportService) ExportShoppingAmounts(condition *domainmodel.ShoppingSearchCondition, write func([]*domainmodel.ExportRecord) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportShoppingAmounts", condition, write)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportShoppingAmounts indicates an expected call of ExportShoppingAmounts.
+func (mr *MockExportServiceMockRecorder) ExportShoppingAmounts(condition, write any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportShoppingAmounts", reflect.TypeOf((*MockExportService)(nil).ExportShoppingAmounts), condition, write)
+}
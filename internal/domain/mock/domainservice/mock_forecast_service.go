@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: forecast_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=forecast_service.go -destination=../mock/domainservice/mock_forecast_service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForecastService is a mock of ForecastService interface.
+type MockForecastService struct {
+	ctrl     *gomock.Controller
+	recorder *MockForecastServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockForecastServiceMockRecorder is the mock recorder for MockForecastService.
+type MockForecastServiceMockRecorder struct {
+	mock *MockForecastService
+}
+
+// NewMockForecastService creates a new mock instance.
+func NewMockForecastService(ctrl *gomock.Controller) *MockForecastService {
+	mock := &MockForecastService{ctrl: ctrl}
+	mock.recorder = &MockForecastServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForecastService) EXPECT() *MockForecastServiceMockRecorder {
+	return m.recorder
+}
+
+// ForecastMonthEnd mocks base method.
+func (m *MockForecastService) ForecastMonthEnd(houseHoldID domainmodel.HouseHoldID, asOf time.Time) (*domainmodel.SpendingForecast, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForecastMonthEnd", houseHoldID, asOf)
+	ret0, _ := ret[0].(*domainmodel.SpendingForecast)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForecastMonthEnd indicates an expected call of ForecastMonthEnd.
+func (mr *MockForecastServiceMockRecorder) ForecastMonthEnd(houseHoldID, asOf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForecastMonthEnd", reflect.TypeOf((*MockForecastService)(nil).ForecastMonthEnd), houseHoldID, asOf)
+}
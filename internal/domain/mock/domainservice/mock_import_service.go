@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: import_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=import_service.go -destination=../mock/domainservice/mock_import_service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockImportService is a mock of ImportService interface.
+type MockImportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockImportServiceMockRecorder is the mock recorder for MockImportService.
+type MockImportServiceMockRecorder struct {
+	mock *MockImportService
+}
+
+// NewMockImportService creates a new mock instance.
+func NewMockImportService(ctrl *gomock.Controller) *MockImportService {
+	mock := &MockImportService{ctrl: ctrl}
+	mock.recorder = &MockImportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportService) EXPECT() *MockImportServiceMockRecorder {
+	return m.recorder
+}
+
+// CommitImport mocks base method.
+func (m *MockImportService) CommitImport(input *domainmodel.ImportInput) (*domainmodel.ImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitImport", input)
+	ret0, _ := ret[0].(*domainmodel.ImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitImport indicates an expected call of CommitImport.
+func (mr *MockImportServiceMockRecorder) CommitImport(input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitImport", reflect.TypeOf((*MockImportService)(nil).CommitImport), input)
+}
+
+// FetchPresets mocks base method.
+func (m *MockImportService) FetchPresets(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.ImportPreset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchPresets", houseHoldID)
+	ret0, _ := ret[0].([]*domainmodel.ImportPreset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchPresets indicates an expected call of FetchPresets.
+func (mr *MockImportServiceMockRecorder) FetchPresets(houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchPresets", reflect.TypeOf((*MockImportService)(nil).FetchPresets), houseHoldID)
+}
+
+// PreviewImport mocks base method.
+func (m *MockImportService) PreviewImport(input *domainmodel.ImportInput) (*domainmodel.ImportPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewImport", input)
+	ret0, _ := ret[0].(*domainmodel.ImportPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewImport indicates an expected call of PreviewImport.
+func (mr *MockImportServiceMockRecorder) PreviewImport(input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewImport", reflect.TypeOf((*MockImportService)(nil).PreviewImport), input)
+}
+
+// RemovePreset mocks base method.
+func (m *MockImportService) RemovePreset(houseHoldID domainmodel.HouseHoldID, id domainmodel.ImportPresetID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePreset", houseHoldID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePreset indicates an expected call of RemovePreset.
+func (mr *MockImportServiceMockRecorder) RemovePreset(houseHoldID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePreset", reflect.TypeOf((*MockImportService)(nil).RemovePreset), houseHoldID, id)
+}
+
+// SavePreset mocks base method.
+func (m *MockImportService) SavePreset(preset *domainmodel.ImportPreset) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePreset", preset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePreset indicates an expected call of SavePreset.
+func (mr *MockImportServiceMockRecorder) SavePreset(preset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePreset", reflect.TypeOf((*MockImportService)(nil).SavePreset), preset)
+}
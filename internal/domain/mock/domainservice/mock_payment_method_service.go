@@ -0,0 +1,143 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_method_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=payment_method_service.go -destination=../mock/domainservice/mock_payment_method_service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentMethodService is a mock of PaymentMethodService interface.
+type MockPaymentMethodService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentMethodServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentMethodServiceMockRecorder is the mock recorder for MockPaymentMethodService.
+type MockPaymentMethodServiceMockRecorder struct {
+	mock *MockPaymentMethodService
+}
+
+// NewMockPaymentMethodService creates a new mock instance.
+func NewMockPaymentMethodService(ctrl *gomock.Controller) *MockPaymentMethodService {
+	mock := &MockPaymentMethodService{ctrl: ctrl}
+	mock.recorder = &MockPaymentMethodServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentMethodService) EXPECT() *MockPaymentMethodServiceMockRecorder {
+	return m.recorder
+}
+
+// AddPaymentMethod mocks base method.
+func (m *MockPaymentMethodService) AddPaymentMethod(method *domainmodel.PaymentMethod) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPaymentMethod", method)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPaymentMethod indicates an expected call of AddPaymentMethod.
+func (mr *MockPaymentMethodServiceMockRecorder) AddPaymentMethod(method any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPaymentMethod", reflect.TypeOf((*MockPaymentMethodService)(nil).AddPaymentMethod), method)
+}
+
+// ArchivePaymentMethod mocks base method.
+func (m *MockPaymentMethodService) ArchivePaymentMethod(houseHoldID domainmodel.HouseHoldID, id domainmodel.PaymentMethodID, archived bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchivePaymentMethod", houseHoldID, id, archived)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchivePaymentMethod indicates an expected call of ArchivePaymentMethod.
+func (mr *MockPaymentMethodServiceMockRecorder) ArchivePaymentMethod(houseHoldID, id, archived any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchivePaymentMethod", reflect.TypeOf((*MockPaymentMethodService)(nil).ArchivePaymentMethod), houseHoldID, id, archived)
+}
+
+// FetchBalances mocks base method.
+func (m *MockPaymentMethodService) FetchBalances(houseHoldID domainmodel.HouseHoldID, date string) ([]*domainmodel.PaymentMethodBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchBalances", houseHoldID, date)
+	ret0, _ := ret[0].([]*domainmodel.PaymentMethodBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchBalances indicates an expected call of FetchBalances.
+func (mr *MockPaymentMethodServiceMockRecorder) FetchBalances(houseHoldID, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBalances", reflect.TypeOf((*MockPaymentMethodService)(nil).FetchBalances), houseHoldID, date)
+}
+
+// FetchLedger mocks base method.
+func (m *MockPaymentMethodService) FetchLedger(houseHoldID domainmodel.HouseHoldID, id domainmodel.PaymentMethodID, month string) (*domainmodel.PaymentMethodLedger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchLedger", houseHoldID, id, month)
+	ret0, _ := ret[0].(*domainmodel.PaymentMethodLedger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchLedger indicates an expected call of FetchLedger.
+func (mr *MockPaymentMethodServiceMockRecorder) FetchLedger(houseHoldID, id, month any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchLedger", reflect.TypeOf((*MockPaymentMethodService)(nil).FetchLedger), houseHoldID, id, month)
+}
+
+// FetchPaymentMethods mocks base method.
+func (m *MockPaymentMethodService) FetchPaymentMethods(houseHoldID domainmodel.HouseHoldID, includeArchived bool) ([]*domainmodel.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchPaymentMethods", houseHoldID, includeArchived)
+	ret0, _ := ret[0].([]*domainmodel.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchPaymentMethods indicates an expected call of FetchPaymentMethods.
+func (mr *MockPaymentMethodServiceMockRecorder) FetchPaymentMethods(houseHoldID, includeArchived any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchPaymentMethods", reflect.TypeOf((*MockPaymentMethodService)(nil).FetchPaymentMethods), houseHoldID, includeArchived)
+}
+
+// FetchStatement mocks base method.
+func (m *MockPaymentMethodService) FetchStatement(houseHoldID domainmodel.HouseHoldID, id domainmodel.PaymentMethodID, month string) (*domainmodel.BillingStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchStatement", houseHoldID, id, month)
+	ret0, _ := ret[0].(*domainmodel.BillingStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchStatement indicates an expected call of FetchStatement.
+func (mr *MockPaymentMethodServiceMockRecorder) FetchStatement(houseHoldID, id, month any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchStatement", reflect.TypeOf((*MockPaymentMethodService)(nil).FetchStatement), houseHoldID, id, month)
+}
+
+// UpdatePaymentMethod mocks base method.
+func (m *MockPaymentMethodService) UpdatePaymentMethod(method *domainmodel.PaymentMethod) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePaymentMethod", method)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePaymentMethod indicates an expected call of UpdatePaymentMethod.
+func (mr *MockPaymentMethodServiceMockRecorder) UpdatePaymentMethod(method any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentMethod", reflect.TypeOf((*MockPaymentMethodService)(nil).UpdatePaymentMethod), method)
+}
@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: recurring_transaction_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=recurring_transaction_service.go -destination=../mock/domainservice/mock_recurring_transaction_service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRecurringTransactionService is a mock of RecurringTransactionService interface.
+type MockRecurringTransactionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecurringTransactionServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockRecurringTransactionServiceMockRecorder is the mock recorder for MockRecurringTransactionService.
+type MockRecurringTransactionServiceMockRecorder struct {
+	mock *MockRecurringTransactionService
+}
+
+// NewMockRecurringTransactionService creates a new mock instance.
+func NewMockRecurringTransactionService(ctrl *gomock.Controller) *MockRecurringTransactionService {
+	mock := &MockRecurringTransactionService{ctrl: ctrl}
+	mock.recorder = &MockRecurringTransactionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecurringTransactionService) EXPECT() *MockRecurringTransactionServiceMockRecorder {
+	return m.recorder
+}
+
+// AdjustOccurrence mocks base method.
+func (m *MockRecurringTransactionService) AdjustOccurrence(houseHoldID domainmodel.HouseHoldID, id domainmodel.RecurringTransactionID, date string, amount *int, memo *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustOccurrence", houseHoldID, id, date, amount, memo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdjustOccurrence indicates an expected call of AdjustOccurrence.
+func (mr *MockRecurringTransactionServiceMockRecorder) AdjustOccurrence(houseHoldID, id, date, amount, memo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustOccurrence", reflect.TypeOf((*MockRecurringTransactionService)(nil).AdjustOccurrence), houseHoldID, id, date, amount, memo)
+}
+
+// CreateRecurringTransaction mocks base method.
+func (m *MockRecurringTransactionService) CreateRecurringTransaction(recurringTransaction *domainmodel.RecurringTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecurringTransaction", recurringTransaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRecurringTransaction indicates an expected call of CreateRecurringTransaction.
+func (mr *MockRecurringTransactionServiceMockRecorder) CreateRecurringTransaction(recurringTransaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecurringTransaction", reflect.TypeOf((*MockRecurringTransactionService)(nil).CreateRecurringTransaction), recurringTransaction)
+}
+
+// FetchRecurringTransactions mocks base method.
+func (m *MockRecurringTransactionService) FetchRecurringTransactions(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchRecurringTransactions", houseHoldID)
+	ret0, _ := ret[0].([]*domainmodel.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchRecurringTransactions indicates an expected call of FetchRecurringTransactions.
+func (mr *MockRecurringTransactionServiceMockRecorder) FetchRecurringTransactions(houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchRecurringTransactions", reflect.TypeOf((*MockRecurringTransactionService)(nil).FetchRecurringTransactions), houseHoldID)
+}
+
+// FetchUpcomingOccurrences mocks base method.
+func (m *MockRecurringTransactionService) FetchUpcomingOccurrences(houseHoldID domainmodel.HouseHoldID, until time.Time) (domainmodel.RecurringOccurrences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchUpcomingOccurrences", houseHoldID, until)
+	ret0, _ := ret[0].(domainmodel.RecurringOccurrences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchUpcomingOccurrences indicates an expected call of FetchUpcomingOccurrences.
+func (mr *MockRecurringTransactionServiceMockRecorder) FetchUpcomingOccurrences(houseHoldID, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUpcomingOccurrences", reflect.TypeOf((*MockRecurringTransactionService)(nil).FetchUpcomingOccurrences), houseHoldID, until)
+}
+
+// MaterializeDueOccurrences mocks base method.
+func (m *MockRecurringTransactionService) MaterializeDueOccurrences(today time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaterializeDueOccurrences", today)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MaterializeDueOccurrences indicates an expected call of MaterializeDueOccurrences.
+func (mr *MockRecurringTransactionServiceMockRecorder) MaterializeDueOccurrences(today any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaterializeDueOccurrences", reflect.TypeOf((*MockRecurringTransactionService)(nil).MaterializeDueOccurrences), today)
+}
+
+// RemoveRecurringTransaction mocks base method.
+func (m *MockRecurringTransactionService) RemoveRecurringTransaction(houseHoldID domainmodel.HouseHoldID, id domainmodel.RecurringTransactionID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRecurringTransaction", houseHoldID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRecurringTransaction indicates an expected call of RemoveRecurringTransaction.
+func (mr *MockRecurringTransactionServiceMockRecorder) RemoveRecurringTransaction(houseHoldID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRecurringTransaction", reflect.TypeOf((*MockRecurringTransactionService)(nil).RemoveRecurringTransaction), houseHoldID, id)
+}
+
+// SkipOccurrence mocks base method.
+func (m *MockRecurringTransactionService) SkipOccurrence(houseHoldID domainmodel.HouseHoldID, id domainmodel.RecurringTransactionID, date string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SkipOccurrence", houseHoldID, id, date)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SkipOccurrence indicates an expected call of SkipOccurrence.
+func (mr *MockRecurringTransactionServiceMockRecorder) SkipOccurrence(houseHoldID, id, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SkipOccurrence", reflect.TypeOf((*MockRecurringTransactionService)(nil).SkipOccurrence), houseHoldID, id, date)
+}
+
+// UpdateRecurringTransaction mocks base method.
+func (m *MockRecurringTransactionService) UpdateRecurringTransaction(recurringTransaction *domainmodel.RecurringTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecurringTransaction", recurringTransaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRecurringTransaction indicates an expected call of UpdateRecurringTransaction.
+func (mr *MockRecurringTransactionServiceMockRecorder) UpdateRecurringTransaction(recurringTransaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecurringTransaction", reflect.TypeOf((*MockRecurringTransactionService)(nil).UpdateRecurringTransaction), recurringTransaction)
+}
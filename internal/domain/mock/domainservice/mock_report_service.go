@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: report_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=report_service.go -destination=../mock/domainservice/mock_report_service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReportService is a mock of ReportService interface.
+type MockReportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockReportServiceMockRecorder is the mock recorder for MockReportService.
+type MockReportServiceMockRecorder struct {
+	mock *MockReportService
+}
+
+// NewMockReportService creates a new mock instance.
+func NewMockReportService(ctrl *gomock.Controller) *MockReportService {
+	mock := &MockReportService{ctrl: ctrl}
+	mock.recorder = &MockReportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportService) EXPECT() *MockReportServiceMockRecorder {
+	return m.recorder
+}
+
+// FetchTrendReport mocks base method.
+func (m *MockReportService) FetchTrendReport(condition *domainmodel.TrendCondition) (*domainmodel.TrendReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTrendReport", condition)
+	ret0, _ := ret[0].(*domainmodel.TrendReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchTrendReport indicates an expected call of FetchTrendReport.
+func (mr *MockReportServiceMockRecorder) FetchTrendReport(condition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTrendReport", reflect.TypeOf((*MockReportService)(nil).FetchTrendReport), condition)
+}
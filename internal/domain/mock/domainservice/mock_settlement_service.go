@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: settlement_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=settlement_service.go -destination=../mock/domainservice/mock_settlement_service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSettlementService is a mock of SettlementService interface.
+type MockSettlementService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSettlementServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSettlementServiceMockRecorder is the mock recorder for MockSettlementService.
+type MockSettlementServiceMockRecorder struct {
+	mock *MockSettlementService
+}
+
+// NewMockSettlementService creates a new mock instance.
+func NewMockSettlementService(ctrl *gomock.Controller) *MockSettlementService {
+	mock := &MockSettlementService{ctrl: ctrl}
+	mock.recorder = &MockSettlementServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSettlementService) EXPECT() *MockSettlementServiceMockRecorder {
+	return m.recorder
+}
+
+// FetchSettleUpReport mocks base method.
+func (m *MockSettlementService) FetchSettleUpReport(houseHoldID domainmodel.HouseHoldID, from, to string) (*domainmodel.SettleUpReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchSettleUpReport", houseHoldID, from, to)
+	ret0, _ := ret[0].(*domainmodel.SettleUpReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchSettleUpReport indicates an expected call of FetchSettleUpReport.
+func (mr *MockSettlementServiceMockRecorder) FetchSettleUpReport(houseHoldID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchSettleUpReport", reflect.TypeOf((*MockSettlementService)(nil).FetchSettleUpReport), houseHoldID, from, to)
+}
+
+// RecordSettlement mocks base method.
+func (m *MockSettlementService) RecordSettlement(settlement *domainmodel.Settlement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSettlement", settlement)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSettlement indicates an expected call of RecordSettlement.
+func (mr *MockSettlementServiceMockRecorder) RecordSettlement(settlement any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSettlement", reflect.TypeOf((*MockSettlementService)(nil).RecordSettlement), settlement)
+}
+
+// RemoveSettlement mocks base method.
+func (m *MockSettlementService) RemoveSettlement(houseHoldID domainmodel.HouseHoldID, settlementID domainmodel.SettlementID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSettlement", houseHoldID, settlementID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSettlement indicates an expected call of RemoveSettlement.
+func (mr *MockSettlementServiceMockRecorder) RemoveSettlement(houseHoldID, settlementID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSettlement", reflect.TypeOf((*MockSettlementService)(nil).RemoveSettlement), houseHoldID, settlementID)
+}
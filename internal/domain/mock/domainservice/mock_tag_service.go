@@ -0,0 +1,156 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tag_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tag_service.go -destination=../mock/domainservice/mock_tag_service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTagService is a mock of TagService interface.
+type MockTagService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTagServiceMockRecorder is the mock recorder for MockTagService.
+type MockTagServiceMockRecorder struct {
+	mock *MockTagService
+}
+
+// NewMockTagService creates a new mock instance.
+func NewMockTagService(ctrl *gomock.Controller) *MockTagService {
+	mock := &MockTagService{ctrl: ctrl}
+	mock.recorder = &MockTagServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagService) EXPECT() *MockTagServiceMockRecorder {
+	return m.recorder
+}
+
+// AddTag mocks base method.
+func (m *MockTagService) AddTag(tag *domainmodel.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTag", tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTag indicates an expected call of AddTag.
+func (mr *MockTagServiceMockRecorder) AddTag(tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTag", reflect.TypeOf((*MockTagService)(nil).AddTag), tag)
+}
+
+// FetchTaggedShoppingAmounts mocks base method.
+func (m *MockTagService) FetchTaggedShoppingAmounts(houseHoldID domainmodel.HouseHoldID, id domainmodel.TagID, from, to string) (domainmodel.ShoppingAmounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaggedShoppingAmounts", houseHoldID, id, from, to)
+	ret0, _ := ret[0].(domainmodel.ShoppingAmounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchTaggedShoppingAmounts indicates an expected call of FetchTaggedShoppingAmounts.
+func (mr *MockTagServiceMockRecorder) FetchTaggedShoppingAmounts(houseHoldID, id, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedShoppingAmounts", reflect.TypeOf((*MockTagService)(nil).FetchTaggedShoppingAmounts), houseHoldID, id, from, to)
+}
+
+// FetchTags mocks base method.
+func (m *MockTagService) FetchTags(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTags", houseHoldID)
+	ret0, _ := ret[0].([]*domainmodel.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchTags indicates an expected call of FetchTags.
+func (mr *MockTagServiceMockRecorder) FetchTags(houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTags", reflect.TypeOf((*MockTagService)(nil).FetchTags), houseHoldID)
+}
+
+// MergeTags mocks base method.
+func (m *MockTagService) MergeTags(houseHoldID domainmodel.HouseHoldID, sourceID, targetID domainmodel.TagID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeTags", houseHoldID, sourceID, targetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockTagServiceMockRecorder) MergeTags(houseHoldID, sourceID, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockTagService)(nil).MergeTags), houseHoldID, sourceID, targetID)
+}
+
+// RemoveTag mocks base method.
+func (m *MockTagService) RemoveTag(houseHoldID domainmodel.HouseHoldID, id domainmodel.TagID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTag", houseHoldID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTag indicates an expected call of RemoveTag.
+func (mr *MockTagServiceMockRecorder) RemoveTag(houseHoldID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTag", reflect.TypeOf((*MockTagService)(nil).RemoveTag), houseHoldID, id)
+}
+
+// RenameTag mocks base method.
+func (m *MockTagService) RenameTag(tag *domainmodel.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTag", tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameTag indicates an expected call of RenameTag.
+func (mr *MockTagServiceMockRecorder) RenameTag(tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTag", reflect.TypeOf((*MockTagService)(nil).RenameTag), tag)
+}
+
+// SummarizeByTag mocks base method.
+func (m *MockTagService) SummarizeByTag(houseHoldID domainmodel.HouseHoldID, from, to string) (*domainmodel.TagSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummarizeByTag", houseHoldID, from, to)
+	ret0, _ := ret[0].(*domainmodel.TagSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummarizeByTag indicates an expected call of SummarizeByTag.
+func (mr *MockTagServiceMockRecorder) SummarizeByTag(houseHoldID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummarizeByTag", reflect.TypeOf((*MockTagService)(nil).SummarizeByTag), houseHoldID, from, to)
+}
+
+// TagReceiptItem mocks base method.
+func (m *MockTagService) TagReceiptItem(houseHoldID domainmodel.HouseHoldID, receiptItemID uint, tagIDs []domainmodel.TagID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagReceiptItem", houseHoldID, receiptItemID, tagIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TagReceiptItem indicates an expected call of TagReceiptItem.
+func (mr *MockTagServiceMockRecorder) TagReceiptItem(houseHoldID, receiptItemID, tagIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagReceiptItem", reflect.TypeOf((*MockTagService)(nil).TagReceiptItem), houseHoldID, receiptItemID, tagIDs)
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: trash_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=trash_service.go -destination=../mock/domainservice/mock_trash_service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTrashService is a mock of TrashService interface.
+type MockTrashService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTrashServiceMockRecorder is the mock recorder for MockTrashService.
+type MockTrashServiceMockRecorder struct {
+	mock *MockTrashService
+}
+
+// NewMockTrashService creates a new mock instance.
+func NewMockTrashService(ctrl *gomock.Controller) *MockTrashService {
+	mock := &MockTrashService{ctrl: ctrl}
+	mock.recorder = &MockTrashServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrashService) EXPECT() *MockTrashServiceMockRecorder {
+	return m.recorder
+}
+
+// FetchTrashItems mocks base method.
+func (m *MockTrashService) FetchTrashItems(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.TrashItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTrashItems", houseHoldID)
+	ret0, _ := ret[0].([]*domainmodel.TrashItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchTrashItems indicates an expected call of FetchTrashItems.
+func (mr *MockTrashServiceMockRecorder) FetchTrashItems(houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTrashItems", reflect.TypeOf((*MockTrashService)(nil).FetchTrashItems), houseHoldID)
+}
+
+// PurgeExpiredTrashItems mocks base method.
+func (m *MockTrashService) PurgeExpiredTrashItems(now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpiredTrashItems", now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeExpiredTrashItems indicates an expected call of PurgeExpiredTrashItems.
+func (mr *MockTrashServiceMockRecorder) PurgeExpiredTrashItems(now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpiredTrashItems", reflect.TypeOf((*MockTrashService)(nil).PurgeExpiredTrashItems), now)
+}
+
+// RestoreTrashItem mocks base method.
+func (m *MockTrashService) RestoreTrashItem(houseHoldID domainmodel.HouseHoldID, itemType string, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTrashItem", houseHoldID, itemType, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreTrashItem indicates an expected call of RestoreTrashItem.
+func (mr *MockTrashServiceMockRecorder) RestoreTrashItem(houseHoldID, itemType, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTrashItem", reflect.TypeOf((*MockTrashService)(nil).RestoreTrashItem), houseHoldID, itemType, id)
+}
+
+// UndoLastDelete mocks base method.
+func (m *MockTrashService) UndoLastDelete(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID) ([]*domainmodel.TrashItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UndoLastDelete", houseHoldID, operatorID)
+	ret0, _ := ret[0].([]*domainmodel.TrashItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UndoLastDelete indicates an expected call of UndoLastDelete.
+func (mr *MockTrashServiceMockRecorder) UndoLastDelete(houseHoldID, operatorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndoLastDelete", reflect.TypeOf((*MockTrashService)(nil).UndoLastDelete), houseHoldID, operatorID)
+}
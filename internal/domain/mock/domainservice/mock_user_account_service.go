@@ -40,6 +40,20 @@ func (m *MockUserAccountService) EXPECT() *MockUserAccountServiceMockRecorder {
 	return m.recorder
 }
 
+// ChangeDefaultHouseHold mocks base method.
+func (m *MockUserAccountService) ChangeDefaultHouseHold(userID domainmodel.UserID, houseHoldID domainmodel.HouseHoldID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeDefaultHouseHold", userID, houseHoldID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeDefaultHouseHold indicates an expected call of ChangeDefaultHouseHold.
+func (mr *MockUserAccountServiceMockRecorder) ChangeDefaultHouseHold(userID, houseHoldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeDefaultHouseHold", reflect.TypeOf((*MockUserAccountService)(nil).ChangeDefaultHouseHold), userID, houseHoldID)
+}
+
 // CreateUserAccount mocks base method.
 func (m *MockUserAccountService) CreateUserAccount(lineUserInfo *domainmodel.LINEUserInfo) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,567 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainmodel
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"time"
+)
+
+// BackupFormatVersion はバックアップの形式の版。形式を変更した場合は上げ、古い版の読み込みを維持する
+// 2: 基準通貨、換算レート、支出の記録した通貨の金額を追加。1 の基準通貨は JPY とする
+// 3: 収入カテゴリ、収入、定期取引とその発生日ごとの変更、精算を追加。2 以前はこれらを含まない
+const BackupFormatVersion = 3
+
+// MaxBackupEntrySize はバックアップの zip に含まれる1ファイルの展開後の上限
+const MaxBackupEntrySize = 64 << 20
+
+// バックアップの zip に含まれるファイル。レシート画像は images/ 以下にストレージのファイル名で格納する
+const (
+	BackupManifestFile              = "manifest.json"
+	BackupCategoriesFile            = "categories.json"
+	BackupMonthlyBudgetsFile        = "monthly_budgets.json"
+	BackupTagsFile                  = "tags.json"
+	BackupPaymentMethodsFile        = "payment_methods.json"
+	BackupShoppingMemosFile         = "shopping_memos.json"
+	BackupShoppingAmountsFile       = "shopping_amounts.json"
+	BackupReceiptsFile              = "receipts.json"
+	BackupChatMessagesFile          = "chat_messages.json"
+	BackupMembershipsFile           = "memberships.json"
+	BackupExchangeRatesFile         = "exchange_rates.json"
+	BackupIncomeCategoriesFile      = "income_categories.json"
+	BackupIncomesFile               = "incomes.json"
+	BackupRecurringTransactionsFile = "recurring_transactions.json"
+	BackupSettlementsFile           = "settlements.json"
+	backupImageDir                  = "images/"
+)
+
+var (
+	ErrInvalidBackup            = errors.New("invalid backup archive")
+	ErrUnsupportedBackupVersion = errors.New("backup archive version is not supported")
+	ErrBackupImageNotFound      = errors.New("receipt image not found in backup archive")
+)
+
+// BackupManifest はバックアップの版と作成元の家計簿、含まれる件数
+type BackupManifest struct {
+	Version     int         `json:"version"`
+	CreatedAt   time.Time   `json:"createdAt"`
+	HouseHoldID HouseHoldID `json:"houseHoldID"`
+	Title       string      `json:"title"`
+	Description string      `json:"description"`
+	// BaseCurrency は家計簿の基準通貨。版 1 のバックアップでは空
+	BaseCurrency Currency `json:"baseCurrency,omitempty"`
+	// Counts はファイルごとの件数。読み込み時に欠けたファイルや途中で切れたファイルの検出に用いる
+	Counts map[string]int `json:"counts"`
+	// MissingImages はバックアップ時にストレージから取得できなかったレシート画像
+	MissingImages []string `json:"missingImages"`
+}
+
+// BackupCategory は家計簿のカテゴリ。ID はカテゴリ（categories）の ID で、支出などから参照される
+type BackupCategory struct {
+	ID          CategoryID `json:"id"`
+	Name        string     `json:"name"`
+	Color       string     `json:"color"`
+	Icon        string     `json:"icon"`
+	LimitAmount int        `json:"limitAmount"`
+	SortOrder   int        `json:"sortOrder"`
+	ArchivedAt  *time.Time `json:"archivedAt"`
+}
+
+// BackupMonthlyBudget は月ごとのカテゴリの予算
+type BackupMonthlyBudget struct {
+	CategoryID CategoryID `json:"categoryID"`
+	Month      string     `json:"month"`
+	Amount     int        `json:"amount"`
+	Rollover   bool       `json:"rollover"`
+}
+
+type BackupTag struct {
+	ID   TagID  `json:"id"`
+	Name string `json:"name"`
+}
+
+type BackupPaymentMethod struct {
+	ID                  PaymentMethodID  `json:"id"`
+	Name                string           `json:"name"`
+	Type                string           `json:"type"`
+	OpeningBalance      int              `json:"openingBalance"`
+	OpeningDate         string           `json:"openingDate"`
+	ClosingDay          *int             `json:"closingDay"`
+	PaymentDay          *int             `json:"paymentDay"`
+	WithdrawalAccountID *PaymentMethodID `json:"withdrawalAccountID"`
+	ArchivedAt          *time.Time       `json:"archivedAt"`
+}
+
+// BackupShoppingMemo は買い物メモ。CategoryID が 0 の場合はカテゴリを指定していない
+type BackupShoppingMemo struct {
+	CategoryID  CategoryID `json:"categoryID"`
+	Title       string     `json:"title"`
+	Memo        string     `json:"memo"`
+	IsCompleted bool       `json:"isCompleted"`
+}
+
+type BackupShoppingSplit struct {
+	UserID UserID `json:"userID"`
+	Value  int    `json:"value"`
+}
+
+// BackupShoppingAmount は支出。ReceiptID が 0 の場合はレシートと紐付いていない
+type BackupShoppingAmount struct {
+	CategoryID      CategoryID             `json:"categoryID"`
+	Amount          int                    `json:"amount"`
+	Date            string                 `json:"date"`
+	Memo            string                 `json:"memo"`
+	ReceiptID       uint                   `json:"receiptID"`
+	PaidBy          *UserID                `json:"paidBy"`
+	SplitType       string                 `json:"splitType"`
+	Splits          []*BackupShoppingSplit `json:"splits"`
+	PaymentMethodID *PaymentMethodID       `json:"paymentMethodID"`
+	TagIDs          []TagID                `json:"tagIDs"`
+	CreatedAt       time.Time              `json:"createdAt"`
+	// Original と ExchangeRate は基準通貨以外で記録した支出のみ設定する
+	Original     *Money `json:"original,omitempty"`
+	ExchangeRate string `json:"exchangeRate,omitempty"`
+}
+
+type BackupReceiptItem struct {
+	Name   string  `json:"name"`
+	Price  int     `json:"price"`
+	TagIDs []TagID `json:"tagIDs"`
+}
+
+// BackupReceipt はレシートの解析結果。ImageFile は zip の images/ 以下のファイル名で、画像がない場合は空文字
+type BackupReceipt struct {
+	ID            uint                 `json:"id"`
+	ImageFile     string               `json:"imageFile"`
+	AnalyzeStatus string               `json:"analyzeStatus"`
+	TotalPrice    int                  `json:"totalPrice"`
+	Items         []*BackupReceiptItem `json:"items"`
+	Currency      Currency             `json:"currency,omitempty"`
+}
+
+type BackupExchangeRate struct {
+	Currency      Currency `json:"currency"`
+	Rate          string   `json:"rate"`
+	EffectiveDate string   `json:"effectiveDate"`
+}
+
+type BackupIncomeCategory struct {
+	ID        IncomeCategoryID `json:"id"`
+	Name      string           `json:"name"`
+	Color     string           `json:"color"`
+	SortOrder int              `json:"sortOrder"`
+}
+
+// BackupIncome は収入。UserID が nil の場合は家計簿全体の収入
+type BackupIncome struct {
+	IncomeCategoryID IncomeCategoryID `json:"incomeCategoryID"`
+	UserID           *UserID          `json:"userID"`
+	Amount           int              `json:"amount"`
+	Date             string           `json:"date"`
+	Memo             string           `json:"memo"`
+	PaymentMethodID  *PaymentMethodID `json:"paymentMethodID"`
+}
+
+// BackupRecurringOverride は定期取引の発生日ごとのスキップ・変更
+type BackupRecurringOverride struct {
+	Date    string  `json:"date"`
+	Skipped bool    `json:"skipped"`
+	Amount  *int    `json:"amount"`
+	Memo    *string `json:"memo"`
+}
+
+// BackupRecurringTransaction は定期取引。MaterializedUntil までの発生日は支出として shopping_amounts.json に含まれる
+type BackupRecurringTransaction struct {
+	CategoryID        CategoryID                 `json:"categoryID"`
+	Amount            int                        `json:"amount"`
+	Memo              string                     `json:"memo"`
+	Frequency         RecurrenceFrequency        `json:"frequency"`
+	Interval          int                        `json:"interval"`
+	DayOfMonth        int                        `json:"dayOfMonth"`
+	StartDate         string                     `json:"startDate"`
+	EndDate           *string                    `json:"endDate"`
+	MaterializedUntil *string                    `json:"materializedUntil"`
+	Overrides         []*BackupRecurringOverride `json:"overrides"`
+}
+
+type BackupSettlement struct {
+	FromUserID UserID `json:"fromUserID"`
+	ToUserID   UserID `json:"toUserID"`
+	Amount     int    `json:"amount"`
+	Date       string `json:"date"`
+	Memo       string `json:"memo"`
+}
+
+type BackupChatMessage struct {
+	UserID      UserID          `json:"userID"`
+	MessageType ChatMessageType `json:"messageType"`
+	Content     string          `json:"content"`
+	CreatedAt   time.Time       `json:"createdAt"`
+}
+
+type BackupMembership struct {
+	UserID UserID        `json:"userID"`
+	Role   HouseHoldRole `json:"role"`
+}
+
+// HouseHoldBackup は家計簿1件分のバックアップ。ID はバックアップ元の ID のまま保持し、復元時に振り直す
+type HouseHoldBackup struct {
+	Manifest              BackupManifest
+	Categories            []*BackupCategory
+	MonthlyBudgets        []*BackupMonthlyBudget
+	Tags                  []*BackupTag
+	PaymentMethods        []*BackupPaymentMethod
+	ShoppingMemos         []*BackupShoppingMemo
+	ShoppingAmounts       []*BackupShoppingAmount
+	Receipts              []*BackupReceipt
+	ChatMessages          []*BackupChatMessage
+	Memberships           []*BackupMembership
+	ExchangeRates         []*BackupExchangeRate
+	IncomeCategories      []*BackupIncomeCategory
+	Incomes               []*BackupIncome
+	RecurringTransactions []*BackupRecurringTransaction
+	Settlements           []*BackupSettlement
+}
+
+// entries はファイル名と内容の組を zip に格納する順に返す
+func (b *HouseHoldBackup) entries() []struct {
+	name  string
+	value interface{}
+	count int
+} {
+	return []struct {
+		name  string
+		value interface{}
+		count int
+	}{
+		{BackupCategoriesFile, &b.Categories, len(b.Categories)},
+		{BackupMonthlyBudgetsFile, &b.MonthlyBudgets, len(b.MonthlyBudgets)},
+		{BackupTagsFile, &b.Tags, len(b.Tags)},
+		{BackupPaymentMethodsFile, &b.PaymentMethods, len(b.PaymentMethods)},
+		{BackupShoppingMemosFile, &b.ShoppingMemos, len(b.ShoppingMemos)},
+		{BackupShoppingAmountsFile, &b.ShoppingAmounts, len(b.ShoppingAmounts)},
+		{BackupReceiptsFile, &b.Receipts, len(b.Receipts)},
+		{BackupChatMessagesFile, &b.ChatMessages, len(b.ChatMessages)},
+		{BackupMembershipsFile, &b.Memberships, len(b.Memberships)},
+		{BackupExchangeRatesFile, &b.ExchangeRates, len(b.ExchangeRates)},
+		{BackupIncomeCategoriesFile, &b.IncomeCategories, len(b.IncomeCategories)},
+		{BackupIncomesFile, &b.Incomes, len(b.Incomes)},
+		{BackupRecurringTransactionsFile, &b.RecurringTransactions, len(b.RecurringTransactions)},
+		{BackupSettlementsFile, &b.Settlements, len(b.Settlements)},
+	}
+}
+
+// Validate はバックアップ内の参照が同じバックアップに含まれることを検証する
+// 復元時に ID を振り直せない参照があると、別の家計簿のデータを指してしまうため
+func (b *HouseHoldBackup) Validate() error {
+	categories := map[CategoryID]bool{}
+	for _, category := range b.Categories {
+		categories[category.ID] = true
+	}
+	tags := map[TagID]bool{}
+	for _, tag := range b.Tags {
+		tags[tag.ID] = true
+	}
+	paymentMethods := map[PaymentMethodID]bool{}
+	for _, paymentMethod := range b.PaymentMethods {
+		paymentMethods[paymentMethod.ID] = true
+	}
+	receipts := map[uint]bool{}
+	for _, receipt := range b.Receipts {
+		receipts[receipt.ID] = true
+	}
+	incomeCategories := map[IncomeCategoryID]bool{}
+	for _, incomeCategory := range b.IncomeCategories {
+		incomeCategories[incomeCategory.ID] = true
+	}
+
+	if b.Manifest.BaseCurrency != "" {
+		if _, err := ParseCurrency(string(b.Manifest.BaseCurrency)); err != nil {
+			return fmt.Errorf("%w: base currency %q is invalid", ErrInvalidBackup, b.Manifest.BaseCurrency)
+		}
+	}
+	for _, rate := range b.ExchangeRates {
+		if _, err := NewExchangeRate(0, string(rate.Currency), rate.Rate, rate.EffectiveDate); err != nil {
+			return fmt.Errorf("%w: exchange rate for %s on %s is invalid: %v", ErrInvalidBackup, rate.Currency, rate.EffectiveDate, err)
+		}
+	}
+
+	for _, budget := range b.MonthlyBudgets {
+		if !categories[budget.CategoryID] {
+			return fmt.Errorf("%w: monthly budget %s refers to unknown category %d", ErrInvalidBackup, budget.Month, budget.CategoryID)
+		}
+	}
+	for _, paymentMethod := range b.PaymentMethods {
+		if paymentMethod.WithdrawalAccountID != nil && !paymentMethods[*paymentMethod.WithdrawalAccountID] {
+			return fmt.Errorf("%w: payment method %d refers to unknown withdrawal account %d", ErrInvalidBackup, paymentMethod.ID, *paymentMethod.WithdrawalAccountID)
+		}
+	}
+	for _, memo := range b.ShoppingMemos {
+		if memo.CategoryID != 0 && !categories[memo.CategoryID] {
+			return fmt.Errorf("%w: shopping memo %q refers to unknown category %d", ErrInvalidBackup, memo.Title, memo.CategoryID)
+		}
+	}
+	for _, receipt := range b.Receipts {
+		for _, item := range receipt.Items {
+			if err := validateBackupTags(item.TagIDs, tags); err != nil {
+				return err
+			}
+		}
+	}
+	for _, shopping := range b.ShoppingAmounts {
+		if _, err := time.Parse("2006-01-02", shopping.Date); err != nil {
+			return fmt.Errorf("%w: shopping amount date %q is invalid", ErrInvalidBackup, shopping.Date)
+		}
+		if !categories[shopping.CategoryID] {
+			return fmt.Errorf("%w: shopping amount on %s refers to unknown category %d", ErrInvalidBackup, shopping.Date, shopping.CategoryID)
+		}
+		if shopping.PaymentMethodID != nil && !paymentMethods[*shopping.PaymentMethodID] {
+			return fmt.Errorf("%w: shopping amount on %s refers to unknown payment method %d", ErrInvalidBackup, shopping.Date, *shopping.PaymentMethodID)
+		}
+		if shopping.ReceiptID != 0 && !receipts[shopping.ReceiptID] {
+			return fmt.Errorf("%w: shopping amount on %s refers to unknown receipt %d", ErrInvalidBackup, shopping.Date, shopping.ReceiptID)
+		}
+		if err := validateBackupTags(shopping.TagIDs, tags); err != nil {
+			return err
+		}
+		if shopping.Original != nil {
+			if _, err := ParseCurrency(string(shopping.Original.Currency)); err != nil {
+				return fmt.Errorf("%w: shopping amount on %s has invalid currency %q", ErrInvalidBackup, shopping.Date, shopping.Original.Currency)
+			}
+			if _, err := NormalizeExchangeRate(shopping.ExchangeRate); err != nil {
+				return fmt.Errorf("%w: shopping amount on %s has invalid exchange rate %q", ErrInvalidBackup, shopping.Date, shopping.ExchangeRate)
+			}
+		}
+	}
+	for _, income := range b.Incomes {
+		if _, err := time.Parse("2006-01-02", income.Date); err != nil {
+			return fmt.Errorf("%w: income date %q is invalid", ErrInvalidBackup, income.Date)
+		}
+		if !incomeCategories[income.IncomeCategoryID] {
+			return fmt.Errorf("%w: income on %s refers to unknown income category %d", ErrInvalidBackup, income.Date, income.IncomeCategoryID)
+		}
+		if income.PaymentMethodID != nil && !paymentMethods[*income.PaymentMethodID] {
+			return fmt.Errorf("%w: income on %s refers to unknown payment method %d", ErrInvalidBackup, income.Date, *income.PaymentMethodID)
+		}
+	}
+	for _, recurring := range b.RecurringTransactions {
+		definition := &RecurringTransaction{
+			CategoryID: recurring.CategoryID,
+			Amount:     recurring.Amount,
+			Frequency:  recurring.Frequency,
+			Interval:   recurring.Interval,
+			DayOfMonth: recurring.DayOfMonth,
+			StartDate:  recurring.StartDate,
+			EndDate:    recurring.EndDate,
+		}
+		if err := definition.Validate(); err != nil {
+			return fmt.Errorf("%w: recurring transaction from %s is invalid: %v", ErrInvalidBackup, recurring.StartDate, err)
+		}
+		if !categories[recurring.CategoryID] {
+			return fmt.Errorf("%w: recurring transaction from %s refers to unknown category %d", ErrInvalidBackup, recurring.StartDate, recurring.CategoryID)
+		}
+		if recurring.MaterializedUntil != nil {
+			if _, err := ParseRecurringDate(*recurring.MaterializedUntil); err != nil {
+				return fmt.Errorf("%w: recurring transaction from %s has invalid materialized date %q", ErrInvalidBackup, recurring.StartDate, *recurring.MaterializedUntil)
+			}
+		}
+		for _, override := range recurring.Overrides {
+			if _, err := ParseRecurringDate(override.Date); err != nil {
+				return fmt.Errorf("%w: recurring transaction from %s has invalid override date %q", ErrInvalidBackup, recurring.StartDate, override.Date)
+			}
+		}
+	}
+	for _, settlement := range b.Settlements {
+		if _, err := time.Parse("2006-01-02", settlement.Date); err != nil {
+			return fmt.Errorf("%w: settlement date %q is invalid", ErrInvalidBackup, settlement.Date)
+		}
+	}
+	for _, membership := range b.Memberships {
+		if _, err := ParseHouseHoldRole(string(membership.Role)); err != nil {
+			return fmt.Errorf("%w: membership of user %d has invalid role %q", ErrInvalidBackup, membership.UserID, membership.Role)
+		}
+	}
+	return nil
+}
+
+func validateBackupTags(tagIDs []TagID, tags map[TagID]bool) error {
+	for _, tagID := range tagIDs {
+		if !tags[tagID] {
+			return fmt.Errorf("%w: unknown tag %d", ErrInvalidBackup, tagID)
+		}
+	}
+	return nil
+}
+
+// BackupWriter はバックアップを zip に書き出す。レシート画像を先に書き出し、Close で各データとマニフェストを書き出す
+type BackupWriter struct {
+	zip *zip.Writer
+}
+
+func NewBackupWriter(w io.Writer) *BackupWriter {
+	return &BackupWriter{zip: zip.NewWriter(w)}
+}
+
+// WriteImage はレシート画像を書き出す。画像は圧縮済みのため無圧縮で格納する
+func (w *BackupWriter) WriteImage(name string, data []byte) error {
+	f, err := w.zip.CreateHeader(&zip.FileHeader{Name: backupImageDir + path.Base(name), Method: zip.Store, Modified: time.Now()})
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	return err
+}
+
+// Close は各データとマニフェストを書き出して zip を閉じる。マニフェストの版と件数はここで設定する
+func (w *BackupWriter) Close(backup *HouseHoldBackup) error {
+	backup.Manifest.Version = BackupFormatVersion
+	backup.Manifest.Counts = map[string]int{}
+	for _, entry := range backup.entries() {
+		backup.Manifest.Counts[entry.name] = entry.count
+		if err := w.writeJSON(entry.name, entry.value); err != nil {
+			return err
+		}
+	}
+	if err := w.writeJSON(BackupManifestFile, backup.Manifest); err != nil {
+		return err
+	}
+	return w.zip.Close()
+}
+
+func (w *BackupWriter) writeJSON(name string, value interface{}) error {
+	f, err := w.zip.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: time.Now()})
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(f)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+// BackupArchive は読み込んだバックアップ。レシート画像は必要になった時点で展開する
+type BackupArchive struct {
+	Backup *HouseHoldBackup
+	images map[string]*zip.File
+}
+
+// ReadBackupArchive はバックアップの zip を読み込み、版と件数、参照を検証する
+func ReadBackupArchive(r io.ReaderAt, size int64) (*BackupArchive, error) {
+	reader, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBackup, err)
+	}
+
+	archive := &BackupArchive{Backup: &HouseHoldBackup{}, images: map[string]*zip.File{}}
+	files := map[string]*zip.File{}
+	for _, f := range reader.File {
+		if dir, name := path.Split(f.Name); dir == backupImageDir && name != "" {
+			archive.images[name] = f
+			continue
+		}
+		files[f.Name] = f
+	}
+
+	manifest, ok := files[BackupManifestFile]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s not found", ErrInvalidBackup, BackupManifestFile)
+	}
+	if err := readBackupJSON(manifest, &archive.Backup.Manifest); err != nil {
+		return nil, err
+	}
+	if archive.Backup.Manifest.Version < 1 || archive.Backup.Manifest.Version > BackupFormatVersion {
+		return nil, fmt.Errorf("%w: version %d", ErrUnsupportedBackupVersion, archive.Backup.Manifest.Version)
+	}
+
+	for _, entry := range archive.Backup.entries() {
+		// 件数が0のファイルは省略されていてもよい
+		if f, ok := files[entry.name]; ok {
+			if err := readBackupJSON(f, entry.value); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, entry := range archive.Backup.entries() {
+		if entry.count != archive.Backup.Manifest.Counts[entry.name] {
+			return nil, fmt.Errorf("%w: %s has %d entries but manifest says %d", ErrInvalidBackup, entry.name, entry.count, archive.Backup.Manifest.Counts[entry.name])
+		}
+	}
+
+	if err := archive.Backup.Validate(); err != nil {
+		return nil, err
+	}
+	return archive, nil
+}
+
+// Image はレシート画像を展開して返す
+func (a *BackupArchive) Image(name string) ([]byte, error) {
+	f, ok := a.images[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrBackupImageNotFound, name)
+	}
+	return readBackupFile(f)
+}
+
+func readBackupJSON(f *zip.File, value interface{}) error {
+	data, err := readBackupFile(f)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidBackup, f.Name, err)
+	}
+	return nil
+}
+
+// readBackupFile は zip 内のファイルを展開する。展開後の大きさが上限を超える場合はエラーとする
+func readBackupFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalidBackup, f.Name, err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, MaxBackupEntrySize+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalidBackup, f.Name, err)
+	}
+	if len(data) > MaxBackupEntrySize {
+		return nil, fmt.Errorf("%w: %s exceeds %d bytes", ErrInvalidBackup, f.Name, MaxBackupEntrySize)
+	}
+	return data, nil
+}
+
+// RestoreBackupInput はバックアップから家計簿を作成する指定
+type RestoreBackupInput struct {
+	// UserID は復元した家計簿の所有者
+	UserID UserID
+	// Title は復元した家計簿の名前。空文字の場合はバックアップの名前
+	Title string
+	// IncludeMembers はバックアップのメンバーのうち、現在 UserID のユーザーと同じ家計簿のメンバーであるユーザーを
+	// 復元した家計簿のメンバーに加えるか
+	IncludeMembers bool
+}
+
+// RestoreBackupResult は復元した家計簿と、復元できなかったデータの件数
+type RestoreBackupResult struct {
+	HouseHoldID HouseHoldID `json:"houseHoldID"`
+	// Counts はファイルごとの復元した件数
+	Counts map[string]int `json:"counts"`
+	// SkippedChatMessages は存在しないユーザーの投稿のため復元しなかったチャットの件数
+	SkippedChatMessages int `json:"skippedChatMessages"`
+	// SkippedSettlements は支払った、または受け取ったユーザーが存在しないため復元しなかった精算の件数
+	SkippedSettlements int `json:"skippedSettlements"`
+	// MissingImages は画像がなかったため、画像なしで復元したレシート
+	MissingImages []string `json:"missingImages"`
+}
+
+type BackupRepository interface {
+	// FindHouseHoldBackup は家計簿のバックアップの内容を返す。家計簿が存在しない場合は gorm.ErrRecordNotFound を返す
+	FindHouseHoldBackup(houseHoldID HouseHoldID) (*HouseHoldBackup, error)
+	// RestoreHouseHoldBackup はバックアップから新しい家計簿を1つのトランザクションで作成する
+	// images はバックアップのレシート画像のファイル名からストレージに保存したファイル名への対応
+	RestoreHouseHoldBackup(backup *HouseHoldBackup, input *RestoreBackupInput, images map[string]string) (*RestoreBackupResult, error)
+}
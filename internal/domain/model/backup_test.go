@@ -0,0 +1,131 @@
+package domainmodel
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestBackup() *HouseHoldBackup {
+	paymentMethodID := PaymentMethodID(5)
+	paidBy := UserID(3)
+	return &HouseHoldBackup{
+		Manifest: BackupManifest{
+			CreatedAt:   time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC),
+			HouseHoldID: 1,
+			Title:       "我が家",
+		},
+		Categories:     []*BackupCategory{{ID: 1, Name: "食費", Color: "#FF0000", LimitAmount: 40000, SortOrder: 1}},
+		MonthlyBudgets: []*BackupMonthlyBudget{{CategoryID: 1, Month: "2026-10", Amount: 45000}},
+		Tags:           []*BackupTag{{ID: 7, Name: "まとめ買い"}},
+		PaymentMethods: []*BackupPaymentMethod{{ID: 5, Name: "楽天カード", Type: "credit_card", OpeningDate: "2026-01-01"}},
+		Receipts: []*BackupReceipt{
+			{ID: 9, ImageFile: "receipt.jpg", AnalyzeStatus: "finished", TotalPrice: 1200, Items: []*BackupReceiptItem{{Name: "牛乳", Price: 200, TagIDs: []TagID{7}}}},
+		},
+		ShoppingAmounts: []*BackupShoppingAmount{
+			{CategoryID: 1, Amount: 1200, Date: "2026-10-18", ReceiptID: 9, PaidBy: &paidBy, SplitType: "equal", PaymentMethodID: &paymentMethodID, TagIDs: []TagID{7}},
+		},
+		IncomeCategories: []*BackupIncomeCategory{{ID: 2, Name: "給与", Color: "#00FF00", SortOrder: 1}},
+		Incomes:          []*BackupIncome{{IncomeCategoryID: 2, UserID: &paidBy, Amount: 300000, Date: "2026-10-25", PaymentMethodID: &paymentMethodID}},
+		RecurringTransactions: []*BackupRecurringTransaction{
+			{CategoryID: 1, Amount: 980, Frequency: RecurrenceMonthly, Interval: 1, DayOfMonth: 1, StartDate: "2026-09-01",
+				Overrides: []*BackupRecurringOverride{{Date: "2026-11-01", Skipped: true}}},
+		},
+		Settlements: []*BackupSettlement{{FromUserID: 4, ToUserID: 3, Amount: 600, Date: "2026-10-05"}},
+		Memberships: []*BackupMembership{{UserID: 3, Role: HouseHoldRoleOwner}},
+	}
+}
+
+func TestBackupArchive(t *testing.T) {
+	t.Run("書き出したバックアップを読み込める", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		writer := NewBackupWriter(buf)
+		assert.NoError(t, writer.WriteImage("receipt.jpg", []byte("jpeg")))
+		assert.NoError(t, writer.Close(newTestBackup()))
+
+		archive, err := ReadBackupArchive(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		assert.NoError(t, err)
+		assert.Equal(t, BackupFormatVersion, archive.Backup.Manifest.Version)
+		assert.Equal(t, 1, archive.Backup.Manifest.Counts[BackupShoppingAmountsFile])
+		assert.Equal(t, newTestBackup().ShoppingAmounts, archive.Backup.ShoppingAmounts)
+		assert.Equal(t, newTestBackup().Receipts, archive.Backup.Receipts)
+		assert.Equal(t, newTestBackup().Incomes, archive.Backup.Incomes)
+		assert.Equal(t, newTestBackup().RecurringTransactions, archive.Backup.RecurringTransactions)
+		assert.Equal(t, newTestBackup().Settlements, archive.Backup.Settlements)
+
+		image, err := archive.Image("receipt.jpg")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("jpeg"), image)
+
+		_, err = archive.Image("other.jpg")
+		assert.ErrorIs(t, err, ErrBackupImageNotFound)
+	})
+
+	t.Run("新しい版のバックアップは読み込めない", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		w := zip.NewWriter(buf)
+		f, _ := w.Create(BackupManifestFile)
+		_, _ = f.Write([]byte(fmt.Sprintf(`{"version": %d}`, BackupFormatVersion+1)))
+		assert.NoError(t, w.Close())
+
+		_, err := ReadBackupArchive(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		assert.ErrorIs(t, err, ErrUnsupportedBackupVersion)
+	})
+
+	t.Run("マニフェストの件数と一致しない場合は読み込めない", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		w := zip.NewWriter(buf)
+		f, _ := w.Create(BackupManifestFile)
+		_, _ = f.Write([]byte(`{"version": 1, "counts": {"shopping_amounts.json": 3}}`))
+		assert.NoError(t, w.Close())
+
+		_, err := ReadBackupArchive(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		assert.ErrorIs(t, err, ErrInvalidBackup)
+	})
+
+	t.Run("zip ではない", func(t *testing.T) {
+		data := []byte("not a zip")
+		_, err := ReadBackupArchive(bytes.NewReader(data), int64(len(data)))
+		assert.ErrorIs(t, err, ErrInvalidBackup)
+	})
+}
+
+func TestHouseHoldBackup_Validate(t *testing.T) {
+	unknown := PaymentMethodID(99)
+	tests := []struct {
+		name   string
+		modify func(b *HouseHoldBackup)
+		valid  bool
+	}{
+		{name: "参照がすべて含まれる", modify: func(b *HouseHoldBackup) {}, valid: true},
+		{name: "存在しないカテゴリの支出", modify: func(b *HouseHoldBackup) { b.ShoppingAmounts[0].CategoryID = 2 }},
+		{name: "存在しない支払い方法の支出", modify: func(b *HouseHoldBackup) { b.ShoppingAmounts[0].PaymentMethodID = &unknown }},
+		{name: "存在しないレシートの支出", modify: func(b *HouseHoldBackup) { b.ShoppingAmounts[0].ReceiptID = 10 }},
+		{name: "存在しないタグの品目", modify: func(b *HouseHoldBackup) { b.Receipts[0].Items[0].TagIDs = []TagID{8} }},
+		{name: "存在しないカテゴリの予算", modify: func(b *HouseHoldBackup) { b.MonthlyBudgets[0].CategoryID = 2 }},
+		{name: "日付の形式が不正な支出", modify: func(b *HouseHoldBackup) { b.ShoppingAmounts[0].Date = "2026/10/18" }},
+		{name: "存在しない収入カテゴリの収入", modify: func(b *HouseHoldBackup) { b.Incomes[0].IncomeCategoryID = 3 }},
+		{name: "存在しない支払い方法の収入", modify: func(b *HouseHoldBackup) { b.Incomes[0].PaymentMethodID = &unknown }},
+		{name: "存在しないカテゴリの定期取引", modify: func(b *HouseHoldBackup) { b.RecurringTransactions[0].CategoryID = 2 }},
+		{name: "繰り返し単位が不正な定期取引", modify: func(b *HouseHoldBackup) { b.RecurringTransactions[0].Frequency = "daily" }},
+		{name: "日付の形式が不正な精算", modify: func(b *HouseHoldBackup) { b.Settlements[0].Date = "2026/10/05" }},
+		{name: "未定義のロール", modify: func(b *HouseHoldBackup) { b.Memberships[0].Role = "admin" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backup := newTestBackup()
+			tt.modify(backup)
+			err := backup.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidBackup)
+			}
+		})
+	}
+}
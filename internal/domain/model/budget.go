@@ -0,0 +1,198 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainmodel
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// BudgetMonthLayout は予算の対象月の表記
+const BudgetMonthLayout = "2006-01"
+
+type MonthlyBudgetID uint
+
+// MonthlyBudget は家計簿・カテゴリ・月ごとの予算
+// 予算を変更しても過去の月の予算が変わらないよう、月ごとに保持する
+type MonthlyBudget struct {
+	ID          MonthlyBudgetID `json:"id"`
+	HouseHoldID HouseHoldID     `json:"houseHoldID"`
+	CategoryID  CategoryID      `json:"categoryID"`
+	Month       string          `json:"month"`
+	Amount      int             `json:"amount"`
+	// Rollover が有効な場合、この月の残額（超過した場合は超過額）を翌月に繰り越す
+	Rollover bool `json:"rollover"`
+}
+
+var (
+	ErrInvalidBudgetMonth  = errors.New("budget month must be in YYYY-MM format")
+	ErrInvalidBudgetAmount = errors.New("budget amount must be 0 or greater")
+)
+
+// Validate は月ごとの予算を検証する
+func (b *MonthlyBudget) Validate() error {
+	if _, err := ParseBudgetMonth(b.Month); err != nil {
+		return err
+	}
+	if b.Amount < 0 {
+		return ErrInvalidBudgetAmount
+	}
+	return nil
+}
+
+// ParseBudgetMonth は YYYY-MM 形式の月を解析し、月初の日時を返す
+func ParseBudgetMonth(month string) (time.Time, error) {
+	t, err := time.Parse(BudgetMonthLayout, month)
+	if err != nil {
+		return time.Time{}, ErrInvalidBudgetMonth
+	}
+	return t, nil
+}
+
+// BudgetMonthOf は日時が属する月を YYYY-MM 形式で返す
+func BudgetMonthOf(t time.Time) string {
+	return t.Format(BudgetMonthLayout)
+}
+
+// nextBudgetMonth は翌月を返す。month は検証済みであること
+func nextBudgetMonth(month string) string {
+	t, _ := ParseBudgetMonth(month)
+	return BudgetMonthOf(t.AddDate(0, 1, 0))
+}
+
+// MonthlyCategoryAmount はカテゴリごとの月間支出
+type MonthlyCategoryAmount struct {
+	Month      string
+	CategoryID CategoryID
+	Amount     int
+}
+
+// CategoryBudget はカテゴリごとの予算と実績
+type CategoryBudget struct {
+	Category Category `json:"category"`
+	// Budget はその月に設定された予算
+	Budget int `json:"budget"`
+	// CarriedOver は前月から繰り越された金額。前月に超過した場合は負の値になる
+	CarriedOver int  `json:"carriedOver"`
+	Actual      int  `json:"actual"`
+	Remaining   int  `json:"remaining"`
+	Rollover    bool `json:"rollover"`
+}
+
+type CategoryBudgets []*CategoryBudget
+
+// BudgetHistory は家計簿の予算と支出の履歴
+// 予算が登録されていない月は、それより前で最も新しい月の予算を引き継ぐ
+type BudgetHistory struct {
+	budgets map[CategoryID][]*MonthlyBudget
+	actuals map[CategoryID]map[string]int
+}
+
+func NewBudgetHistory(budgets []*MonthlyBudget, actuals []*MonthlyCategoryAmount) *BudgetHistory {
+	h := &BudgetHistory{
+		budgets: make(map[CategoryID][]*MonthlyBudget),
+		actuals: make(map[CategoryID]map[string]int),
+	}
+	for _, budget := range budgets {
+		h.budgets[budget.CategoryID] = append(h.budgets[budget.CategoryID], budget)
+	}
+	for _, categoryBudgets := range h.budgets {
+		sort.SliceStable(categoryBudgets, func(i, j int) bool {
+			return categoryBudgets[i].Month < categoryBudgets[j].Month
+		})
+	}
+	for _, actual := range actuals {
+		if _, ok := h.actuals[actual.CategoryID]; !ok {
+			h.actuals[actual.CategoryID] = make(map[string]int)
+		}
+		h.actuals[actual.CategoryID][actual.Month] += actual.Amount
+	}
+	return h
+}
+
+// EffectiveBudget は指定月に適用される予算を返す。予算が一度も登録されていない場合は nil を返す
+func (h *BudgetHistory) EffectiveBudget(categoryID CategoryID, month string) *MonthlyBudget {
+	var effective *MonthlyBudget
+	for _, budget := range h.budgets[categoryID] {
+		if budget.Month > month {
+			break
+		}
+		effective = budget
+	}
+	return effective
+}
+
+// CarriedOver は指定月に前月から繰り越される金額を返す
+// 繰り越しが有効な月が続く限り、残額（超過額）を積み上げる
+func (h *BudgetHistory) CarriedOver(categoryID CategoryID, month string) int {
+	categoryBudgets := h.budgets[categoryID]
+	if len(categoryBudgets) == 0 {
+		return 0
+	}
+
+	carriedOver := 0
+	for m := categoryBudgets[0].Month; m < month; m = nextBudgetMonth(m) {
+		budget := h.EffectiveBudget(categoryID, m)
+		if budget.Rollover {
+			carriedOver = budget.Amount + carriedOver - h.actuals[categoryID][m]
+		} else {
+			carriedOver = 0
+		}
+	}
+	return carriedOver
+}
+
+// MissingBudgets は指定月の予算が未登録のカテゴリについて、前月までの予算を引き継いだ予算を返す
+// 一度も予算が登録されていないカテゴリは、カテゴリの上限金額を予算とする
+func (h *BudgetHistory) MissingBudgets(houseHoldID HouseHoldID, categories []*CategoryLimit, month string) []*MonthlyBudget {
+	missing := []*MonthlyBudget{}
+	for _, category := range categories {
+		effective := h.EffectiveBudget(category.Category.ID, month)
+		if effective != nil && effective.Month == month {
+			continue
+		}
+
+		budget := &MonthlyBudget{
+			HouseHoldID: houseHoldID,
+			CategoryID:  category.Category.ID,
+			Month:       month,
+			Amount:      category.LimitAmount,
+		}
+		if effective != nil {
+			budget.Amount = effective.Amount
+			budget.Rollover = effective.Rollover
+		}
+		missing = append(missing, budget)
+	}
+	return missing
+}
+
+// Summarize は指定月のカテゴリごとの予算・実績・残額を、カテゴリの並び順で返す
+func (h *BudgetHistory) Summarize(categories []*CategoryLimit, month string) CategoryBudgets {
+	summaries := CategoryBudgets{}
+	for _, category := range categories {
+		categoryBudget := &CategoryBudget{
+			Category:    category.Category,
+			Budget:      category.LimitAmount,
+			CarriedOver: h.CarriedOver(category.Category.ID, month),
+			Actual:      h.actuals[category.Category.ID][month],
+		}
+		if budget := h.EffectiveBudget(category.Category.ID, month); budget != nil {
+			categoryBudget.Budget = budget.Amount
+			categoryBudget.Rollover = budget.Rollover
+		}
+		categoryBudget.Remaining = categoryBudget.Budget + categoryBudget.CarriedOver - categoryBudget.Actual
+		summaries = append(summaries, categoryBudget)
+	}
+	return summaries
+}
+
+// MonthlyBudgetRepository は月ごとの予算の永続化を担うリポジトリのインターフェース
+type MonthlyBudgetRepository interface {
+	// FindByHouseHoldID は指定月以前の予算を月の昇順で取得します
+	FindByHouseHoldID(houseHoldID HouseHoldID, untilMonth string) ([]*MonthlyBudget, error)
+	// CreateMonthlyBudgets は予算を一括で登録します。既に登録済みの月の予算は更新しません
+	CreateMonthlyBudgets(budgets []*MonthlyBudget) error
+	// SaveMonthlyBudget は予算を登録し、既に登録済みの場合は更新します
+	SaveMonthlyBudget(budget *MonthlyBudget) error
+}
@@ -0,0 +1,86 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainmodel
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+type BudgetAlertID uint
+
+// BudgetAlert はカテゴリの予算消化率が閾値に達したことを表すアラート
+// 同じ家計簿・カテゴリ・月・閾値のアラートは一度だけ作成する
+type BudgetAlert struct {
+	ID          BudgetAlertID `json:"id"`
+	HouseHoldID HouseHoldID   `json:"houseHoldID"`
+	CategoryID  CategoryID    `json:"categoryID"`
+	Category    Category      `json:"category"`
+	Month       string        `json:"month"`
+	// Threshold は予算に対する消化率（%）
+	Threshold int `json:"threshold"`
+	// Budget は繰越額を含めた予算
+	Budget    int       `json:"budget"`
+	Actual    int       `json:"actual"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// NewBudgetAlerts はカテゴリの支出が達した閾値ごとのアラートを返す
+// 予算が設定されていないカテゴリは対象外とし、繰り越しで予算が残っていない場合は全ての閾値に達したものとする
+func NewBudgetAlerts(houseHoldID HouseHoldID, month string, budget *CategoryBudget, thresholds []int) []*BudgetAlert {
+	if budget.Budget <= 0 || budget.Actual <= 0 {
+		return nil
+	}
+
+	available := budget.Budget + budget.CarriedOver
+	alerts := []*BudgetAlert{}
+	for _, threshold := range thresholds {
+		if available > 0 && budget.Actual*100 < available*threshold {
+			continue
+		}
+		alerts = append(alerts, &BudgetAlert{
+			HouseHoldID: houseHoldID,
+			CategoryID:  budget.Category.ID,
+			Category:    budget.Category,
+			Month:       month,
+			Threshold:   threshold,
+			Budget:      available,
+			Actual:      budget.Actual,
+		})
+	}
+	return alerts
+}
+
+// Message はチャットに投稿するアラートの本文を返す
+func (a *BudgetAlert) Message() string {
+	month := a.Month
+	if t, err := ParseBudgetMonth(a.Month); err == nil {
+		month = t.Format("2006年1月")
+	}
+	return fmt.Sprintf("【予算アラート】%sの「%s」が予算の%d%%に達しました（%s円 / %s円）",
+		month, a.Category.Name, a.Threshold, formatYen(a.Actual), formatYen(a.Budget))
+}
+
+// formatYen は金額を3桁区切りで返す
+func formatYen(amount int) string {
+	digits := strconv.Itoa(amount)
+	sign := ""
+	if amount < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	for i := len(digits) - 3; i > 0; i -= 3 {
+		digits = digits[:i] + "," + digits[i:]
+	}
+	return sign + digits
+}
+
+// BudgetAlertRepository は予算アラートの永続化を担うリポジトリのインターフェース
+type BudgetAlertRepository interface {
+	// CreateIfNotExists は同じ月・閾値のアラートが未登録の場合のみ登録し、登録したかを返します
+	CreateIfNotExists(alert *BudgetAlert) (bool, error)
+}
+
+// BudgetAlertNotifier は予算アラートを家計簿のメンバーに通知する
+type BudgetAlertNotifier interface {
+	Notify(alert *BudgetAlert) error
+}
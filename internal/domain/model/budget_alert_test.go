@@ -0,0 +1,56 @@
+package domainmodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBudgetAlerts(t *testing.T) {
+	thresholds := []int{80, 100}
+	tests := []struct {
+		name       string
+		budget     *CategoryBudget
+		thresholds []int
+	}{
+		{name: "閾値に達していない", budget: &CategoryBudget{Budget: 10000, Actual: 7999}, thresholds: []int{}},
+		{name: "80%に達した", budget: &CategoryBudget{Budget: 10000, Actual: 8000}, thresholds: []int{80}},
+		{name: "100%に達した", budget: &CategoryBudget{Budget: 10000, Actual: 12000}, thresholds: []int{80, 100}},
+		{name: "繰越額を含めた予算で判定する", budget: &CategoryBudget{Budget: 10000, CarriedOver: 5000, Actual: 12000}, thresholds: []int{80}},
+		{name: "繰り越しで予算が残っていない", budget: &CategoryBudget{Budget: 10000, CarriedOver: -10000, Actual: 100}, thresholds: []int{80, 100}},
+		{name: "予算が設定されていない", budget: &CategoryBudget{Budget: 0, Actual: 5000}, thresholds: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alerts := NewBudgetAlerts(10, "2026-10", tt.budget, thresholds)
+			reached := []int{}
+			for _, alert := range alerts {
+				assert.Equal(t, HouseHoldID(10), alert.HouseHoldID)
+				assert.Equal(t, "2026-10", alert.Month)
+				assert.Equal(t, tt.budget.Budget+tt.budget.CarriedOver, alert.Budget)
+				reached = append(reached, alert.Threshold)
+			}
+			assert.Equal(t, tt.thresholds, reached)
+		})
+	}
+}
+
+func TestBudgetAlert_Message(t *testing.T) {
+	alert := &BudgetAlert{
+		Category:  Category{Name: "食費"},
+		Month:     "2026-10",
+		Threshold: 80,
+		Budget:    30000,
+		Actual:    24500,
+	}
+	assert.Equal(t, "【予算アラート】2026年10月の「食費」が予算の80%に達しました（24,500円 / 30,000円）", alert.Message())
+}
+
+func TestFormatYen(t *testing.T) {
+	assert.Equal(t, "0", formatYen(0))
+	assert.Equal(t, "999", formatYen(999))
+	assert.Equal(t, "1,000", formatYen(1000))
+	assert.Equal(t, "1,234,567", formatYen(1234567))
+	assert.Equal(t, "-12,000", formatYen(-12000))
+}
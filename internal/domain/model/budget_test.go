@@ -0,0 +1,136 @@
+package domainmodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonthlyBudget_Validate(t *testing.T) {
+	assert.NoError(t, (&MonthlyBudget{Month: "2026-10", Amount: 0}).Validate())
+	assert.Equal(t, ErrInvalidBudgetMonth, (&MonthlyBudget{Month: "2026/10", Amount: 100}).Validate())
+	assert.Equal(t, ErrInvalidBudgetMonth, (&MonthlyBudget{Month: "2026-13", Amount: 100}).Validate())
+	assert.Equal(t, ErrInvalidBudgetAmount, (&MonthlyBudget{Month: "2026-10", Amount: -1}).Validate())
+}
+
+func TestBudgetHistory_EffectiveBudget(t *testing.T) {
+	history := NewBudgetHistory([]*MonthlyBudget{
+		{CategoryID: 1, Month: "2026-08", Amount: 30000},
+		{CategoryID: 1, Month: "2026-06", Amount: 20000},
+	}, nil)
+
+	assert.Nil(t, history.EffectiveBudget(1, "2026-05"))
+	assert.Equal(t, 20000, history.EffectiveBudget(1, "2026-07").Amount)
+	assert.Equal(t, 30000, history.EffectiveBudget(1, "2026-08").Amount)
+	assert.Equal(t, 30000, history.EffectiveBudget(1, "2026-12").Amount)
+	assert.Nil(t, history.EffectiveBudget(2, "2026-08"))
+}
+
+func TestBudgetHistory_CarriedOver(t *testing.T) {
+	tests := []struct {
+		name     string
+		budgets  []*MonthlyBudget
+		actuals  []*MonthlyCategoryAmount
+		month    string
+		expected int
+	}{
+		{
+			name:     "繰り越しが無効な場合は繰り越さない",
+			budgets:  []*MonthlyBudget{{CategoryID: 1, Month: "2026-09", Amount: 30000}},
+			actuals:  []*MonthlyCategoryAmount{{Month: "2026-09", CategoryID: 1, Amount: 10000}},
+			month:    "2026-10",
+			expected: 0,
+		},
+		{
+			name:     "残額を翌月に繰り越す",
+			budgets:  []*MonthlyBudget{{CategoryID: 1, Month: "2026-09", Amount: 30000, Rollover: true}},
+			actuals:  []*MonthlyCategoryAmount{{Month: "2026-09", CategoryID: 1, Amount: 10000}},
+			month:    "2026-10",
+			expected: 20000,
+		},
+		{
+			name:     "超過額は翌月の予算から差し引く",
+			budgets:  []*MonthlyBudget{{CategoryID: 1, Month: "2026-09", Amount: 30000, Rollover: true}},
+			actuals:  []*MonthlyCategoryAmount{{Month: "2026-09", CategoryID: 1, Amount: 35000}},
+			month:    "2026-10",
+			expected: -5000,
+		},
+		{
+			name:    "予算が未登録の月も前月の予算を引き継いで繰り越しを積み上げる",
+			budgets: []*MonthlyBudget{{CategoryID: 1, Month: "2026-08", Amount: 30000, Rollover: true}},
+			actuals: []*MonthlyCategoryAmount{
+				{Month: "2026-08", CategoryID: 1, Amount: 25000},
+				{Month: "2026-09", CategoryID: 1, Amount: 20000},
+			},
+			month:    "2026-10",
+			expected: 15000,
+		},
+		{
+			name: "繰り越しを無効にした月で繰り越しが途切れる",
+			budgets: []*MonthlyBudget{
+				{CategoryID: 1, Month: "2026-08", Amount: 30000, Rollover: true},
+				{CategoryID: 1, Month: "2026-09", Amount: 30000, Rollover: false},
+			},
+			actuals:  []*MonthlyCategoryAmount{{Month: "2026-08", CategoryID: 1, Amount: 10000}},
+			month:    "2026-10",
+			expected: 0,
+		},
+		{
+			name: "年をまたいで繰り越す",
+			budgets: []*MonthlyBudget{
+				{CategoryID: 1, Month: "2026-12", Amount: 10000, Rollover: true},
+			},
+			actuals:  []*MonthlyCategoryAmount{{Month: "2026-12", CategoryID: 1, Amount: 4000}},
+			month:    "2027-01",
+			expected: 6000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history := NewBudgetHistory(tt.budgets, tt.actuals)
+			assert.Equal(t, tt.expected, history.CarriedOver(1, tt.month))
+		})
+	}
+}
+
+func TestBudgetHistory_MissingBudgets(t *testing.T) {
+	history := NewBudgetHistory([]*MonthlyBudget{
+		{CategoryID: 1, Month: "2026-09", Amount: 30000, Rollover: true},
+		{CategoryID: 2, Month: "2026-10", Amount: 5000},
+	}, nil)
+	categories := []*CategoryLimit{
+		{Category: Category{ID: 1}, LimitAmount: 10000},
+		{Category: Category{ID: 2}, LimitAmount: 10000},
+		{Category: Category{ID: 3}, LimitAmount: 8000},
+	}
+
+	missing := history.MissingBudgets(10, categories, "2026-10")
+	assert.Equal(t, []*MonthlyBudget{
+		// 前月の予算と繰り越し設定を引き継ぐ
+		{HouseHoldID: 10, CategoryID: 1, Month: "2026-10", Amount: 30000, Rollover: true},
+		// 予算が一度も登録されていない場合はカテゴリの上限金額を用いる
+		{HouseHoldID: 10, CategoryID: 3, Month: "2026-10", Amount: 8000},
+	}, missing)
+}
+
+func TestBudgetHistory_Summarize(t *testing.T) {
+	history := NewBudgetHistory([]*MonthlyBudget{
+		{CategoryID: 1, Month: "2026-09", Amount: 30000, Rollover: true},
+		{CategoryID: 1, Month: "2026-10", Amount: 30000, Rollover: true},
+		{CategoryID: 2, Month: "2026-10", Amount: 5000},
+	}, []*MonthlyCategoryAmount{
+		{Month: "2026-09", CategoryID: 1, Amount: 25000},
+		{Month: "2026-10", CategoryID: 1, Amount: 12000},
+		{Month: "2026-10", CategoryID: 2, Amount: 6000},
+	})
+	categories := []*CategoryLimit{
+		{Category: Category{ID: 1, Name: "食費"}, LimitAmount: 10000},
+		{Category: Category{ID: 2, Name: "日用品"}, LimitAmount: 10000},
+	}
+
+	assert.Equal(t, CategoryBudgets{
+		{Category: Category{ID: 1, Name: "食費"}, Budget: 30000, CarriedOver: 5000, Actual: 12000, Remaining: 23000, Rollover: true},
+		{Category: Category{ID: 2, Name: "日用品"}, Budget: 5000, CarriedOver: 0, Actual: 6000, Remaining: -1000},
+	}, history.Summarize(categories, "2026-10"))
+}
@@ -1,11 +1,18 @@
 //go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
 package domainmodel
 
+import (
+	"errors"
+	"regexp"
+	"time"
+)
+
 // Category はカテゴリのドメインエンティティ
 type Category struct {
 	ID    CategoryID `json:"id"`
 	Name  string     `json:"name"`
 	Color string     `json:"color"`
+	Icon  string     `json:"icon"`
 }
 
 type HouseHoldCategory struct {
@@ -15,11 +22,47 @@ type HouseHoldCategory struct {
 	HouseholdBookID HouseHoldID
 }
 
+// CategoryLimit は家計簿ごとのカテゴリ設定
+// 名前・色・アイコンは家計簿ごとに変更できるため、マスタではなくこちらの値を表示に用いる
 type CategoryLimit struct {
 	ID              CategoryLimitID `json:"categoryLimitID"`
 	HouseholdBookID HouseHoldID     `json:"houseHoldID"`
 	Category        Category        `json:"category"`
 	LimitAmount     int             `json:"limitAmount"`
+	SortOrder       int             `json:"sortOrder"`
+	// ArchivedAt はアーカイブされた日時。アーカイブされたカテゴリは入力対象外になるが、過去の記録の集計には用いる
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+var (
+	ErrInvalidCategoryName  = errors.New("category name must be 1 to 255 characters")
+	ErrInvalidCategoryColor = errors.New("category color must be in #RRGGBB format")
+	ErrInvalidCategoryIcon  = errors.New("category icon must be 64 characters or less")
+	ErrInvalidLimitAmount   = errors.New("limit amount must be 0 or greater")
+)
+
+var categoryColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// Validate は家計簿のカテゴリ設定を検証する
+func (c *CategoryLimit) Validate() error {
+	if nameLength := len([]rune(c.Category.Name)); nameLength == 0 || nameLength > 255 {
+		return ErrInvalidCategoryName
+	}
+	if !categoryColorPattern.MatchString(c.Category.Color) {
+		return ErrInvalidCategoryColor
+	}
+	if len([]rune(c.Category.Icon)) > 64 {
+		return ErrInvalidCategoryIcon
+	}
+	if c.LimitAmount < 0 {
+		return ErrInvalidLimitAmount
+	}
+	return nil
+}
+
+// IsArchived はカテゴリがアーカイブされているかを返す
+func (c *CategoryLimit) IsArchived() bool {
+	return c.ArchivedAt != nil
 }
 
 type CategoryID uint
@@ -41,11 +84,16 @@ type CategoryRepository interface {
 	FindMasterCategoryByID(categoryID CategoryID) (*Category, error)
 	FindHouseHoldCategoryByHouseHoldID(categoryLimitID CategoryLimitID) (*CategoryLimit, error)
 
+	// FindHouseHoldCategories は家計簿のカテゴリを並び順で取得します
+	FindHouseHoldCategories(houseHoldID HouseHoldID, includeArchived bool) ([]*CategoryLimit, error)
+
 	// Update は既存のカテゴリを更新します
 	UpdateMasterCategory(category *Category) error
 	UpdateHouseHoldCategory(categoryLimit *CategoryLimit) error
+	UpdateHouseHoldCategorySortOrders(houseHoldID HouseHoldID, categoryLimitIDs []CategoryLimitID) error
+	ArchiveHouseHoldCategory(houseHoldID HouseHoldID, categoryLimitID CategoryLimitID, archivedAt *time.Time) error
 
 	// Delete は指定されたIDのカテゴリを削除します
 	DeleteMasterCategory(id CategoryID) error
-	DeleteHouseHoldCategory(categoryLimitID CategoryLimitID) error
+	DeleteHouseHoldCategory(houseHoldID HouseHoldID, categoryLimitID CategoryLimitID, deletion *Deletion) error
 }
@@ -0,0 +1,42 @@
+package domainmodel
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategoryLimit_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		category Category
+		limit    int
+		expected error
+	}{
+		{name: "正常な値", category: Category{Name: "食費", Color: "#FF8800", Icon: "food"}, limit: 10000, expected: nil},
+		{name: "アイコンは省略できる", category: Category{Name: "食費", Color: "#ff8800"}, limit: 0, expected: nil},
+		{name: "名前が空", category: Category{Name: "", Color: "#FF8800"}, limit: 0, expected: ErrInvalidCategoryName},
+		{name: "名前が長すぎる", category: Category{Name: strings.Repeat("あ", 256), Color: "#FF8800"}, limit: 0, expected: ErrInvalidCategoryName},
+		{name: "色の形式が不正", category: Category{Name: "食費", Color: "red"}, limit: 0, expected: ErrInvalidCategoryColor},
+		{name: "アイコンが長すぎる", category: Category{Name: "食費", Color: "#FF8800", Icon: strings.Repeat("a", 65)}, limit: 0, expected: ErrInvalidCategoryIcon},
+		{name: "上限金額が負の値", category: Category{Name: "食費", Color: "#FF8800"}, limit: -1, expected: ErrInvalidLimitAmount},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categoryLimit := &CategoryLimit{Category: tt.category, LimitAmount: tt.limit}
+			assert.Equal(t, tt.expected, categoryLimit.Validate())
+		})
+	}
+}
+
+func TestCategoryLimit_IsArchived(t *testing.T) {
+	categoryLimit := &CategoryLimit{}
+	assert.False(t, categoryLimit.IsArchived())
+
+	archivedAt := time.Now()
+	categoryLimit.ArchivedAt = &archivedAt
+	assert.True(t, categoryLimit.IsArchived())
+}
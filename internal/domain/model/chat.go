@@ -5,6 +5,8 @@ import "time"
 const (
 	ChatMessageTypeUser ChatMessageType = "user"
 	ChatMessageTypeAI   ChatMessageType = "ai"
+	// ChatMessageTypeSystem は予算アラートなど、サーバーから投稿するメッセージ
+	ChatMessageTypeSystem ChatMessageType = "system"
 )
 
 type ChatMessage struct {
@@ -38,3 +40,14 @@ func NewAIChatReplyMessage(householdID int) *ChatMessage {
 		CreatedAt:   time.Now(),
 	}
 }
+
+// NewSystemChatMessage はシステム用のユーザーで投稿するメッセージを作成する
+func NewSystemChatMessage(householdID int, content string) *ChatMessage {
+	return &ChatMessage{
+		HouseholdID: householdID,
+		UserID:      0,
+		MessageType: ChatMessageTypeSystem,
+		Content:     content,
+		CreatedAt:   time.Now(),
+	}
+}
@@ -0,0 +1,58 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainmodel
+
+import (
+	"errors"
+	"time"
+)
+
+type ExchangeRateID uint
+
+var (
+	ErrInvalidExchangeRateDate     = errors.New("exchange rate effective date must be in YYYY-MM-DD format")
+	ErrExchangeRateForBaseCurrency = errors.New("exchange rate for the base currency is not needed")
+	ErrExchangeRateNotFound        = errors.New("exchange rate is not registered for the currency on or before the date")
+	ErrBaseCurrencyInUse           = errors.New("base currency cannot be changed after amounts are registered in the household")
+)
+
+// ExchangeRate は家計簿で管理する換算レート。EffectiveDate 以降の支出に、次のレートの適用日の前日まで適用する
+type ExchangeRate struct {
+	ID          ExchangeRateID `json:"id"`
+	HouseHoldID HouseHoldID    `json:"householdID"`
+	Currency    Currency       `json:"currency"`
+	// Rate は 1 単位の Currency が家計簿の基準通貨の何単位にあたるか
+	Rate          string `json:"rate"`
+	EffectiveDate string `json:"effectiveDate"`
+}
+
+// NewExchangeRate は換算レートを検証して作成する
+func NewExchangeRate(houseHoldID HouseHoldID, currency string, rate string, effectiveDate string) (*ExchangeRate, error) {
+	c, err := ParseCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+	r, err := NormalizeExchangeRate(rate)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := time.Parse("2006-01-02", effectiveDate); err != nil {
+		return nil, ErrInvalidExchangeRateDate
+	}
+
+	return &ExchangeRate{
+		HouseHoldID:   houseHoldID,
+		Currency:      c,
+		Rate:          r,
+		EffectiveDate: effectiveDate,
+	}, nil
+}
+
+type ExchangeRateRepository interface {
+	// FindExchangeRates は家計簿の換算レートを通貨と適用日の順に取得する
+	FindExchangeRates(houseHoldID HouseHoldID) ([]*ExchangeRate, error)
+	// FindEffectiveExchangeRate は date に適用する換算レートを取得する。登録されていない場合は gorm.ErrRecordNotFound を返す
+	FindEffectiveExchangeRate(houseHoldID HouseHoldID, currency Currency, date string) (*ExchangeRate, error)
+	// SaveExchangeRate は換算レートを保存する。同じ通貨と適用日のレートが登録済みの場合は更新する
+	SaveExchangeRate(rate *ExchangeRate) error
+	DeleteExchangeRate(houseHoldID HouseHoldID, id ExchangeRateID) error
+}
@@ -0,0 +1,149 @@
+package domainmodel
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ExportFormat は支出のエクスポートの形式
+type ExportFormat string
+
+const (
+	ExportFormatCSV  ExportFormat = "csv"
+	ExportFormatJSON ExportFormat = "json"
+)
+
+// ExportEncoding は CSV の文字コード
+type ExportEncoding string
+
+const (
+	// ExportEncodingUTF8 は Excel で文字化けしないよう BOM を付けた UTF-8
+	ExportEncodingUTF8     ExportEncoding = "utf-8"
+	ExportEncodingShiftJIS ExportEncoding = "shift_jis"
+)
+
+// CSV の行の種類。支出の行の後にレシートの品目の行を続ける
+const (
+	ExportRowTypeExpense     = "expense"
+	ExportRowTypeReceiptItem = "receipt_item"
+)
+
+var (
+	ErrInvalidExportFormat   = errors.New("export format must be csv or json")
+	ErrInvalidExportEncoding = errors.New("export encoding must be utf-8 or shift_jis, and shift_jis is available only for csv")
+)
+
+// ExportCSVHeader は CSV の見出し行
+var ExportCSVHeader = []string{"type", "id", "date", "category", "amount", "memo", "payer", "payment_method", "tags", "item_name", "item_price"}
+
+// ValidateExportFormat はエクスポートの形式と文字コードの組み合わせを検証する
+func ValidateExportFormat(format ExportFormat, encoding ExportEncoding) error {
+	switch format {
+	case ExportFormatCSV:
+		if encoding != ExportEncodingUTF8 && encoding != ExportEncodingShiftJIS {
+			return ErrInvalidExportEncoding
+		}
+	case ExportFormatJSON:
+		if encoding != ExportEncodingUTF8 {
+			return ErrInvalidExportEncoding
+		}
+	default:
+		return ErrInvalidExportFormat
+	}
+	return nil
+}
+
+// ExportReceiptItem はエクスポートするレシートの品目
+type ExportReceiptItem struct {
+	Name  string `json:"name"`
+	Price int    `json:"price"`
+}
+
+// ExportRecord はエクスポートする支出
+type ExportRecord struct {
+	ID         ShoppingID `json:"id"`
+	Date       string     `json:"date"`
+	CategoryID CategoryID `json:"categoryID"`
+	Category   string     `json:"category"`
+	Amount     int        `json:"amount"`
+	Memo       string     `json:"memo"`
+	// PaidBy, PayerName は支払ったメンバー。共通の財布から支払った場合は nil と空文字
+	PaidBy        *UserID              `json:"paidBy"`
+	PayerName     string               `json:"payerName"`
+	PaymentMethod string               `json:"paymentMethod"`
+	Tags          []string             `json:"tags"`
+	ReceiptItems  []*ExportReceiptItem `json:"receiptItems"`
+}
+
+// CSVRows は支出の行と、レシートの品目ごとの行を返す
+// 品目の行は支出の ID と日付のみを繰り返し、金額の列は空にして合計が二重に数えられないようにする
+func (r *ExportRecord) CSVRows() [][]string {
+	id := strconv.FormatUint(uint64(r.ID), 10)
+	rows := [][]string{{
+		ExportRowTypeExpense, id, r.Date, r.Category, strconv.Itoa(r.Amount), r.Memo, r.PayerName, r.PaymentMethod, strings.Join(r.Tags, ";"), "", "",
+	}}
+	for _, item := range r.ReceiptItems {
+		rows = append(rows, []string{
+			ExportRowTypeReceiptItem, id, r.Date, "", "", "", "", "", "", item.Name, strconv.Itoa(item.Price),
+		})
+	}
+	return rows
+}
+
+// ShoppingExporter は支出をエクスポートする形に変換する
+// 表示には家計簿ごとのカテゴリ設定とメンバーの名前を用いる
+type ShoppingExporter struct {
+	categories map[CategoryID]Category
+	members    map[UserID]string
+}
+
+func NewShoppingExporter(categories []*CategoryLimit, members []*HouseHoldMember) *ShoppingExporter {
+	exporter := &ShoppingExporter{
+		categories: make(map[CategoryID]Category, len(categories)),
+		members:    make(map[UserID]string, len(members)),
+	}
+	for _, category := range categories {
+		exporter.categories[category.Category.ID] = category.Category
+	}
+	for _, member := range members {
+		exporter.members[member.UserID] = member.Name
+	}
+	return exporter
+}
+
+// Convert は支出をエクスポートする形に変換する
+func (e *ShoppingExporter) Convert(shoppingAmounts ShoppingAmounts) []*ExportRecord {
+	records := make([]*ExportRecord, len(shoppingAmounts))
+	for i, shoppingAmount := range shoppingAmounts {
+		category := shoppingAmount.Category
+		if houseHoldCategory, ok := e.categories[shoppingAmount.CategoryID]; ok {
+			category = houseHoldCategory
+		}
+		record := &ExportRecord{
+			ID:           shoppingAmount.ID,
+			Date:         shoppingAmount.Date,
+			CategoryID:   shoppingAmount.CategoryID,
+			Category:     category.Name,
+			Amount:       shoppingAmount.Amount,
+			Memo:         shoppingAmount.Memo,
+			PaidBy:       shoppingAmount.PaidBy,
+			Tags:         []string{},
+			ReceiptItems: []*ExportReceiptItem{},
+		}
+		if shoppingAmount.PaidBy != nil {
+			record.PayerName = e.members[*shoppingAmount.PaidBy]
+		}
+		if shoppingAmount.PaymentMethod != nil {
+			record.PaymentMethod = shoppingAmount.PaymentMethod.Name
+		}
+		for _, tag := range shoppingAmount.Tags {
+			record.Tags = append(record.Tags, tag.Name)
+		}
+		for _, item := range shoppingAmount.Analyze.Items {
+			record.ReceiptItems = append(record.ReceiptItems, &ExportReceiptItem{Name: item.Name, Price: int(item.Price)})
+		}
+		records[i] = record
+	}
+	return records
+}
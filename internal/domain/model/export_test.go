@@ -0,0 +1,74 @@
+package domainmodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateExportFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   ExportFormat
+		encoding ExportEncoding
+		expected error
+	}{
+		{name: "UTF-8 の CSV", format: ExportFormatCSV, encoding: ExportEncodingUTF8},
+		{name: "Shift_JIS の CSV", format: ExportFormatCSV, encoding: ExportEncodingShiftJIS},
+		{name: "UTF-8 の JSON", format: ExportFormatJSON, encoding: ExportEncodingUTF8},
+		{name: "Shift_JIS の JSON は出力できない", format: ExportFormatJSON, encoding: ExportEncodingShiftJIS, expected: ErrInvalidExportEncoding},
+		{name: "未定義の文字コード", format: ExportFormatCSV, encoding: "euc-jp", expected: ErrInvalidExportEncoding},
+		{name: "未定義の形式", format: "xlsx", encoding: ExportEncodingUTF8, expected: ErrInvalidExportFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ValidateExportFormat(tt.format, tt.encoding))
+		})
+	}
+}
+
+func TestShoppingExporter_Convert(t *testing.T) {
+	paidBy := UserID(3)
+	exporter := NewShoppingExporter(
+		[]*CategoryLimit{{Category: Category{ID: 1, Name: "食料品"}}},
+		[]*HouseHoldMember{{UserID: 3, Name: "花子"}},
+	)
+
+	records := exporter.Convert(ShoppingAmounts{
+		{
+			ID: 10, CategoryID: 1, Amount: 1200, Date: "2026-10-18", Memo: "スーパー",
+			Category:      Category{ID: 1, Name: "食費"},
+			PaidBy:        &paidBy,
+			PaymentMethod: &PaymentMethod{Name: "楽天カード"},
+			Tags:          []*Tag{{Name: "日用品"}, {Name: "まとめ買い"}},
+			Analyze: ReceiptAnalyze{Items: []ReceiptAnalyzeItem{
+				{Name: "牛乳", Price: 200},
+				{Name: "卵", Price: 1000},
+			}},
+		},
+		{ID: 11, CategoryID: 2, Amount: 500, Date: "2026-10-19", Category: Category{ID: 2, Name: "交通費"}},
+	})
+
+	assert.Equal(t, []*ExportRecord{
+		{
+			ID: 10, Date: "2026-10-18", CategoryID: 1, Category: "食料品", Amount: 1200, Memo: "スーパー",
+			PaidBy: &paidBy, PayerName: "花子", PaymentMethod: "楽天カード",
+			Tags: []string{"日用品", "まとめ買い"},
+			ReceiptItems: []*ExportReceiptItem{
+				{Name: "牛乳", Price: 200},
+				{Name: "卵", Price: 1000},
+			},
+		},
+		{
+			ID: 11, Date: "2026-10-19", CategoryID: 2, Category: "交通費", Amount: 500,
+			Tags: []string{}, ReceiptItems: []*ExportReceiptItem{},
+		},
+	}, records)
+
+	assert.Equal(t, [][]string{
+		{"expense", "10", "2026-10-18", "食料品", "1200", "スーパー", "花子", "楽天カード", "日用品;まとめ買い", "", ""},
+		{"receipt_item", "10", "2026-10-18", "", "", "", "", "", "", "牛乳", "200"},
+		{"receipt_item", "10", "2026-10-18", "", "", "", "", "", "", "卵", "1000"},
+	}, records[0].CSVRows())
+}
@@ -0,0 +1,188 @@
+package domainmodel
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+)
+
+// ForecastHistoryMonths は月末に集中する支出を求めるために参照する過去の月数
+const ForecastHistoryMonths = 3
+
+var ErrInvalidForecastDate = errors.New("forecast date must be in YYYY-MM-DD format")
+
+// DayOfMonthSplit は月・カテゴリごとの支出を、基準日までとその翌日以降に分けた集計結果
+type DayOfMonthSplit struct {
+	Month      string
+	CategoryID CategoryID
+	// Early は月初から基準日まで、Late は基準日の翌日から月末までの支出
+	Early int
+	Late  int
+}
+
+// CategoryForecast はカテゴリごとの月末の支出の予測
+// 予測額 = 基準日までの支出 + 日割りのペースで見込む残りの支出 + 過去の月で月末に集中した支出
+// ただし、基準日より後の日付で登録済みの支出は下回らない
+type CategoryForecast struct {
+	Category Category `json:"category"`
+	// Spent は基準日までの支出、Scheduled は基準日より後の日付で登録済みの支出
+	Spent     int `json:"spent"`
+	Scheduled int `json:"scheduled"`
+	// DailyRate は基準日までの1日あたりの支出（円未満は四捨五入）
+	DailyRate int `json:"dailyRate"`
+	// RunRateAmount は DailyRate のペースで残りの日数に見込む支出
+	RunRateAmount int `json:"runRateAmount"`
+	// LateCharges は過去の月で、基準日までのペースから見込まれる額を超えて月末に支出した額の平均
+	LateCharges int `json:"lateCharges"`
+	Projected   int `json:"projected"`
+	Budget      int `json:"budget"`
+	CarriedOver int `json:"carriedOver"`
+	// ProjectedRemaining は予算（繰り越しを含む）から予測額を引いた額。超過が見込まれる場合は負の値
+	ProjectedRemaining int  `json:"projectedRemaining"`
+	IsOverBudget       bool `json:"isOverBudget"`
+}
+
+// SpendingForecast は月末の支出の予測
+type SpendingForecast struct {
+	Month string `json:"month"`
+	// AsOf は基準日。基準日までの支出を実績として扱う
+	AsOf          string `json:"asOf"`
+	DaysElapsed   int    `json:"daysElapsed"`
+	DaysRemaining int    `json:"daysRemaining"`
+	// HistoryMonths は月末に集中する支出の算出に用いた過去の月
+	HistoryMonths []string            `json:"historyMonths"`
+	Total         *CategoryForecast   `json:"total"`
+	Categories    []*CategoryForecast `json:"categories"`
+}
+
+// ForecastHistoryRange は基準日の月の前月から遡って参照する過去の月の範囲を返す
+func ForecastHistoryRange(asOf time.Time) (string, string) {
+	month := time.Date(asOf.Year(), asOf.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return BudgetMonthOf(month.AddDate(0, -ForecastHistoryMonths, 0)), BudgetMonthOf(month.AddDate(0, -1, 0))
+}
+
+// NewSpendingForecast は基準日の月の支出、過去の月の支出、予算から月末の支出を予測する
+// 過去の月は支出のあった月のみを平均に用いる。表示には家計簿ごとのカテゴリ設定を用い、カテゴリの並び順で返す
+func NewSpendingForecast(asOf time.Time, shoppingAmounts ShoppingAmounts, history []*DayOfMonthSplit, categories []*CategoryLimit, budgets CategoryBudgets) *SpendingForecast {
+	month := BudgetMonthOf(asOf)
+	daysInMonth := daysInBudgetMonth(month)
+	elapsed := asOf.Day()
+	remaining := daysInMonth - elapsed
+	asOfDate := asOf.Format("2006-01-02")
+
+	forecast := &SpendingForecast{
+		Month:         month,
+		AsOf:          asOfDate,
+		DaysElapsed:   elapsed,
+		DaysRemaining: remaining,
+		HistoryMonths: []string{},
+		Total:         &CategoryForecast{},
+		Categories:    []*CategoryForecast{},
+	}
+
+	order := make(map[CategoryID]int, len(categories))
+	forecasts := make(map[CategoryID]*CategoryForecast)
+	forecastOf := func(category Category) *CategoryForecast {
+		if _, ok := forecasts[category.ID]; !ok {
+			forecasts[category.ID] = &CategoryForecast{Category: category}
+		}
+		return forecasts[category.ID]
+	}
+	for i, category := range categories {
+		order[category.Category.ID] = i
+		if category.ArchivedAt == nil {
+			forecastOf(category.Category)
+		}
+	}
+
+	for _, shoppingAmount := range shoppingAmounts {
+		if len(shoppingAmount.Date) < len(month) || shoppingAmount.Date[:len(month)] != month {
+			continue
+		}
+		categoryForecast := forecastOf(shoppingAmount.Category)
+		if shoppingAmount.Date <= asOfDate {
+			categoryForecast.Spent += shoppingAmount.Amount
+		} else {
+			categoryForecast.Scheduled += shoppingAmount.Amount
+		}
+	}
+
+	// 過去の月ごとに、基準日までのペースから見込まれる額を超えて月末に支出した額を求める
+	historyMonths := map[string]bool{}
+	excess := map[CategoryID]float64{}
+	for _, split := range history {
+		historyMonths[split.Month] = true
+		historyRemaining := daysInBudgetMonth(split.Month) - elapsed
+		if historyRemaining < 0 {
+			historyRemaining = 0
+		}
+		excess[split.CategoryID] += float64(split.Late) - float64(split.Early)*float64(historyRemaining)/float64(elapsed)
+	}
+	for historyMonth := range historyMonths {
+		forecast.HistoryMonths = append(forecast.HistoryMonths, historyMonth)
+	}
+	sort.Strings(forecast.HistoryMonths)
+	for categoryID, amount := range excess {
+		if remaining == 0 || amount <= 0 {
+			continue
+		}
+		forecastOf(Category{ID: categoryID}).LateCharges = int(math.Round(amount / float64(len(historyMonths))))
+	}
+
+	budgetMap := make(map[CategoryID]*CategoryBudget, len(budgets))
+	for _, budget := range budgets {
+		budgetMap[budget.Category.ID] = budget
+	}
+	for categoryID, categoryForecast := range forecasts {
+		if budget, ok := budgetMap[categoryID]; ok {
+			categoryForecast.Category = budget.Category
+			categoryForecast.Budget = budget.Budget
+			categoryForecast.CarriedOver = budget.CarriedOver
+		}
+		categoryForecast.DailyRate = int(math.Round(float64(categoryForecast.Spent) / float64(elapsed)))
+		categoryForecast.RunRateAmount = int(math.Round(float64(categoryForecast.Spent) * float64(remaining) / float64(elapsed)))
+		categoryForecast.Projected = categoryForecast.Spent + categoryForecast.RunRateAmount + categoryForecast.LateCharges
+		if known := categoryForecast.Spent + categoryForecast.Scheduled; categoryForecast.Projected < known {
+			categoryForecast.Projected = known
+		}
+		categoryForecast.applyBudget()
+		forecast.Categories = append(forecast.Categories, categoryForecast)
+
+		forecast.Total.Spent += categoryForecast.Spent
+		forecast.Total.Scheduled += categoryForecast.Scheduled
+		forecast.Total.DailyRate += categoryForecast.DailyRate
+		forecast.Total.RunRateAmount += categoryForecast.RunRateAmount
+		forecast.Total.LateCharges += categoryForecast.LateCharges
+		forecast.Total.Projected += categoryForecast.Projected
+		forecast.Total.Budget += categoryForecast.Budget
+		forecast.Total.CarriedOver += categoryForecast.CarriedOver
+	}
+	forecast.Total.applyBudget()
+
+	sort.Slice(forecast.Categories, func(i, j int) bool {
+		iOrder, iOK := order[forecast.Categories[i].Category.ID]
+		jOrder, jOK := order[forecast.Categories[j].Category.ID]
+		if iOK != jOK {
+			return iOK
+		}
+		if iOK && iOrder != jOrder {
+			return iOrder < jOrder
+		}
+		return forecast.Categories[i].Category.ID < forecast.Categories[j].Category.ID
+	})
+
+	return forecast
+}
+
+// applyBudget は予測額と予算から残額と超過の見込みを設定する
+func (f *CategoryForecast) applyBudget() {
+	f.ProjectedRemaining = f.Budget + f.CarriedOver - f.Projected
+	f.IsOverBudget = f.ProjectedRemaining < 0
+}
+
+// daysInBudgetMonth は月の日数を返す。month は検証済みであること
+func daysInBudgetMonth(month string) int {
+	t, _ := ParseBudgetMonth(month)
+	return t.AddDate(0, 1, -1).Day()
+}
@@ -0,0 +1,71 @@
+package domainmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForecastHistoryRange(t *testing.T) {
+	from, to := ForecastHistoryRange(time.Date(2026, 1, 18, 0, 0, 0, 0, time.Local))
+	assert.Equal(t, "2025-10", from)
+	assert.Equal(t, "2025-12", to)
+}
+
+func TestNewSpendingForecast(t *testing.T) {
+	asOf := time.Date(2026, 10, 10, 0, 0, 0, 0, time.Local)
+	categories := []*CategoryLimit{
+		{Category: Category{ID: 2, Name: "住居"}, LimitAmount: 80000},
+		{Category: Category{ID: 1, Name: "食料品"}, LimitAmount: 30000},
+	}
+	shoppingAmounts := ShoppingAmounts{
+		{CategoryID: 1, Category: Category{ID: 1, Name: "食費"}, Amount: 3000, Date: "2026-10-03"},
+		{CategoryID: 1, Category: Category{ID: 1, Name: "食費"}, Amount: 2000, Date: "2026-10-10"},
+		{CategoryID: 1, Category: Category{ID: 1, Name: "食費"}, Amount: 1000, Date: "2026-10-25"},
+		// 登録済みの旅行代金は日割りのペースより優先する
+		{CategoryID: 3, Category: Category{ID: 3, Name: "旅行"}, Amount: 50000, Date: "2026-10-20"},
+		// 翌月の支出は含めない
+		{CategoryID: 1, Category: Category{ID: 1, Name: "食費"}, Amount: 9999, Date: "2026-11-01"},
+	}
+	history := []*DayOfMonthSplit{
+		// 食費は月末も同じペースで支出しているため、月末に集中する支出はない
+		{Month: "2026-07", CategoryID: 1, Early: 5000, Late: 10000},
+		{Month: "2026-08", CategoryID: 1, Early: 5000, Late: 10000},
+		{Month: "2026-09", CategoryID: 1, Early: 4000, Late: 9000},
+		// 家賃は毎月27日に支払う
+		{Month: "2026-07", CategoryID: 2, Early: 0, Late: 80000},
+		{Month: "2026-08", CategoryID: 2, Early: 0, Late: 80000},
+		{Month: "2026-09", CategoryID: 2, Early: 0, Late: 80000},
+	}
+	budgets := NewBudgetHistory(nil, nil).Summarize(categories, "2026-10")
+
+	forecast := NewSpendingForecast(asOf, shoppingAmounts, history, categories, budgets)
+
+	assert.Equal(t, "2026-10", forecast.Month)
+	assert.Equal(t, "2026-10-10", forecast.AsOf)
+	assert.Equal(t, 10, forecast.DaysElapsed)
+	assert.Equal(t, 21, forecast.DaysRemaining)
+	assert.Equal(t, []string{"2026-07", "2026-08", "2026-09"}, forecast.HistoryMonths)
+	assert.Equal(t, []*CategoryForecast{
+		{Category: Category{ID: 2, Name: "住居"}, LateCharges: 80000, Projected: 80000, Budget: 80000},
+		{Category: Category{ID: 1, Name: "食料品"}, Spent: 5000, Scheduled: 1000, DailyRate: 500, RunRateAmount: 10500, Projected: 15500, Budget: 30000, ProjectedRemaining: 14500},
+		{Category: Category{ID: 3, Name: "旅行"}, Scheduled: 50000, Projected: 50000, ProjectedRemaining: -50000, IsOverBudget: true},
+	}, forecast.Categories)
+	assert.Equal(t, &CategoryForecast{
+		Spent: 5000, Scheduled: 51000, DailyRate: 500, RunRateAmount: 10500, LateCharges: 80000,
+		Projected: 145500, Budget: 110000, ProjectedRemaining: -35500, IsOverBudget: true,
+	}, forecast.Total)
+}
+
+func TestNewSpendingForecast_LastDay(t *testing.T) {
+	// 月末は実績をそのまま予測額とする
+	asOf := time.Date(2026, 9, 30, 0, 0, 0, 0, time.Local)
+	history := []*DayOfMonthSplit{{Month: "2026-08", CategoryID: 1, Early: 30000, Late: 5000}}
+	shoppingAmounts := ShoppingAmounts{{CategoryID: 1, Category: Category{ID: 1}, Amount: 30000, Date: "2026-09-12"}}
+
+	forecast := NewSpendingForecast(asOf, shoppingAmounts, history, nil, nil)
+	assert.Equal(t, 0, forecast.DaysRemaining)
+	assert.Equal(t, 30000, forecast.Total.Projected)
+	assert.Equal(t, 0, forecast.Total.LateCharges)
+}
@@ -2,10 +2,12 @@
 package domainmodel
 
 type HouseHold struct {
-	ID            HouseHoldID      `json:"id"`
-	UserID        UserID           `json:"userID"`
-	Title         string           `json:"title"`
-	Description   string           `json:"description"`
+	ID          HouseHoldID `json:"id"`
+	UserID      UserID      `json:"userID"`
+	Title       string      `json:"title"`
+	Description string      `json:"description"`
+	// BaseCurrency は支出の集計と予算の通貨。基準通貨以外で記録した支出はこの通貨に換算する
+	BaseCurrency  Currency         `json:"baseCurrency"`
 	CategoryLimit []*CategoryLimit `json:"categoryLimit"`
 }
 
@@ -24,6 +26,7 @@ func NewDefaultHouseHold(userAccount *UserAccount) *HouseHold {
 					Color: "#FF0000",
 				},
 				LimitAmount: 40000,
+				SortOrder:   1,
 			},
 			{
 				Category: Category{
@@ -32,6 +35,7 @@ func NewDefaultHouseHold(userAccount *UserAccount) *HouseHold {
 					Color: "#00FF00",
 				},
 				LimitAmount: 10000,
+				SortOrder:   2,
 			},
 		},
 	}
@@ -41,13 +45,41 @@ func NewDefaultHouseHold(userAccount *UserAccount) *HouseHold {
 type UserHouseHold struct {
 	UserID      UserID
 	HouseHoldID HouseHoldID
+	Role        HouseHoldRole
+}
+
+// BelongingHouseHold はユーザーが所属する家計簿（自分の家計簿・共有された家計簿）の一覧表示用の情報
+type BelongingHouseHold struct {
+	ID          HouseHoldID   `json:"id"`
+	Title       string        `json:"title"`
+	Description string        `json:"description"`
+	Role        HouseHoldRole `json:"role"`
+	IsDefault   bool          `json:"isDefault"`
+}
+
+// HouseHoldMember は家計簿メンバーの一覧表示用の情報
+type HouseHoldMember struct {
+	UserID     UserID        `json:"userID"`
+	Name       string        `json:"name"`
+	PictureURL string        `json:"pictureURL"`
+	Role       HouseHoldRole `json:"role"`
 }
 
 type HouseHoldRepository interface {
 	Create(houseHold *HouseHold) error
 	CreateUserHouseHold(userHouseHold *UserHouseHold) error
-	FindByUserID(userID UserID) (*HouseHold, error)
+	FindUserHouseHold(userID UserID, houseHoldID HouseHoldID) (*UserHouseHold, error)
+	UpdateUserHouseHoldRole(houseHoldID HouseHoldID, userID UserID, role HouseHoldRole) error
+	TransferOwnership(houseHoldID HouseHoldID, fromUserID UserID, toUserID UserID) error
+	FindMembers(houseHoldID HouseHoldID) ([]*HouseHoldMember, error)
+	DeleteUserHouseHold(houseHoldID HouseHoldID, userID UserID) error
+	FindByUserID(userID UserID) ([]*BelongingHouseHold, error)
 	FindByHouseHoldID(houseHoldID HouseHoldID) (*HouseHold, error)
 	Update(houseHold *HouseHold) error
-	Delete(houseHoldID HouseHoldID) error
+	// UpdateBaseCurrency は家計簿の基準通貨を変更し、同じ通貨の換算レートを削除する
+	// 基準通貨の金額（支出・収入・予算・カテゴリの上限・口座の開始残高・精算・定期取引）が登録済みの場合は ErrBaseCurrencyInUse を返す
+	UpdateBaseCurrency(houseHoldID HouseHoldID, currency Currency) error
+	// Delete は家計簿と紐づくデータを削除し、削除したレシートの画像のファイル名を返す
+	// 画像はストレージに保存しているため、削除後に呼び出し側でストレージから削除する
+	Delete(houseHoldID HouseHoldID) ([]string, error)
 }
@@ -0,0 +1,59 @@
+package domainmodel
+
+import "errors"
+
+// HouseHoldRole は家計簿メンバーの権限ロール
+type HouseHoldRole string
+
+const (
+	// HouseHoldRoleOwner は家計簿の所有者。メンバー管理を含む全ての操作が可能
+	HouseHoldRoleOwner HouseHoldRole = "owner"
+	// HouseHoldRoleEditor は記録やカテゴリの追加・編集が可能なメンバー
+	HouseHoldRoleEditor HouseHoldRole = "editor"
+	// HouseHoldRoleViewer は閲覧のみ可能なメンバー
+	HouseHoldRoleViewer HouseHoldRole = "viewer"
+)
+
+// HouseHoldPermission はロールによって許可される操作
+type HouseHoldPermission string
+
+const (
+	// HouseHoldPermissionView は家計簿や記録の閲覧
+	HouseHoldPermissionView HouseHoldPermission = "view"
+	// HouseHoldPermissionEdit は買い物記録・カテゴリの追加、更新、削除
+	HouseHoldPermissionEdit HouseHoldPermission = "edit"
+	// HouseHoldPermissionManageMember は招待の発行やメンバーのロール変更
+	HouseHoldPermissionManageMember HouseHoldPermission = "manage_member"
+)
+
+var (
+	ErrInvalidHouseHoldRole = errors.New("invalid household role")
+	ErrNotHouseHoldMember   = errors.New("user is not a member of the household")
+	ErrOwnerRoleChange      = errors.New("owner role can only be changed by transferring ownership")
+	ErrOwnerCannotLeave     = errors.New("owner cannot leave the household; transfer ownership or delete the household instead")
+)
+
+var houseHoldRolePermissions = map[HouseHoldRole][]HouseHoldPermission{
+	HouseHoldRoleOwner:  {HouseHoldPermissionView, HouseHoldPermissionEdit, HouseHoldPermissionManageMember},
+	HouseHoldRoleEditor: {HouseHoldPermissionView, HouseHoldPermissionEdit},
+	HouseHoldRoleViewer: {HouseHoldPermissionView},
+}
+
+// ParseHouseHoldRole は文字列をロールに変換する
+func ParseHouseHoldRole(role string) (HouseHoldRole, error) {
+	r := HouseHoldRole(role)
+	if _, ok := houseHoldRolePermissions[r]; !ok {
+		return "", ErrInvalidHouseHoldRole
+	}
+	return r, nil
+}
+
+// Can はロールが指定の操作を許可しているかを返す
+func (r HouseHoldRole) Can(permission HouseHoldPermission) bool {
+	for _, p := range houseHoldRolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,38 @@
+package domainmodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHouseHoldRole_Can(t *testing.T) {
+	tests := []struct {
+		name       string
+		role       HouseHoldRole
+		permission HouseHoldPermission
+		expected   bool
+	}{
+		{name: "所有者はメンバーを管理できる", role: HouseHoldRoleOwner, permission: HouseHoldPermissionManageMember, expected: true},
+		{name: "編集者は記録を編集できる", role: HouseHoldRoleEditor, permission: HouseHoldPermissionEdit, expected: true},
+		{name: "編集者はメンバーを管理できない", role: HouseHoldRoleEditor, permission: HouseHoldPermissionManageMember, expected: false},
+		{name: "閲覧者は閲覧できる", role: HouseHoldRoleViewer, permission: HouseHoldPermissionView, expected: true},
+		{name: "閲覧者は記録を編集できない", role: HouseHoldRoleViewer, permission: HouseHoldPermissionEdit, expected: false},
+		{name: "未定義のロールは何もできない", role: HouseHoldRole("unknown"), permission: HouseHoldPermissionView, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.role.Can(tt.permission))
+		})
+	}
+}
+
+func TestParseHouseHoldRole(t *testing.T) {
+	role, err := ParseHouseHoldRole("viewer")
+	assert.NoError(t, err)
+	assert.Equal(t, HouseHoldRoleViewer, role)
+
+	_, err = ParseHouseHoldRole("admin")
+	assert.ErrorIs(t, err, ErrInvalidHouseHoldRole)
+}
@@ -0,0 +1,556 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainmodel
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"echo-household-budget/internal/infrastructure/persistence/models"
+
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+type ImportPresetID uint
+
+// MaxImportRows は1回に取り込める行数の上限（見出し行を除く）
+const MaxImportRows = 10000
+
+// 組み込みのプリセット
+const (
+	ImportPresetMoneyForward = "moneyforward"
+	ImportPresetZaim         = "zaim"
+	// ImportPresetKaimemo はこのアプリの CSV エクスポートの形式
+	ImportPresetKaimemo = "kaimemo"
+)
+
+var (
+	ErrInvalidImportPresetName = errors.New("import preset name must be 1 to 50 characters")
+	ErrInvalidImportMapping    = errors.New("import mapping must specify date and amount columns, and category column or default category")
+	ErrImportPresetNotFound    = errors.New("import preset not found")
+	ErrImportFileEmpty         = errors.New("import file has no header row")
+	ErrImportTooManyRows       = errors.New("import file must have at most 10000 rows")
+	ErrImportHasErrors         = errors.New("import has rows with errors; fix the mapping or the file and preview again")
+)
+
+// ImportSkipRule は取り込まない行の条件。列の値が一致する行は取り込まない
+type ImportSkipRule struct {
+	Column string `json:"column"`
+	Value  string `json:"value"`
+}
+
+// ImportColumnMapping は CSV の列と支出の項目の対応。列は見出しの名前で指定する
+type ImportColumnMapping struct {
+	Encoding   ExportEncoding `json:"encoding"`
+	DateColumn string         `json:"dateColumn"`
+	// DateLayout は日付の形式（Go の time パッケージの形式）。空の場合は YYYY-MM-DD と YYYY/MM/DD を受け付ける
+	DateLayout   string `json:"dateLayout"`
+	AmountColumn string `json:"amountColumn"`
+	// NegativeExpense は支出を負の金額で記録する形式かどうか。正の金額の行は収入として取り込まない
+	NegativeExpense bool   `json:"negativeExpense"`
+	CategoryColumn  string `json:"categoryColumn"`
+	// MemoColumns はメモとする列。空でない値を空白でつなげる
+	MemoColumns []string         `json:"memoColumns"`
+	SkipRules   []ImportSkipRule `json:"skipRules"`
+}
+
+// Validate は列の対応を検証する。カテゴリの列がない場合は既定のカテゴリを指定すること
+func (m *ImportColumnMapping) Validate(hasDefaultCategory bool) error {
+	if m.DateColumn == "" || m.AmountColumn == "" || (m.CategoryColumn == "" && !hasDefaultCategory) {
+		return ErrInvalidImportMapping
+	}
+	if m.Encoding != "" && m.Encoding != ExportEncodingUTF8 && m.Encoding != ExportEncodingShiftJIS {
+		return ErrInvalidExportEncoding
+	}
+	return nil
+}
+
+// ImportPreset は保存した CSV の列の対応と、取り込み元のカテゴリと家計簿のカテゴリの対応
+type ImportPreset struct {
+	ID          ImportPresetID `json:"id"`
+	HouseHoldID HouseHoldID    `json:"houseHoldID"`
+	// Key は組み込みのプリセットの名前。保存したプリセットは空文字
+	Key              string                `json:"key"`
+	Name             string                `json:"name"`
+	Mapping          ImportColumnMapping   `json:"mapping"`
+	CategoryMappings map[string]CategoryID `json:"categoryMappings"`
+}
+
+// Validate はプリセットを検証する
+func (p *ImportPreset) Validate() error {
+	if nameLength := len([]rune(p.Name)); nameLength == 0 || nameLength > 50 {
+		return ErrInvalidImportPresetName
+	}
+	return p.Mapping.Validate(false)
+}
+
+// BuiltInImportPresets は家計簿アプリのエクスポートの組み込みのプリセットを返す
+func BuiltInImportPresets() []*ImportPreset {
+	return []*ImportPreset{
+		{
+			Key:  ImportPresetMoneyForward,
+			Name: "マネーフォワード ME",
+			Mapping: ImportColumnMapping{
+				Encoding:        ExportEncodingShiftJIS,
+				DateColumn:      "日付",
+				DateLayout:      "2006/01/02",
+				AmountColumn:    "金額（円）",
+				NegativeExpense: true,
+				CategoryColumn:  "大項目",
+				MemoColumns:     []string{"内容", "メモ"},
+				SkipRules: []ImportSkipRule{
+					{Column: "計算対象", Value: "0"},
+					{Column: "振替", Value: "1"},
+				},
+			},
+			CategoryMappings: map[string]CategoryID{},
+		},
+		{
+			Key:  ImportPresetZaim,
+			Name: "Zaim",
+			Mapping: ImportColumnMapping{
+				Encoding:       ExportEncodingUTF8,
+				DateColumn:     "日付",
+				DateLayout:     "2006-01-02",
+				AmountColumn:   "支出",
+				CategoryColumn: "カテゴリ",
+				MemoColumns:    []string{"お店", "品目", "メモ"},
+				SkipRules: []ImportSkipRule{
+					{Column: "方法", Value: "income"},
+					{Column: "方法", Value: "transfer"},
+					{Column: "集計の設定", Value: "集計に含めない"},
+				},
+			},
+			CategoryMappings: map[string]CategoryID{},
+		},
+		{
+			Key:  ImportPresetKaimemo,
+			Name: "家計簿（CSV エクスポート）",
+			Mapping: ImportColumnMapping{
+				Encoding:       ExportEncodingUTF8,
+				DateColumn:     "date",
+				DateLayout:     "2006-01-02",
+				AmountColumn:   "amount",
+				CategoryColumn: "category",
+				MemoColumns:    []string{"memo"},
+				SkipRules: []ImportSkipRule{
+					{Column: "type", Value: ExportRowTypeReceiptItem},
+				},
+			},
+			CategoryMappings: map[string]CategoryID{},
+		},
+	}
+}
+
+// FindBuiltInImportPreset は組み込みのプリセットを名前で取得する。存在しない場合は nil を返す
+func FindBuiltInImportPreset(key string) *ImportPreset {
+	for _, preset := range BuiltInImportPresets() {
+		if preset.Key == key {
+			return preset
+		}
+	}
+	return nil
+}
+
+// ReadImportCSV は CSV を読み込む。UTF-8 の BOM は取り除く
+func ReadImportCSV(r io.Reader, encoding ExportEncoding) ([][]string, error) {
+	if encoding == ExportEncodingShiftJIS {
+		r = transform.NewReader(r, japanese.ShiftJIS.NewDecoder())
+	} else {
+		buffered := bufio.NewReader(r)
+		if bom, err := buffered.Peek(len(utf8BOM)); err == nil && bytes.Equal(bom, []byte(utf8BOM)) {
+			_, _ = buffered.Discard(len(utf8BOM))
+		}
+		r = buffered
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	records := [][]string{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+		if len(records) > MaxImportRows+1 {
+			return nil, ErrImportTooManyRows
+		}
+	}
+	if len(records) == 0 {
+		return nil, ErrImportFileEmpty
+	}
+	return records, nil
+}
+
+// utf8BOM は Excel などが CSV の先頭に付ける UTF-8 の BOM
+const utf8BOM = "\xEF\xBB\xBF"
+
+// ImportInput は CSV の取り込みの指定
+// 列の対応は PresetID、PresetKey、Mapping の順に用いる
+type ImportInput struct {
+	HouseHoldID HouseHoldID
+	File        io.Reader
+	PresetID    ImportPresetID
+	PresetKey   string
+	Mapping     *ImportColumnMapping
+	// Encoding は CSV の文字コード。空の場合は列の対応の文字コードを用いる
+	Encoding ExportEncoding
+	// CategoryMappings は取り込み元のカテゴリと家計簿のカテゴリの対応。プリセットの対応より優先する
+	CategoryMappings map[string]CategoryID
+	// DefaultCategoryID は対応付けられないカテゴリの行を登録するカテゴリ
+	DefaultCategoryID *CategoryID
+	// IncludeDuplicates は登録済みの支出と重複する行も登録するかどうか
+	IncludeDuplicates bool
+}
+
+// ImportCategoryResolver は取り込み元のカテゴリを家計簿のカテゴリに対応付ける
+// 指定した対応、家計簿のカテゴリの名前の完全一致、既定のカテゴリの順に用いる
+type ImportCategoryResolver struct {
+	categories      map[CategoryID]Category
+	names           map[string]Category
+	mappings        map[string]CategoryID
+	defaultCategory *CategoryID
+}
+
+// NewImportCategoryResolver は家計簿の有効なカテゴリから対応付けを作成する。有効なカテゴリ以外への対応は用いない
+func NewImportCategoryResolver(categories []*CategoryLimit, mappings map[string]CategoryID, defaultCategory *CategoryID) *ImportCategoryResolver {
+	resolver := &ImportCategoryResolver{
+		categories: make(map[CategoryID]Category, len(categories)),
+		names:      make(map[string]Category, len(categories)),
+		mappings:   mappings,
+	}
+	for _, category := range categories {
+		if category.ArchivedAt != nil {
+			continue
+		}
+		resolver.categories[category.Category.ID] = category.Category
+		resolver.names[category.Category.Name] = category.Category
+	}
+	if defaultCategory != nil {
+		if _, ok := resolver.categories[*defaultCategory]; ok {
+			resolver.defaultCategory = defaultCategory
+		}
+	}
+	return resolver
+}
+
+// Resolve は取り込み元のカテゴリに対応する家計簿のカテゴリを返す
+func (r *ImportCategoryResolver) Resolve(name string) (Category, bool) {
+	if categoryID, ok := r.mappings[name]; ok {
+		if category, ok := r.categories[categoryID]; ok {
+			return category, true
+		}
+	}
+	if category, ok := r.names[name]; ok {
+		return category, true
+	}
+	if r.defaultCategory != nil {
+		return r.categories[*r.defaultCategory], true
+	}
+	return Category{}, false
+}
+
+// ImportRowStatus は取り込む行の状態
+type ImportRowStatus string
+
+const (
+	ImportRowValid ImportRowStatus = "valid"
+	// ImportRowSkipped は除外の条件や収入の行など、取り込みの対象外の行
+	ImportRowSkipped ImportRowStatus = "skipped"
+	ImportRowError   ImportRowStatus = "error"
+	// ImportRowDuplicate は登録済みの支出と日付・金額・カテゴリが一致する行
+	ImportRowDuplicate ImportRowStatus = "duplicate"
+)
+
+// ImportRow は CSV の1行を支出として解釈した結果
+type ImportRow struct {
+	// Line は CSV の行番号（見出し行を1行目とする）
+	Line           int             `json:"line"`
+	Status         ImportRowStatus `json:"status"`
+	Date           string          `json:"date"`
+	Amount         int             `json:"amount"`
+	SourceCategory string          `json:"sourceCategory"`
+	Category       *Category       `json:"category"`
+	Memo           string          `json:"memo"`
+	// Reason は取り込みの対象外とした理由やエラーの内容
+	Reason string `json:"reason"`
+	// DuplicateOf は一致した登録済みの支出
+	DuplicateOf *ShoppingID `json:"duplicateOf"`
+}
+
+// ImportPreview は CSV の取り込み内容の確認結果
+type ImportPreview struct {
+	Rows       []*ImportRow `json:"rows"`
+	Total      int          `json:"total"`
+	Valid      int          `json:"valid"`
+	Skipped    int          `json:"skipped"`
+	Errors     int          `json:"errors"`
+	Duplicates int          `json:"duplicates"`
+	// UnmappedCategories は家計簿のカテゴリに対応付けられなかった取り込み元のカテゴリ
+	UnmappedCategories []string `json:"unmappedCategories"`
+}
+
+// ImportResult は CSV の取り込み結果
+type ImportResult struct {
+	Imported          int `json:"imported"`
+	Skipped           int `json:"skipped"`
+	SkippedDuplicates int `json:"skippedDuplicates"`
+}
+
+// ParseImportRows は CSV の見出し行と列の対応から各行を支出として解釈する
+// 空行は結果に含めない。見出しに対応する列がない場合はエラーを返す
+func ParseImportRows(records [][]string, mapping ImportColumnMapping, resolver *ImportCategoryResolver) ([]*ImportRow, error) {
+	if len(records) == 0 {
+		return nil, ErrImportFileEmpty
+	}
+	columns := make(map[string]int, len(records[0]))
+	for i, name := range records[0] {
+		name = strings.TrimSpace(strings.TrimPrefix(name, utf8BOM))
+		if _, ok := columns[name]; !ok {
+			columns[name] = i
+		}
+	}
+	required := []string{mapping.DateColumn, mapping.AmountColumn}
+	if mapping.CategoryColumn != "" {
+		required = append(required, mapping.CategoryColumn)
+	}
+	required = append(required, mapping.MemoColumns...)
+	for _, column := range required {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("import column %q not found in header", column)
+		}
+	}
+	value := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	rows := []*ImportRow{}
+	for i, record := range records[1:] {
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+		row := &ImportRow{
+			Line:           i + 2,
+			Status:         ImportRowValid,
+			SourceCategory: value(record, mapping.CategoryColumn),
+		}
+		rows = append(rows, row)
+
+		memos := []string{}
+		for _, column := range mapping.MemoColumns {
+			if memo := value(record, column); memo != "" {
+				memos = append(memos, memo)
+			}
+		}
+		row.Memo = strings.Join(memos, " ")
+
+		if rule := matchSkipRule(mapping.SkipRules, func(column string) string { return value(record, column) }); rule != nil {
+			row.Status = ImportRowSkipped
+			row.Reason = fmt.Sprintf("%s is %s", rule.Column, rule.Value)
+			continue
+		}
+
+		date, err := parseImportDate(value(record, mapping.DateColumn), mapping.DateLayout)
+		if err != nil {
+			row.Status = ImportRowError
+			row.Reason = fmt.Sprintf("date %q is invalid", value(record, mapping.DateColumn))
+			continue
+		}
+		row.Date = date.Format("2006-01-02")
+
+		amount, err := parseImportAmount(value(record, mapping.AmountColumn))
+		if err != nil {
+			row.Status = ImportRowError
+			row.Reason = fmt.Sprintf("amount %q is invalid", value(record, mapping.AmountColumn))
+			continue
+		}
+		if mapping.NegativeExpense {
+			if amount >= 0 {
+				row.Status = ImportRowSkipped
+				row.Reason = "income or zero amount"
+				continue
+			}
+			amount = -amount
+		}
+		if amount <= 0 {
+			row.Status = ImportRowError
+			row.Reason = "amount must be positive"
+			continue
+		}
+		row.Amount = amount
+
+		category, ok := resolver.Resolve(row.SourceCategory)
+		if !ok {
+			row.Status = ImportRowError
+			row.Reason = fmt.Sprintf("category %q is not mapped to a household category", row.SourceCategory)
+			continue
+		}
+		row.Category = &category
+	}
+	return rows, nil
+}
+
+// matchSkipRule は行に一致する除外の条件を返す。一致しない場合は nil を返す
+func matchSkipRule(rules []ImportSkipRule, value func(column string) string) *ImportSkipRule {
+	for i, rule := range rules {
+		if value(rule.Column) == rule.Value {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+// parseImportDate は日付を解釈する。形式を指定しない場合は YYYY-MM-DD と YYYY/MM/DD（月日は1桁も可）を受け付ける
+func parseImportDate(s string, layout string) (time.Time, error) {
+	layouts := []string{layout}
+	if layout == "" {
+		layouts = []string{"2006-01-02", "2006/01/02", "2006-1-2", "2006/1/2"}
+	}
+	var err error
+	for _, layout := range layouts {
+		var date time.Time
+		if date, err = time.Parse(layout, s); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// parseImportAmount は桁区切りや通貨記号を含む金額を解釈する
+func parseImportAmount(s string) (int, error) {
+	s = strings.NewReplacer(",", "", "，", "", "円", "", "¥", "", "￥", "", " ", "").Replace(s)
+	return strconv.Atoi(s)
+}
+
+// MarkImportDuplicates は登録済みの支出と日付・金額・カテゴリが一致する行を重複とする
+// 同じ内容の行が複数ある場合は、登録済みの支出の件数まで重複とする
+func MarkImportDuplicates(rows []*ImportRow, existing ShoppingAmounts) {
+	type key struct {
+		date       string
+		amount     int
+		categoryID CategoryID
+	}
+	candidates := map[key][]ShoppingID{}
+	for _, shoppingAmount := range existing {
+		k := key{shoppingAmount.Date, shoppingAmount.Amount, shoppingAmount.CategoryID}
+		candidates[k] = append(candidates[k], shoppingAmount.ID)
+	}
+	for _, row := range rows {
+		if row.Status != ImportRowValid {
+			continue
+		}
+		k := key{row.Date, row.Amount, row.Category.ID}
+		if ids := candidates[k]; len(ids) > 0 {
+			id := ids[0]
+			candidates[k] = ids[1:]
+			row.Status = ImportRowDuplicate
+			row.DuplicateOf = &id
+		}
+	}
+}
+
+// ImportPeriod は取り込む行の日付の範囲を返す。取り込む行がない場合は false を返す
+func ImportPeriod(rows []*ImportRow) (string, string, bool) {
+	from, to := "", ""
+	for _, row := range rows {
+		if row.Status != ImportRowValid {
+			continue
+		}
+		if from == "" || row.Date < from {
+			from = row.Date
+		}
+		if to == "" || row.Date > to {
+			to = row.Date
+		}
+	}
+	return from, to, from != ""
+}
+
+// NewImportPreview は解釈した行から確認結果を作成する
+func NewImportPreview(rows []*ImportRow) *ImportPreview {
+	preview := &ImportPreview{Rows: rows, Total: len(rows), UnmappedCategories: []string{}}
+	unmapped := map[string]bool{}
+	for _, row := range rows {
+		switch row.Status {
+		case ImportRowValid:
+			preview.Valid++
+		case ImportRowSkipped:
+			preview.Skipped++
+		case ImportRowDuplicate:
+			preview.Duplicates++
+		case ImportRowError:
+			preview.Errors++
+			// 金額まで解釈できた行のエラーはカテゴリの対応付けによるもの
+			if row.Category == nil && row.Amount > 0 && !unmapped[row.SourceCategory] {
+				unmapped[row.SourceCategory] = true
+				preview.UnmappedCategories = append(preview.UnmappedCategories, row.SourceCategory)
+			}
+		}
+	}
+	return preview
+}
+
+// ImportShoppingAmounts は取り込む行を支出に変換する。includeDuplicates の場合は重複とした行も取り込む
+func ImportShoppingAmounts(houseHoldID HouseHoldID, rows []*ImportRow, includeDuplicates bool) []*models.ShoppingAmount {
+	shoppingAmounts := []*models.ShoppingAmount{}
+	for _, row := range rows {
+		if row.Status != ImportRowValid && !(includeDuplicates && row.Status == ImportRowDuplicate) {
+			continue
+		}
+		date, _ := time.Parse("2006-01-02", row.Date)
+		shoppingAmounts = append(shoppingAmounts, &models.ShoppingAmount{
+			HouseholdBookID: uint(houseHoldID),
+			CategoryID:      uint(row.Category.ID),
+			Amount:          row.Amount,
+			Date:            date,
+			Memo:            row.Memo,
+			SplitType:       string(SplitEqual),
+		})
+	}
+	return shoppingAmounts
+}
+
+// ConvertImportPreset は保存したプリセットのモデルを変換する
+func ConvertImportPreset(model *models.ImportPreset) (*ImportPreset, error) {
+	preset := &ImportPreset{
+		ID:               ImportPresetID(model.ID),
+		HouseHoldID:      HouseHoldID(model.HouseholdBookID),
+		Name:             model.Name,
+		CategoryMappings: map[string]CategoryID{},
+	}
+	if err := json.Unmarshal([]byte(model.Mapping), &preset.Mapping); err != nil {
+		return nil, err
+	}
+	if model.CategoryMappings != "" {
+		if err := json.Unmarshal([]byte(model.CategoryMappings), &preset.CategoryMappings); err != nil {
+			return nil, err
+		}
+	}
+	return preset, nil
+}
+
+// ImportPresetRepository は保存した取り込みのプリセットを担うリポジトリのインターフェース
+type ImportPresetRepository interface {
+	FindByHouseHoldID(houseHoldID HouseHoldID) ([]*ImportPreset, error)
+	// FindByID は家計簿のプリセットを取得します。存在しない場合は nil を返します
+	FindByID(houseHoldID HouseHoldID, id ImportPresetID) (*ImportPreset, error)
+	Create(preset *ImportPreset) error
+	Delete(houseHoldID HouseHoldID, id ImportPresetID) error
+}
@@ -0,0 +1,166 @@
+package domainmodel
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/encoding/japanese"
+)
+
+func TestReadImportCSV(t *testing.T) {
+	t.Run("UTF-8 の BOM を取り除く", func(t *testing.T) {
+		records, err := ReadImportCSV(strings.NewReader(utf8BOM+"日付,金額\n2026-10-01,100\n"), ExportEncodingUTF8)
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"日付", "金額"}, {"2026-10-01", "100"}}, records)
+	})
+
+	t.Run("Shift_JIS を変換して読み込む", func(t *testing.T) {
+		encoded, err := japanese.ShiftJIS.NewEncoder().String("日付,金額（円）\r\n2026/10/01,-100\r\n")
+		assert.NoError(t, err)
+		records, err := ReadImportCSV(bytes.NewReader([]byte(encoded)), ExportEncodingShiftJIS)
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"日付", "金額（円）"}, {"2026/10/01", "-100"}}, records)
+	})
+
+	t.Run("空のファイルはエラー", func(t *testing.T) {
+		_, err := ReadImportCSV(strings.NewReader(""), ExportEncodingUTF8)
+		assert.ErrorIs(t, err, ErrImportFileEmpty)
+	})
+}
+
+func TestParseImportRows(t *testing.T) {
+	categories := []*CategoryLimit{
+		{Category: Category{ID: 1, Name: "食費"}},
+		{Category: Category{ID: 2, Name: "日用品"}},
+	}
+
+	t.Run("マネーフォワード ME の形式", func(t *testing.T) {
+		records := [][]string{
+			{"計算対象", "日付", "内容", "金額（円）", "保有金融機関", "大項目", "中項目", "メモ", "振替", "ID"},
+			{"1", "2026/10/01", "スーパー", "-1,200", "現金", "食費", "食料品", "", "0", "a"},
+			{"1", "2026/10/02", "ドラッグストア", "-800", "現金", "日用品", "消耗品", "洗剤", "0", "b"},
+			{"1", "2026/10/03", "カード引き落とし", "-30000", "銀行", "未分類", "未分類", "", "1", "c"},
+			{"0", "2026/10/04", "立替", "-500", "現金", "食費", "外食", "", "0", "d"},
+			{"1", "2026/10/25", "給与", "250000", "銀行", "収入", "給与", "", "0", "e"},
+			{"1", "2026/10/26", "書店", "-1500", "現金", "教養・教育", "書籍", "", "0", "f"},
+			{"1", "2026/13/01", "不正な日付", "-100", "現金", "食費", "食料品", "", "0", "g"},
+			{"", "", "", "", "", "", "", "", "", ""},
+		}
+		resolver := NewImportCategoryResolver(categories, map[string]CategoryID{}, nil)
+
+		rows, err := ParseImportRows(records, FindBuiltInImportPreset(ImportPresetMoneyForward).Mapping, resolver)
+		assert.NoError(t, err)
+		assert.Len(t, rows, 7)
+		assert.Equal(t, &ImportRow{Line: 2, Status: ImportRowValid, Date: "2026-10-01", Amount: 1200, SourceCategory: "食費", Category: &Category{ID: 1, Name: "食費"}, Memo: "スーパー"}, rows[0])
+		assert.Equal(t, "ドラッグストア 洗剤", rows[1].Memo)
+		assert.Equal(t, ImportRowSkipped, rows[2].Status)
+		assert.Equal(t, "振替 is 1", rows[2].Reason)
+		assert.Equal(t, ImportRowSkipped, rows[3].Status)
+		assert.Equal(t, ImportRowSkipped, rows[4].Status)
+		assert.Equal(t, "income or zero amount", rows[4].Reason)
+		assert.Equal(t, ImportRowError, rows[5].Status)
+		assert.Equal(t, `category "教養・教育" is not mapped to a household category`, rows[5].Reason)
+		assert.Equal(t, ImportRowError, rows[6].Status)
+		assert.Equal(t, 8, rows[6].Line)
+
+		preview := NewImportPreview(rows)
+		assert.Equal(t, 7, preview.Total)
+		assert.Equal(t, 2, preview.Valid)
+		assert.Equal(t, 3, preview.Skipped)
+		assert.Equal(t, 2, preview.Errors)
+		assert.Equal(t, []string{"教養・教育"}, preview.UnmappedCategories)
+	})
+
+	t.Run("Zaim の形式とカテゴリの対応", func(t *testing.T) {
+		records := [][]string{
+			{"日付", "方法", "カテゴリ", "カテゴリの内訳", "支払元", "入金先", "品目", "メモ", "お店", "通貨", "収入", "支出", "振替", "残高調整", "通貨変換前の金額", "集計の設定"},
+			{"2026-10-01", "payment", "食費", "食料品", "お財布", "", "牛乳", "", "スーパー", "JPY", "0", "250", "0", "0", "250", "常に集計に含める"},
+			{"2026-10-02", "payment", "日用雑貨", "消耗品", "お財布", "", "", "", "", "JPY", "0", "500", "0", "0", "500", "常に集計に含める"},
+			{"2026-10-25", "income", "給与", "", "", "銀行", "", "", "", "JPY", "250000", "0", "0", "0", "250000", "常に集計に含める"},
+		}
+		resolver := NewImportCategoryResolver(categories, map[string]CategoryID{"日用雑貨": 2}, nil)
+
+		rows, err := ParseImportRows(records, FindBuiltInImportPreset(ImportPresetZaim).Mapping, resolver)
+		assert.NoError(t, err)
+		assert.Len(t, rows, 3)
+		assert.Equal(t, "スーパー 牛乳", rows[0].Memo)
+		assert.Equal(t, &Category{ID: 2, Name: "日用品"}, rows[1].Category)
+		assert.Equal(t, ImportRowSkipped, rows[2].Status)
+	})
+
+	t.Run("既定のカテゴリと金額の表記", func(t *testing.T) {
+		records := [][]string{
+			{"date", "price"},
+			{"2026/1/5", "¥1,000円"},
+			{"2026-01-06", "0"},
+		}
+		defaultCategory := CategoryID(2)
+		resolver := NewImportCategoryResolver(categories, nil, &defaultCategory)
+
+		rows, err := ParseImportRows(records, ImportColumnMapping{DateColumn: "date", AmountColumn: "price"}, resolver)
+		assert.NoError(t, err)
+		assert.Equal(t, "2026-01-05", rows[0].Date)
+		assert.Equal(t, 1000, rows[0].Amount)
+		assert.Equal(t, &Category{ID: 2, Name: "日用品"}, rows[0].Category)
+		assert.Equal(t, ImportRowError, rows[1].Status)
+		assert.Equal(t, "amount must be positive", rows[1].Reason)
+	})
+
+	t.Run("見出しにない列を指定した場合はエラー", func(t *testing.T) {
+		resolver := NewImportCategoryResolver(categories, nil, nil)
+		_, err := ParseImportRows([][]string{{"日付", "金額"}}, ImportColumnMapping{DateColumn: "日付", AmountColumn: "支出", CategoryColumn: "カテゴリ"}, resolver)
+		assert.EqualError(t, err, `import column "支出" not found in header`)
+	})
+}
+
+func TestImportCategoryResolver_Resolve(t *testing.T) {
+	categories := []*CategoryLimit{
+		{Category: Category{ID: 1, Name: "食費"}},
+		{Category: Category{ID: 2, Name: "日用品"}},
+	}
+	resolver := NewImportCategoryResolver(categories, map[string]CategoryID{"食料品": 1, "旧カテゴリ": 99}, nil)
+
+	category, ok := resolver.Resolve("食料品")
+	assert.True(t, ok)
+	assert.Equal(t, CategoryID(1), category.ID)
+
+	category, ok = resolver.Resolve("日用品")
+	assert.True(t, ok)
+	assert.Equal(t, CategoryID(2), category.ID)
+
+	// 家計簿にないカテゴリへの対応は用いない
+	_, ok = resolver.Resolve("旧カテゴリ")
+	assert.False(t, ok)
+}
+
+func TestMarkImportDuplicates(t *testing.T) {
+	food := &Category{ID: 1, Name: "食費"}
+	rows := []*ImportRow{
+		{Line: 2, Status: ImportRowValid, Date: "2026-10-01", Amount: 500, Category: food},
+		{Line: 3, Status: ImportRowValid, Date: "2026-10-01", Amount: 500, Category: food},
+		{Line: 4, Status: ImportRowValid, Date: "2026-10-02", Amount: 500, Category: food},
+		{Line: 5, Status: ImportRowSkipped},
+	}
+
+	from, to, ok := ImportPeriod(rows)
+	assert.True(t, ok)
+	assert.Equal(t, "2026-10-01", from)
+	assert.Equal(t, "2026-10-02", to)
+
+	MarkImportDuplicates(rows, ShoppingAmounts{
+		{ID: 10, Date: "2026-10-01", Amount: 500, CategoryID: 1},
+		{ID: 11, Date: "2026-10-02", Amount: 500, CategoryID: 2},
+	})
+
+	// 登録済みの支出1件に対して、同じ内容の行は1行のみ重複とする
+	duplicateOf := ShoppingID(10)
+	assert.Equal(t, ImportRowDuplicate, rows[0].Status)
+	assert.Equal(t, &duplicateOf, rows[0].DuplicateOf)
+	assert.Equal(t, ImportRowValid, rows[1].Status)
+	assert.Equal(t, ImportRowValid, rows[2].Status)
+
+	assert.Len(t, ImportShoppingAmounts(1, rows, false), 2)
+	assert.Len(t, ImportShoppingAmounts(1, rows, true), 3)
+}
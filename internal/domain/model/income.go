@@ -0,0 +1,107 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainmodel
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+type IncomeCategoryID uint
+type IncomeID uint
+
+// IncomeCategory は家計簿ごとの収入カテゴリ
+type IncomeCategory struct {
+	ID          IncomeCategoryID `json:"id"`
+	HouseHoldID HouseHoldID      `json:"houseHoldID"`
+	Name        string           `json:"name"`
+	Color       string           `json:"color"`
+	SortOrder   int              `json:"sortOrder"`
+}
+
+// Income は収入の記録
+type Income struct {
+	ID               IncomeID         `json:"id"`
+	HouseHoldID      HouseHoldID      `json:"houseHoldID"`
+	IncomeCategoryID IncomeCategoryID `json:"incomeCategoryID"`
+	IncomeCategory   IncomeCategory   `json:"incomeCategory"`
+	// UserID は収入を得たメンバー。家計簿全体の収入の場合は nil
+	UserID *UserID `json:"userID"`
+	Amount int     `json:"amount"`
+	Date   string  `json:"date"`
+	Memo   string  `json:"memo"`
+	// PaymentMethodID は入金先の支払い方法・口座。未設定の場合は nil
+	PaymentMethodID *PaymentMethodID `json:"paymentMethodID"`
+	PaymentMethod   *PaymentMethod   `json:"paymentMethod"`
+}
+
+var (
+	ErrInvalidIncomeAmount       = errors.New("income amount must be greater than 0")
+	ErrInvalidIncomeDate         = errors.New("income date must be in YYYY-MM-DD format")
+	ErrInvalidIncomeCategoryName = errors.New("income category name must be 1 to 255 characters")
+)
+
+// Validate は収入を検証する
+func (i *Income) Validate() error {
+	if i.Amount <= 0 {
+		return ErrInvalidIncomeAmount
+	}
+	if _, err := time.Parse("2006-01-02", i.Date); err != nil {
+		return ErrInvalidIncomeDate
+	}
+	return nil
+}
+
+// Validate は収入カテゴリを検証する
+func (c *IncomeCategory) Validate() error {
+	if nameLength := len([]rune(c.Name)); nameLength == 0 || nameLength > 255 {
+		return ErrInvalidIncomeCategoryName
+	}
+	if !categoryColorPattern.MatchString(c.Color) {
+		return ErrInvalidCategoryColor
+	}
+	return nil
+}
+
+// NewDefaultIncomeCategories は家計簿に初期登録する収入カテゴリを返す
+func NewDefaultIncomeCategories(houseHoldID HouseHoldID) []*IncomeCategory {
+	return []*IncomeCategory{
+		{HouseHoldID: houseHoldID, Name: "給与", Color: "#4CAF50", SortOrder: 1},
+		{HouseHoldID: houseHoldID, Name: "賞与", Color: "#2196F3", SortOrder: 2},
+		{HouseHoldID: houseHoldID, Name: "副収入", Color: "#FF9800", SortOrder: 3},
+	}
+}
+
+// MonthlyBalance は月ごとの収支
+type MonthlyBalance struct {
+	TotalIncome  int `json:"totalIncome"`
+	TotalExpense int `json:"totalExpense"`
+	NetBalance   int `json:"netBalance"`
+	// SavingsRate は収入に対する収支の割合（%、小数第1位まで）。収入がない場合は nil
+	SavingsRate *float64 `json:"savingsRate"`
+}
+
+func NewMonthlyBalance(totalIncome int, totalExpense int) MonthlyBalance {
+	balance := MonthlyBalance{
+		TotalIncome:  totalIncome,
+		TotalExpense: totalExpense,
+		NetBalance:   totalIncome - totalExpense,
+	}
+	if totalIncome > 0 {
+		savingsRate := math.Round(float64(balance.NetBalance)*1000/float64(totalIncome)) / 10
+		balance.SavingsRate = &savingsRate
+	}
+	return balance
+}
+
+// IncomeRepository は収入の永続化を担うリポジトリのインターフェース
+type IncomeRepository interface {
+	FindIncomeCategories(houseHoldID HouseHoldID) ([]*IncomeCategory, error)
+	CreateIncomeCategories(categories []*IncomeCategory) error
+
+	// FindIncomes は指定月の収入を日付順で取得します
+	FindIncomes(houseHoldID HouseHoldID, month string) ([]*Income, error)
+	CreateIncome(income *Income) error
+	UpdateIncome(income *Income) error
+	DeleteIncome(houseHoldID HouseHoldID, incomeID IncomeID) error
+}
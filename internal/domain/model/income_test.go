@@ -0,0 +1,53 @@
+package domainmodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncome_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		income  Income
+		wantErr error
+	}{
+		{name: "正常", income: Income{Amount: 300000, Date: "2026-10-25"}},
+		{name: "金額が0", income: Income{Amount: 0, Date: "2026-10-25"}, wantErr: ErrInvalidIncomeAmount},
+		{name: "日付の形式が不正", income: Income{Amount: 300000, Date: "2026/10/25"}, wantErr: ErrInvalidIncomeDate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, tt.income.Validate())
+		})
+	}
+}
+
+func TestIncomeCategory_Validate(t *testing.T) {
+	assert.NoError(t, (&IncomeCategory{Name: "給与", Color: "#4CAF50"}).Validate())
+	assert.Equal(t, ErrInvalidIncomeCategoryName, (&IncomeCategory{Name: "", Color: "#4CAF50"}).Validate())
+	assert.Equal(t, ErrInvalidCategoryColor, (&IncomeCategory{Name: "給与", Color: "green"}).Validate())
+}
+
+func TestNewMonthlyBalance(t *testing.T) {
+	balance := NewMonthlyBalance(300000, 240000)
+	assert.Equal(t, 300000, balance.TotalIncome)
+	assert.Equal(t, 240000, balance.TotalExpense)
+	assert.Equal(t, 60000, balance.NetBalance)
+	assert.Equal(t, 20.0, *balance.SavingsRate)
+
+	// 支出が収入を上回る場合は貯蓄率が負になる
+	balance = NewMonthlyBalance(200000, 250000)
+	assert.Equal(t, -50000, balance.NetBalance)
+	assert.Equal(t, -25.0, *balance.SavingsRate)
+
+	// 端数は小数第1位に丸める
+	balance = NewMonthlyBalance(300000, 100000)
+	assert.Equal(t, 66.7, *balance.SavingsRate)
+
+	// 収入がない月は貯蓄率を算出しない
+	balance = NewMonthlyBalance(0, 10000)
+	assert.Equal(t, -10000, balance.NetBalance)
+	assert.Nil(t, balance.SavingsRate)
+}
@@ -0,0 +1,82 @@
+package domainmodel
+
+import (
+	"errors"
+	"time"
+)
+
+// HouseHoldInvitation は家計簿への招待リンクを表すドメインモデル
+type HouseHoldInvitation struct {
+	ID          HouseHoldInvitationID `json:"id"`
+	HouseHoldID HouseHoldID           `json:"householdID"`
+	Code        string                `json:"code"`
+	CreatedBy   UserID                `json:"createdBy"`
+	MaxUses     int                   `json:"maxUses"` // 0は無制限
+	UsedCount   int                   `json:"usedCount"`
+	ExpiresAt   time.Time             `json:"expiresAt"`
+	RevokedAt   *time.Time            `json:"revokedAt"`
+	CreatedAt   time.Time             `json:"createdAt"`
+}
+
+type HouseHoldInvitationID uint
+
+type InvitationStatus string
+
+const (
+	InvitationStatusPending InvitationStatus = "pending"
+	InvitationStatusExpired InvitationStatus = "expired"
+	InvitationStatusRevoked InvitationStatus = "revoked"
+	InvitationStatusUsedUp  InvitationStatus = "used_up"
+)
+
+type InvitationResponseStatus string
+
+const (
+	InvitationResponseAccepted InvitationResponseStatus = "accepted"
+	InvitationResponseDeclined InvitationResponseStatus = "declined"
+)
+
+var (
+	ErrInvitationExpired = errors.New("invitation has expired")
+	ErrInvitationRevoked = errors.New("invitation has been revoked")
+	ErrInvitationUsedUp  = errors.New("invitation has no remaining uses")
+	ErrAlreadyMember     = errors.New("user is already a member of the household")
+)
+
+func NewHouseHoldInvitation(houseHoldID HouseHoldID, createdBy UserID, code string, maxUses int, expiresAt time.Time) *HouseHoldInvitation {
+	return &HouseHoldInvitation{
+		HouseHoldID: houseHoldID,
+		Code:        code,
+		CreatedBy:   createdBy,
+		MaxUses:     maxUses,
+		UsedCount:   0,
+		ExpiresAt:   expiresAt,
+	}
+}
+
+// Status は指定時刻における招待の状態を返す
+func (i *HouseHoldInvitation) Status(now time.Time) InvitationStatus {
+	switch {
+	case i.RevokedAt != nil:
+		return InvitationStatusRevoked
+	case !now.Before(i.ExpiresAt):
+		return InvitationStatusExpired
+	case i.MaxUses > 0 && i.UsedCount >= i.MaxUses:
+		return InvitationStatusUsedUp
+	default:
+		return InvitationStatusPending
+	}
+}
+
+// Validate は招待が承諾可能かを検証する
+func (i *HouseHoldInvitation) Validate(now time.Time) error {
+	switch i.Status(now) {
+	case InvitationStatusRevoked:
+		return ErrInvitationRevoked
+	case InvitationStatusExpired:
+		return ErrInvitationExpired
+	case InvitationStatusUsedUp:
+		return ErrInvitationUsedUp
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package domainmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHouseHoldInvitation_Status(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	revokedAt := now.Add(-time.Hour)
+
+	tests := []struct {
+		name       string
+		invitation HouseHoldInvitation
+		expected   InvitationStatus
+		expectErr  error
+	}{
+		{
+			name:       "有効期限内で未使用の招待は承諾可能",
+			invitation: HouseHoldInvitation{MaxUses: 1, UsedCount: 0, ExpiresAt: now.Add(time.Hour)},
+			expected:   InvitationStatusPending,
+		},
+		{
+			name:       "回数無制限の招待は使用回数に関わらず承諾可能",
+			invitation: HouseHoldInvitation{MaxUses: 0, UsedCount: 10, ExpiresAt: now.Add(time.Hour)},
+			expected:   InvitationStatusPending,
+		},
+		{
+			name:       "有効期限切れの招待は承諾できない",
+			invitation: HouseHoldInvitation{MaxUses: 1, ExpiresAt: now},
+			expected:   InvitationStatusExpired,
+			expectErr:  ErrInvitationExpired,
+		},
+		{
+			name:       "使用回数の上限に達した招待は承諾できない",
+			invitation: HouseHoldInvitation{MaxUses: 1, UsedCount: 1, ExpiresAt: now.Add(time.Hour)},
+			expected:   InvitationStatusUsedUp,
+			expectErr:  ErrInvitationUsedUp,
+		},
+		{
+			name:       "取り消された招待は有効期限内でも承諾できない",
+			invitation: HouseHoldInvitation{MaxUses: 1, ExpiresAt: now.Add(time.Hour), RevokedAt: &revokedAt},
+			expected:   InvitationStatusRevoked,
+			expectErr:  ErrInvitationRevoked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.invitation.Status(now))
+			assert.Equal(t, tt.expectErr, tt.invitation.Validate(now))
+		})
+	}
+}
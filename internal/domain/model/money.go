@@ -0,0 +1,134 @@
+package domainmodel
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Currency は ISO 4217 の通貨コード
+type Currency string
+
+// DefaultCurrency は家計簿の基準通貨の既定値
+const DefaultCurrency Currency = "JPY"
+
+// currencyMinorUnits は通貨ごとの補助単位の桁数。金額はこの桁数の補助単位（円、セントなど）の整数で扱う
+var currencyMinorUnits = map[Currency]int{
+	"JPY": 0,
+	"KRW": 0,
+	"VND": 0,
+	"USD": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"CHF": 2,
+	"CNY": 2,
+	"HKD": 2,
+	"TWD": 2,
+	"SGD": 2,
+	"THB": 2,
+	"PHP": 2,
+	"MYR": 2,
+	"IDR": 2,
+	"INR": 2,
+	"AUD": 2,
+	"NZD": 2,
+	"CAD": 2,
+}
+
+var (
+	ErrUnsupportedCurrency = errors.New("currency is not supported")
+	ErrCurrencyMismatch    = errors.New("currencies of the amounts are different")
+	ErrInvalidExchangeRate = errors.New("exchange rate must be a positive decimal number")
+)
+
+// ParseCurrency は通貨コードを大文字に揃えて検証する
+func ParseCurrency(code string) (Currency, error) {
+	currency := Currency(strings.ToUpper(strings.TrimSpace(code)))
+	if _, ok := currencyMinorUnits[currency]; !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedCurrency, code)
+	}
+	return currency, nil
+}
+
+// MinorUnits は補助単位の桁数を返す
+func (c Currency) MinorUnits() int {
+	return currencyMinorUnits[c]
+}
+
+// Money は金額と通貨。Amount は補助単位の整数で、USD の 12.34 ドルは 1234 となる
+type Money struct {
+	Amount   int64    `json:"amount"`
+	Currency Currency `json:"currency"`
+}
+
+func NewMoney(amount int64, currency Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Add は同じ通貨の金額を足す
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+// String は補助単位の桁数に合わせて "12.34 USD" の形式で返す
+func (m Money) String() string {
+	return fmt.Sprintf("%s %s", new(big.Rat).SetFrac64(m.Amount, pow10(m.Currency.MinorUnits())).FloatString(m.Currency.MinorUnits()), m.Currency)
+}
+
+// Convert は rate（1 単位の m.Currency が何単位の to にあたるか）で to に換算する。補助単位未満は四捨五入する
+func (m Money) Convert(rate string, to Currency) (Money, error) {
+	r, err := parseExchangeRate(rate)
+	if err != nil {
+		return Money{}, err
+	}
+
+	value := new(big.Rat).SetFrac64(m.Amount, pow10(m.Currency.MinorUnits()))
+	value.Mul(value, r)
+	value.Mul(value, new(big.Rat).SetInt64(pow10(to.MinorUnits())))
+
+	return Money{Amount: roundRat(value), Currency: to}, nil
+}
+
+// NormalizeExchangeRate は換算レートを検証し、末尾の 0 を除いた10進数の文字列に揃える
+func NormalizeExchangeRate(rate string) (string, error) {
+	r, err := parseExchangeRate(rate)
+	if err != nil {
+		return "", err
+	}
+	s := strings.TrimRight(r.FloatString(exchangeRateScale), "0")
+	return strings.TrimSuffix(s, "."), nil
+}
+
+// exchangeRateScale は換算レートの小数点以下の桁数の上限
+const exchangeRateScale = 10
+
+func parseExchangeRate(rate string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(rate))
+	if !ok || r.Sign() <= 0 || strings.ContainsAny(rate, "/eE") {
+		return nil, ErrInvalidExchangeRate
+	}
+	return r, nil
+}
+
+// roundRat は小数点以下を四捨五入する（0 から遠い側に丸める）
+func roundRat(r *big.Rat) int64 {
+	num := new(big.Int).Abs(r.Num())
+	num.Mul(num, big.NewInt(2)).Add(num, r.Denom())
+	q := num.Quo(num, new(big.Int).Mul(r.Denom(), big.NewInt(2)))
+	if r.Sign() < 0 {
+		q.Neg(q)
+	}
+	return q.Int64()
+}
+
+func pow10(n int) int64 {
+	result := int64(1)
+	for i := 0; i < n; i++ {
+		result *= 10
+	}
+	return result
+}
@@ -0,0 +1,107 @@
+package domainmodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCurrency(t *testing.T) {
+	currency, err := ParseCurrency(" usd ")
+	assert.NoError(t, err)
+	assert.Equal(t, Currency("USD"), currency)
+	assert.Equal(t, 2, currency.MinorUnits())
+	assert.Equal(t, 0, DefaultCurrency.MinorUnits())
+
+	_, err = ParseCurrency("XXX")
+	assert.ErrorIs(t, err, ErrUnsupportedCurrency)
+}
+
+func TestMoney_Convert(t *testing.T) {
+	tests := []struct {
+		name     string
+		money    Money
+		rate     string
+		to       Currency
+		expected Money
+	}{
+		{name: "補助単位のある通貨から円に換算する", money: NewMoney(1234, "USD"), rate: "149.5", to: "JPY", expected: NewMoney(1845, "JPY")},
+		{name: "円から補助単位のある通貨に換算する", money: NewMoney(1000, "JPY"), rate: "0.0067", to: "USD", expected: NewMoney(670, "USD")},
+		{name: "補助単位未満はちょうど半分の場合に切り上げる", money: NewMoney(1, "USD"), rate: "150", to: "JPY", expected: NewMoney(2, "JPY")},
+		{name: "負の金額は 0 から遠い側に丸める", money: NewMoney(-1, "USD"), rate: "150", to: "JPY", expected: NewMoney(-2, "JPY")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			converted, err := tt.money.Convert(tt.rate, tt.to)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, converted)
+		})
+	}
+
+	_, err := NewMoney(100, "USD").Convert("0", "JPY")
+	assert.ErrorIs(t, err, ErrInvalidExchangeRate)
+}
+
+func TestMoney_AddAndString(t *testing.T) {
+	sum, err := NewMoney(1234, "USD").Add(NewMoney(66, "USD"))
+	assert.NoError(t, err)
+	assert.Equal(t, "13.00 USD", sum.String())
+	assert.Equal(t, "1500 JPY", NewMoney(1500, "JPY").String())
+
+	_, err = NewMoney(100, "USD").Add(NewMoney(100, "JPY"))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+}
+
+func TestNormalizeExchangeRate(t *testing.T) {
+	rate, err := NormalizeExchangeRate("149.5000000000")
+	assert.NoError(t, err)
+	assert.Equal(t, "149.5", rate)
+
+	rate, err = NormalizeExchangeRate("150")
+	assert.NoError(t, err)
+	assert.Equal(t, "150", rate)
+
+	for _, invalid := range []string{"", "-1", "0", "1/3", "1e3", "abc"} {
+		_, err := NormalizeExchangeRate(invalid)
+		assert.ErrorIs(t, err, ErrInvalidExchangeRate, invalid)
+	}
+}
+
+func TestShoppingAmount_ApplyExchangeRate(t *testing.T) {
+	shoppingAmount := &ShoppingAmount{Original: &Money{Amount: 2000, Currency: "EUR"}}
+	assert.NoError(t, shoppingAmount.ApplyExchangeRate("JPY", "161.25"))
+	assert.Equal(t, 3225, shoppingAmount.Amount)
+	assert.Equal(t, "161.25", shoppingAmount.ExchangeRate)
+
+	// 基準通貨で記録した場合は記録した金額のまま保存する
+	shoppingAmount = &ShoppingAmount{Original: &Money{Amount: 500, Currency: "JPY"}}
+	assert.NoError(t, shoppingAmount.ApplyExchangeRate("JPY", ""))
+	assert.Equal(t, 500, shoppingAmount.Amount)
+	assert.Nil(t, shoppingAmount.Original)
+	assert.Empty(t, shoppingAmount.ExchangeRate)
+}
+
+func TestShoppingAmount_ApplyExchangeRate_FixedSplit(t *testing.T) {
+	payer := UserID(1)
+	shoppingAmount := &ShoppingAmount{
+		Original:  &Money{Amount: 1000, Currency: "USD"},
+		PaidBy:    &payer,
+		SplitType: SplitFixed,
+		Shares:    []ExpenseShare{{UserID: 2, Value: 500}, {UserID: 1, Value: 500}},
+	}
+	assert.NoError(t, shoppingAmount.ApplyExchangeRate("JPY", "150.1"))
+	assert.Equal(t, 1501, shoppingAmount.Amount)
+	// 端数の 1 円はユーザーIDの小さいメンバーが負担し、合計は換算後の金額と一致する
+	assert.Equal(t, []ExpenseShare{{UserID: 2, Value: 750}, {UserID: 1, Value: 751}}, shoppingAmount.Shares)
+	assert.NoError(t, shoppingAmount.ValidateSplit([]UserID{1, 2}))
+
+	// 記録した通貨で合計が一致しない場合は換算しない
+	shoppingAmount = &ShoppingAmount{
+		Original:  &Money{Amount: 1000, Currency: "USD"},
+		PaidBy:    &payer,
+		SplitType: SplitFixed,
+		Shares:    []ExpenseShare{{UserID: 1, Value: 333}, {UserID: 2, Value: 666}},
+	}
+	assert.Equal(t, ErrInvalidSplitFixed, shoppingAmount.ApplyExchangeRate("JPY", "150"))
+}
@@ -0,0 +1,216 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainmodel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// 移行元の Notion のデータベース
+const (
+	NotionMigrationSourceKaimemo       = "kaimemo"
+	NotionMigrationSourceKaimemoAmount = "kaimemo_amount"
+)
+
+const (
+	// DefaultKaimemoCategory は買い物メモのタグが空の場合のカテゴリ名
+	DefaultKaimemoCategory = "未分類"
+	// KaimemoCategoryColor は買い物メモのタグから作成するカテゴリの色
+	KaimemoCategoryColor = "#9E9E9E"
+)
+
+var (
+	ErrInvalidNotionMigrationMapping = errors.New("notion migration mapping must specify lineUserID for each tempUserID")
+	ErrInvalidNotionKaimemoDate      = errors.New("notion kaimemo date must be in YYYY-MM-DD format")
+	ErrInvalidNotionKaimemoAmount    = errors.New("notion kaimemo amount must be 0 or greater")
+	ErrEmptyNotionKaimemoName        = errors.New("notion kaimemo name is empty")
+)
+
+// NotionKaimemoPage は Notion の買い物メモのデータベースのページ
+type NotionKaimemoPage struct {
+	PageID      string
+	TempUserID  string
+	Name        string
+	Tag         string
+	Done        bool
+	CreatedTime time.Time
+}
+
+// NotionKaimemoAmountPage は Notion の支出のデータベースのページ。日付はタイトルに自由入力の文字列で記録されている
+type NotionKaimemoAmountPage struct {
+	PageID      string
+	TempUserID  string
+	Date        string
+	Tag         string
+	Amount      int
+	CreatedTime time.Time
+}
+
+// ShoppingDate は支出の日付を YYYY-MM-DD に揃えて返す
+func (p *NotionKaimemoAmountPage) ShoppingDate() (string, error) {
+	date, err := parseImportDate(strings.TrimSpace(p.Date), "")
+	if err != nil {
+		return "", ErrInvalidNotionKaimemoDate
+	}
+	return date.Format("2006-01-02"), nil
+}
+
+// NotionMigrationUser は tempUserID の移行先のユーザー
+type NotionMigrationUser struct {
+	LINEUserID LINEUserID `json:"lineUserID"`
+	// HouseHoldID は移行先の家計簿。省略した場合はユーザーの既定の家計簿に移行する
+	HouseHoldID *HouseHoldID `json:"householdID"`
+}
+
+// NotionMigrationMapping は Notion から移行する際の対応表
+type NotionMigrationMapping struct {
+	// Users は tempUserID ごとの移行先。指定がない tempUserID は LINE のユーザー ID とみなす
+	Users map[string]NotionMigrationUser `json:"users"`
+	// Categories は Notion のタグ名ごとの移行先のカテゴリ名。指定がないタグはタグ名と同じ名前のカテゴリに移行する
+	Categories map[string]string `json:"categories"`
+	// DefaultCategory はタグが空のページの移行先のカテゴリ名
+	DefaultCategory string `json:"defaultCategory"`
+}
+
+// ParseNotionMigrationMapping は JSON の対応表を読み込む。空の場合は既定の対応表を返す
+func ParseNotionMigrationMapping(data []byte) (*NotionMigrationMapping, error) {
+	mapping := &NotionMigrationMapping{}
+	if len(strings.TrimSpace(string(data))) > 0 {
+		if err := json.Unmarshal(data, mapping); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidNotionMigrationMapping, err)
+		}
+	}
+	for _, user := range mapping.Users {
+		if user.LINEUserID == "" {
+			return nil, ErrInvalidNotionMigrationMapping
+		}
+	}
+	if mapping.DefaultCategory == "" {
+		mapping.DefaultCategory = DefaultKaimemoCategory
+	}
+	return mapping, nil
+}
+
+// User は tempUserID の移行先のユーザーを返す
+func (m *NotionMigrationMapping) User(tempUserID string) NotionMigrationUser {
+	if user, ok := m.Users[tempUserID]; ok {
+		return user
+	}
+	return NotionMigrationUser{LINEUserID: LINEUserID(tempUserID)}
+}
+
+// CategoryName は Notion のタグの移行先のカテゴリ名を返す
+func (m *NotionMigrationMapping) CategoryName(tag string) string {
+	tag = strings.TrimSpace(tag)
+	if name, ok := m.Categories[tag]; ok && name != "" {
+		return name
+	}
+	if tag == "" {
+		return m.DefaultCategory
+	}
+	return tag
+}
+
+// KaimemoUserMapping は Notion の tempUserID と移行先のユーザー・家計簿の対応
+type KaimemoUserMapping struct {
+	TempUserID  string
+	UserID      UserID
+	HouseHoldID HouseHoldID
+}
+
+// NotionMigrationCount はデータベースごとの移行の件数
+type NotionMigrationCount struct {
+	Fetched         int `json:"fetched"`
+	Migrated        int `json:"migrated"`
+	AlreadyMigrated int `json:"alreadyMigrated"`
+	Skipped         int `json:"skipped"`
+}
+
+// NotionUnmappedUser は移行先のユーザーが見つからなかった tempUserID
+type NotionUnmappedUser struct {
+	TempUserID string `json:"tempUserID"`
+	Pages      int    `json:"pages"`
+	Reason     string `json:"reason"`
+}
+
+// NotionMigrationFailure は移行できなかったページ
+type NotionMigrationFailure struct {
+	PageID string `json:"pageID"`
+	Source string `json:"source"`
+	Reason string `json:"reason"`
+}
+
+// NotionMigrationCategory は移行のために作成したカテゴリ
+type NotionMigrationCategory struct {
+	HouseHoldID HouseHoldID `json:"householdID"`
+	Name        string      `json:"name"`
+}
+
+// NotionMigrationTotal は家計簿ごとの件数と支出の合計金額
+type NotionMigrationTotal struct {
+	MemoCount   int `json:"memoCount"`
+	AmountCount int `json:"amountCount"`
+	Amount      int `json:"amount"`
+}
+
+// NotionMigrationReconciliation は家計簿ごとの Notion と Postgres の突き合わせの結果
+type NotionMigrationReconciliation struct {
+	HouseHoldID HouseHoldID          `json:"householdID"`
+	Notion      NotionMigrationTotal `json:"notion"`
+	Migrated    NotionMigrationTotal `json:"migrated"`
+}
+
+// Matched は Notion のページがすべて移行されているかを返す
+func (r *NotionMigrationReconciliation) Matched() bool {
+	return r.Notion == r.Migrated
+}
+
+// NotionMigrationReport は移行の結果
+type NotionMigrationReport struct {
+	DryRun            bool                             `json:"dryRun"`
+	Memos             NotionMigrationCount             `json:"memos"`
+	Amounts           NotionMigrationCount             `json:"amounts"`
+	UnmappedUsers     []*NotionUnmappedUser            `json:"unmappedUsers"`
+	CreatedCategories []*NotionMigrationCategory       `json:"createdCategories"`
+	Failures          []*NotionMigrationFailure        `json:"failures"`
+	HouseHolds        []*NotionMigrationReconciliation `json:"households"`
+}
+
+// Reconciled は移行できなかったページがなく、すべての家計簿で件数と金額が一致しているかを返す
+func (r *NotionMigrationReport) Reconciled() bool {
+	if len(r.UnmappedUsers) > 0 || len(r.Failures) > 0 {
+		return false
+	}
+	for _, houseHold := range r.HouseHolds {
+		if !houseHold.Matched() {
+			return false
+		}
+	}
+	return true
+}
+
+// NotionKaimemoSource は移行元の Notion の買い物メモと支出を取得する
+type NotionKaimemoSource interface {
+	// FetchKaimemoPages は買い物メモのデータベースのすべてのページを取得する
+	FetchKaimemoPages(ctx context.Context) ([]*NotionKaimemoPage, error)
+	// FetchKaimemoAmountPages は支出のデータベースのすべてのページを取得する
+	FetchKaimemoAmountPages(ctx context.Context) ([]*NotionKaimemoAmountPage, error)
+}
+
+// NotionMigrationRepository は Notion から移行した記録を永続化する
+type NotionMigrationRepository interface {
+	// FindMigratedPageIDs は移行済みの Notion のページ ID を取得する
+	FindMigratedPageIDs() (map[string]bool, error)
+	// SaveKaimemoUserMapping は tempUserID の移行先を保存する。保存済みの場合は更新する
+	SaveKaimemoUserMapping(mapping *KaimemoUserMapping) error
+	// RegisterMigratedShoppingMemo は買い物メモを登録し、移行元のページを移行済みとして記録する
+	RegisterMigratedShoppingMemo(pageID string, memo *ShoppingMemo) error
+	// RegisterMigratedShoppingAmount は支出を登録し、移行元のページを移行済みとして記録する
+	RegisterMigratedShoppingAmount(pageID string, amount *ShoppingAmount) error
+	// SummarizeMigratedRecords は Notion から移行した家計簿の記録の件数と支出の合計金額を集計する
+	SummarizeMigratedRecords(houseHoldID HouseHoldID) (*NotionMigrationTotal, error)
+}
@@ -0,0 +1,35 @@
+package domainmodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNotionMigrationMapping(t *testing.T) {
+	mapping, err := ParseNotionMigrationMapping(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultKaimemoCategory, mapping.DefaultCategory)
+	assert.Equal(t, NotionMigrationUser{LINEUserID: "U1"}, mapping.User("U1"))
+
+	mapping, err = ParseNotionMigrationMapping([]byte(`{"users": {"temp": {"lineUserID": "U2"}}, "categories": {"食材": "食費"}, "defaultCategory": "その他"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, LINEUserID("U2"), mapping.User("temp").LINEUserID)
+	assert.Equal(t, "食費", mapping.CategoryName("食材"))
+	assert.Equal(t, "日用品", mapping.CategoryName(" 日用品 "))
+	assert.Equal(t, "その他", mapping.CategoryName(""))
+
+	_, err = ParseNotionMigrationMapping([]byte(`{"users": {"temp": {}}}`))
+	assert.ErrorIs(t, err, ErrInvalidNotionMigrationMapping)
+	_, err = ParseNotionMigrationMapping([]byte(`{`))
+	assert.ErrorIs(t, err, ErrInvalidNotionMigrationMapping)
+}
+
+func TestNotionKaimemoAmountPage_ShoppingDate(t *testing.T) {
+	date, err := (&NotionKaimemoAmountPage{Date: " 2024/5/1 "}).ShoppingDate()
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-05-01", date)
+
+	_, err = (&NotionKaimemoAmountPage{Date: "5月1日"}).ShoppingDate()
+	assert.ErrorIs(t, err, ErrInvalidNotionKaimemoDate)
+}
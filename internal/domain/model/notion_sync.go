@@ -0,0 +1,307 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainmodel
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// NotionSyncConflictPolicy は家計簿と Notion の両方で同じ支出が変更された場合に優先する側
+type NotionSyncConflictPolicy string
+
+const (
+	// NotionSyncConflictLatest は最後に変更された側を優先する
+	NotionSyncConflictLatest NotionSyncConflictPolicy = "latest"
+	// NotionSyncConflictHouseHold は家計簿の変更を優先する
+	NotionSyncConflictHouseHold NotionSyncConflictPolicy = "household"
+	// NotionSyncConflictNotion は Notion の変更を優先する
+	NotionSyncConflictNotion NotionSyncConflictPolicy = "notion"
+)
+
+// 競合の解決で採用した側
+const (
+	NotionSyncSideHouseHold = "household"
+	NotionSyncSideNotion    = "notion"
+)
+
+var (
+	ErrInvalidNotionSyncDatabaseID     = errors.New("notion sync database id is required")
+	ErrInvalidNotionSyncConflictPolicy = errors.New("notion sync conflict policy must be latest, household or notion")
+)
+
+// NotionSyncSetting は家計簿の支出を Notion のデータベースと同期する設定
+type NotionSyncSetting struct {
+	HouseHoldID HouseHoldID `json:"householdID"`
+	// DatabaseID は同期先の Notion のデータベース。date（タイトル）、amount（数値）、tag（セレクト）、memo（テキスト）のプロパティを持つ
+	DatabaseID     string                   `json:"databaseID"`
+	ConflictPolicy NotionSyncConflictPolicy `json:"conflictPolicy"`
+	Enabled        bool                     `json:"enabled"`
+	LastSyncedAt   *time.Time               `json:"lastSyncedAt"`
+}
+
+// NewNotionSyncSetting は同期しない既定の設定を作成する
+func NewNotionSyncSetting(houseHoldID HouseHoldID) *NotionSyncSetting {
+	return &NotionSyncSetting{
+		HouseHoldID:    houseHoldID,
+		ConflictPolicy: NotionSyncConflictLatest,
+	}
+}
+
+// Validate は同期の設定を検証する。競合の解決方法が空の場合は最後に変更された側を優先する
+func (s *NotionSyncSetting) Validate() error {
+	s.DatabaseID = strings.TrimSpace(s.DatabaseID)
+	if s.Enabled && s.DatabaseID == "" {
+		return ErrInvalidNotionSyncDatabaseID
+	}
+	switch s.ConflictPolicy {
+	case "":
+		s.ConflictPolicy = NotionSyncConflictLatest
+	case NotionSyncConflictLatest, NotionSyncConflictHouseHold, NotionSyncConflictNotion:
+	default:
+		return ErrInvalidNotionSyncConflictPolicy
+	}
+	return nil
+}
+
+// NotionSyncValues は家計簿と Notion の間で同期する支出の値。日付は YYYY-MM-DD、タグはカテゴリ名
+type NotionSyncValues struct {
+	Date   string `json:"date"`
+	Amount int    `json:"amount"`
+	Tag    string `json:"tag"`
+	Memo   string `json:"memo"`
+}
+
+// normalize は Notion で入力された値を家計簿の支出として登録できる形に揃える
+func (v NotionSyncValues) normalize() (NotionSyncValues, error) {
+	date, err := parseImportDate(strings.TrimSpace(v.Date), "")
+	if err != nil {
+		return v, ErrInvalidNotionKaimemoDate
+	}
+	if v.Amount < 0 {
+		return v, ErrInvalidNotionKaimemoAmount
+	}
+	v.Date = date.Format("2006-01-02")
+	v.Tag = strings.TrimSpace(v.Tag)
+	if v.Tag == "" {
+		v.Tag = DefaultKaimemoCategory
+	}
+	return v, nil
+}
+
+// NotionSyncRecord は同期する家計簿の支出
+type NotionSyncRecord struct {
+	ShoppingID ShoppingID
+	Values     NotionSyncValues
+	UpdatedAt  time.Time
+}
+
+// NotionSyncPage は同期先の Notion のデータベースのページ
+type NotionSyncPage struct {
+	PageID         string
+	Values         NotionSyncValues
+	LastEditedTime time.Time
+}
+
+// NotionSyncLink は同期した支出と Notion のページの対応。Snapshot は前回の同期の時点の値
+type NotionSyncLink struct {
+	ShoppingID ShoppingID
+	PageID     string
+	Snapshot   NotionSyncValues
+}
+
+// NotionSyncPageUpdate は Notion のページの更新。更新に失敗した場合は Link を変更せずに残す
+type NotionSyncPageUpdate struct {
+	Link   *NotionSyncLink
+	Values NotionSyncValues
+}
+
+// NotionSyncConflict は家計簿と Notion の両方で変更された支出と、解決の結果
+type NotionSyncConflict struct {
+	ShoppingID ShoppingID        `json:"shoppingID"`
+	PageID     string            `json:"pageID"`
+	HouseHold  *NotionSyncValues `json:"household"`
+	Notion     *NotionSyncValues `json:"notion"`
+	// Resolution は採用した側。household または notion
+	Resolution string `json:"resolution"`
+}
+
+// NotionSyncFailure は同期できなかった支出またはページ
+type NotionSyncFailure struct {
+	ShoppingID ShoppingID `json:"shoppingID,omitempty"`
+	PageID     string     `json:"pageID,omitempty"`
+	Reason     string     `json:"reason"`
+}
+
+// NotionSyncPlan は家計簿と Notion のそれぞれに反映する変更
+type NotionSyncPlan struct {
+	CreatePages  []*NotionSyncRecord
+	UpdatePages  []*NotionSyncPageUpdate
+	ArchivePages []*NotionSyncLink
+
+	CreateRecords []*NotionSyncPage
+	// UpdateRecords は Notion の値で更新する支出。Snapshot は更新後の値
+	UpdateRecords []*NotionSyncLink
+	DeleteRecords []ShoppingID
+
+	// Links は同期後も残す対応。Notion のページを作成・更新した場合の対応は同期の結果に応じて追加する
+	Links     []*NotionSyncLink
+	Conflicts []*NotionSyncConflict
+	Failures  []*NotionSyncFailure
+}
+
+// PlanNotionSync は前回の同期の時点の値と比べて、家計簿と Notion のどちらで変更されたかを判定し、反映する変更を決める
+// Notion の last_edited_time は分単位に丸められるため、変更の有無は値で判定し、時刻は競合の解決にのみ使う
+// 一方で削除され、もう一方で変更された場合は変更を優先して削除された側に作成し直す
+func PlanNotionSync(records []*NotionSyncRecord, pages []*NotionSyncPage, links []*NotionSyncLink, policy NotionSyncConflictPolicy) *NotionSyncPlan {
+	plan := &NotionSyncPlan{}
+
+	recordByID := map[ShoppingID]*NotionSyncRecord{}
+	for _, record := range records {
+		recordByID[record.ShoppingID] = record
+	}
+	pageByID := map[string]*NotionSyncPage{}
+	invalidPages := map[string]bool{}
+	for _, page := range pages {
+		values, err := page.Values.normalize()
+		if err != nil {
+			invalidPages[page.PageID] = true
+			plan.Failures = append(plan.Failures, &NotionSyncFailure{PageID: page.PageID, Reason: err.Error()})
+			continue
+		}
+		pageByID[page.PageID] = &NotionSyncPage{PageID: page.PageID, Values: values, LastEditedTime: page.LastEditedTime}
+	}
+
+	linkedRecords := map[ShoppingID]bool{}
+	linkedPages := map[string]bool{}
+	for _, link := range links {
+		linkedRecords[link.ShoppingID] = true
+		linkedPages[link.PageID] = true
+
+		// Notion で不正な値に変更されたページは、値が直されるまで同期しない
+		if invalidPages[link.PageID] {
+			plan.Links = append(plan.Links, link)
+			continue
+		}
+
+		record, page := recordByID[link.ShoppingID], pageByID[link.PageID]
+		switch {
+		case record == nil && page == nil:
+		case record == nil:
+			if page.Values == link.Snapshot {
+				plan.ArchivePages = append(plan.ArchivePages, link)
+				continue
+			}
+			plan.CreateRecords = append(plan.CreateRecords, page)
+			plan.Conflicts = append(plan.Conflicts, &NotionSyncConflict{ShoppingID: link.ShoppingID, PageID: page.PageID, Notion: &page.Values, Resolution: NotionSyncSideNotion})
+		case page == nil:
+			if record.Values == link.Snapshot {
+				plan.DeleteRecords = append(plan.DeleteRecords, record.ShoppingID)
+				continue
+			}
+			plan.CreatePages = append(plan.CreatePages, record)
+			plan.Conflicts = append(plan.Conflicts, &NotionSyncConflict{ShoppingID: record.ShoppingID, PageID: link.PageID, HouseHold: &record.Values, Resolution: NotionSyncSideHouseHold})
+		default:
+			plan.planLinked(link, record, page, policy)
+		}
+	}
+
+	for _, record := range records {
+		if !linkedRecords[record.ShoppingID] {
+			plan.CreatePages = append(plan.CreatePages, record)
+		}
+	}
+	for _, page := range pages {
+		if !linkedPages[page.PageID] && !invalidPages[page.PageID] {
+			plan.CreateRecords = append(plan.CreateRecords, pageByID[page.PageID])
+		}
+	}
+
+	return plan
+}
+
+// planLinked は家計簿と Notion の両方に残っている支出の変更を決める
+func (p *NotionSyncPlan) planLinked(link *NotionSyncLink, record *NotionSyncRecord, page *NotionSyncPage, policy NotionSyncConflictPolicy) {
+	recordChanged := record.Values != link.Snapshot
+	pageChanged := page.Values != link.Snapshot
+
+	switch {
+	case record.Values == page.Values:
+		p.Links = append(p.Links, &NotionSyncLink{ShoppingID: record.ShoppingID, PageID: page.PageID, Snapshot: record.Values})
+	case recordChanged && pageChanged:
+		conflict := &NotionSyncConflict{ShoppingID: record.ShoppingID, PageID: page.PageID, HouseHold: &record.Values, Notion: &page.Values}
+		p.Conflicts = append(p.Conflicts, conflict)
+		if preferHouseHold(record, page, policy) {
+			conflict.Resolution = NotionSyncSideHouseHold
+			p.UpdatePages = append(p.UpdatePages, &NotionSyncPageUpdate{Link: link, Values: record.Values})
+		} else {
+			conflict.Resolution = NotionSyncSideNotion
+			p.updateRecord(record, page)
+		}
+	case recordChanged:
+		p.UpdatePages = append(p.UpdatePages, &NotionSyncPageUpdate{Link: link, Values: record.Values})
+	default:
+		p.updateRecord(record, page)
+	}
+}
+
+func (p *NotionSyncPlan) updateRecord(record *NotionSyncRecord, page *NotionSyncPage) {
+	link := &NotionSyncLink{ShoppingID: record.ShoppingID, PageID: page.PageID, Snapshot: page.Values}
+	p.UpdateRecords = append(p.UpdateRecords, link)
+	p.Links = append(p.Links, link)
+}
+
+// preferHouseHold は競合した支出で家計簿の値を採用するかを返す
+func preferHouseHold(record *NotionSyncRecord, page *NotionSyncPage, policy NotionSyncConflictPolicy) bool {
+	switch policy {
+	case NotionSyncConflictHouseHold:
+		return true
+	case NotionSyncConflictNotion:
+		return false
+	default:
+		return !record.UpdatedAt.Before(page.LastEditedTime)
+	}
+}
+
+// NotionSyncResult は同期の結果
+type NotionSyncResult struct {
+	HouseHoldID    HouseHoldID           `json:"householdID"`
+	PagesCreated   int                   `json:"pagesCreated"`
+	PagesUpdated   int                   `json:"pagesUpdated"`
+	PagesArchived  int                   `json:"pagesArchived"`
+	RecordsCreated int                   `json:"recordsCreated"`
+	RecordsUpdated int                   `json:"recordsUpdated"`
+	RecordsDeleted int                   `json:"recordsDeleted"`
+	Conflicts      []*NotionSyncConflict `json:"conflicts"`
+	Failures       []*NotionSyncFailure  `json:"failures"`
+	SyncedAt       time.Time             `json:"syncedAt"`
+}
+
+// NotionSyncRepository は Notion との同期の設定と、同期する家計簿の支出を永続化する
+type NotionSyncRepository interface {
+	// FindNotionSyncSetting は家計簿の同期の設定を取得する。設定がない場合は gorm.ErrRecordNotFound を返す
+	FindNotionSyncSetting(houseHoldID HouseHoldID) (*NotionSyncSetting, error)
+	// FindEnabledNotionSyncSettings は同期が有効なすべての家計簿の設定を取得する
+	FindEnabledNotionSyncSettings() ([]*NotionSyncSetting, error)
+	// SaveNotionSyncSetting は同期の設定を保存する。保存済みの場合は更新する
+	SaveNotionSyncSetting(setting *NotionSyncSetting) error
+	// FindNotionSyncRecords は家計簿のすべての支出を同期する値として取得する
+	FindNotionSyncRecords(houseHoldID HouseHoldID) ([]*NotionSyncRecord, error)
+	// FindNotionSyncLinks は家計簿の支出と Notion のページの対応を取得する
+	FindNotionSyncLinks(houseHoldID HouseHoldID) ([]*NotionSyncLink, error)
+	// ApplyNotionSync は家計簿に反映する変更と、同期後の対応をまとめて保存し、同期した日時を記録する
+	ApplyNotionSync(houseHoldID HouseHoldID, plan *NotionSyncPlan, syncedAt time.Time) error
+}
+
+// NotionSyncClient は同期先の Notion のデータベースのページを読み書きする
+type NotionSyncClient interface {
+	// FetchPages はデータベースのアーカイブされていないすべてのページを取得する
+	FetchPages(ctx context.Context, databaseID string) ([]*NotionSyncPage, error)
+	// CreatePage はページを作成し、作成したページの ID を返す
+	CreatePage(ctx context.Context, databaseID string, values NotionSyncValues) (string, error)
+	// UpdatePage はページのプロパティを更新する
+	UpdatePage(ctx context.Context, pageID string, values NotionSyncValues) error
+	// ArchivePage はページをアーカイブする
+	ArchivePage(ctx context.Context, pageID string) error
+}
@@ -0,0 +1,175 @@
+package domainmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotionSyncSetting_Validate(t *testing.T) {
+	setting := &NotionSyncSetting{DatabaseID: " db ", Enabled: true}
+	assert.NoError(t, setting.Validate())
+	assert.Equal(t, "db", setting.DatabaseID)
+	assert.Equal(t, NotionSyncConflictLatest, setting.ConflictPolicy)
+
+	// 同期しない場合はデータベースを指定しなくてよい
+	assert.NoError(t, (&NotionSyncSetting{ConflictPolicy: NotionSyncConflictNotion}).Validate())
+
+	assert.ErrorIs(t, (&NotionSyncSetting{Enabled: true}).Validate(), ErrInvalidNotionSyncDatabaseID)
+	assert.ErrorIs(t, (&NotionSyncSetting{DatabaseID: "db", ConflictPolicy: "manual"}).Validate(), ErrInvalidNotionSyncConflictPolicy)
+}
+
+func TestPlanNotionSync(t *testing.T) {
+	base := NotionSyncValues{Date: "2026-10-01", Amount: 1000, Tag: "食費", Memo: "スーパー"}
+	changed := func(amount int) NotionSyncValues {
+		values := base
+		values.Amount = amount
+		return values
+	}
+	earlier := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	later := earlier.Add(time.Hour)
+
+	t.Run("対応のない支出とページは相手側に作成する", func(t *testing.T) {
+		record := &NotionSyncRecord{ShoppingID: 1, Values: base}
+		page := &NotionSyncPage{PageID: "p2", Values: NotionSyncValues{Date: "2026/10/2", Amount: 500}}
+
+		plan := PlanNotionSync([]*NotionSyncRecord{record}, []*NotionSyncPage{page}, nil, NotionSyncConflictLatest)
+
+		assert.Equal(t, []*NotionSyncRecord{record}, plan.CreatePages)
+		assert.Len(t, plan.CreateRecords, 1)
+		// Notion で入力された日付とタグは家計簿の形に揃える
+		assert.Equal(t, NotionSyncValues{Date: "2026-10-02", Amount: 500, Tag: DefaultKaimemoCategory}, plan.CreateRecords[0].Values)
+		assert.Empty(t, plan.Links)
+	})
+
+	t.Run("片方だけで変更された場合は相手側を更新する", func(t *testing.T) {
+		links := []*NotionSyncLink{
+			{ShoppingID: 1, PageID: "p1", Snapshot: base},
+			{ShoppingID: 2, PageID: "p2", Snapshot: base},
+			{ShoppingID: 3, PageID: "p3", Snapshot: base},
+		}
+		records := []*NotionSyncRecord{
+			{ShoppingID: 1, Values: changed(1200)},
+			{ShoppingID: 2, Values: base},
+			{ShoppingID: 3, Values: base},
+		}
+		pages := []*NotionSyncPage{
+			{PageID: "p1", Values: base},
+			{PageID: "p2", Values: changed(800)},
+			{PageID: "p3", Values: base},
+		}
+
+		plan := PlanNotionSync(records, pages, links, NotionSyncConflictLatest)
+
+		assert.Equal(t, []*NotionSyncPageUpdate{{Link: links[0], Values: changed(1200)}}, plan.UpdatePages)
+		assert.Equal(t, []*NotionSyncLink{{ShoppingID: 2, PageID: "p2", Snapshot: changed(800)}}, plan.UpdateRecords)
+		assert.Equal(t, []*NotionSyncLink{
+			{ShoppingID: 2, PageID: "p2", Snapshot: changed(800)},
+			{ShoppingID: 3, PageID: "p3", Snapshot: base},
+		}, plan.Links)
+		assert.Empty(t, plan.Conflicts)
+	})
+
+	t.Run("片方で削除され、もう片方で変更されていない場合は相手側も削除する", func(t *testing.T) {
+		links := []*NotionSyncLink{
+			{ShoppingID: 1, PageID: "p1", Snapshot: base},
+			{ShoppingID: 2, PageID: "p2", Snapshot: base},
+			{ShoppingID: 3, PageID: "p3", Snapshot: base},
+		}
+		records := []*NotionSyncRecord{{ShoppingID: 2, Values: base}}
+		pages := []*NotionSyncPage{{PageID: "p1", Values: base}}
+
+		plan := PlanNotionSync(records, pages, links, NotionSyncConflictLatest)
+
+		assert.Equal(t, []*NotionSyncLink{links[0]}, plan.ArchivePages)
+		assert.Equal(t, []ShoppingID{2}, plan.DeleteRecords)
+		assert.Empty(t, plan.Links)
+	})
+
+	t.Run("削除と変更が競合した場合は変更を優先して作成し直す", func(t *testing.T) {
+		links := []*NotionSyncLink{
+			{ShoppingID: 1, PageID: "p1", Snapshot: base},
+			{ShoppingID: 2, PageID: "p2", Snapshot: base},
+		}
+		records := []*NotionSyncRecord{{ShoppingID: 2, Values: changed(1500)}}
+		pages := []*NotionSyncPage{{PageID: "p1", Values: changed(900)}}
+
+		plan := PlanNotionSync(records, pages, links, NotionSyncConflictHouseHold)
+
+		assert.Equal(t, records, plan.CreatePages)
+		assert.Len(t, plan.CreateRecords, 1)
+		assert.Equal(t, "p1", plan.CreateRecords[0].PageID)
+		assert.Empty(t, plan.ArchivePages)
+		assert.Empty(t, plan.DeleteRecords)
+		assert.Len(t, plan.Conflicts, 2)
+		assert.Equal(t, NotionSyncSideNotion, plan.Conflicts[0].Resolution)
+		assert.Equal(t, NotionSyncSideHouseHold, plan.Conflicts[1].Resolution)
+	})
+
+	t.Run("両方で変更された場合は設定に従って解決する", func(t *testing.T) {
+		links := []*NotionSyncLink{{ShoppingID: 1, PageID: "p1", Snapshot: base}}
+		testCases := []struct {
+			name       string
+			policy     NotionSyncConflictPolicy
+			updatedAt  time.Time
+			resolution string
+		}{
+			{name: "家計簿を優先", policy: NotionSyncConflictHouseHold, updatedAt: earlier, resolution: NotionSyncSideHouseHold},
+			{name: "Notion を優先", policy: NotionSyncConflictNotion, updatedAt: later, resolution: NotionSyncSideNotion},
+			{name: "家計簿が後に変更された", policy: NotionSyncConflictLatest, updatedAt: later, resolution: NotionSyncSideHouseHold},
+			{name: "Notion が後に変更された", policy: NotionSyncConflictLatest, updatedAt: earlier, resolution: NotionSyncSideNotion},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				records := []*NotionSyncRecord{{ShoppingID: 1, Values: changed(1200), UpdatedAt: tc.updatedAt}}
+				pages := []*NotionSyncPage{{PageID: "p1", Values: changed(800), LastEditedTime: earlier.Add(30 * time.Minute)}}
+
+				plan := PlanNotionSync(records, pages, links, tc.policy)
+
+				assert.Len(t, plan.Conflicts, 1)
+				assert.Equal(t, tc.resolution, plan.Conflicts[0].Resolution)
+				if tc.resolution == NotionSyncSideHouseHold {
+					assert.Equal(t, []*NotionSyncPageUpdate{{Link: links[0], Values: changed(1200)}}, plan.UpdatePages)
+					assert.Empty(t, plan.UpdateRecords)
+				} else {
+					assert.Empty(t, plan.UpdatePages)
+					assert.Equal(t, []*NotionSyncLink{{ShoppingID: 1, PageID: "p1", Snapshot: changed(800)}}, plan.UpdateRecords)
+				}
+			})
+		}
+	})
+
+	t.Run("両方で同じ値に変更された場合は対応のみ更新する", func(t *testing.T) {
+		links := []*NotionSyncLink{{ShoppingID: 1, PageID: "p1", Snapshot: base}}
+		records := []*NotionSyncRecord{{ShoppingID: 1, Values: changed(1200)}}
+		pages := []*NotionSyncPage{{PageID: "p1", Values: changed(1200)}}
+
+		plan := PlanNotionSync(records, pages, links, NotionSyncConflictLatest)
+
+		assert.Empty(t, plan.Conflicts)
+		assert.Empty(t, plan.UpdatePages)
+		assert.Empty(t, plan.UpdateRecords)
+		assert.Equal(t, []*NotionSyncLink{{ShoppingID: 1, PageID: "p1", Snapshot: changed(1200)}}, plan.Links)
+	})
+
+	t.Run("不正な値のページは同期せず、対応を残す", func(t *testing.T) {
+		links := []*NotionSyncLink{{ShoppingID: 1, PageID: "p1", Snapshot: base}}
+		records := []*NotionSyncRecord{{ShoppingID: 1, Values: changed(1200)}}
+		pages := []*NotionSyncPage{
+			{PageID: "p1", Values: NotionSyncValues{Date: "来週", Amount: 1000}},
+			{PageID: "p2", Values: NotionSyncValues{Date: "2026-10-01", Amount: -1}},
+		}
+
+		plan := PlanNotionSync(records, pages, links, NotionSyncConflictLatest)
+
+		assert.Equal(t, links, plan.Links)
+		assert.Empty(t, plan.UpdatePages)
+		assert.Empty(t, plan.CreateRecords)
+		assert.Equal(t, []*NotionSyncFailure{
+			{PageID: "p1", Reason: ErrInvalidNotionKaimemoDate.Error()},
+			{PageID: "p2", Reason: ErrInvalidNotionKaimemoAmount.Error()},
+		}, plan.Failures)
+	})
+}
@@ -0,0 +1,460 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainmodel
+
+import (
+	"echo-household-budget/internal/infrastructure/persistence/models"
+	"errors"
+	"sort"
+	"time"
+)
+
+type PaymentMethodID uint
+
+// PaymentMethodType は支払い方法・口座の種類
+type PaymentMethodType string
+
+const (
+	// PaymentMethodCash は現金（財布）
+	PaymentMethodCash PaymentMethodType = "cash"
+	// PaymentMethodCreditCard はクレジットカード。締め日までの利用分を翌月の引き落とし日に支払う
+	PaymentMethodCreditCard PaymentMethodType = "credit_card"
+	// PaymentMethodBank は銀行口座
+	PaymentMethodBank PaymentMethodType = "bank"
+	// PaymentMethodEMoney は PayPay や Suica などの電子マネー
+	PaymentMethodEMoney PaymentMethodType = "e_money"
+)
+
+// PaymentMethod は家計簿ごとの支払い方法・口座
+type PaymentMethod struct {
+	ID          PaymentMethodID   `json:"id"`
+	HouseHoldID HouseHoldID       `json:"houseHoldID"`
+	Name        string            `json:"name"`
+	Type        PaymentMethodType `json:"type"`
+	// OpeningBalance は OpeningDate 時点の残高。クレジットカードは未払いの利用額を負の値で指定する
+	OpeningBalance int    `json:"openingBalance"`
+	OpeningDate    string `json:"openingDate"`
+	// ClosingDay, PaymentDay はクレジットカードの締め日と翌月の引き落とし日。月末より大きい場合はその月の末日とする
+	ClosingDay *int `json:"closingDay"`
+	PaymentDay *int `json:"paymentDay"`
+	// WithdrawalAccountID はクレジットカードの引き落とし口座。未指定の場合は家計簿の外から支払ったものとする
+	WithdrawalAccountID *PaymentMethodID `json:"withdrawalAccountID"`
+	// ArchivedAt はアーカイブされた日時。アーカイブされた支払い方法は一覧に表示しないが、残高の計算には用いる
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+var (
+	ErrInvalidPaymentMethodName = errors.New("payment method name must be 1 to 255 characters")
+	ErrInvalidPaymentMethodType = errors.New("payment method type must be cash, credit_card, bank or e_money")
+	ErrInvalidPaymentMethodDate = errors.New("opening date must be in YYYY-MM-DD format")
+	ErrBillingCycleRequired     = errors.New("closing day and payment day are required for credit card")
+	ErrInvalidBillingDay        = errors.New("closing day and payment day must be between 1 and 31")
+	ErrBillingCycleNotAllowed   = errors.New("billing cycle and withdrawal account are only for credit card")
+	ErrInvalidWithdrawalAccount = errors.New("withdrawal account must be a non credit card payment method in the household")
+	ErrNotCreditCard            = errors.New("payment method is not a credit card")
+	ErrInvalidBalanceDate       = errors.New("balance date must be in YYYY-MM-DD format")
+)
+
+// Validate は支払い方法を検証する
+func (m *PaymentMethod) Validate() error {
+	if nameLength := len([]rune(m.Name)); nameLength == 0 || nameLength > 255 {
+		return ErrInvalidPaymentMethodName
+	}
+	if _, err := time.Parse("2006-01-02", m.OpeningDate); err != nil {
+		return ErrInvalidPaymentMethodDate
+	}
+	switch m.Type {
+	case PaymentMethodCreditCard:
+		if m.ClosingDay == nil || m.PaymentDay == nil {
+			return ErrBillingCycleRequired
+		}
+		if *m.ClosingDay < 1 || *m.ClosingDay > 31 || *m.PaymentDay < 1 || *m.PaymentDay > 31 {
+			return ErrInvalidBillingDay
+		}
+		if m.WithdrawalAccountID != nil && *m.WithdrawalAccountID == m.ID {
+			return ErrInvalidWithdrawalAccount
+		}
+	case PaymentMethodCash, PaymentMethodBank, PaymentMethodEMoney:
+		if m.ClosingDay != nil || m.PaymentDay != nil || m.WithdrawalAccountID != nil {
+			return ErrBillingCycleNotAllowed
+		}
+	default:
+		return ErrInvalidPaymentMethodType
+	}
+	return nil
+}
+
+// IsArchived は支払い方法がアーカイブされているかを返す
+func (m *PaymentMethod) IsArchived() bool {
+	return m.ArchivedAt != nil
+}
+
+// IsCreditCard はクレジットカードかどうかを返す
+func (m *PaymentMethod) IsCreditCard() bool {
+	return m.Type == PaymentMethodCreditCard
+}
+
+// StatementClosing は指定日の利用分が含まれる請求の締め日を返す（クレジットカードのみ）
+func (m *PaymentMethod) StatementClosing(date time.Time) time.Time {
+	closing := dateInMonth(date.Year(), date.Month(), *m.ClosingDay)
+	if date.After(closing) {
+		closing = dateInMonth(date.Year(), date.Month()+1, *m.ClosingDay)
+	}
+	return closing
+}
+
+// StatementClosingIn は指定月に締める請求の締め日を返す（クレジットカードのみ）
+func (m *PaymentMethod) StatementClosingIn(month time.Time) time.Time {
+	return dateInMonth(month.Year(), month.Month(), *m.ClosingDay)
+}
+
+// StatementPeriod は締め日 closing の請求の利用期間を返す。開始日より前の利用は含めない
+func (m *PaymentMethod) StatementPeriod(closing time.Time) (from string, to string) {
+	from = m.statementStart(closing).Format("2006-01-02")
+	if from < m.OpeningDate {
+		from = m.OpeningDate
+	}
+	return from, closing.Format("2006-01-02")
+}
+
+// statementStart は締め日 closing の請求の利用期間の初日を返す
+func (m *PaymentMethod) statementStart(closing time.Time) time.Time {
+	return dateInMonth(closing.Year(), closing.Month()-1, *m.ClosingDay).AddDate(0, 0, 1)
+}
+
+// dueDate は締め日 closing の請求の引き落とし日を返す
+func (m *PaymentMethod) dueDate(closing time.Time) time.Time {
+	return dateInMonth(closing.Year(), closing.Month()+1, *m.PaymentDay)
+}
+
+// PaymentMethodDailyAmount は支払い方法ごと・日ごとの支出と収入の合計
+type PaymentMethodDailyAmount struct {
+	PaymentMethodID PaymentMethodID
+	Date            string
+	Expense         int
+	Income          int
+}
+
+// BillPayment はクレジットカードの請求の引き落とし
+type BillPayment struct {
+	PaymentMethodID PaymentMethodID `json:"paymentMethodID"`
+	ClosingDate     string          `json:"closingDate"`
+	DueDate         string          `json:"dueDate"`
+	Amount          int             `json:"amount"`
+}
+
+// BillPayments は until までに引き落とし日を迎えた請求を返す（クレジットカードのみ）
+// amounts はこの支払い方法の日ごとの合計。開始残高は最初の請求に含める
+func (m *PaymentMethod) BillPayments(amounts []*PaymentMethodDailyAmount, until string) []*BillPayment {
+	bills := []*BillPayment{}
+	opening, err := time.Parse("2006-01-02", m.OpeningDate)
+	if err != nil || !m.IsCreditCard() {
+		return bills
+	}
+
+	closing := m.StatementClosing(opening)
+	for {
+		due := m.dueDate(closing).Format("2006-01-02")
+		if due > until {
+			break
+		}
+		from, to := m.StatementPeriod(closing)
+		income, expense := sumDailyAmounts(amounts, from, to)
+		amount := expense - income
+		if len(bills) == 0 {
+			amount -= m.OpeningBalance
+		}
+		bills = append(bills, &BillPayment{
+			PaymentMethodID: m.ID,
+			ClosingDate:     to,
+			DueDate:         due,
+			Amount:          amount,
+		})
+		closing = dateInMonth(closing.Year(), closing.Month()+1, *m.ClosingDay)
+	}
+	return bills
+}
+
+// sumDailyAmounts は from から to まで（両端を含む）の収入と支出の合計を返す
+func sumDailyAmounts(amounts []*PaymentMethodDailyAmount, from string, to string) (income int, expense int) {
+	for _, amount := range amounts {
+		if amount.Date < from || amount.Date > to {
+			continue
+		}
+		income += amount.Income
+		expense += amount.Expense
+	}
+	return income, expense
+}
+
+// PaymentMethodBalance は支払い方法ごとの残高
+type PaymentMethodBalance struct {
+	PaymentMethod *PaymentMethod `json:"paymentMethod"`
+	Income        int            `json:"income"`
+	Expense       int            `json:"expense"`
+	// BillPayments はクレジットカードの引き落とし額。カードでは支払った額、引き落とし口座では引き落とされた額
+	BillPayments int `json:"billPayments"`
+	// Balance は残高。クレジットカードは未払いの利用額を負の値で表す
+	Balance int `json:"balance"`
+}
+
+// NewPaymentMethodBalances は until 時点の支払い方法ごとの残高を算出する
+// クレジットカードの請求は引き落とし日に、カードの残高から引き落とし口座の残高に振り替える
+func NewPaymentMethodBalances(methods []*PaymentMethod, amounts []*PaymentMethodDailyAmount, until string) []*PaymentMethodBalance {
+	amountsByMethod := groupDailyAmounts(amounts)
+
+	balances := make([]*PaymentMethodBalance, len(methods))
+	balanceByID := make(map[PaymentMethodID]*PaymentMethodBalance, len(methods))
+	for i, method := range methods {
+		income, expense := sumDailyAmounts(amountsByMethod[method.ID], method.OpeningDate, until)
+		balances[i] = &PaymentMethodBalance{
+			PaymentMethod: method,
+			Income:        income,
+			Expense:       expense,
+			Balance:       method.OpeningBalance + income - expense,
+		}
+		if method.OpeningDate > until {
+			balances[i].Balance = 0
+		}
+		balanceByID[method.ID] = balances[i]
+	}
+
+	for _, method := range methods {
+		if !method.IsCreditCard() {
+			continue
+		}
+		for _, bill := range method.BillPayments(amountsByMethod[method.ID], until) {
+			balanceByID[method.ID].BillPayments += bill.Amount
+			balanceByID[method.ID].Balance += bill.Amount
+			if method.WithdrawalAccountID == nil {
+				continue
+			}
+			account, ok := balanceByID[*method.WithdrawalAccountID]
+			if !ok || bill.DueDate < account.PaymentMethod.OpeningDate {
+				continue
+			}
+			account.BillPayments += bill.Amount
+			account.Balance -= bill.Amount
+		}
+	}
+
+	return balances
+}
+
+func groupDailyAmounts(amounts []*PaymentMethodDailyAmount) map[PaymentMethodID][]*PaymentMethodDailyAmount {
+	grouped := make(map[PaymentMethodID][]*PaymentMethodDailyAmount)
+	for _, amount := range amounts {
+		grouped[amount.PaymentMethodID] = append(grouped[amount.PaymentMethodID], amount)
+	}
+	return grouped
+}
+
+// PaymentMethodTransactionType は支払い方法の入出金の種類
+type PaymentMethodTransactionType string
+
+const (
+	PaymentMethodTransactionExpense PaymentMethodTransactionType = "expense"
+	PaymentMethodTransactionIncome  PaymentMethodTransactionType = "income"
+	// PaymentMethodTransactionBillPayment はクレジットカードの請求の支払い（カードの残高が増える）
+	PaymentMethodTransactionBillPayment PaymentMethodTransactionType = "bill_payment"
+	// PaymentMethodTransactionBillWithdrawal はクレジットカードの請求の引き落とし（口座の残高が減る）
+	PaymentMethodTransactionBillWithdrawal PaymentMethodTransactionType = "bill_withdrawal"
+)
+
+// PaymentMethodTransaction は支払い方法の入出金
+type PaymentMethodTransaction struct {
+	Type PaymentMethodTransactionType `json:"type"`
+	// ID は支出または収入のID。クレジットカードの請求の場合は 0
+	ID     uint   `json:"id"`
+	Date   string `json:"date"`
+	Amount int    `json:"amount"`
+	Memo   string `json:"memo"`
+}
+
+// Delta は入出金による残高の増減を返す
+func (t *PaymentMethodTransaction) Delta() int {
+	switch t.Type {
+	case PaymentMethodTransactionIncome, PaymentMethodTransactionBillPayment:
+		return t.Amount
+	default:
+		return -t.Amount
+	}
+}
+
+// BillTransactions は from から to まで（両端を含む）に引き落とし日を迎えたクレジットカードの請求を、method の入出金として返す
+// method がクレジットカードの場合は請求の支払い、引き落とし口座の場合は請求の引き落としとなる
+func BillTransactions(method *PaymentMethod, methods []*PaymentMethod, amounts []*PaymentMethodDailyAmount, from string, to string) []*PaymentMethodTransaction {
+	amountsByMethod := groupDailyAmounts(amounts)
+	transactions := []*PaymentMethodTransaction{}
+	for _, card := range methods {
+		if !card.IsCreditCard() {
+			continue
+		}
+		transactionType := PaymentMethodTransactionBillPayment
+		if card.ID != method.ID {
+			if card.WithdrawalAccountID == nil || *card.WithdrawalAccountID != method.ID {
+				continue
+			}
+			transactionType = PaymentMethodTransactionBillWithdrawal
+		}
+		for _, bill := range card.BillPayments(amountsByMethod[card.ID], to) {
+			if bill.DueDate < from || bill.DueDate < method.OpeningDate {
+				continue
+			}
+			transactions = append(transactions, &PaymentMethodTransaction{
+				Type:   transactionType,
+				Date:   bill.DueDate,
+				Amount: bill.Amount,
+				Memo:   card.Name + "（" + bill.ClosingDate + " 締め）",
+			})
+		}
+	}
+	return transactions
+}
+
+// PaymentMethodLedgerEntry は入出金と、その時点の残高
+type PaymentMethodLedgerEntry struct {
+	*PaymentMethodTransaction
+	Balance int `json:"balance"`
+}
+
+// PaymentMethodLedger は支払い方法の期間内の入出金明細
+type PaymentMethodLedger struct {
+	PaymentMethod  *PaymentMethod              `json:"paymentMethod"`
+	From           string                      `json:"from"`
+	To             string                      `json:"to"`
+	OpeningBalance int                         `json:"openingBalance"`
+	ClosingBalance int                         `json:"closingBalance"`
+	Entries        []*PaymentMethodLedgerEntry `json:"entries"`
+}
+
+// NewPaymentMethodLedger は期首残高に入出金を日付順に加算した明細を作成する
+func NewPaymentMethodLedger(method *PaymentMethod, from string, to string, openingBalance int, transactions []*PaymentMethodTransaction) *PaymentMethodLedger {
+	sorted := append([]*PaymentMethodTransaction{}, transactions...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Date < sorted[j].Date })
+
+	ledger := &PaymentMethodLedger{
+		PaymentMethod:  method,
+		From:           from,
+		To:             to,
+		OpeningBalance: openingBalance,
+		Entries:        []*PaymentMethodLedgerEntry{},
+	}
+	balance := openingBalance
+	for _, transaction := range sorted {
+		balance += transaction.Delta()
+		ledger.Entries = append(ledger.Entries, &PaymentMethodLedgerEntry{PaymentMethodTransaction: transaction, Balance: balance})
+	}
+	ledger.ClosingBalance = balance
+	return ledger
+}
+
+// BillingStatement はクレジットカードの請求
+type BillingStatement struct {
+	PaymentMethod *PaymentMethod `json:"paymentMethod"`
+	// From, To は利用期間（To が締め日）。開始日を含む請求の From は開始日とする
+	From    string `json:"from"`
+	To      string `json:"to"`
+	DueDate string `json:"dueDate"`
+	// Amount は請求額。利用期間に開始日を含む場合は開始時点の未払いの利用額を含む
+	Amount       int                         `json:"amount"`
+	Transactions []*PaymentMethodTransaction `json:"transactions"`
+}
+
+// NewBillingStatement は締め日 closing の請求を作成する。transactions は StatementPeriod の期間内の入出金であること
+func (m *PaymentMethod) NewBillingStatement(closing time.Time, transactions []*PaymentMethodTransaction) *BillingStatement {
+	from, to := m.StatementPeriod(closing)
+	statement := &BillingStatement{
+		PaymentMethod: m,
+		From:          from,
+		To:            to,
+		DueDate:       m.dueDate(closing).Format("2006-01-02"),
+		Transactions:  transactions,
+	}
+	for _, transaction := range transactions {
+		statement.Amount -= transaction.Delta()
+	}
+	if statement.From == m.OpeningDate {
+		statement.Amount -= m.OpeningBalance
+	}
+	return statement
+}
+
+// PaymentMethodAmount は月の支出・収入の支払い方法ごとの合計
+type PaymentMethodAmount struct {
+	// PaymentMethod は支払い方法。支払い方法が未設定の記録の合計は nil
+	PaymentMethod *PaymentMethod `json:"paymentMethod"`
+	Expense       int            `json:"expense"`
+	Income        int            `json:"income"`
+}
+
+// NewPaymentMethodAmounts は支出と収入を支払い方法ごとに集計する。支払い方法が未設定の記録の合計は末尾に配置する
+func NewPaymentMethodAmounts(expenses ShoppingAmounts, incomes []*Income) []*PaymentMethodAmount {
+	amounts := make(map[PaymentMethodID]*PaymentMethodAmount)
+	amountOf := func(method *PaymentMethod) *PaymentMethodAmount {
+		id := PaymentMethodID(0)
+		if method != nil {
+			id = method.ID
+		}
+		if _, ok := amounts[id]; !ok {
+			amounts[id] = &PaymentMethodAmount{PaymentMethod: method}
+		}
+		return amounts[id]
+	}
+	for _, expense := range expenses {
+		amountOf(expense.PaymentMethod).Expense += expense.Amount
+	}
+	for _, income := range incomes {
+		amountOf(income.PaymentMethod).Income += income.Amount
+	}
+
+	output := make([]*PaymentMethodAmount, 0, len(amounts))
+	for _, amount := range amounts {
+		output = append(output, amount)
+	}
+	sort.Slice(output, func(i, j int) bool {
+		if output[i].PaymentMethod == nil || output[j].PaymentMethod == nil {
+			return output[j].PaymentMethod == nil && output[i].PaymentMethod != nil
+		}
+		return output[i].PaymentMethod.ID < output[j].PaymentMethod.ID
+	})
+	return output
+}
+
+// ConvertPaymentMethod は支払い方法のモデルを変換する。nil の場合は nil を返す
+func ConvertPaymentMethod(model *models.PaymentMethod) *PaymentMethod {
+	if model == nil {
+		return nil
+	}
+
+	method := &PaymentMethod{
+		ID:             PaymentMethodID(model.ID),
+		HouseHoldID:    HouseHoldID(model.HouseholdBookID),
+		Name:           model.Name,
+		Type:           PaymentMethodType(model.Type),
+		OpeningBalance: model.OpeningBalance,
+		OpeningDate:    model.OpeningDate.Format("2006-01-02"),
+		ClosingDay:     model.ClosingDay,
+		PaymentDay:     model.PaymentDay,
+		ArchivedAt:     model.ArchivedAt,
+	}
+	if model.WithdrawalAccountID != nil {
+		withdrawalAccountID := PaymentMethodID(*model.WithdrawalAccountID)
+		method.WithdrawalAccountID = &withdrawalAccountID
+	}
+	return method
+}
+
+// PaymentMethodRepository は支払い方法の永続化を担うリポジトリのインターフェース
+type PaymentMethodRepository interface {
+	FindByHouseHoldID(houseHoldID HouseHoldID, includeArchived bool) ([]*PaymentMethod, error)
+	// FindByID は家計簿の支払い方法を取得します。存在しない場合は nil を返します
+	FindByID(houseHoldID HouseHoldID, id PaymentMethodID) (*PaymentMethod, error)
+	Create(method *PaymentMethod) error
+	Update(method *PaymentMethod) error
+	Archive(houseHoldID HouseHoldID, id PaymentMethodID, archivedAt *time.Time) error
+
+	// SummarizeDailyAmounts は until までの支出と収入を支払い方法・日ごとに集計します
+	SummarizeDailyAmounts(houseHoldID HouseHoldID, until string) ([]*PaymentMethodDailyAmount, error)
+	// FindTransactions は支払い方法の from から to まで（両端を含む）の支出と収入を日付順で取得します
+	FindTransactions(houseHoldID HouseHoldID, id PaymentMethodID, from string, to string) ([]*PaymentMethodTransaction, error)
+}
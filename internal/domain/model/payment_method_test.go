@@ -0,0 +1,166 @@
+package domainmodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func intPtr(v int) *int { return &v }
+
+// testPaymentMethods は銀行口座（ID: 1）と、その口座から15日締め翌月10日払いで引き落とすクレジットカード（ID: 2）
+func testPaymentMethods() (*PaymentMethod, *PaymentMethod) {
+	bank := &PaymentMethod{ID: 1, Name: "銀行", Type: PaymentMethodBank, OpeningBalance: 100000, OpeningDate: "2026-08-01"}
+	bankID := bank.ID
+	card := &PaymentMethod{
+		ID: 2, Name: "カード", Type: PaymentMethodCreditCard, OpeningBalance: -5000, OpeningDate: "2026-08-01",
+		ClosingDay: intPtr(15), PaymentDay: intPtr(10), WithdrawalAccountID: &bankID,
+	}
+	return bank, card
+}
+
+func testDailyAmounts() []*PaymentMethodDailyAmount {
+	return []*PaymentMethodDailyAmount{
+		{PaymentMethodID: 2, Date: "2026-08-10", Expense: 3000},
+		{PaymentMethodID: 2, Date: "2026-08-20", Expense: 4000},
+		{PaymentMethodID: 1, Date: "2026-08-25", Income: 300000},
+		{PaymentMethodID: 2, Date: "2026-09-05", Income: 1000},
+		{PaymentMethodID: 2, Date: "2026-09-20", Expense: 2000},
+	}
+}
+
+func TestPaymentMethod_Validate(t *testing.T) {
+	withdrawalAccountID := PaymentMethodID(1)
+
+	tests := []struct {
+		name    string
+		method  PaymentMethod
+		wantErr error
+	}{
+		{name: "現金", method: PaymentMethod{Name: "財布", Type: PaymentMethodCash, OpeningDate: "2026-10-01"}},
+		{name: "クレジットカード", method: PaymentMethod{ID: 2, Name: "カード", Type: PaymentMethodCreditCard, OpeningDate: "2026-10-01", ClosingDay: intPtr(31), PaymentDay: intPtr(27), WithdrawalAccountID: &withdrawalAccountID}},
+		{name: "名前が空", method: PaymentMethod{Name: "", Type: PaymentMethodCash, OpeningDate: "2026-10-01"}, wantErr: ErrInvalidPaymentMethodName},
+		{name: "種類が不正", method: PaymentMethod{Name: "財布", Type: "point", OpeningDate: "2026-10-01"}, wantErr: ErrInvalidPaymentMethodType},
+		{name: "開始日の形式が不正", method: PaymentMethod{Name: "財布", Type: PaymentMethodCash, OpeningDate: "2026/10/01"}, wantErr: ErrInvalidPaymentMethodDate},
+		{name: "締め日の指定がない", method: PaymentMethod{Name: "カード", Type: PaymentMethodCreditCard, OpeningDate: "2026-10-01", PaymentDay: intPtr(27)}, wantErr: ErrBillingCycleRequired},
+		{name: "引き落とし日が範囲外", method: PaymentMethod{Name: "カード", Type: PaymentMethodCreditCard, OpeningDate: "2026-10-01", ClosingDay: intPtr(15), PaymentDay: intPtr(32)}, wantErr: ErrInvalidBillingDay},
+		{name: "自身を引き落とし口座に指定", method: PaymentMethod{ID: 1, Name: "カード", Type: PaymentMethodCreditCard, OpeningDate: "2026-10-01", ClosingDay: intPtr(15), PaymentDay: intPtr(10), WithdrawalAccountID: &withdrawalAccountID}, wantErr: ErrInvalidWithdrawalAccount},
+		{name: "カード以外に締め日を指定", method: PaymentMethod{Name: "銀行", Type: PaymentMethodBank, OpeningDate: "2026-10-01", ClosingDay: intPtr(15)}, wantErr: ErrBillingCycleNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, tt.method.Validate())
+		})
+	}
+}
+
+func TestPaymentMethod_StatementClosing(t *testing.T) {
+	_, card := testPaymentMethods()
+	assert.Equal(t, testDate("2026-08-15"), card.StatementClosing(testDate("2026-08-15")))
+	assert.Equal(t, testDate("2026-09-15"), card.StatementClosing(testDate("2026-08-16")))
+	assert.Equal(t, testDate("2027-01-15"), card.StatementClosing(testDate("2026-12-20")))
+
+	// 月末締めは月の末日に丸める
+	monthEnd := &PaymentMethod{Type: PaymentMethodCreditCard, OpeningDate: "2026-01-01", ClosingDay: intPtr(31), PaymentDay: intPtr(31)}
+	closing := monthEnd.StatementClosing(testDate("2026-02-10"))
+	assert.Equal(t, testDate("2026-02-28"), closing)
+	from, to := monthEnd.StatementPeriod(closing)
+	assert.Equal(t, "2026-02-01", from)
+	assert.Equal(t, "2026-02-28", to)
+	assert.Equal(t, testDate("2026-03-31"), monthEnd.dueDate(closing))
+}
+
+func TestPaymentMethod_BillPayments(t *testing.T) {
+	_, card := testPaymentMethods()
+	amounts := groupDailyAmounts(testDailyAmounts())[card.ID]
+
+	// 最初の請求には開始時点の未払いの利用額を含める
+	assert.Equal(t, []*BillPayment{
+		{PaymentMethodID: 2, ClosingDate: "2026-08-15", DueDate: "2026-09-10", Amount: 8000},
+		{PaymentMethodID: 2, ClosingDate: "2026-09-15", DueDate: "2026-10-10", Amount: 3000},
+	}, card.BillPayments(amounts, "2026-11-09"))
+	assert.Empty(t, card.BillPayments(amounts, "2026-09-09"))
+}
+
+func TestNewPaymentMethodBalances(t *testing.T) {
+	bank, card := testPaymentMethods()
+	cash := &PaymentMethod{ID: 3, Name: "財布", Type: PaymentMethodCash, OpeningBalance: 2000, OpeningDate: "2026-11-01"}
+	methods := []*PaymentMethod{bank, card, cash}
+
+	// 9月の請求のみ引き落とし済み
+	balances := NewPaymentMethodBalances(methods, testDailyAmounts(), "2026-09-30")
+	assert.Equal(t, []*PaymentMethodBalance{
+		{PaymentMethod: bank, Income: 300000, BillPayments: 8000, Balance: 392000},
+		{PaymentMethod: card, Income: 1000, Expense: 9000, BillPayments: 8000, Balance: -5000},
+		{PaymentMethod: cash, Balance: 0},
+	}, balances)
+
+	balances = NewPaymentMethodBalances(methods, testDailyAmounts(), "2026-11-01")
+	assert.Equal(t, 389000, balances[0].Balance)
+	assert.Equal(t, -2000, balances[1].Balance)
+	assert.Equal(t, 2000, balances[2].Balance)
+}
+
+func TestNewPaymentMethodLedger(t *testing.T) {
+	bank, card := testPaymentMethods()
+	methods := []*PaymentMethod{bank, card}
+
+	transactions := BillTransactions(bank, methods, testDailyAmounts(), "2026-09-01", "2026-09-30")
+	assert.Equal(t, []*PaymentMethodTransaction{
+		{Type: PaymentMethodTransactionBillWithdrawal, Date: "2026-09-10", Amount: 8000, Memo: "カード（2026-08-15 締め）"},
+	}, transactions)
+
+	transactions = append(transactions, &PaymentMethodTransaction{Type: PaymentMethodTransactionIncome, ID: 3, Date: "2026-09-25", Amount: 300000, Memo: "給与"})
+	transactions = append(transactions, &PaymentMethodTransaction{Type: PaymentMethodTransactionExpense, ID: 8, Date: "2026-09-01", Amount: 20000, Memo: "家賃"})
+	ledger := NewPaymentMethodLedger(bank, "2026-09-01", "2026-09-30", 400000, transactions)
+	assert.Equal(t, 400000, ledger.OpeningBalance)
+	assert.Equal(t, 672000, ledger.ClosingBalance)
+	balances := []int{}
+	for _, entry := range ledger.Entries {
+		balances = append(balances, entry.Balance)
+	}
+	assert.Equal(t, []int{380000, 372000, 672000}, balances)
+
+	// カード側では請求の支払いとして残高が増える
+	transactions = BillTransactions(card, methods, testDailyAmounts(), "2026-09-01", "2026-09-30")
+	assert.Equal(t, PaymentMethodTransactionBillPayment, transactions[0].Type)
+	assert.Equal(t, 8000, transactions[0].Delta())
+}
+
+func TestPaymentMethod_NewBillingStatement(t *testing.T) {
+	_, card := testPaymentMethods()
+
+	statement := card.NewBillingStatement(testDate("2026-09-15"), []*PaymentMethodTransaction{
+		{Type: PaymentMethodTransactionExpense, ID: 1, Date: "2026-08-20", Amount: 4000},
+		{Type: PaymentMethodTransactionIncome, ID: 2, Date: "2026-09-05", Amount: 1000},
+	})
+	assert.Equal(t, "2026-08-16", statement.From)
+	assert.Equal(t, "2026-09-15", statement.To)
+	assert.Equal(t, "2026-10-10", statement.DueDate)
+	assert.Equal(t, 3000, statement.Amount)
+
+	// 開始日を含む請求は開始時点の未払いの利用額を含める
+	statement = card.NewBillingStatement(testDate("2026-08-15"), []*PaymentMethodTransaction{
+		{Type: PaymentMethodTransactionExpense, ID: 3, Date: "2026-08-10", Amount: 3000},
+	})
+	assert.Equal(t, "2026-08-01", statement.From)
+	assert.Equal(t, 8000, statement.Amount)
+}
+
+func TestNewPaymentMethodAmounts(t *testing.T) {
+	bank, card := testPaymentMethods()
+	expenses := ShoppingAmounts{
+		{Amount: 3000, PaymentMethod: card},
+		{Amount: 500},
+		{Amount: 2000, PaymentMethod: card},
+		{Amount: 10000, PaymentMethod: bank},
+	}
+	incomes := []*Income{{Amount: 300000, PaymentMethod: bank}}
+
+	assert.Equal(t, []*PaymentMethodAmount{
+		{PaymentMethod: bank, Expense: 10000, Income: 300000},
+		{PaymentMethod: card, Expense: 5000},
+		{PaymentMethod: nil, Expense: 500},
+	}, NewPaymentMethodAmounts(expenses, incomes))
+}
@@ -7,6 +7,8 @@ type ReceiptAnalyze struct {
 	S3FilePath      string               `json:"receiptImageURL"`
 	HouseholdBookID HouseHoldID          `json:"householdID"`
 	Items           []ReceiptAnalyzeItem `json:"items"`
+	// Currency はレシートの通貨。TotalPrice と品目の金額はこの通貨の補助単位。空の場合は家計簿の基準通貨
+	Currency Currency `json:"currency,omitempty"`
 }
 
 type ReceiptAnalyzeReception struct {
@@ -17,8 +19,10 @@ type ReceiptAnalyzeReception struct {
 }
 
 type ReceiptAnalyzeItem struct {
+	ID    uint   `json:"id"`
 	Name  string `json:"name"`
 	Price uint   `json:"amount"`
+	Tags  []*Tag `json:"tags"`
 }
 
 type ReceiptAnalyzeRepository interface {
@@ -26,4 +30,6 @@ type ReceiptAnalyzeRepository interface {
 	CreateReceiptAnalyzeResult(receiptAnalyze *ReceiptAnalyze) error
 	FindReceiptAnalyzeByS3FilePath(s3FilePath string) (*ReceiptAnalyze, error)
 	FindByID(id HouseHoldID) (*ReceiptAnalyze, error)
+	// DeleteReceiptAnalyze はレシートをゴミ箱に移す。家計簿のレシートでない場合は gorm.ErrRecordNotFound を返す
+	DeleteReceiptAnalyze(houseHoldID HouseHoldID, id uint, deletion *Deletion) error
 }
@@ -0,0 +1,251 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainmodel
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// RecurringDateLayout は定期取引で扱う日付の表記
+const RecurringDateLayout = "2006-01-02"
+
+type RecurringTransactionID uint
+
+// RecurrenceFrequency は定期取引の繰り返し単位
+type RecurrenceFrequency string
+
+const (
+	// RecurrenceMonthly は Interval か月ごとの DayOfMonth 日
+	RecurrenceMonthly RecurrenceFrequency = "monthly"
+	// RecurrenceYearly は Interval 年ごとの開始日と同じ月日
+	RecurrenceYearly RecurrenceFrequency = "yearly"
+	// RecurrenceWeekly は開始日から Interval 週ごと
+	RecurrenceWeekly RecurrenceFrequency = "weekly"
+)
+
+// RecurringTransaction は家賃や光熱費、サブスクリプションなどの定期的な支出の定義
+type RecurringTransaction struct {
+	ID          RecurringTransactionID `json:"id"`
+	HouseHoldID HouseHoldID            `json:"houseHoldID"`
+	CategoryID  CategoryID             `json:"categoryID"`
+	Amount      int                    `json:"amount"`
+	Memo        string                 `json:"memo"`
+	Frequency   RecurrenceFrequency    `json:"frequency"`
+	Interval    int                    `json:"interval"`
+	// DayOfMonth は毎月の支払日。月末より大きい場合はその月の末日とする（monthly のみ）
+	DayOfMonth int     `json:"dayOfMonth"`
+	StartDate  string  `json:"startDate"`
+	EndDate    *string `json:"endDate"`
+	// MaterializedUntil は支出として登録済みの日付。この日までの発生日は処理済みとして扱う
+	MaterializedUntil *string `json:"materializedUntil"`
+}
+
+var (
+	ErrInvalidRecurringAmount     = errors.New("recurring transaction amount must be greater than 0")
+	ErrInvalidRecurrenceFrequency = errors.New("recurrence frequency must be monthly, yearly or weekly")
+	ErrInvalidRecurrenceInterval  = errors.New("recurrence interval must be 1 or greater")
+	ErrInvalidRecurrenceDay       = errors.New("day of month must be between 1 and 31")
+	ErrInvalidRecurringDate       = errors.New("recurring transaction date must be in YYYY-MM-DD format")
+	ErrInvalidRecurringEndDate    = errors.New("end date must not be before start date")
+	ErrNotRecurringOccurrence     = errors.New("date is not an occurrence of the recurring transaction")
+	ErrRecurringOccurrencePassed  = errors.New("occurrence has already been materialized")
+)
+
+// Validate は定期取引を検証する
+func (r *RecurringTransaction) Validate() error {
+	if r.Amount <= 0 {
+		return ErrInvalidRecurringAmount
+	}
+	switch r.Frequency {
+	case RecurrenceMonthly:
+		if r.DayOfMonth < 1 || r.DayOfMonth > 31 {
+			return ErrInvalidRecurrenceDay
+		}
+	case RecurrenceYearly, RecurrenceWeekly:
+	default:
+		return ErrInvalidRecurrenceFrequency
+	}
+	if r.Interval < 1 {
+		return ErrInvalidRecurrenceInterval
+	}
+	start, err := ParseRecurringDate(r.StartDate)
+	if err != nil {
+		return err
+	}
+	if r.EndDate != nil {
+		end, err := ParseRecurringDate(*r.EndDate)
+		if err != nil {
+			return err
+		}
+		if end.Before(start) {
+			return ErrInvalidRecurringEndDate
+		}
+	}
+	return nil
+}
+
+// ParseRecurringDate は YYYY-MM-DD 形式の日付を解析する
+func ParseRecurringDate(date string) (time.Time, error) {
+	t, err := time.Parse(RecurringDateLayout, date)
+	if err != nil {
+		return time.Time{}, ErrInvalidRecurringDate
+	}
+	return t, nil
+}
+
+// Occurrences は from から to まで（両端を含む）の発生日を昇順で返す。定義は検証済みであること
+func (r *RecurringTransaction) Occurrences(from time.Time, to time.Time) []time.Time {
+	start, _ := ParseRecurringDate(r.StartDate)
+	if r.EndDate != nil {
+		end, _ := ParseRecurringDate(*r.EndDate)
+		if end.Before(to) {
+			to = end
+		}
+	}
+
+	occurrences := []time.Time{}
+	if r.Interval < 1 {
+		return occurrences
+	}
+	for k := 0; ; k++ {
+		date := r.nthOccurrence(start, k)
+		if date.After(to) {
+			break
+		}
+		if date.Before(start) || date.Before(from) {
+			continue
+		}
+		occurrences = append(occurrences, date)
+	}
+	return occurrences
+}
+
+// IsOccurrence は指定日が発生日かどうかを返す
+func (r *RecurringTransaction) IsOccurrence(date time.Time) bool {
+	return len(r.Occurrences(date, date)) == 1
+}
+
+// PendingOccurrences は未登録の発生日のうち、until までのものを返す
+func (r *RecurringTransaction) PendingOccurrences(until time.Time) []time.Time {
+	from, _ := ParseRecurringDate(r.StartDate)
+	if r.MaterializedUntil != nil {
+		materializedUntil, _ := ParseRecurringDate(*r.MaterializedUntil)
+		from = materializedUntil.AddDate(0, 0, 1)
+	}
+	return r.Occurrences(from, until)
+}
+
+// IsMaterialized は指定日の発生分が登録済みかどうかを返す
+func (r *RecurringTransaction) IsMaterialized(date time.Time) bool {
+	if r.MaterializedUntil == nil {
+		return false
+	}
+	materializedUntil, _ := ParseRecurringDate(*r.MaterializedUntil)
+	return !date.After(materializedUntil)
+}
+
+// nthOccurrence は開始日から数えて k 回目の発生日を返す。月末を超える日付はその月の末日に丸める
+func (r *RecurringTransaction) nthOccurrence(start time.Time, k int) time.Time {
+	switch r.Frequency {
+	case RecurrenceWeekly:
+		return start.AddDate(0, 0, 7*r.Interval*k)
+	case RecurrenceYearly:
+		return dateInMonth(start.Year()+r.Interval*k, start.Month(), start.Day())
+	default:
+		return dateInMonth(start.Year(), start.Month()+time.Month(r.Interval*k), r.DayOfMonth)
+	}
+}
+
+// dateInMonth は指定月の day 日を返す。月末を超える場合はその月の末日を返す
+func dateInMonth(year int, month time.Month, day int) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	lastDay := first.AddDate(0, 1, -1).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+// RecurringOccurrenceOverride は定期取引の特定の発生日に対するスキップ・変更
+type RecurringOccurrenceOverride struct {
+	RecurringTransactionID RecurringTransactionID `json:"recurringTransactionID"`
+	Date                   string                 `json:"date"`
+	Skipped                bool                   `json:"skipped"`
+	// Amount, Memo は変更後の値。nil の場合は定義の値を用いる
+	Amount *int    `json:"amount"`
+	Memo   *string `json:"memo"`
+}
+
+// RecurringOccurrence は定期取引の発生予定
+type RecurringOccurrence struct {
+	RecurringTransactionID RecurringTransactionID `json:"recurringTransactionID"`
+	CategoryID             CategoryID             `json:"categoryID"`
+	Date                   string                 `json:"date"`
+	Amount                 int                    `json:"amount"`
+	Memo                   string                 `json:"memo"`
+	Skipped                bool                   `json:"skipped"`
+	Adjusted               bool                   `json:"adjusted"`
+}
+
+// NewRecurringOccurrence は発生日の変更を反映した発生予定を返す
+func NewRecurringOccurrence(r *RecurringTransaction, date time.Time, override *RecurringOccurrenceOverride) *RecurringOccurrence {
+	occurrence := &RecurringOccurrence{
+		RecurringTransactionID: r.ID,
+		CategoryID:             r.CategoryID,
+		Date:                   date.Format(RecurringDateLayout),
+		Amount:                 r.Amount,
+		Memo:                   r.Memo,
+	}
+	if override == nil {
+		return occurrence
+	}
+	occurrence.Skipped = override.Skipped
+	if override.Amount != nil {
+		occurrence.Amount = *override.Amount
+		occurrence.Adjusted = true
+	}
+	if override.Memo != nil {
+		occurrence.Memo = *override.Memo
+		occurrence.Adjusted = true
+	}
+	return occurrence
+}
+
+// ToShoppingAmount は発生予定を支出に変換する
+func (o *RecurringOccurrence) ToShoppingAmount(houseHoldID HouseHoldID) *ShoppingAmount {
+	return NewShoppingAmount(houseHoldID, o.CategoryID, o.Amount, o.Date, o.Memo, 0)
+}
+
+// RecurringOccurrences は発生予定の一覧
+type RecurringOccurrences []*RecurringOccurrence
+
+// Sort は発生予定を日付順に並べる
+func (o RecurringOccurrences) Sort() {
+	sort.SliceStable(o, func(i, j int) bool {
+		if o[i].Date == o[j].Date {
+			return o[i].RecurringTransactionID < o[j].RecurringTransactionID
+		}
+		return o[i].Date < o[j].Date
+	})
+}
+
+// RecurringTransactionRepository は定期取引の永続化を担うリポジトリのインターフェース
+type RecurringTransactionRepository interface {
+	FindByHouseHoldID(houseHoldID HouseHoldID) ([]*RecurringTransaction, error)
+	// FindByID は家計簿の定期取引を取得します。存在しない場合は nil を返します
+	FindByID(houseHoldID HouseHoldID, id RecurringTransactionID) (*RecurringTransaction, error)
+	Create(recurringTransaction *RecurringTransaction) error
+	Update(recurringTransaction *RecurringTransaction) error
+	Delete(houseHoldID HouseHoldID, id RecurringTransactionID) error
+	// FindDue は指定日までに未登録の発生日がある可能性のある定期取引を取得します
+	FindDue(today string) ([]*RecurringTransaction, error)
+	// AdvanceMaterializedUntil は登録済みの日付を from から until に進めます
+	// 他のプロセスが先に進めていた場合は false を返します
+	AdvanceMaterializedUntil(id RecurringTransactionID, from *string, until string) (bool, error)
+
+	// FindOverrides は定期取引の from から to までの発生日に対する変更を取得します
+	FindOverrides(ids []RecurringTransactionID, from string, to string) ([]*RecurringOccurrenceOverride, error)
+	// SaveOverride は発生日に対する変更を登録し、既に登録済みの場合は更新します
+	SaveOverride(override *RecurringOccurrenceOverride) error
+}
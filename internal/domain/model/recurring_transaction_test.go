@@ -0,0 +1,140 @@
+package domainmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testDate(s string) time.Time {
+	t, _ := ParseRecurringDate(s)
+	return t
+}
+
+func formatTestDates(times []time.Time) []string {
+	output := []string{}
+	for _, t := range times {
+		output = append(output, t.Format(RecurringDateLayout))
+	}
+	return output
+}
+
+func TestRecurringTransaction_Validate(t *testing.T) {
+	endDate := "2026-09-30"
+	tests := []struct {
+		name    string
+		r       RecurringTransaction
+		wantErr error
+	}{
+		{name: "毎月", r: RecurringTransaction{Amount: 80000, Frequency: RecurrenceMonthly, Interval: 1, DayOfMonth: 27, StartDate: "2026-10-01"}},
+		{name: "毎週", r: RecurringTransaction{Amount: 1000, Frequency: RecurrenceWeekly, Interval: 2, StartDate: "2026-10-01"}},
+		{name: "金額が0", r: RecurringTransaction{Amount: 0, Frequency: RecurrenceYearly, Interval: 1, StartDate: "2026-10-01"}, wantErr: ErrInvalidRecurringAmount},
+		{name: "繰り返し単位が不正", r: RecurringTransaction{Amount: 1000, Frequency: "daily", Interval: 1, StartDate: "2026-10-01"}, wantErr: ErrInvalidRecurrenceFrequency},
+		{name: "支払日が不正", r: RecurringTransaction{Amount: 1000, Frequency: RecurrenceMonthly, Interval: 1, DayOfMonth: 32, StartDate: "2026-10-01"}, wantErr: ErrInvalidRecurrenceDay},
+		{name: "間隔が0", r: RecurringTransaction{Amount: 1000, Frequency: RecurrenceWeekly, Interval: 0, StartDate: "2026-10-01"}, wantErr: ErrInvalidRecurrenceInterval},
+		{name: "開始日の形式が不正", r: RecurringTransaction{Amount: 1000, Frequency: RecurrenceWeekly, Interval: 1, StartDate: "2026/10/01"}, wantErr: ErrInvalidRecurringDate},
+		{name: "終了日が開始日より前", r: RecurringTransaction{Amount: 1000, Frequency: RecurrenceWeekly, Interval: 1, StartDate: "2026-10-01", EndDate: &endDate}, wantErr: ErrInvalidRecurringEndDate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, tt.r.Validate())
+		})
+	}
+}
+
+func TestRecurringTransaction_Occurrences(t *testing.T) {
+	endDate := "2026-12-31"
+	tests := []struct {
+		name     string
+		r        RecurringTransaction
+		from     string
+		to       string
+		expected []string
+	}{
+		{
+			name:     "毎月27日",
+			r:        RecurringTransaction{Frequency: RecurrenceMonthly, Interval: 1, DayOfMonth: 27, StartDate: "2026-10-01"},
+			from:     "2026-10-01",
+			to:       "2026-12-31",
+			expected: []string{"2026-10-27", "2026-11-27", "2026-12-27"},
+		},
+		{
+			name:     "開始日より前の支払日は含めない",
+			r:        RecurringTransaction{Frequency: RecurrenceMonthly, Interval: 1, DayOfMonth: 5, StartDate: "2026-10-18"},
+			from:     "2026-10-01",
+			to:       "2026-12-31",
+			expected: []string{"2026-11-05", "2026-12-05"},
+		},
+		{
+			name:     "月末を超える支払日はその月の末日",
+			r:        RecurringTransaction{Frequency: RecurrenceMonthly, Interval: 1, DayOfMonth: 31, StartDate: "2027-01-01"},
+			from:     "2027-01-01",
+			to:       "2027-04-30",
+			expected: []string{"2027-01-31", "2027-02-28", "2027-03-31", "2027-04-30"},
+		},
+		{
+			name:     "2か月ごと",
+			r:        RecurringTransaction{Frequency: RecurrenceMonthly, Interval: 2, DayOfMonth: 10, StartDate: "2026-10-01"},
+			from:     "2026-10-01",
+			to:       "2027-03-31",
+			expected: []string{"2026-10-10", "2026-12-10", "2027-02-10"},
+		},
+		{
+			name:     "毎年（うるう日は末日）",
+			r:        RecurringTransaction{Frequency: RecurrenceYearly, Interval: 1, StartDate: "2028-02-29"},
+			from:     "2028-01-01",
+			to:       "2030-12-31",
+			expected: []string{"2028-02-29", "2029-02-28", "2030-02-28"},
+		},
+		{
+			name:     "2週ごと",
+			r:        RecurringTransaction{Frequency: RecurrenceWeekly, Interval: 2, StartDate: "2026-10-02"},
+			from:     "2026-10-10",
+			to:       "2026-11-15",
+			expected: []string{"2026-10-16", "2026-10-30", "2026-11-13"},
+		},
+		{
+			name:     "終了日以降は含めない",
+			r:        RecurringTransaction{Frequency: RecurrenceMonthly, Interval: 1, DayOfMonth: 27, StartDate: "2026-10-01", EndDate: &endDate},
+			from:     "2026-10-01",
+			to:       "2027-03-31",
+			expected: []string{"2026-10-27", "2026-11-27", "2026-12-27"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, formatTestDates(tt.r.Occurrences(testDate(tt.from), testDate(tt.to))))
+		})
+	}
+}
+
+func TestRecurringTransaction_PendingOccurrences(t *testing.T) {
+	materializedUntil := "2026-11-27"
+	r := RecurringTransaction{Frequency: RecurrenceMonthly, Interval: 1, DayOfMonth: 27, StartDate: "2026-10-01", MaterializedUntil: &materializedUntil}
+
+	assert.Equal(t, []string{"2026-12-27", "2027-01-27"}, formatTestDates(r.PendingOccurrences(testDate("2027-01-31"))))
+	assert.True(t, r.IsMaterialized(testDate("2026-11-27")))
+	assert.False(t, r.IsMaterialized(testDate("2026-12-27")))
+	assert.True(t, r.IsOccurrence(testDate("2026-12-27")))
+	assert.False(t, r.IsOccurrence(testDate("2026-12-28")))
+}
+
+func TestNewRecurringOccurrence(t *testing.T) {
+	r := &RecurringTransaction{ID: 1, CategoryID: 2, Amount: 1490, Memo: "動画配信"}
+
+	occurrence := NewRecurringOccurrence(r, testDate("2026-10-27"), nil)
+	assert.Equal(t, &RecurringOccurrence{RecurringTransactionID: 1, CategoryID: 2, Date: "2026-10-27", Amount: 1490, Memo: "動画配信"}, occurrence)
+
+	amount := 1990
+	occurrence = NewRecurringOccurrence(r, testDate("2026-11-27"), &RecurringOccurrenceOverride{Amount: &amount})
+	assert.Equal(t, 1990, occurrence.Amount)
+	assert.Equal(t, "動画配信", occurrence.Memo)
+	assert.True(t, occurrence.Adjusted)
+
+	occurrence = NewRecurringOccurrence(r, testDate("2026-12-27"), &RecurringOccurrenceOverride{Skipped: true})
+	assert.True(t, occurrence.Skipped)
+	assert.False(t, occurrence.Adjusted)
+}
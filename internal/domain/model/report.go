@@ -0,0 +1,215 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainmodel
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+const (
+	DefaultTrendMonths = 12
+	MaxTrendMonths     = 60
+	DefaultTrendWindow = 3
+	MaxTrendWindow     = 12
+)
+
+var (
+	ErrInvalidTrendPeriod = errors.New("trend period must be from <= to in YYYY-MM format and within 60 months")
+	ErrInvalidTrendWindow = errors.New("trend window must be 1 to 12 months")
+)
+
+// TrendCondition は月ごとの推移の集計条件
+type TrendCondition struct {
+	HouseHoldID HouseHoldID
+	// From, To は集計する月（両端を含む）。YYYY-MM 形式
+	From        string
+	To          string
+	CategoryIDs []CategoryID
+	// Window は移動平均を求める月数
+	Window int
+}
+
+// NewTrendCondition は指定日時の月までの12か月を、3か月の移動平均で集計する条件を作成する
+func NewTrendCondition(houseHoldID HouseHoldID, now time.Time) *TrendCondition {
+	to := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return &TrendCondition{
+		HouseHoldID: houseHoldID,
+		From:        BudgetMonthOf(to.AddDate(0, -(DefaultTrendMonths - 1), 0)),
+		To:          BudgetMonthOf(to),
+		Window:      DefaultTrendWindow,
+	}
+}
+
+// Validate は集計条件を検証する
+func (c *TrendCondition) Validate() error {
+	from, err := ParseBudgetMonth(c.From)
+	if err != nil {
+		return ErrInvalidTrendPeriod
+	}
+	to, err := ParseBudgetMonth(c.To)
+	if err != nil {
+		return ErrInvalidTrendPeriod
+	}
+	if from.After(to) || !from.AddDate(0, MaxTrendMonths, 0).After(to) {
+		return ErrInvalidTrendPeriod
+	}
+	if c.Window < 1 || c.Window > MaxTrendWindow {
+		return ErrInvalidTrendWindow
+	}
+	return nil
+}
+
+// Months は集計する月を昇順で返す。条件は検証済みであること
+func (c *TrendCondition) Months() []string {
+	months := []string{}
+	for month := c.From; month <= c.To; month = nextBudgetMonth(month) {
+		months = append(months, month)
+	}
+	return months
+}
+
+// SeriesFrom は前年同月比と移動平均を求めるために集計を始める月を返す。条件は検証済みであること
+func (c *TrendCondition) SeriesFrom() string {
+	from, _ := ParseBudgetMonth(c.From)
+	return BudgetMonthOf(from.AddDate(0, -12, 0))
+}
+
+// CategoryMonthlyTrend はカテゴリごとの月間支出と、前月・前年同月からの増減、移動平均の集計結果
+type CategoryMonthlyTrend struct {
+	Month          string
+	Category       Category
+	Amount         int
+	MonthOverMonth int
+	YearOverYear   int
+	RollingAverage int
+}
+
+// TrendPoint は月ごとの支出と予算
+type TrendPoint struct {
+	Month  string `json:"month"`
+	Amount int    `json:"amount"`
+	// MonthOverMonth は前月からの増減、YearOverYear は前年同月からの増減
+	MonthOverMonth int `json:"monthOverMonth"`
+	YearOverYear   int `json:"yearOverYear"`
+	// RollingAverage はその月までの移動平均（円未満は四捨五入）
+	RollingAverage int `json:"rollingAverage"`
+	Budget         int `json:"budget"`
+	CarriedOver    int `json:"carriedOver"`
+}
+
+// CategoryTrend はカテゴリごとの月ごとの推移
+type CategoryTrend struct {
+	Category Category      `json:"category"`
+	Points   []*TrendPoint `json:"points"`
+}
+
+// TrendReport は期間内の月ごとの推移
+type TrendReport struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Window int    `json:"window"`
+	// Totals は集計したカテゴリの合計の推移
+	Totals     []*TrendPoint    `json:"totals"`
+	Categories []*CategoryTrend `json:"categories"`
+}
+
+// NewTrendReport はカテゴリごとの集計結果と予算の履歴から推移を作成する
+// 表示には家計簿ごとのカテゴリ設定を用い、カテゴリの並び順で返す（家計簿に設定のないカテゴリはIDの順で後ろに並べる）
+func NewTrendReport(condition *TrendCondition, categories []*CategoryLimit, trends []*CategoryMonthlyTrend, history *BudgetHistory) *TrendReport {
+	months := condition.Months()
+	budgets := make(map[string]map[CategoryID]*CategoryBudget, len(months))
+	for _, month := range months {
+		budgets[month] = make(map[CategoryID]*CategoryBudget, len(categories))
+		for _, budget := range history.Summarize(categories, month) {
+			budgets[month][budget.Category.ID] = budget
+		}
+	}
+
+	order := make(map[CategoryID]int, len(categories))
+	houseHoldCategories := make(map[CategoryID]Category, len(categories))
+	for i, category := range categories {
+		order[category.Category.ID] = i
+		houseHoldCategories[category.Category.ID] = category.Category
+	}
+
+	categoryTrends := map[CategoryID]*CategoryTrend{}
+	for _, trend := range trends {
+		categoryTrend, ok := categoryTrends[trend.Category.ID]
+		if !ok {
+			category := trend.Category
+			if houseHoldCategory, ok := houseHoldCategories[category.ID]; ok {
+				category = houseHoldCategory
+			}
+			categoryTrend = &CategoryTrend{Category: category, Points: []*TrendPoint{}}
+			categoryTrends[trend.Category.ID] = categoryTrend
+		}
+
+		point := &TrendPoint{
+			Month:          trend.Month,
+			Amount:         trend.Amount,
+			MonthOverMonth: trend.MonthOverMonth,
+			YearOverYear:   trend.YearOverYear,
+			RollingAverage: trend.RollingAverage,
+		}
+		if budget, ok := budgets[trend.Month][trend.Category.ID]; ok {
+			point.Budget = budget.Budget
+			point.CarriedOver = budget.CarriedOver
+		}
+		categoryTrend.Points = append(categoryTrend.Points, point)
+	}
+
+	report := &TrendReport{
+		From:       condition.From,
+		To:         condition.To,
+		Window:     condition.Window,
+		Totals:     make([]*TrendPoint, len(months)),
+		Categories: []*CategoryTrend{},
+	}
+	for _, categoryTrend := range categoryTrends {
+		sort.Slice(categoryTrend.Points, func(i, j int) bool { return categoryTrend.Points[i].Month < categoryTrend.Points[j].Month })
+		report.Categories = append(report.Categories, categoryTrend)
+	}
+	sort.Slice(report.Categories, func(i, j int) bool {
+		iOrder, iOK := order[report.Categories[i].Category.ID]
+		jOrder, jOK := order[report.Categories[j].Category.ID]
+		if iOK != jOK {
+			return iOK
+		}
+		if iOK && iOrder != jOrder {
+			return iOrder < jOrder
+		}
+		return report.Categories[i].Category.ID < report.Categories[j].Category.ID
+	})
+
+	totals := make(map[string]*TrendPoint, len(months))
+	for i, month := range months {
+		report.Totals[i] = &TrendPoint{Month: month}
+		totals[month] = report.Totals[i]
+	}
+	for _, categoryTrend := range report.Categories {
+		for _, point := range categoryTrend.Points {
+			total, ok := totals[point.Month]
+			if !ok {
+				continue
+			}
+			total.Amount += point.Amount
+			total.MonthOverMonth += point.MonthOverMonth
+			total.YearOverYear += point.YearOverYear
+			total.RollingAverage += point.RollingAverage
+			total.Budget += point.Budget
+			total.CarriedOver += point.CarriedOver
+		}
+	}
+
+	return report
+}
+
+// ReportRepository は集計レポートを担うリポジトリのインターフェース
+type ReportRepository interface {
+	// SummarizeCategoryTrends は期間内の月・カテゴリごとの支出と、前月・前年同月からの増減、移動平均を集計します
+	// 支出のない月も0円として、家計簿の有効なカテゴリと期間内に支出のあるカテゴリの月をすべて返します
+	SummarizeCategoryTrends(condition *TrendCondition) ([]*CategoryMonthlyTrend, error)
+	// SummarizeDayOfMonthSplits は fromMonth から toMonth まで（両端を含む）の月・カテゴリごとの支出を、day 日までとその翌日以降に分けて集計します
+	SummarizeDayOfMonthSplits(houseHoldID HouseHoldID, fromMonth string, toMonth string, day int) ([]*DayOfMonthSplit, error)
+}
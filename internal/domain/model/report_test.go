@@ -0,0 +1,83 @@
+package domainmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrendCondition_Validate(t *testing.T) {
+	condition := NewTrendCondition(10, time.Date(2026, 10, 18, 0, 0, 0, 0, time.Local))
+	assert.Equal(t, "2025-11", condition.From)
+	assert.Equal(t, "2026-10", condition.To)
+	assert.NoError(t, condition.Validate())
+	assert.Len(t, condition.Months(), 12)
+	assert.Equal(t, "2024-11", condition.SeriesFrom())
+
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		window  int
+		wantErr error
+	}{
+		{name: "1か月", from: "2026-10", to: "2026-10", window: 1, wantErr: nil},
+		{name: "60か月", from: "2022-01", to: "2026-12", window: 12, wantErr: nil},
+		{name: "61か月", from: "2021-12", to: "2026-12", window: 3, wantErr: ErrInvalidTrendPeriod},
+		{name: "開始月が終了月より後", from: "2026-11", to: "2026-10", window: 3, wantErr: ErrInvalidTrendPeriod},
+		{name: "月の形式が不正", from: "2026-10-01", to: "2026-10", window: 3, wantErr: ErrInvalidTrendPeriod},
+		{name: "移動平均の月数が0", from: "2026-01", to: "2026-10", window: 0, wantErr: ErrInvalidTrendWindow},
+		{name: "移動平均の月数が13", from: "2026-01", to: "2026-10", window: 13, wantErr: ErrInvalidTrendWindow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition := &TrendCondition{HouseHoldID: 10, From: tt.from, To: tt.to, Window: tt.window}
+			assert.Equal(t, tt.wantErr, condition.Validate())
+		})
+	}
+}
+
+func TestNewTrendReport(t *testing.T) {
+	condition := &TrendCondition{HouseHoldID: 10, From: "2026-09", To: "2026-10", Window: 2}
+	categories := []*CategoryLimit{
+		{Category: Category{ID: 2, Name: "日用品"}, LimitAmount: 5000, SortOrder: 0},
+		{Category: Category{ID: 1, Name: "食料品"}, LimitAmount: 30000, SortOrder: 1},
+	}
+	trends := []*CategoryMonthlyTrend{
+		{Month: "2026-09", Category: Category{ID: 1, Name: "食費"}, Amount: 28000, MonthOverMonth: -2000, YearOverYear: 3000, RollingAverage: 29000},
+		{Month: "2026-10", Category: Category{ID: 1, Name: "食費"}, Amount: 32000, MonthOverMonth: 4000, YearOverYear: 1000, RollingAverage: 30000},
+		{Month: "2026-09", Category: Category{ID: 2, Name: "日用品"}, Amount: 4000, MonthOverMonth: 0, YearOverYear: -500, RollingAverage: 4000},
+		{Month: "2026-10", Category: Category{ID: 2, Name: "日用品"}, Amount: 0, MonthOverMonth: -4000, YearOverYear: 0, RollingAverage: 2000},
+		{Month: "2026-09", Category: Category{ID: 9, Name: "旧カテゴリ"}, Amount: 1000, MonthOverMonth: 1000, YearOverYear: 1000, RollingAverage: 500},
+		{Month: "2026-10", Category: Category{ID: 9, Name: "旧カテゴリ"}, Amount: 0, MonthOverMonth: -1000, YearOverYear: 0, RollingAverage: 500},
+	}
+	// 食料品は9月から繰り越しありの予算 30000 円
+	history := NewBudgetHistory(
+		[]*MonthlyBudget{{CategoryID: 1, Month: "2026-09", Amount: 30000, Rollover: true}},
+		[]*MonthlyCategoryAmount{
+			{Month: "2026-09", CategoryID: 1, Amount: 28000},
+			{Month: "2026-10", CategoryID: 1, Amount: 32000},
+		},
+	)
+
+	report := NewTrendReport(condition, categories, trends, history)
+
+	assert.Equal(t, "2026-09", report.From)
+	assert.Equal(t, 2, report.Window)
+	// 家計簿のカテゴリの並び順で、設定のないカテゴリは後ろに並ぶ
+	assert.Len(t, report.Categories, 3)
+	assert.Equal(t, "日用品", report.Categories[0].Category.Name)
+	assert.Equal(t, "食料品", report.Categories[1].Category.Name)
+	assert.Equal(t, "旧カテゴリ", report.Categories[2].Category.Name)
+
+	assert.Equal(t, &TrendPoint{Month: "2026-10", Amount: 32000, MonthOverMonth: 4000, YearOverYear: 1000, RollingAverage: 30000, Budget: 30000, CarriedOver: 2000}, report.Categories[1].Points[1])
+	assert.Equal(t, &TrendPoint{Month: "2026-09", Amount: 4000, MonthOverMonth: 0, YearOverYear: -500, RollingAverage: 4000, Budget: 5000}, report.Categories[0].Points[0])
+	assert.Equal(t, 0, report.Categories[2].Points[0].Budget)
+
+	assert.Equal(t, []*TrendPoint{
+		{Month: "2026-09", Amount: 33000, MonthOverMonth: -1000, YearOverYear: 3500, RollingAverage: 33500, Budget: 35000},
+		{Month: "2026-10", Amount: 32000, MonthOverMonth: -1000, YearOverYear: 1000, RollingAverage: 32500, Budget: 35000, CarriedOver: 2000},
+	}, report.Totals)
+}
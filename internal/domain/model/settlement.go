@@ -0,0 +1,325 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainmodel
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// SplitType は支出をメンバー間で負担する方法
+type SplitType string
+
+const (
+	// SplitEqual は参加者で均等に負担する。参加者を指定しない場合は登録時点の家計簿の全メンバーを参加者として保存する
+	SplitEqual SplitType = "equal"
+	// SplitPercentage は参加者ごとの割合（%）で負担する
+	SplitPercentage SplitType = "percentage"
+	// SplitFixed は参加者ごとの金額で負担する
+	SplitFixed SplitType = "fixed"
+	// SplitPersonal は支払ったメンバー個人の支出として扱い、精算の対象にしない
+	SplitPersonal SplitType = "personal"
+)
+
+// ExpenseShare は支出の負担者と負担の値（割合または金額）
+type ExpenseShare struct {
+	UserID UserID `json:"user_id"`
+	// Value は percentage の場合は割合（%）、fixed の場合は金額。equal の場合は使用しない
+	Value int `json:"value"`
+}
+
+var (
+	ErrInvalidSplitType       = errors.New("split type must be equal, percentage, fixed or personal")
+	ErrSplitWithoutPayer      = errors.New("paying member is required to split the expense")
+	ErrSplitSharesRequired    = errors.New("shares are required for percentage and fixed split")
+	ErrDuplicateSplitShare    = errors.New("shares must not contain the same member twice")
+	ErrInvalidSplitPercentage = errors.New("split percentages must be 0 or greater and add up to 100")
+	ErrInvalidSplitFixed      = errors.New("split amounts must be 0 or greater and add up to the expense amount")
+	ErrNotSplitMember         = errors.New("payer and shares must be household members")
+)
+
+// ValidateSplit は支払ったメンバーと負担方法を検証する
+func (s *ShoppingAmount) ValidateSplit(members []UserID) error {
+	switch s.SplitType {
+	case SplitEqual, SplitPercentage, SplitFixed, SplitPersonal:
+	default:
+		return ErrInvalidSplitType
+	}
+	if s.PaidBy == nil {
+		if len(s.Shares) > 0 {
+			return ErrSplitWithoutPayer
+		}
+		return nil
+	}
+
+	memberSet := make(map[UserID]bool, len(members))
+	for _, member := range members {
+		memberSet[member] = true
+	}
+	if !memberSet[*s.PaidBy] {
+		return ErrNotSplitMember
+	}
+
+	seen := make(map[UserID]bool, len(s.Shares))
+	total := 0
+	for _, share := range s.Shares {
+		if !memberSet[share.UserID] {
+			return ErrNotSplitMember
+		}
+		if seen[share.UserID] {
+			return ErrDuplicateSplitShare
+		}
+		seen[share.UserID] = true
+		total += share.Value
+	}
+
+	switch s.SplitType {
+	case SplitPercentage:
+		if len(s.Shares) == 0 {
+			return ErrSplitSharesRequired
+		}
+		for _, share := range s.Shares {
+			if share.Value < 0 {
+				return ErrInvalidSplitPercentage
+			}
+		}
+		if total != 100 {
+			return ErrInvalidSplitPercentage
+		}
+	case SplitFixed:
+		if len(s.Shares) == 0 {
+			return ErrSplitSharesRequired
+		}
+		for _, share := range s.Shares {
+			if share.Value < 0 {
+				return ErrInvalidSplitFixed
+			}
+		}
+		if total != s.Amount {
+			return ErrInvalidSplitFixed
+		}
+	}
+	return nil
+}
+
+// FillEqualSplitParticipants は参加者を指定しない均等の負担に、登録時点のメンバーを参加者として設定する
+// 後からメンバーが加入・脱退しても、過去の支出の負担者が変わらないようにするため
+func (s *ShoppingAmount) FillEqualSplitParticipants(members []UserID) {
+	if s.PaidBy == nil || s.SplitType != SplitEqual || len(s.Shares) > 0 {
+		return
+	}
+	s.Shares = make([]ExpenseShare, len(members))
+	for i, member := range members {
+		s.Shares[i] = ExpenseShare{UserID: member}
+	}
+}
+
+// OwedShares は保存した負担からメンバーごとの負担額を返す。精算の対象外の支出は nil を返す
+// 割り切れない端数は、ユーザーIDの小さい参加者から1円ずつ負担する
+// 参加者を保存していない均等の負担は、支払ったメンバーのみの負担とする
+func (s *ShoppingAmount) OwedShares() map[UserID]int {
+	if s.PaidBy == nil || s.SplitType == SplitPersonal {
+		return nil
+	}
+
+	owed := make(map[UserID]int)
+	switch s.SplitType {
+	case SplitFixed:
+		for _, share := range s.Shares {
+			owed[share.UserID] += share.Value
+		}
+	case SplitPercentage:
+		weights := make(map[UserID]int, len(s.Shares))
+		for _, share := range s.Shares {
+			weights[share.UserID] = share.Value
+		}
+		distribute(owed, s.Amount, weights, 100)
+	default:
+		participants := []UserID{*s.PaidBy}
+		if len(s.Shares) > 0 {
+			participants = make([]UserID, len(s.Shares))
+			for i, share := range s.Shares {
+				participants[i] = share.UserID
+			}
+		}
+		weights := make(map[UserID]int, len(participants))
+		for _, participant := range participants {
+			weights[participant] = 1
+		}
+		distribute(owed, s.Amount, weights, len(participants))
+	}
+	return owed
+}
+
+// distribute は amount を weights の比率で配分する。端数はユーザーIDの小さい順に1円ずつ配分する
+func distribute(owed map[UserID]int, amount int, weights map[UserID]int, totalWeight int) {
+	if totalWeight == 0 {
+		return
+	}
+	userIDs := make([]UserID, 0, len(weights))
+	for userID := range weights {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+
+	remainder := amount
+	for _, userID := range userIDs {
+		share := amount * weights[userID] / totalWeight
+		owed[userID] += share
+		remainder -= share
+	}
+	for i := 0; remainder > 0 && len(userIDs) > 0; i = (i + 1) % len(userIDs) {
+		if weights[userIDs[i]] == 0 {
+			continue
+		}
+		owed[userIDs[i]]++
+		remainder--
+	}
+}
+
+type SettlementID uint
+
+// Settlement はメンバー間の精算の支払い
+type Settlement struct {
+	ID          SettlementID `json:"id"`
+	HouseHoldID HouseHoldID  `json:"houseHoldID"`
+	FromUserID  UserID       `json:"fromUserID"`
+	ToUserID    UserID       `json:"toUserID"`
+	Amount      int          `json:"amount"`
+	Date        string       `json:"date"`
+	Memo        string       `json:"memo"`
+}
+
+var (
+	ErrInvalidSettlementAmount = errors.New("settlement amount must be greater than 0")
+	ErrInvalidSettlementDate   = errors.New("settlement date must be in YYYY-MM-DD format")
+	ErrSettlementSameMember    = errors.New("settlement must be between different members")
+	ErrInvalidSettlementPeriod = errors.New("settlement period must be from <= to in YYYY-MM-DD format")
+)
+
+// Validate は精算の支払いを検証する
+func (s *Settlement) Validate() error {
+	if s.Amount <= 0 {
+		return ErrInvalidSettlementAmount
+	}
+	if _, err := time.Parse("2006-01-02", s.Date); err != nil {
+		return ErrInvalidSettlementDate
+	}
+	if s.FromUserID == s.ToUserID {
+		return ErrSettlementSameMember
+	}
+	return nil
+}
+
+// MemberBalance はメンバーごとの精算状況
+type MemberBalance struct {
+	UserID UserID `json:"userID"`
+	// Paid は立て替えた金額、Owed は負担すべき金額
+	Paid int `json:"paid"`
+	Owed int `json:"owed"`
+	// Sent は精算で支払った金額、Received は精算で受け取った金額
+	Sent     int `json:"sent"`
+	Received int `json:"received"`
+	// Net は受け取るべき金額。負の値の場合は支払うべき金額
+	Net int `json:"net"`
+}
+
+// SettlementTransfer は精算に必要な送金
+type SettlementTransfer struct {
+	FromUserID UserID `json:"fromUserID"`
+	ToUserID   UserID `json:"toUserID"`
+	Amount     int    `json:"amount"`
+}
+
+// SettleUpReport は期間内の支出と精算から算出したメンバー間の貸し借り
+type SettleUpReport struct {
+	From        string                `json:"from"`
+	To          string                `json:"to"`
+	Balances    []*MemberBalance      `json:"balances"`
+	Transfers   []*SettlementTransfer `json:"transfers"`
+	Settlements []*Settlement         `json:"settlements"`
+}
+
+// NewSettleUpReport は精算レポートを作成する
+// 送金は支払うべき金額の大きいメンバーから、受け取るべき金額の大きいメンバーへ順に割り当てる
+func NewSettleUpReport(from string, to string, members []UserID, expenses ShoppingAmounts, settlements []*Settlement) *SettleUpReport {
+	balances := make(map[UserID]*MemberBalance)
+	balance := func(userID UserID) *MemberBalance {
+		if _, ok := balances[userID]; !ok {
+			balances[userID] = &MemberBalance{UserID: userID}
+		}
+		return balances[userID]
+	}
+	for _, member := range members {
+		balance(member)
+	}
+
+	for _, expense := range expenses {
+		owed := expense.OwedShares()
+		if owed == nil {
+			continue
+		}
+		balance(*expense.PaidBy).Paid += expense.Amount
+		for userID, amount := range owed {
+			balance(userID).Owed += amount
+		}
+	}
+	for _, settlement := range settlements {
+		balance(settlement.FromUserID).Sent += settlement.Amount
+		balance(settlement.ToUserID).Received += settlement.Amount
+	}
+
+	report := &SettleUpReport{
+		From:        from,
+		To:          to,
+		Balances:    []*MemberBalance{},
+		Transfers:   []*SettlementTransfer{},
+		Settlements: settlements,
+	}
+	for _, b := range balances {
+		b.Net = b.Paid - b.Owed + b.Sent - b.Received
+		report.Balances = append(report.Balances, b)
+	}
+	sort.Slice(report.Balances, func(i, j int) bool { return report.Balances[i].UserID < report.Balances[j].UserID })
+
+	debtors := []*MemberBalance{}
+	creditors := []*MemberBalance{}
+	remaining := make(map[UserID]int, len(report.Balances))
+	for _, b := range report.Balances {
+		remaining[b.UserID] = b.Net
+		if b.Net < 0 {
+			debtors = append(debtors, b)
+		} else if b.Net > 0 {
+			creditors = append(creditors, b)
+		}
+	}
+	sort.SliceStable(debtors, func(i, j int) bool { return debtors[i].Net < debtors[j].Net })
+	sort.SliceStable(creditors, func(i, j int) bool { return creditors[i].Net > creditors[j].Net })
+
+	for i, j := 0, 0; i < len(debtors) && j < len(creditors); {
+		debtor, creditor := debtors[i].UserID, creditors[j].UserID
+		amount := -remaining[debtor]
+		if remaining[creditor] < amount {
+			amount = remaining[creditor]
+		}
+		report.Transfers = append(report.Transfers, &SettlementTransfer{FromUserID: debtor, ToUserID: creditor, Amount: amount})
+		remaining[debtor] += amount
+		remaining[creditor] -= amount
+		if remaining[debtor] == 0 {
+			i++
+		}
+		if remaining[creditor] == 0 {
+			j++
+		}
+	}
+
+	return report
+}
+
+// SettlementRepository は精算の支払いの永続化を担うリポジトリのインターフェース
+type SettlementRepository interface {
+	// FindSettlements は from から to まで（両端を含む）の精算の支払いを日付順で取得します
+	FindSettlements(houseHoldID HouseHoldID, from string, to string) ([]*Settlement, error)
+	CreateSettlement(settlement *Settlement) error
+	DeleteSettlement(houseHoldID HouseHoldID, id SettlementID) error
+}
@@ -0,0 +1,130 @@
+package domainmodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShoppingAmount_ValidateSplit(t *testing.T) {
+	payer := UserID(1)
+	members := []UserID{1, 2}
+
+	tests := []struct {
+		name    string
+		amount  ShoppingAmount
+		wantErr error
+	}{
+		{name: "共通の財布からの支払い", amount: ShoppingAmount{Amount: 1000, SplitType: SplitEqual}},
+		{name: "全員で均等に負担", amount: ShoppingAmount{Amount: 1000, PaidBy: &payer, SplitType: SplitEqual}},
+		{name: "個人の支出", amount: ShoppingAmount{Amount: 1000, PaidBy: &payer, SplitType: SplitPersonal}},
+		{name: "割合で負担", amount: ShoppingAmount{Amount: 1000, PaidBy: &payer, SplitType: SplitPercentage, Shares: []ExpenseShare{{UserID: 1, Value: 60}, {UserID: 2, Value: 40}}}},
+		{name: "金額で負担", amount: ShoppingAmount{Amount: 1000, PaidBy: &payer, SplitType: SplitFixed, Shares: []ExpenseShare{{UserID: 1, Value: 300}, {UserID: 2, Value: 700}}}},
+		{name: "負担方法が不正", amount: ShoppingAmount{Amount: 1000, PaidBy: &payer, SplitType: "half"}, wantErr: ErrInvalidSplitType},
+		{name: "支払ったメンバーがいない負担の指定", amount: ShoppingAmount{Amount: 1000, SplitType: SplitEqual, Shares: []ExpenseShare{{UserID: 1}}}, wantErr: ErrSplitWithoutPayer},
+		{name: "割合の指定がない", amount: ShoppingAmount{Amount: 1000, PaidBy: &payer, SplitType: SplitPercentage}, wantErr: ErrSplitSharesRequired},
+		{name: "割合の合計が100でない", amount: ShoppingAmount{Amount: 1000, PaidBy: &payer, SplitType: SplitPercentage, Shares: []ExpenseShare{{UserID: 1, Value: 60}, {UserID: 2, Value: 50}}}, wantErr: ErrInvalidSplitPercentage},
+		{name: "金額の合計が支出と一致しない", amount: ShoppingAmount{Amount: 1000, PaidBy: &payer, SplitType: SplitFixed, Shares: []ExpenseShare{{UserID: 1, Value: 300}, {UserID: 2, Value: 600}}}, wantErr: ErrInvalidSplitFixed},
+		{name: "同じメンバーを重複して指定", amount: ShoppingAmount{Amount: 1000, PaidBy: &payer, SplitType: SplitEqual, Shares: []ExpenseShare{{UserID: 1}, {UserID: 1}}}, wantErr: ErrDuplicateSplitShare},
+		{name: "メンバー以外を指定", amount: ShoppingAmount{Amount: 1000, PaidBy: &payer, SplitType: SplitEqual, Shares: []ExpenseShare{{UserID: 1}, {UserID: 99}}}, wantErr: ErrNotSplitMember},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, tt.amount.ValidateSplit(members))
+		})
+	}
+}
+
+func TestShoppingAmount_FillEqualSplitParticipants(t *testing.T) {
+	payer := UserID(1)
+	members := []UserID{1, 2, 3}
+
+	// 参加者を指定しない均等の負担は、登録時点のメンバーを参加者とする
+	amount := ShoppingAmount{Amount: 1000, PaidBy: &payer, SplitType: SplitEqual}
+	amount.FillEqualSplitParticipants(members)
+	assert.Equal(t, []ExpenseShare{{UserID: 1}, {UserID: 2}, {UserID: 3}}, amount.Shares)
+
+	// 指定した参加者は変更しない
+	amount = ShoppingAmount{Amount: 1000, PaidBy: &payer, SplitType: SplitEqual, Shares: []ExpenseShare{{UserID: 2}}}
+	amount.FillEqualSplitParticipants(members)
+	assert.Equal(t, []ExpenseShare{{UserID: 2}}, amount.Shares)
+
+	// 共通の財布からの支払いと均等以外の負担は対象外
+	amount = ShoppingAmount{Amount: 1000, SplitType: SplitEqual}
+	amount.FillEqualSplitParticipants(members)
+	assert.Empty(t, amount.Shares)
+	amount = ShoppingAmount{Amount: 1000, PaidBy: &payer, SplitType: SplitPersonal}
+	amount.FillEqualSplitParticipants(members)
+	assert.Empty(t, amount.Shares)
+}
+
+func TestShoppingAmount_OwedShares(t *testing.T) {
+	payer := UserID(1)
+
+	// 割り切れない端数はユーザーIDの小さいメンバーから1円ずつ負担する
+	amount := ShoppingAmount{Amount: 1000, PaidBy: &payer, SplitType: SplitEqual, Shares: []ExpenseShare{{UserID: 1}, {UserID: 2}, {UserID: 3}}}
+	assert.Equal(t, map[UserID]int{1: 334, 2: 333, 3: 333}, amount.OwedShares())
+
+	// 保存した参加者のみで負担する
+	amount = ShoppingAmount{Amount: 1001, PaidBy: &payer, SplitType: SplitEqual, Shares: []ExpenseShare{{UserID: 3}, {UserID: 2}}}
+	assert.Equal(t, map[UserID]int{2: 501, 3: 500}, amount.OwedShares())
+
+	// 参加者を保存していない場合は支払ったメンバーのみの負担とする
+	amount = ShoppingAmount{Amount: 1000, PaidBy: &payer, SplitType: SplitEqual}
+	assert.Equal(t, map[UserID]int{1: 1000}, amount.OwedShares())
+
+	amount = ShoppingAmount{Amount: 999, PaidBy: &payer, SplitType: SplitPercentage, Shares: []ExpenseShare{{UserID: 1, Value: 50}, {UserID: 2, Value: 50}, {UserID: 3, Value: 0}}}
+	assert.Equal(t, map[UserID]int{1: 500, 2: 499, 3: 0}, amount.OwedShares())
+
+	amount = ShoppingAmount{Amount: 1000, PaidBy: &payer, SplitType: SplitFixed, Shares: []ExpenseShare{{UserID: 2, Value: 800}, {UserID: 3, Value: 200}}}
+	assert.Equal(t, map[UserID]int{2: 800, 3: 200}, amount.OwedShares())
+
+	// 個人の支出と共通の財布からの支払いは精算の対象外
+	assert.Nil(t, (&ShoppingAmount{Amount: 1000, PaidBy: &payer, SplitType: SplitPersonal}).OwedShares())
+	assert.Nil(t, (&ShoppingAmount{Amount: 1000, SplitType: SplitEqual}).OwedShares())
+}
+
+func TestSettlement_Validate(t *testing.T) {
+	assert.NoError(t, (&Settlement{FromUserID: 2, ToUserID: 1, Amount: 500, Date: "2026-10-18"}).Validate())
+	assert.Equal(t, ErrInvalidSettlementAmount, (&Settlement{FromUserID: 2, ToUserID: 1, Amount: 0, Date: "2026-10-18"}).Validate())
+	assert.Equal(t, ErrInvalidSettlementDate, (&Settlement{FromUserID: 2, ToUserID: 1, Amount: 500, Date: "2026/10/18"}).Validate())
+	assert.Equal(t, ErrSettlementSameMember, (&Settlement{FromUserID: 1, ToUserID: 1, Amount: 500, Date: "2026-10-18"}).Validate())
+}
+
+func TestNewSettleUpReport(t *testing.T) {
+	alice, bob, carol, dave := UserID(1), UserID(2), UserID(3), UserID(4)
+	members := []UserID{alice, bob, carol, dave}
+	expenses := ShoppingAmounts{
+		// 3人で均等に負担: 1人 3000
+		{Amount: 9000, PaidBy: &alice, SplitType: SplitEqual, Shares: []ExpenseShare{{UserID: alice}, {UserID: bob}, {UserID: carol}}},
+		// 後から加入した dave は、登録時点の参加者ではないため負担しない: 1人 1000
+		{Amount: 2000, PaidBy: &alice, SplitType: SplitEqual, Shares: []ExpenseShare{{UserID: alice}, {UserID: bob}}},
+		// bob と carol で半分ずつ: 1人 1500
+		{Amount: 3000, PaidBy: &bob, SplitType: SplitPercentage, Shares: []ExpenseShare{{UserID: bob, Value: 50}, {UserID: carol, Value: 50}}},
+		// 精算の対象外
+		{Amount: 5000, PaidBy: &carol, SplitType: SplitPersonal},
+	}
+	settlements := []*Settlement{
+		{FromUserID: carol, ToUserID: alice, Amount: 1000, Date: "2026-10-10"},
+	}
+
+	report := NewSettleUpReport("2026-10-01", "2026-10-31", members, expenses, settlements)
+
+	assert.Equal(t, []*MemberBalance{
+		{UserID: alice, Paid: 11000, Owed: 4000, Received: 1000, Net: 6000},
+		{UserID: bob, Paid: 3000, Owed: 5500, Net: -2500},
+		{UserID: carol, Owed: 4500, Sent: 1000, Net: -3500},
+		{UserID: dave},
+	}, report.Balances)
+	assert.Equal(t, []*SettlementTransfer{
+		{FromUserID: carol, ToUserID: alice, Amount: 3500},
+		{FromUserID: bob, ToUserID: alice, Amount: 2500},
+	}, report.Transfers)
+	assert.Equal(t, settlements, report.Settlements)
+
+	// 精算済みの場合は送金は不要
+	report = NewSettleUpReport("2026-10-01", "2026-10-31", members, ShoppingAmounts{}, nil)
+	assert.Empty(t, report.Transfers)
+	assert.Len(t, report.Balances, 4)
+}
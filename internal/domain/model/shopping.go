@@ -3,6 +3,7 @@ package domainmodel
 
 import (
 	"echo-household-budget/internal/infrastructure/persistence/models"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 )
@@ -27,6 +28,75 @@ type ShoppingAmount struct {
 	Category    Category       `json:"category"`
 	AnalyzeID   int            `json:"analyze_id"`
 	Analyze     ReceiptAnalyze `json:"receipt_analyze_results"`
+	// PaidBy は支払ったメンバー。家計簿の共通の財布から支払った場合は nil
+	PaidBy    *UserID        `json:"paid_by"`
+	SplitType SplitType      `json:"split_type"`
+	Shares    []ExpenseShare `json:"split_shares"`
+	// PaymentMethodID は支払い方法。未設定の場合は nil
+	PaymentMethodID *PaymentMethodID `json:"payment_method_id"`
+	PaymentMethod   *PaymentMethod   `json:"payment_method"`
+	// TagIDs は支出に付けるタグ。登録・更新時は指定したタグに置き換える
+	TagIDs []TagID `json:"tag_ids"`
+	Tags   []*Tag  `json:"tags"`
+	// Original は家計簿の基準通貨以外で記録した場合の、記録した通貨の金額。Amount は基準通貨に換算した金額
+	Original *Money `json:"original"`
+	// ExchangeRate は Original を基準通貨に換算したレート。記録した時点のレートを保持し、後からレートを変更しても換算し直さない
+	ExchangeRate string `json:"exchange_rate,omitempty"`
+}
+
+// ApplyExchangeRate は記録した通貨の金額を rate で基準通貨に換算し、Amount に設定する
+// 基準通貨で記録した場合は換算せず、Original と ExchangeRate を空にする
+// 金額で負担する場合は負担額も同じレートで換算し、換算後の合計が Amount と一致するように端数を配分する
+func (s *ShoppingAmount) ApplyExchangeRate(base Currency, rate string) error {
+	if s.Original == nil || s.Original.Currency == base {
+		if s.Original != nil {
+			s.Amount = int(s.Original.Amount)
+		}
+		s.Original = nil
+		s.ExchangeRate = ""
+		return nil
+	}
+
+	normalized, err := NormalizeExchangeRate(rate)
+	if err != nil {
+		return err
+	}
+	converted, err := s.Original.Convert(normalized, base)
+	if err != nil {
+		return err
+	}
+	if s.SplitType == SplitFixed {
+		if err := s.convertFixedShares(int(converted.Amount)); err != nil {
+			return err
+		}
+	}
+	s.Amount = int(converted.Amount)
+	s.ExchangeRate = normalized
+	return nil
+}
+
+// convertFixedShares は記録した通貨の負担額を、記録した金額に占める比率で amount に配分し直す
+// 端数は distribute と同じくユーザーIDの小さい順に1単位ずつ配分する
+func (s *ShoppingAmount) convertFixedShares(amount int) error {
+	total := 0
+	weights := make(map[UserID]int, len(s.Shares))
+	for _, share := range s.Shares {
+		if share.Value < 0 {
+			return ErrInvalidSplitFixed
+		}
+		total += share.Value
+		weights[share.UserID] += share.Value
+	}
+	if total != int(s.Original.Amount) {
+		return ErrInvalidSplitFixed
+	}
+
+	converted := make(map[UserID]int, len(weights))
+	distribute(converted, amount, weights, total)
+	for i := range s.Shares {
+		s.Shares[i].Value = converted[s.Shares[i].UserID]
+	}
+	return nil
 }
 
 type CategoryAmount struct {
@@ -38,27 +108,60 @@ type ShoppingAmounts []*ShoppingAmount
 type CategoryAmounts []*CategoryAmount
 
 type SummarizeShoppingAmounts struct {
+	// Currency は金額の通貨（家計簿の基準通貨）。基準通貨以外で記録した支出は換算した金額で集計する
+	Currency        Currency        `json:"currency"`
 	ShoppingAmounts ShoppingAmounts `json:"shoppingAmounts"`
 	TotalAmount     int             `json:"totalAmount"`
 	CategoryAmounts CategoryAmounts `json:"categoryAmounts"`
+	// Budgets はカテゴリごとの予算・実績・残額
+	Budgets        CategoryBudgets `json:"budgets"`
+	TotalBudget    int             `json:"totalBudget"`
+	TotalRemaining int             `json:"totalRemaining"`
+	// Balance は収入を含めた月の収支
+	Balance MonthlyBalance `json:"balance"`
+	// PaymentMethodAmounts は支払い方法ごとの支出と収入の合計
+	PaymentMethodAmounts []*PaymentMethodAmount `json:"paymentMethodAmounts"`
+	// DailyAmounts, WeeklyAmounts, Heatmap は月の支出の日ごと・週ごとの内訳
+	DailyAmounts  []*DailyAmount   `json:"dailyAmounts"`
+	WeeklyAmounts []*WeeklyAmount  `json:"weeklyAmounts"`
+	Heatmap       *CalendarHeatmap `json:"heatmap"`
+}
+
+// ApplyBudgets はカテゴリごとの予算と、その合計を設定する
+func (s *SummarizeShoppingAmounts) ApplyBudgets(budgets CategoryBudgets) {
+	s.Budgets = budgets
+	s.TotalBudget = 0
+	s.TotalRemaining = 0
+	for _, budget := range budgets {
+		s.TotalBudget += budget.Budget + budget.CarriedOver
+		s.TotalRemaining += budget.Remaining
+	}
+}
+
+// ApplyBreakdown は月の支出の日ごと・週ごとの内訳を設定する
+func (s *SummarizeShoppingAmounts) ApplyBreakdown(month string, weekStart time.Weekday) {
+	breakdown := NewShoppingBreakdown(month, weekStart, s.ShoppingAmounts)
+	s.DailyAmounts = breakdown.DailyAmounts
+	s.WeeklyAmounts = breakdown.WeeklyAmounts
+	s.Heatmap = breakdown.Heatmap
 }
 
 func (s *ShoppingAmounts) SummarizeMonthlyGroupByCategory() CategoryAmounts {
 	amounts := CategoryAmounts{}
 	categoryMap := make(map[CategoryID]*CategoryAmount)
+	// 集計結果の並び順が安定するよう、カテゴリが最初に出現した順で返す
 	for _, amount := range *s {
 		if existing, ok := categoryMap[amount.CategoryID]; ok {
 			existing.Amount += amount.Amount
 		} else {
-			categoryMap[amount.CategoryID] = &CategoryAmount{
+			categoryAmount := &CategoryAmount{
 				Category: amount.Category,
 				Amount:   amount.Amount,
 			}
+			categoryMap[amount.CategoryID] = categoryAmount
+			amounts = append(amounts, categoryAmount)
 		}
 	}
-	for _, amount := range categoryMap {
-		amounts = append(amounts, amount)
-	}
 	return amounts
 }
 
@@ -87,8 +190,10 @@ func ConvertShoppingAmountsToShoppingAmount(shoppingAmount *models.ShoppingAmoun
 	if shoppingAmount.Analyze != nil {
 		for _, item := range shoppingAmount.Analyze.Items {
 			items = append(items, ReceiptAnalyzeItem{
+				ID:    uint(item.ID),
 				Name:  item.Name,
 				Price: uint(item.Price),
+				Tags:  ConvertTags(item.Tags),
 			})
 		}
 	}
@@ -104,6 +209,34 @@ func ConvertShoppingAmountsToShoppingAmount(shoppingAmount *models.ShoppingAmoun
 		}
 	}
 
+	shares := []ExpenseShare{}
+	for _, split := range shoppingAmount.Splits {
+		shares = append(shares, ExpenseShare{UserID: UserID(split.UserID), Value: split.Value})
+	}
+	var paymentMethodID *PaymentMethodID
+	if shoppingAmount.PaymentMethodID != nil {
+		id := PaymentMethodID(*shoppingAmount.PaymentMethodID)
+		paymentMethodID = &id
+	}
+	tags := ConvertTags(shoppingAmount.Tags)
+	tagIDs := make([]TagID, len(tags))
+	for i, tag := range tags {
+		tagIDs[i] = tag.ID
+	}
+	var paidBy *UserID
+	if shoppingAmount.PaidBy != nil {
+		userID := UserID(*shoppingAmount.PaidBy)
+		paidBy = &userID
+	}
+	var original *Money
+	exchangeRate := ""
+	if shoppingAmount.OriginalAmount != nil && shoppingAmount.OriginalCurrency != nil {
+		original = &Money{Amount: *shoppingAmount.OriginalAmount, Currency: Currency(*shoppingAmount.OriginalCurrency)}
+		if shoppingAmount.ExchangeRate != nil {
+			exchangeRate, _ = NormalizeExchangeRate(*shoppingAmount.ExchangeRate)
+		}
+	}
+
 	return &ShoppingAmount{
 		ID:          ShoppingID(shoppingAmount.ID),
 		HouseholdID: HouseHoldID(shoppingAmount.HouseholdBookID),
@@ -113,9 +246,18 @@ func ConvertShoppingAmountsToShoppingAmount(shoppingAmount *models.ShoppingAmoun
 		Memo:        shoppingAmount.Memo,
 		// TODO : カテゴリについて、家計簿ごとに、上限金額を設定できるようにした上で、上限金額を取得するようにする
 		// HouseHoldCategory、のようなモデルが必要か
-		Category:  Category{ID: CategoryID(shoppingAmount.CategoryID), Name: shoppingAmount.Category.Name, Color: shoppingAmount.Category.Color},
-		AnalyzeID: int(shoppingAmount.AnalyzeID),
-		Analyze:   analyze,
+		Category:        Category{ID: CategoryID(shoppingAmount.CategoryID), Name: shoppingAmount.Category.Name, Color: shoppingAmount.Category.Color},
+		AnalyzeID:       int(shoppingAmount.AnalyzeID),
+		Analyze:         analyze,
+		PaidBy:          paidBy,
+		SplitType:       SplitType(shoppingAmount.SplitType),
+		Shares:          shares,
+		PaymentMethodID: paymentMethodID,
+		PaymentMethod:   ConvertPaymentMethod(shoppingAmount.PaymentMethod),
+		TagIDs:          tagIDs,
+		Tags:            tags,
+		Original:        original,
+		ExchangeRate:    exchangeRate,
 	}
 }
 
@@ -137,6 +279,7 @@ func NewShoppingAmount(householdID HouseHoldID, categoryID CategoryID, amount in
 		Date:        date,
 		Memo:        memo,
 		AnalyzeID:   analyzeID,
+		SplitType:   SplitEqual,
 	}
 }
 
@@ -151,9 +294,20 @@ const (
 type ShoppingRepository interface {
 	RegisterShoppingMemo(shopping *ShoppingMemo) error
 	FetchShoppingMemoItem(householdID HouseHoldID) ([]*ShoppingMemo, error)
-	DeleteShoppingMemo(id ShoppingID) error
+	// DeleteShoppingMemo は家計簿の買い物メモをゴミ箱に移す。家計簿にない場合は gorm.ErrRecordNotFound を返す
+	DeleteShoppingMemo(householdID HouseHoldID, id ShoppingID, deletion *Deletion) error
 	RegisterShoppingAmount(shopping *models.ShoppingAmount) error
 	UpdateShoppingAmount(shopping *models.ShoppingAmount) error
 	FetchShoppingAmountItemByHouseholdID(householdID HouseHoldID, date string) ([]*models.ShoppingAmount, error)
-	DeleteShoppingAmount(id ShoppingID) error
+	DeleteShoppingAmount(householdID HouseHoldID, id ShoppingID, deletion *Deletion) error
+	// FindSharedShoppingAmounts は from から to まで（両端を含む）の精算の対象となる支出を取得する
+	FindSharedShoppingAmounts(householdID HouseHoldID, from string, to string) (ShoppingAmounts, error)
+	// SummarizeShoppingAmountByMonth は指定月以前の支出を月・カテゴリごとに集計する
+	SummarizeShoppingAmountByMonth(householdID HouseHoldID, untilMonth string) ([]*MonthlyCategoryAmount, error)
+	// SearchShoppingAmounts は検索条件に一致する支出を並び順で取得する。次のページの有無を判定するため、件数の上限より1件多く取得する
+	SearchShoppingAmounts(condition *ShoppingSearchCondition) (ShoppingAmounts, error)
+	// FindShoppingAmounts は from から to まで（両端を含む）の支出を取得する
+	FindShoppingAmounts(householdID HouseHoldID, from string, to string) (ShoppingAmounts, error)
+	// RegisterShoppingAmounts は支出をまとめて登録する。いずれかの登録に失敗した場合はすべて取り消す
+	RegisterShoppingAmounts(shoppings []*models.ShoppingAmount) error
 }
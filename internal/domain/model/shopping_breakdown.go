@@ -0,0 +1,146 @@
+package domainmodel
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// HeatmapLevels はカレンダーのヒートマップの濃淡の段階数（支出のない日の0を除く）
+const HeatmapLevels = 4
+
+var ErrInvalidWeekStart = errors.New("week start must be one of sunday, monday, tuesday, wednesday, thursday, friday, saturday")
+
+// ParseWeekStart は曜日の英語名（大文字・小文字を区別しない）から週の始まりの曜日を返す。空文字の場合は ISO 8601 と同じ月曜日とする
+func ParseWeekStart(s string) (time.Weekday, error) {
+	if s == "" {
+		return time.Monday, nil
+	}
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(s, weekday.String()) {
+			return weekday, nil
+		}
+	}
+	return time.Sunday, ErrInvalidWeekStart
+}
+
+// DailyAmount は日ごとの支出の合計
+type DailyAmount struct {
+	Date   string `json:"date"`
+	Amount int    `json:"amount"`
+	Count  int    `json:"count"`
+}
+
+// WeeklyAmount は週ごとの支出の合計
+type WeeklyAmount struct {
+	// Week は ISO 8601 の週番号（YYYY-Www 形式）。週の始まりが月曜日以外の場合は、週の4日目が属する ISO 週とする
+	Week      string `json:"week"`
+	WeekStart string `json:"weekStart"`
+	WeekEnd   string `json:"weekEnd"`
+	Amount    int    `json:"amount"`
+	Count     int    `json:"count"`
+}
+
+// HeatmapCell はカレンダーのヒートマップの1日
+type HeatmapCell struct {
+	Date   string `json:"date"`
+	Amount int    `json:"amount"`
+	// Level は月内で支出が最も多い日に対する割合を 0〜4 の段階で表す。支出のない日は0
+	Level int `json:"level"`
+	// InMonth は集計した月の日かどうか。月初・月末の週を埋める前後の月の日は false
+	InMonth bool `json:"inMonth"`
+}
+
+// CalendarHeatmap は月のカレンダーの形に並べた日ごとの支出
+type CalendarHeatmap struct {
+	Month     string `json:"month"`
+	WeekStart string `json:"weekStart"`
+	MaxAmount int    `json:"maxAmount"`
+	// Weeks は週ごとに週の始まりの曜日から7日分の日を並べる
+	Weeks [][]*HeatmapCell `json:"weeks"`
+}
+
+// ShoppingBreakdown は月の支出の日ごと・週ごとの内訳
+type ShoppingBreakdown struct {
+	DailyAmounts  []*DailyAmount   `json:"dailyAmounts"`
+	WeeklyAmounts []*WeeklyAmount  `json:"weeklyAmounts"`
+	Heatmap       *CalendarHeatmap `json:"heatmap"`
+}
+
+// NewShoppingBreakdown は月の支出から日ごと・週ごとの内訳とヒートマップを作成する
+// 支出のない日も0円として月のすべての日を返し、月をまたぐ週は月内の支出のみを集計する。month は検証済みであること
+func NewShoppingBreakdown(month string, weekStart time.Weekday, shoppingAmounts ShoppingAmounts) *ShoppingBreakdown {
+	first, _ := ParseBudgetMonth(month)
+	days := daysInBudgetMonth(month)
+
+	breakdown := &ShoppingBreakdown{
+		DailyAmounts:  make([]*DailyAmount, days),
+		WeeklyAmounts: []*WeeklyAmount{},
+	}
+	daily := make(map[string]*DailyAmount, days)
+	for i := range breakdown.DailyAmounts {
+		date := first.AddDate(0, 0, i).Format("2006-01-02")
+		breakdown.DailyAmounts[i] = &DailyAmount{Date: date}
+		daily[date] = breakdown.DailyAmounts[i]
+	}
+	for _, shoppingAmount := range shoppingAmounts {
+		dailyAmount, ok := daily[shoppingAmount.Date]
+		if !ok {
+			continue
+		}
+		dailyAmount.Amount += shoppingAmount.Amount
+		dailyAmount.Count++
+	}
+
+	maxAmount := 0
+	for _, dailyAmount := range breakdown.DailyAmounts {
+		if dailyAmount.Amount > maxAmount {
+			maxAmount = dailyAmount.Amount
+		}
+	}
+	breakdown.Heatmap = &CalendarHeatmap{
+		Month:     month,
+		WeekStart: strings.ToLower(weekStart.String()),
+		MaxAmount: maxAmount,
+		Weeks:     [][]*HeatmapCell{},
+	}
+
+	start := first.AddDate(0, 0, -((int(first.Weekday()) - int(weekStart) + 7) % 7))
+	last := first.AddDate(0, 0, days-1)
+	for week := start; !week.After(last); week = week.AddDate(0, 0, 7) {
+		year, number := week.AddDate(0, 0, 3).ISOWeek()
+		weeklyAmount := &WeeklyAmount{
+			Week:      fmt.Sprintf("%04d-W%02d", year, number),
+			WeekStart: week.Format("2006-01-02"),
+			WeekEnd:   week.AddDate(0, 0, 6).Format("2006-01-02"),
+		}
+		cells := make([]*HeatmapCell, 7)
+		for i := range cells {
+			date := week.AddDate(0, 0, i).Format("2006-01-02")
+			cells[i] = &HeatmapCell{Date: date}
+			dailyAmount, ok := daily[date]
+			if !ok {
+				continue
+			}
+			cells[i].InMonth = true
+			cells[i].Amount = dailyAmount.Amount
+			cells[i].Level = heatmapLevel(dailyAmount.Amount, maxAmount)
+			weeklyAmount.Amount += dailyAmount.Amount
+			weeklyAmount.Count += dailyAmount.Count
+		}
+		breakdown.WeeklyAmounts = append(breakdown.WeeklyAmounts, weeklyAmount)
+		breakdown.Heatmap.Weeks = append(breakdown.Heatmap.Weeks, cells)
+	}
+
+	return breakdown
+}
+
+// heatmapLevel は最も多い日に対する支出の割合を段階に変換する。返金などで合計が0円以下の日は0とする
+func heatmapLevel(amount int, maxAmount int) int {
+	if amount <= 0 || maxAmount <= 0 {
+		return 0
+	}
+	return int(math.Ceil(float64(amount) * HeatmapLevels / float64(maxAmount)))
+}
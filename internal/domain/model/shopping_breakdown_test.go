@@ -0,0 +1,104 @@
+package domainmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWeekStart(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Weekday
+		wantErr  bool
+	}{
+		{name: "省略した場合は月曜日", input: "", expected: time.Monday},
+		{name: "大文字・小文字を区別しない", input: "Sunday", expected: time.Sunday},
+		{name: "小文字の曜日", input: "saturday", expected: time.Saturday},
+		{name: "曜日以外はエラー", input: "1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			weekday, err := ParseWeekStart(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidWeekStart)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, weekday)
+		})
+	}
+}
+
+func TestNewShoppingBreakdown(t *testing.T) {
+	shoppingAmounts := ShoppingAmounts{
+		{Date: "2026-10-01", Amount: 1000},
+		{Date: "2026-10-01", Amount: 500},
+		{Date: "2026-10-05", Amount: 3000},
+		{Date: "2026-10-31", Amount: 600},
+		// 翌月の支出は集計しない
+		{Date: "2026-11-01", Amount: 999},
+	}
+
+	t.Run("日ごとの合計は支出のない日も含めて月のすべての日を返す", func(t *testing.T) {
+		breakdown := NewShoppingBreakdown("2026-10", time.Monday, shoppingAmounts)
+		assert.Len(t, breakdown.DailyAmounts, 31)
+		assert.Equal(t, &DailyAmount{Date: "2026-10-01", Amount: 1500, Count: 2}, breakdown.DailyAmounts[0])
+		assert.Equal(t, &DailyAmount{Date: "2026-10-02"}, breakdown.DailyAmounts[1])
+		assert.Equal(t, &DailyAmount{Date: "2026-10-31", Amount: 600, Count: 1}, breakdown.DailyAmounts[30])
+	})
+
+	t.Run("月曜日始まりの週は ISO 週で集計する", func(t *testing.T) {
+		breakdown := NewShoppingBreakdown("2026-10", time.Monday, shoppingAmounts)
+		assert.Equal(t, []*WeeklyAmount{
+			{Week: "2026-W40", WeekStart: "2026-09-28", WeekEnd: "2026-10-04", Amount: 1500, Count: 2},
+			{Week: "2026-W41", WeekStart: "2026-10-05", WeekEnd: "2026-10-11", Amount: 3000, Count: 1},
+			{Week: "2026-W42", WeekStart: "2026-10-12", WeekEnd: "2026-10-18"},
+			{Week: "2026-W43", WeekStart: "2026-10-19", WeekEnd: "2026-10-25"},
+			{Week: "2026-W44", WeekStart: "2026-10-26", WeekEnd: "2026-11-01", Amount: 600, Count: 1},
+		}, breakdown.WeeklyAmounts)
+	})
+
+	t.Run("日曜日始まりの週は週の4日目の ISO 週とする", func(t *testing.T) {
+		breakdown := NewShoppingBreakdown("2026-10", time.Sunday, shoppingAmounts)
+		assert.Equal(t, []*WeeklyAmount{
+			{Week: "2026-W40", WeekStart: "2026-09-27", WeekEnd: "2026-10-03", Amount: 1500, Count: 2},
+			{Week: "2026-W41", WeekStart: "2026-10-04", WeekEnd: "2026-10-10", Amount: 3000, Count: 1},
+			{Week: "2026-W42", WeekStart: "2026-10-11", WeekEnd: "2026-10-17"},
+			{Week: "2026-W43", WeekStart: "2026-10-18", WeekEnd: "2026-10-24"},
+			{Week: "2026-W44", WeekStart: "2026-10-25", WeekEnd: "2026-10-31", Amount: 600, Count: 1},
+		}, breakdown.WeeklyAmounts)
+	})
+
+	t.Run("ヒートマップは週の始まりから7日ずつ並べ、最も多い日に対する割合を段階で表す", func(t *testing.T) {
+		breakdown := NewShoppingBreakdown("2026-10", time.Monday, shoppingAmounts)
+		heatmap := breakdown.Heatmap
+		assert.Equal(t, "2026-10", heatmap.Month)
+		assert.Equal(t, "monday", heatmap.WeekStart)
+		assert.Equal(t, 3000, heatmap.MaxAmount)
+		assert.Len(t, heatmap.Weeks, 5)
+		for _, week := range heatmap.Weeks {
+			assert.Len(t, week, 7)
+		}
+		assert.Equal(t, &HeatmapCell{Date: "2026-09-28"}, heatmap.Weeks[0][0])
+		assert.Equal(t, &HeatmapCell{Date: "2026-10-01", Amount: 1500, Level: 2, InMonth: true}, heatmap.Weeks[0][3])
+		assert.Equal(t, &HeatmapCell{Date: "2026-10-05", Amount: 3000, Level: 4, InMonth: true}, heatmap.Weeks[1][0])
+		assert.Equal(t, &HeatmapCell{Date: "2026-10-31", Amount: 600, Level: 1, InMonth: true}, heatmap.Weeks[4][5])
+		assert.Equal(t, &HeatmapCell{Date: "2026-11-01"}, heatmap.Weeks[4][6])
+	})
+
+	t.Run("支出がない月はすべて0円で段階も0", func(t *testing.T) {
+		breakdown := NewShoppingBreakdown("2026-02", time.Sunday, ShoppingAmounts{})
+		assert.Len(t, breakdown.DailyAmounts, 28)
+		assert.Len(t, breakdown.WeeklyAmounts, 4)
+		assert.Equal(t, 0, breakdown.Heatmap.MaxAmount)
+		for _, week := range breakdown.Heatmap.Weeks {
+			for _, cell := range week {
+				assert.Equal(t, 0, cell.Level)
+			}
+		}
+	})
+}
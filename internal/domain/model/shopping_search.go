@@ -0,0 +1,173 @@
+package domainmodel
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ShoppingSearchSort は支出の検索結果の並び順
+type ShoppingSearchSort string
+
+const (
+	ShoppingSearchSortDateDesc   ShoppingSearchSort = "date_desc"
+	ShoppingSearchSortDateAsc    ShoppingSearchSort = "date_asc"
+	ShoppingSearchSortAmountDesc ShoppingSearchSort = "amount_desc"
+	ShoppingSearchSortAmountAsc  ShoppingSearchSort = "amount_asc"
+)
+
+const (
+	DefaultShoppingSearchLimit = 50
+	MaxShoppingSearchLimit     = 100
+)
+
+var (
+	ErrInvalidShoppingSearchPeriod = errors.New("search period must be from <= to in YYYY-MM-DD format")
+	ErrInvalidShoppingSearchAmount = errors.New("search amount must be minAmount <= maxAmount")
+	ErrInvalidShoppingSearchSort   = errors.New("search sort must be one of date_desc, date_asc, amount_desc, amount_asc")
+	ErrInvalidShoppingSearchLimit  = errors.New("search limit must be 1 to 100")
+	ErrInvalidShoppingSearchCursor = errors.New("search cursor is invalid for the sort order")
+)
+
+// IsValid は並び順が定義済みの値かを判定する
+func (s ShoppingSearchSort) IsValid() bool {
+	switch s {
+	case ShoppingSearchSortDateDesc, ShoppingSearchSortDateAsc, ShoppingSearchSortAmountDesc, ShoppingSearchSortAmountAsc:
+		return true
+	}
+	return false
+}
+
+// IsDesc は降順かを判定する
+func (s ShoppingSearchSort) IsDesc() bool {
+	return s == ShoppingSearchSortDateDesc || s == ShoppingSearchSortAmountDesc
+}
+
+// ShoppingSearchCursor は検索結果の最後の支出の位置。次のページはこの支出より後ろから取得する
+type ShoppingSearchCursor struct {
+	Sort   ShoppingSearchSort `json:"sort"`
+	Date   string             `json:"date,omitempty"`
+	Amount int                `json:"amount,omitempty"`
+	ID     ShoppingID         `json:"id"`
+}
+
+// NewShoppingSearchCursor は支出の並び順の値からカーソルを作成する
+func NewShoppingSearchCursor(sort ShoppingSearchSort, shoppingAmount *ShoppingAmount) *ShoppingSearchCursor {
+	cursor := &ShoppingSearchCursor{Sort: sort, ID: shoppingAmount.ID}
+	switch sort {
+	case ShoppingSearchSortAmountDesc, ShoppingSearchSortAmountAsc:
+		cursor.Amount = shoppingAmount.Amount
+	default:
+		cursor.Date = shoppingAmount.Date
+	}
+	return cursor
+}
+
+// Encode はカーソルを URL に含められる文字列に変換する
+func (c *ShoppingSearchCursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// ParseShoppingSearchCursor は Encode したカーソルを復元する
+func ParseShoppingSearchCursor(s string) (*ShoppingSearchCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidShoppingSearchCursor
+	}
+	cursor := &ShoppingSearchCursor{}
+	if err := json.Unmarshal(b, cursor); err != nil {
+		return nil, ErrInvalidShoppingSearchCursor
+	}
+	return cursor, nil
+}
+
+// ShoppingSearchCondition は支出の検索条件。ゼロ値の条件は絞り込みに用いない
+type ShoppingSearchCondition struct {
+	HouseHoldID HouseHoldID
+	// From, To は期間（両端を含む）。YYYY-MM-DD 形式
+	From        string
+	To          string
+	CategoryIDs []CategoryID
+	MinAmount   *int
+	MaxAmount   *int
+	// Memo はメモの部分一致（大文字・小文字を区別しない）
+	Memo string
+	// HasReceipt はレシートから登録した支出かどうか
+	HasReceipt *bool
+	Sort       ShoppingSearchSort
+	Limit      int
+	Cursor     *ShoppingSearchCursor
+}
+
+// NewShoppingSearchCondition は並び順を日付の新しい順、件数を既定値とした検索条件を作成する
+func NewShoppingSearchCondition(houseHoldID HouseHoldID) *ShoppingSearchCondition {
+	return &ShoppingSearchCondition{
+		HouseHoldID: houseHoldID,
+		Sort:        ShoppingSearchSortDateDesc,
+		Limit:       DefaultShoppingSearchLimit,
+	}
+}
+
+// Validate は検索条件を検証する
+func (c *ShoppingSearchCondition) Validate() error {
+	var from, to time.Time
+	var err error
+	if c.From != "" {
+		if from, err = time.Parse("2006-01-02", c.From); err != nil {
+			return ErrInvalidShoppingSearchPeriod
+		}
+	}
+	if c.To != "" {
+		if to, err = time.Parse("2006-01-02", c.To); err != nil {
+			return ErrInvalidShoppingSearchPeriod
+		}
+	}
+	if c.From != "" && c.To != "" && from.After(to) {
+		return ErrInvalidShoppingSearchPeriod
+	}
+	if c.MinAmount != nil && c.MaxAmount != nil && *c.MinAmount > *c.MaxAmount {
+		return ErrInvalidShoppingSearchAmount
+	}
+	if !c.Sort.IsValid() {
+		return ErrInvalidShoppingSearchSort
+	}
+	if c.Limit < 1 || c.Limit > MaxShoppingSearchLimit {
+		return ErrInvalidShoppingSearchLimit
+	}
+	if c.Cursor != nil {
+		if c.Cursor.Sort != c.Sort {
+			return ErrInvalidShoppingSearchCursor
+		}
+		if c.Sort == ShoppingSearchSortDateDesc || c.Sort == ShoppingSearchSortDateAsc {
+			if _, err := time.Parse("2006-01-02", c.Cursor.Date); err != nil {
+				return ErrInvalidShoppingSearchCursor
+			}
+		}
+	}
+	return nil
+}
+
+// ShoppingSearchResult は支出の検索結果の1ページ
+type ShoppingSearchResult struct {
+	ShoppingAmounts ShoppingAmounts `json:"shoppingAmounts"`
+	// NextCursor は次のページを取得するカーソル。次のページがない場合は空文字
+	NextCursor string `json:"nextCursor"`
+	HasMore    bool   `json:"hasMore"`
+}
+
+// NewShoppingSearchResult はリポジトリから取得した支出から検索結果を作成する
+// リポジトリは次のページの有無を判定するため、件数の上限より1件多く取得する
+func NewShoppingSearchResult(condition *ShoppingSearchCondition, shoppingAmounts ShoppingAmounts) *ShoppingSearchResult {
+	result := &ShoppingSearchResult{ShoppingAmounts: ShoppingAmounts{}}
+	result.ShoppingAmounts = append(result.ShoppingAmounts, shoppingAmounts...)
+	if len(result.ShoppingAmounts) <= condition.Limit {
+		return result
+	}
+
+	result.ShoppingAmounts = result.ShoppingAmounts[:condition.Limit]
+	result.HasMore = true
+	result.NextCursor = NewShoppingSearchCursor(condition.Sort, result.ShoppingAmounts[condition.Limit-1]).Encode()
+	return result
+}
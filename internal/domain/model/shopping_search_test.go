@@ -0,0 +1,98 @@
+package domainmodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShoppingSearchCondition_Validate(t *testing.T) {
+	hasReceipt := true
+	tests := []struct {
+		name    string
+		modify  func(c *ShoppingSearchCondition)
+		wantErr error
+	}{
+		{name: "条件を指定しない", modify: func(c *ShoppingSearchCondition) {}, wantErr: nil},
+		{
+			name: "すべての条件を指定する",
+			modify: func(c *ShoppingSearchCondition) {
+				c.From, c.To = "2026-01-01", "2026-12-31"
+				c.CategoryIDs = []CategoryID{1, 2}
+				c.MinAmount, c.MaxAmount = intPtr(100), intPtr(100)
+				c.Memo = "スーパー"
+				c.HasReceipt = &hasReceipt
+				c.Sort = ShoppingSearchSortAmountAsc
+				c.Cursor = &ShoppingSearchCursor{Sort: ShoppingSearchSortAmountAsc, Amount: 100, ID: 3}
+			},
+			wantErr: nil,
+		},
+		{name: "開始日が終了日より後", modify: func(c *ShoppingSearchCondition) { c.From, c.To = "2026-02-01", "2026-01-31" }, wantErr: ErrInvalidShoppingSearchPeriod},
+		{name: "日付の形式が不正", modify: func(c *ShoppingSearchCondition) { c.To = "2026-01" }, wantErr: ErrInvalidShoppingSearchPeriod},
+		{name: "最小金額が最大金額より大きい", modify: func(c *ShoppingSearchCondition) { c.MinAmount, c.MaxAmount = intPtr(200), intPtr(100) }, wantErr: ErrInvalidShoppingSearchAmount},
+		{name: "未定義の並び順", modify: func(c *ShoppingSearchCondition) { c.Sort = "memo_asc" }, wantErr: ErrInvalidShoppingSearchSort},
+		{name: "件数が上限を超える", modify: func(c *ShoppingSearchCondition) { c.Limit = MaxShoppingSearchLimit + 1 }, wantErr: ErrInvalidShoppingSearchLimit},
+		{name: "件数が0", modify: func(c *ShoppingSearchCondition) { c.Limit = 0 }, wantErr: ErrInvalidShoppingSearchLimit},
+		{
+			name: "並び順の異なるカーソル",
+			modify: func(c *ShoppingSearchCondition) {
+				c.Cursor = &ShoppingSearchCursor{Sort: ShoppingSearchSortAmountDesc, Amount: 100, ID: 3}
+			},
+			wantErr: ErrInvalidShoppingSearchCursor,
+		},
+		{
+			name: "日付のないカーソル",
+			modify: func(c *ShoppingSearchCondition) {
+				c.Cursor = &ShoppingSearchCursor{Sort: ShoppingSearchSortDateDesc, ID: 3}
+			},
+			wantErr: ErrInvalidShoppingSearchCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition := NewShoppingSearchCondition(10)
+			tt.modify(condition)
+			assert.Equal(t, tt.wantErr, condition.Validate())
+		})
+	}
+}
+
+func TestShoppingSearchCursor_Encode(t *testing.T) {
+	cursor := NewShoppingSearchCursor(ShoppingSearchSortDateDesc, &ShoppingAmount{ID: 42, Date: "2026-10-18", Amount: 1280})
+	assert.Equal(t, &ShoppingSearchCursor{Sort: ShoppingSearchSortDateDesc, Date: "2026-10-18", ID: 42}, cursor)
+
+	parsed, err := ParseShoppingSearchCursor(cursor.Encode())
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, parsed)
+
+	_, err = ParseShoppingSearchCursor("not a cursor")
+	assert.Equal(t, ErrInvalidShoppingSearchCursor, err)
+}
+
+func TestNewShoppingSearchResult(t *testing.T) {
+	condition := NewShoppingSearchCondition(10)
+	condition.Sort = ShoppingSearchSortAmountDesc
+	condition.Limit = 2
+
+	t.Run("上限より1件多い場合は次のページのカーソルを返す", func(t *testing.T) {
+		result := NewShoppingSearchResult(condition, ShoppingAmounts{
+			{ID: 1, Amount: 3000},
+			{ID: 3, Amount: 2000},
+			{ID: 2, Amount: 2000},
+		})
+		assert.Len(t, result.ShoppingAmounts, 2)
+		assert.True(t, result.HasMore)
+
+		cursor, err := ParseShoppingSearchCursor(result.NextCursor)
+		assert.NoError(t, err)
+		assert.Equal(t, &ShoppingSearchCursor{Sort: ShoppingSearchSortAmountDesc, Amount: 2000, ID: 3}, cursor)
+	})
+
+	t.Run("上限以下の場合は最後のページ", func(t *testing.T) {
+		result := NewShoppingSearchResult(condition, ShoppingAmounts{{ID: 1, Amount: 3000}})
+		assert.Len(t, result.ShoppingAmounts, 1)
+		assert.False(t, result.HasMore)
+		assert.Empty(t, result.NextCursor)
+	})
+}
@@ -0,0 +1,178 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainmodel
+
+import (
+	"echo-household-budget/internal/infrastructure/persistence/models"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+type TagID uint
+
+// Tag はカテゴリをまたいで支出やレシートの品目に付ける家計簿ごとのタグ
+type Tag struct {
+	ID          TagID       `json:"id"`
+	HouseHoldID HouseHoldID `json:"houseHoldID"`
+	Name        string      `json:"name"`
+}
+
+var (
+	ErrInvalidTagName   = errors.New("tag name must be 1 to 50 characters")
+	ErrMergeSameTag     = errors.New("tag cannot be merged into itself")
+	ErrInvalidTagPeriod = errors.New("tag summary period must be from <= to in YYYY-MM-DD format")
+)
+
+// NewTag は前後の空白を取り除いた名前でタグを作成する
+func NewTag(houseHoldID HouseHoldID, name string) *Tag {
+	return &Tag{
+		HouseHoldID: houseHoldID,
+		Name:        strings.TrimSpace(name),
+	}
+}
+
+// Validate はタグを検証する
+func (t *Tag) Validate() error {
+	if nameLength := len([]rune(t.Name)); nameLength == 0 || nameLength > 50 {
+		return ErrInvalidTagName
+	}
+	return nil
+}
+
+// UniqueTagIDs は重複を取り除いたタグIDを指定順で返す
+func UniqueTagIDs(ids []TagID) []TagID {
+	seen := make(map[TagID]bool, len(ids))
+	output := make([]TagID, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		output = append(output, id)
+	}
+	return output
+}
+
+// ValidateTagPeriod は集計期間を検証する
+func ValidateTagPeriod(from string, to string) error {
+	fromDate, err := time.Parse("2006-01-02", from)
+	if err != nil {
+		return ErrInvalidTagPeriod
+	}
+	toDate, err := time.Parse("2006-01-02", to)
+	if err != nil {
+		return ErrInvalidTagPeriod
+	}
+	if fromDate.After(toDate) {
+		return ErrInvalidTagPeriod
+	}
+	return nil
+}
+
+// TagTotal はタグごとの金額と件数の集計結果
+type TagTotal struct {
+	TagID  TagID
+	Amount int
+	Count  int
+}
+
+// TagAmount はタグごとの合計金額
+type TagAmount struct {
+	Tag    *Tag `json:"tag"`
+	Amount int  `json:"amount"`
+	// ShoppingCount はタグを付けた支出の件数、ReceiptItemCount はタグを付けたレシートの品目の件数
+	ShoppingCount    int `json:"shoppingCount"`
+	ReceiptItemCount int `json:"receiptItemCount"`
+}
+
+// TagSummary は期間内のタグごとの合計金額
+type TagSummary struct {
+	From       string       `json:"from"`
+	To         string       `json:"to"`
+	TagAmounts []*TagAmount `json:"tagAmounts"`
+}
+
+// NewTagSummary はタグごとの支出とレシートの品目の集計を合算する
+// 支出とその品目の両方に同じタグを付けた場合、品目は集計に含めない（リポジトリで除外する）
+// 金額の大きい順に並べ、金額が同じ場合はタグIDの小さい順とする
+func NewTagSummary(from string, to string, tags []*Tag, shoppingTotals []*TagTotal, receiptItemTotals []*TagTotal) *TagSummary {
+	tagMap := make(map[TagID]*Tag, len(tags))
+	for _, tag := range tags {
+		tagMap[tag.ID] = tag
+	}
+
+	amounts := make(map[TagID]*TagAmount)
+	amountOf := func(tagID TagID) *TagAmount {
+		if _, ok := amounts[tagID]; !ok {
+			amounts[tagID] = &TagAmount{Tag: tagMap[tagID]}
+		}
+		return amounts[tagID]
+	}
+	for _, total := range shoppingTotals {
+		if _, ok := tagMap[total.TagID]; !ok {
+			continue
+		}
+		amount := amountOf(total.TagID)
+		amount.Amount += total.Amount
+		amount.ShoppingCount += total.Count
+	}
+	for _, total := range receiptItemTotals {
+		if _, ok := tagMap[total.TagID]; !ok {
+			continue
+		}
+		amount := amountOf(total.TagID)
+		amount.Amount += total.Amount
+		amount.ReceiptItemCount += total.Count
+	}
+
+	summary := &TagSummary{From: from, To: to, TagAmounts: []*TagAmount{}}
+	for _, amount := range amounts {
+		summary.TagAmounts = append(summary.TagAmounts, amount)
+	}
+	sort.Slice(summary.TagAmounts, func(i, j int) bool {
+		if summary.TagAmounts[i].Amount == summary.TagAmounts[j].Amount {
+			return summary.TagAmounts[i].Tag.ID < summary.TagAmounts[j].Tag.ID
+		}
+		return summary.TagAmounts[i].Amount > summary.TagAmounts[j].Amount
+	})
+
+	return summary
+}
+
+// ConvertTags はタグのモデルを名前順のタグに変換する
+func ConvertTags(tags []models.Tag) []*Tag {
+	output := make([]*Tag, len(tags))
+	for i, tag := range tags {
+		output[i] = &Tag{
+			ID:          TagID(tag.ID),
+			HouseHoldID: HouseHoldID(tag.HouseholdBookID),
+			Name:        tag.Name,
+		}
+	}
+	sort.SliceStable(output, func(i, j int) bool { return output[i].Name < output[j].Name })
+	return output
+}
+
+// TagRepository はタグの永続化を担うリポジトリのインターフェース
+type TagRepository interface {
+	FindByHouseHoldID(houseHoldID HouseHoldID) ([]*Tag, error)
+	// FindByIDs は家計簿のタグのうち指定したIDのタグを取得します。存在しないIDは無視します
+	FindByIDs(houseHoldID HouseHoldID, ids []TagID) ([]*Tag, error)
+	// FindByName は家計簿の同じ名前のタグを取得します。存在しない場合は nil を返します
+	FindByName(houseHoldID HouseHoldID, name string) (*Tag, error)
+	Create(tag *Tag) error
+	Rename(tag *Tag) error
+	// Merge は source のタグを付けた支出・品目を target のタグに付け替え、source のタグを削除します
+	Merge(houseHoldID HouseHoldID, sourceID TagID, targetID TagID) error
+	Delete(houseHoldID HouseHoldID, id TagID) error
+	// ReplaceReceiptItemTags はレシートの品目のタグを指定したタグに置き換えます
+	ReplaceReceiptItemTags(houseHoldID HouseHoldID, receiptItemID uint, tagIDs []TagID) error
+	// FindShoppingAmounts は from から to まで（両端を含む）のタグを付けた支出を日付順で取得します
+	FindShoppingAmounts(houseHoldID HouseHoldID, id TagID, from string, to string) (ShoppingAmounts, error)
+	// SummarizeShoppingAmounts は from から to まで（両端を含む）の支出をタグごとに集計します
+	SummarizeShoppingAmounts(houseHoldID HouseHoldID, from string, to string) ([]*TagTotal, error)
+	// SummarizeReceiptItems は from から to まで（両端を含む）の支出のレシートの品目をタグごとに集計します
+	// 支出に同じタグを付けている品目は二重に計上しないよう除外します
+	SummarizeReceiptItems(houseHoldID HouseHoldID, from string, to string) ([]*TagTotal, error)
+}
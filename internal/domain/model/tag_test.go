@@ -0,0 +1,72 @@
+package domainmodel
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTag_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     *Tag
+		wantErr error
+	}{
+		{name: "前後の空白を取り除く", tag: NewTag(1, "  旅行2026 "), wantErr: nil},
+		{name: "空白のみ", tag: NewTag(1, "  "), wantErr: ErrInvalidTagName},
+		{name: "50文字", tag: NewTag(1, strings.Repeat("あ", 50)), wantErr: nil},
+		{name: "51文字", tag: NewTag(1, strings.Repeat("あ", 51)), wantErr: ErrInvalidTagName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, tt.tag.Validate())
+		})
+	}
+	assert.Equal(t, "旅行2026", NewTag(1, "  旅行2026 ").Name)
+}
+
+func TestUniqueTagIDs(t *testing.T) {
+	assert.Equal(t, []TagID{3, 1, 2}, UniqueTagIDs([]TagID{3, 1, 3, 2, 1}))
+	assert.Equal(t, []TagID{}, UniqueTagIDs(nil))
+}
+
+func TestValidateTagPeriod(t *testing.T) {
+	assert.NoError(t, ValidateTagPeriod("2026-01-01", "2026-12-31"))
+	assert.NoError(t, ValidateTagPeriod("2026-10-18", "2026-10-18"))
+	assert.Equal(t, ErrInvalidTagPeriod, ValidateTagPeriod("2026-12-31", "2026-01-01"))
+	assert.Equal(t, ErrInvalidTagPeriod, ValidateTagPeriod("2026-10", "2026-10-31"))
+}
+
+func TestNewTagSummary(t *testing.T) {
+	travel := &Tag{ID: 1, HouseHoldID: 10, Name: "旅行2026"}
+	kids := &Tag{ID: 2, HouseHoldID: 10, Name: "子ども"}
+	furusato := &Tag{ID: 3, HouseHoldID: 10, Name: "ふるさと納税"}
+	unused := &Tag{ID: 4, HouseHoldID: 10, Name: "未使用"}
+
+	summary := NewTagSummary("2026-01-01", "2026-12-31",
+		[]*Tag{travel, kids, furusato, unused},
+		[]*TagTotal{
+			{TagID: 1, Amount: 80000, Count: 3},
+			{TagID: 3, Amount: 30000, Count: 1},
+			// 削除済みのタグは集計に含めない
+			{TagID: 9, Amount: 1000, Count: 1},
+		},
+		[]*TagTotal{
+			{TagID: 2, Amount: 25000, Count: 4},
+			{TagID: 3, Amount: 5000, Count: 2},
+		},
+	)
+
+	assert.Equal(t, "2026-01-01", summary.From)
+	assert.Equal(t, "2026-12-31", summary.To)
+	assert.Equal(t, []*TagAmount{
+		{Tag: travel, Amount: 80000, ShoppingCount: 3},
+		{Tag: furusato, Amount: 35000, ShoppingCount: 1, ReceiptItemCount: 2},
+		{Tag: kids, Amount: 25000, ReceiptItemCount: 4},
+	}, summary.TagAmounts)
+
+	// 集計対象がない場合は空の配列を返す
+	assert.Equal(t, []*TagAmount{}, NewTagSummary("2026-01-01", "2026-01-31", []*Tag{travel}, nil, nil).TagAmounts)
+}
@@ -0,0 +1,112 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainmodel
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultTrashRetention はゴミ箱に移した記録を物理削除するまでの既定の保持期間
+const DefaultTrashRetention = 30 * 24 * time.Hour
+
+var (
+	ErrInvalidTrashItemType = errors.New("trash item type must be one of shopping_amount, shopping_memo, category, receipt")
+	ErrNothingToUndo        = errors.New("no deletion to undo")
+)
+
+// TrashItemType はゴミ箱に移した記録の種類
+type TrashItemType string
+
+const (
+	TrashItemTypeShoppingAmount TrashItemType = "shopping_amount"
+	TrashItemTypeShoppingMemo   TrashItemType = "shopping_memo"
+	TrashItemTypeCategory       TrashItemType = "category"
+	TrashItemTypeReceipt        TrashItemType = "receipt"
+)
+
+// ParseTrashItemType はゴミ箱の記録の種類を検証する
+func ParseTrashItemType(value string) (TrashItemType, error) {
+	switch itemType := TrashItemType(value); itemType {
+	case TrashItemTypeShoppingAmount, TrashItemTypeShoppingMemo, TrashItemTypeCategory, TrashItemTypeReceipt:
+		return itemType, nil
+	default:
+		return "", ErrInvalidTrashItemType
+	}
+}
+
+// DeleteBatchID は一度の削除操作でゴミ箱に移した記録をまとめる ID
+type DeleteBatchID string
+
+// Deletion は記録をゴミ箱に移す削除操作。一度の操作で削除した記録には同じ Deletion を記録する
+type Deletion struct {
+	BatchID DeleteBatchID
+	// DeletedBy は削除したメンバー。Notion の同期など、メンバーの操作によらない削除は nil
+	DeletedBy *UserID
+	DeletedAt time.Time
+}
+
+// NewDeletion は deletedBy のメンバーによる新しい削除操作を作成する
+func NewDeletion(deletedBy *UserID) *Deletion {
+	return &Deletion{
+		BatchID:   DeleteBatchID(uuid.New().String()),
+		DeletedBy: deletedBy,
+		DeletedAt: time.Now(),
+	}
+}
+
+// TrashItem はゴミ箱に移した記録の一覧表示用の要約
+type TrashItem struct {
+	Type TrashItemType `json:"type"`
+	ID   uint          `json:"id"`
+	// Title は支出のメモ（空の場合はカテゴリ名）、買い物メモのタイトル、カテゴリ名、レシートの画像のパス
+	Title string `json:"title"`
+	// Amount は支出の金額、カテゴリの予算、レシートの合計金額。買い物メモは 0
+	Amount int `json:"amount"`
+	// Date は支出の日付。支出以外は空
+	Date string `json:"date,omitempty"`
+	// DeletedBy は削除したメンバー。メンバーの操作によらない削除は nil
+	DeletedBy *UserID `json:"deletedBy"`
+	// BatchID は削除操作の ID。削除操作を記録する前にゴミ箱に移した記録は空
+	BatchID   DeleteBatchID `json:"-"`
+	DeletedAt time.Time     `json:"deletedAt"`
+	// ExpiresAt は保持期間を過ぎて物理削除される日時
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// LastDeleteBatch は userID のメンバーが最後に行った削除操作でゴミ箱に移した記録を返す
+// 他のメンバーの削除や、削除操作を記録する前にゴミ箱に移した記録は含めない
+func LastDeleteBatch(items []*TrashItem, userID UserID) []*TrashItem {
+	var last *TrashItem
+	for _, item := range items {
+		if item.BatchID == "" || item.DeletedBy == nil || *item.DeletedBy != userID {
+			continue
+		}
+		if last == nil || item.DeletedAt.After(last.DeletedAt) {
+			last = item
+		}
+	}
+
+	batch := []*TrashItem{}
+	if last == nil {
+		return batch
+	}
+	for _, item := range items {
+		if item.BatchID == last.BatchID {
+			batch = append(batch, item)
+		}
+	}
+	return batch
+}
+
+type TrashRepository interface {
+	// FindTrashItems は家計簿のゴミ箱の記録を削除日時の新しい順に取得する
+	FindTrashItems(houseHoldID HouseHoldID) ([]*TrashItem, error)
+	// RestoreTrashItem はゴミ箱の記録を元に戻す。家計簿のゴミ箱にない場合は gorm.ErrRecordNotFound を返す
+	RestoreTrashItem(houseHoldID HouseHoldID, itemType TrashItemType, id uint) error
+	// RestoreDeleteBatch は家計簿のゴミ箱にある、削除操作 batchID で削除した記録をまとめて元に戻し、戻した件数を返す
+	RestoreDeleteBatch(houseHoldID HouseHoldID, batchID DeleteBatchID) (int64, error)
+	// PurgeTrashItems は deletedBefore より前にゴミ箱に移した記録をすべての家計簿から物理削除し、削除した件数を返す
+	PurgeTrashItems(deletedBefore time.Time) (int64, error)
+}
@@ -0,0 +1,40 @@
+package domainmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTrashItemType(t *testing.T) {
+	for _, value := range []string{"shopping_amount", "shopping_memo", "category", "receipt"} {
+		itemType, err := ParseTrashItemType(value)
+		assert.NoError(t, err)
+		assert.Equal(t, TrashItemType(value), itemType)
+	}
+
+	_, err := ParseTrashItemType("tag")
+	assert.Equal(t, ErrInvalidTrashItemType, err)
+}
+
+func TestLastDeleteBatch(t *testing.T) {
+	earlier := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	latest := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	me, other := UserID(1), UserID(2)
+	items := []*TrashItem{
+		{Type: TrashItemTypeShoppingAmount, ID: 1, DeletedAt: earlier, DeletedBy: &me, BatchID: "batch-1"},
+		{Type: TrashItemTypeReceipt, ID: 2, DeletedAt: latest, DeletedBy: &me, BatchID: "batch-2"},
+		{Type: TrashItemTypeShoppingAmount, ID: 3, DeletedAt: latest, DeletedBy: &me, BatchID: "batch-2"},
+		// 他のメンバーの削除は戻さない
+		{Type: TrashItemTypeShoppingMemo, ID: 4, DeletedAt: latest.Add(time.Minute), DeletedBy: &other, BatchID: "batch-3"},
+		// 削除操作を記録する前にゴミ箱に移した記録は戻さない
+		{Type: TrashItemTypeCategory, ID: 5, DeletedAt: latest.Add(time.Hour)},
+	}
+
+	// 同じ操作で削除した記録はまとめて返す
+	assert.Equal(t, []*TrashItem{items[1], items[2]}, LastDeleteBatch(items, me))
+	assert.Equal(t, []*TrashItem{items[3]}, LastDeleteBatch(items, other))
+	assert.Equal(t, []*TrashItem{}, LastDeleteBatch(items, UserID(3)))
+	assert.Equal(t, []*TrashItem{}, LastDeleteBatch(nil, me))
+}
@@ -8,6 +8,8 @@ type UserAccount struct {
 	Name           string       `json:"name"`
 	PictureURL     string       `json:"pictureURL"`
 	HouseholdBooks []*HouseHold `json:"householdBooks"`
+	// DefaultHouseholdID はアプリを開いた際に表示する家計簿
+	DefaultHouseholdID *HouseHoldID `json:"defaultHouseholdID"`
 }
 
 type LINEUserInfo struct {
@@ -23,11 +25,28 @@ type UserAccountRepository interface {
 	FindByLINEUserID(userID LINEUserID) (*UserAccount, error)
 	FetchMe(userID UserID) (*UserAccount, error)
 	FetchAll() ([]*UserAccount, error)
+	UpdateDefaultHouseHold(userID UserID, houseHoldID HouseHoldID) error
 }
 
 type LINEUserID string
 type UserID uint
 
+// ResolveDefaultHouseHold は既定の家計簿が未設定、または脱退などで所属していない場合に、
+// 所属している最初の家計簿を既定とする
+func (u *UserAccount) ResolveDefaultHouseHold() {
+	for _, householdBook := range u.HouseholdBooks {
+		if u.DefaultHouseholdID != nil && householdBook.ID == *u.DefaultHouseholdID {
+			return
+		}
+	}
+
+	u.DefaultHouseholdID = nil
+	if len(u.HouseholdBooks) > 0 {
+		id := u.HouseholdBooks[0].ID
+		u.DefaultHouseholdID = &id
+	}
+}
+
 func NewUserAccount(lineUserInfo *LINEUserInfo) *UserAccount {
 	return &UserAccount{
 		UserID:     lineUserInfo.UserID,
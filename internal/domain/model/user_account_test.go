@@ -0,0 +1,49 @@
+package domainmodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserAccount_ResolveDefaultHouseHold(t *testing.T) {
+	houseHoldID := func(id HouseHoldID) *HouseHoldID { return &id }
+
+	tests := []struct {
+		name           string
+		defaultID      *HouseHoldID
+		householdBooks []*HouseHold
+		expected       *HouseHoldID
+	}{
+		{
+			name:           "所属している家計簿が既定の場合はそのまま",
+			defaultID:      houseHoldID(2),
+			householdBooks: []*HouseHold{{ID: 1}, {ID: 2}},
+			expected:       houseHoldID(2),
+		},
+		{
+			name:           "未設定の場合は最初の家計簿が既定になる",
+			householdBooks: []*HouseHold{{ID: 1}, {ID: 2}},
+			expected:       houseHoldID(1),
+		},
+		{
+			name:           "脱退した家計簿が既定の場合は最初の家計簿が既定になる",
+			defaultID:      houseHoldID(3),
+			householdBooks: []*HouseHold{{ID: 1}, {ID: 2}},
+			expected:       houseHoldID(1),
+		},
+		{
+			name:      "家計簿に所属していない場合は未設定",
+			defaultID: houseHoldID(3),
+			expected:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := &UserAccount{DefaultHouseholdID: tt.defaultID, HouseholdBooks: tt.householdBooks}
+			account.ResolveDefaultHouseHold()
+			assert.Equal(t, tt.expected, account.DefaultHouseholdID)
+		})
+	}
+}
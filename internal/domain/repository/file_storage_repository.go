@@ -3,5 +3,6 @@ package repository
 type FileStorageRepository interface {
 	UploadFile(fileData []byte, fileName string) (string, error)
 	GetFileURL(fileName string) (string, error)
+	DownloadFile(fileName string) ([]byte, error)
 	DeleteFile(fileName string) error
 }
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"time"
+)
+
+type HouseHoldInvitationRepository interface {
+	Create(invitation *domainmodel.HouseHoldInvitation) error
+	FindByCode(code string) (*domainmodel.HouseHoldInvitation, error)
+	FindPendingByHouseHoldID(houseHoldID domainmodel.HouseHoldID, now time.Time) ([]*domainmodel.HouseHoldInvitation, error)
+	Revoke(houseHoldID domainmodel.HouseHoldID, invitationID domainmodel.HouseHoldInvitationID, now time.Time) error
+	// Accept は使用回数の消費・家計簿への参加・応答の記録を1トランザクションで行う
+	// now の時点で期限切れの場合は ErrInvitationExpired、取り消し済みか使用回数の上限に達している場合は ErrInvitationUsedUp を返す
+	Accept(invitation *domainmodel.HouseHoldInvitation, userID domainmodel.UserID, now time.Time) error
+	Decline(invitation *domainmodel.HouseHoldInvitation, userID domainmodel.UserID) error
+}
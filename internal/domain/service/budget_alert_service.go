@@ -0,0 +1,47 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainservice
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+)
+
+type BudgetAlertService interface {
+	// NotifyReachedThresholds は予算の消化率が閾値に達したアラートを作成して通知し、新たに作成したアラートを返す
+	// 同じ月・閾値のアラートは一度だけ通知する
+	NotifyReachedThresholds(houseHoldID domainmodel.HouseHoldID, month string, budget *domainmodel.CategoryBudget) ([]*domainmodel.BudgetAlert, error)
+}
+
+type budgetAlertService struct {
+	budgetAlertRepository domainmodel.BudgetAlertRepository
+	notifier              domainmodel.BudgetAlertNotifier
+	thresholds            []int
+}
+
+// NotifyReachedThresholds implements BudgetAlertService.
+func (s *budgetAlertService) NotifyReachedThresholds(houseHoldID domainmodel.HouseHoldID, month string, budget *domainmodel.CategoryBudget) ([]*domainmodel.BudgetAlert, error) {
+	created := []*domainmodel.BudgetAlert{}
+	for _, alert := range domainmodel.NewBudgetAlerts(houseHoldID, month, budget, s.thresholds) {
+		ok, err := s.budgetAlertRepository.CreateIfNotExists(alert)
+		if err != nil {
+			return created, err
+		}
+		if !ok {
+			continue
+		}
+
+		created = append(created, alert)
+		if err := s.notifier.Notify(alert); err != nil {
+			return created, err
+		}
+	}
+
+	return created, nil
+}
+
+func NewBudgetAlertService(budgetAlertRepository domainmodel.BudgetAlertRepository, notifier domainmodel.BudgetAlertNotifier, thresholds []int) BudgetAlertService {
+	return &budgetAlertService{
+		budgetAlertRepository: budgetAlertRepository,
+		notifier:              notifier,
+		thresholds:            thresholds,
+	}
+}
@@ -0,0 +1,54 @@
+package domainservice
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mock "echo-household-budget/internal/domain/mock/domainmodel"
+	domainmodel "echo-household-budget/internal/domain/model"
+)
+
+func TestBudgetAlertService_NotifyReachedThresholds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	budget := &domainmodel.CategoryBudget{
+		Category: domainmodel.Category{ID: 1, Name: "食費"},
+		Budget:   10000,
+		Actual:   10500,
+	}
+
+	mockRepo := mock.NewMockBudgetAlertRepository(ctrl)
+	mockNotifier := mock.NewMockBudgetAlertNotifier(ctrl)
+	// 80%のアラートは通知済みのため、100%のアラートのみ通知する
+	gomock.InOrder(
+		mockRepo.EXPECT().CreateIfNotExists(gomock.Any()).DoAndReturn(func(alert *domainmodel.BudgetAlert) (bool, error) {
+			assert.Equal(t, 80, alert.Threshold)
+			return false, nil
+		}),
+		mockRepo.EXPECT().CreateIfNotExists(gomock.Any()).DoAndReturn(func(alert *domainmodel.BudgetAlert) (bool, error) {
+			assert.Equal(t, 100, alert.Threshold)
+			return true, nil
+		}),
+	)
+	mockNotifier.EXPECT().Notify(gomock.Any()).DoAndReturn(func(alert *domainmodel.BudgetAlert) error {
+		assert.Equal(t, 100, alert.Threshold)
+		return nil
+	})
+
+	service := NewBudgetAlertService(mockRepo, mockNotifier, []int{80, 100})
+	alerts, err := service.NotifyReachedThresholds(10, "2026-10", budget)
+	assert.NoError(t, err)
+	assert.Len(t, alerts, 1)
+
+	// アラートの登録に失敗した場合は通知しない
+	mockRepo.EXPECT().CreateIfNotExists(gomock.Any()).Return(false, errors.New("db error"))
+
+	service = NewBudgetAlertService(mockRepo, mockNotifier, []int{100})
+	alerts, err = service.NotifyReachedThresholds(10, "2026-10", budget)
+	assert.Error(t, err)
+	assert.Empty(t, alerts)
+}
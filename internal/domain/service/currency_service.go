@@ -0,0 +1,90 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainservice
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type CurrencyService interface {
+	FetchExchangeRates(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.ExchangeRate, error)
+	// AddExchangeRate は換算レートを登録する。同じ通貨と適用日のレートが登録済みの場合は置き換える
+	AddExchangeRate(houseHoldID domainmodel.HouseHoldID, currency string, rate string, effectiveDate string) (*domainmodel.ExchangeRate, error)
+	RemoveExchangeRate(houseHoldID domainmodel.HouseHoldID, id domainmodel.ExchangeRateID) error
+	// ChangeBaseCurrency は家計簿の基準通貨を変更する。支出・収入・予算などの金額を登録した後は変更できない
+	ChangeBaseCurrency(houseHoldID domainmodel.HouseHoldID, currency string) error
+}
+
+type currencyService struct {
+	exchangeRateRepository domainmodel.ExchangeRateRepository
+	houseHoldRepository    domainmodel.HouseHoldRepository
+}
+
+// FetchExchangeRates implements CurrencyService.
+func (s *currencyService) FetchExchangeRates(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.ExchangeRate, error) {
+	return s.exchangeRateRepository.FindExchangeRates(houseHoldID)
+}
+
+// AddExchangeRate implements CurrencyService.
+func (s *currencyService) AddExchangeRate(houseHoldID domainmodel.HouseHoldID, currency string, rate string, effectiveDate string) (*domainmodel.ExchangeRate, error) {
+	exchangeRate, err := domainmodel.NewExchangeRate(houseHoldID, currency, rate, effectiveDate)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	houseHold, err := s.houseHoldRepository.FindByHouseHoldID(houseHoldID)
+	if err != nil {
+		return nil, err
+	}
+	if exchangeRate.Currency == houseHold.BaseCurrency {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrExchangeRateForBaseCurrency.Error(), domainmodel.ErrExchangeRateForBaseCurrency)
+	}
+
+	if err := s.exchangeRateRepository.SaveExchangeRate(exchangeRate); err != nil {
+		return nil, err
+	}
+
+	return exchangeRate, nil
+}
+
+// RemoveExchangeRate implements CurrencyService.
+func (s *currencyService) RemoveExchangeRate(houseHoldID domainmodel.HouseHoldID, id domainmodel.ExchangeRateID) error {
+	if err := s.exchangeRateRepository.DeleteExchangeRate(houseHoldID, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "exchange rate not found in household", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ChangeBaseCurrency implements CurrencyService.
+func (s *currencyService) ChangeBaseCurrency(houseHoldID domainmodel.HouseHoldID, currency string) error {
+	baseCurrency, err := domainmodel.ParseCurrency(currency)
+	if err != nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	if err := s.houseHoldRepository.UpdateBaseCurrency(houseHoldID, baseCurrency); err != nil {
+		if errors.Is(err, domainmodel.ErrBaseCurrencyInUse) {
+			return apperrors.NewAppError(apperrors.ErrorCodeConflict, err.Error(), err)
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "household not found", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func NewCurrencyService(exchangeRateRepository domainmodel.ExchangeRateRepository, houseHoldRepository domainmodel.HouseHoldRepository) CurrencyService {
+	return &currencyService{
+		exchangeRateRepository: exchangeRateRepository,
+		houseHoldRepository:    houseHoldRepository,
+	}
+}
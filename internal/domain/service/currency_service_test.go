@@ -0,0 +1,106 @@
+package domainservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mock "echo-household-budget/internal/domain/mock/domainmodel"
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+)
+
+func TestCurrencyService_AddExchangeRate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	houseHold := &domainmodel.HouseHold{ID: 10, BaseCurrency: "JPY"}
+
+	tests := []struct {
+		name         string
+		currency     string
+		rate         string
+		date         string
+		mockSetup    func(*mock.MockExchangeRateRepository, *mock.MockHouseHoldRepository)
+		expectedCode apperrors.ErrorCode
+	}{
+		{
+			name:     "換算レートを登録できる",
+			currency: "usd",
+			rate:     "149.50",
+			date:     "2026-10-01",
+			mockSetup: func(e *mock.MockExchangeRateRepository, h *mock.MockHouseHoldRepository) {
+				h.EXPECT().FindByHouseHoldID(domainmodel.HouseHoldID(10)).Return(houseHold, nil)
+				e.EXPECT().SaveExchangeRate(&domainmodel.ExchangeRate{HouseHoldID: 10, Currency: "USD", Rate: "149.5", EffectiveDate: "2026-10-01"}).Return(nil)
+			},
+		},
+		{
+			name:     "基準通貨のレートは登録できない",
+			currency: "JPY",
+			rate:     "1",
+			date:     "2026-10-01",
+			mockSetup: func(e *mock.MockExchangeRateRepository, h *mock.MockHouseHoldRepository) {
+				h.EXPECT().FindByHouseHoldID(domainmodel.HouseHoldID(10)).Return(houseHold, nil)
+			},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+		{
+			name:         "0 以下のレートは登録できない",
+			currency:     "USD",
+			rate:         "-1",
+			date:         "2026-10-01",
+			mockSetup:    func(e *mock.MockExchangeRateRepository, h *mock.MockHouseHoldRepository) {},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+		{
+			name:         "適用日の形式が正しくない場合は登録できない",
+			currency:     "USD",
+			rate:         "150",
+			date:         "2026/10/01",
+			mockSetup:    func(e *mock.MockExchangeRateRepository, h *mock.MockHouseHoldRepository) {},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExchangeRateRepo := mock.NewMockExchangeRateRepository(ctrl)
+			mockHouseHoldRepo := mock.NewMockHouseHoldRepository(ctrl)
+			tt.mockSetup(mockExchangeRateRepo, mockHouseHoldRepo)
+
+			service := NewCurrencyService(mockExchangeRateRepo, mockHouseHoldRepo)
+			_, err := service.AddExchangeRate(10, tt.currency, tt.rate, tt.date)
+
+			if tt.expectedCode != "" {
+				var appErr *apperrors.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCurrencyService_ChangeBaseCurrency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHouseHoldRepo := mock.NewMockHouseHoldRepository(ctrl)
+	service := NewCurrencyService(nil, mockHouseHoldRepo)
+
+	mockHouseHoldRepo.EXPECT().UpdateBaseCurrency(domainmodel.HouseHoldID(10), domainmodel.Currency("EUR")).Return(nil)
+	assert.NoError(t, service.ChangeBaseCurrency(10, "eur"))
+
+	// 金額を登録した後は変更できない
+	mockHouseHoldRepo.EXPECT().UpdateBaseCurrency(domainmodel.HouseHoldID(10), domainmodel.Currency("USD")).Return(domainmodel.ErrBaseCurrencyInUse)
+	err := service.ChangeBaseCurrency(10, "USD")
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, apperrors.ErrorCodeConflict, appErr.Code)
+
+	err = service.ChangeBaseCurrency(10, "XXX")
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, apperrors.ErrorCodeInvalidInput, appErr.Code)
+}
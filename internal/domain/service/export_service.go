@@ -0,0 +1,64 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainservice
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+)
+
+type ExportService interface {
+	// ExportShoppingAmounts は検索条件に一致するすべての支出を、ページごとに変換して write に渡す
+	// 一致する支出がない場合も、空のページで1度は write を呼び出す
+	ExportShoppingAmounts(condition *domainmodel.ShoppingSearchCondition, write func(records []*domainmodel.ExportRecord) error) error
+}
+
+type exportService struct {
+	shoppingRepository  domainmodel.ShoppingRepository
+	categoryRepository  domainmodel.CategoryRepository
+	houseHoldRepository domainmodel.HouseHoldRepository
+}
+
+// ExportShoppingAmounts implements ExportService.
+// 検索条件の件数とカーソルは用いず、件数の上限ごとに先頭からすべてのページを取得する
+func (s *exportService) ExportShoppingAmounts(condition *domainmodel.ShoppingSearchCondition, write func(records []*domainmodel.ExportRecord) error) error {
+	page := *condition
+	page.Limit = domainmodel.MaxShoppingSearchLimit
+	page.Cursor = nil
+	if err := page.Validate(); err != nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	// 表示にはアーカイブ済みのカテゴリも含めた家計簿ごとのカテゴリ設定を用いる
+	categories, err := s.categoryRepository.FindHouseHoldCategories(page.HouseHoldID, true)
+	if err != nil {
+		return err
+	}
+	members, err := s.houseHoldRepository.FindMembers(page.HouseHoldID)
+	if err != nil {
+		return err
+	}
+	exporter := domainmodel.NewShoppingExporter(categories, members)
+
+	for {
+		shoppingAmounts, err := s.shoppingRepository.SearchShoppingAmounts(&page)
+		if err != nil {
+			return err
+		}
+		result := domainmodel.NewShoppingSearchResult(&page, shoppingAmounts)
+		if err := write(exporter.Convert(result.ShoppingAmounts)); err != nil {
+			return err
+		}
+		if !result.HasMore {
+			return nil
+		}
+		page.Cursor = domainmodel.NewShoppingSearchCursor(page.Sort, result.ShoppingAmounts[len(result.ShoppingAmounts)-1])
+	}
+}
+
+func NewExportService(shoppingRepository domainmodel.ShoppingRepository, categoryRepository domainmodel.CategoryRepository, houseHoldRepository domainmodel.HouseHoldRepository) ExportService {
+	return &exportService{
+		shoppingRepository:  shoppingRepository,
+		categoryRepository:  categoryRepository,
+		houseHoldRepository: houseHoldRepository,
+	}
+}
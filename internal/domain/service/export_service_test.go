@@ -0,0 +1,80 @@
+package domainservice
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mock "echo-household-budget/internal/domain/mock/domainmodel"
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+)
+
+func TestExportService_ExportShoppingAmounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("件数の上限ごとにカーソルで続きのページを取得し、すべての支出を書き出す", func(t *testing.T) {
+		mockShoppingRepo := mock.NewMockShoppingRepository(ctrl)
+		mockCategoryRepo := mock.NewMockCategoryRepository(ctrl)
+		mockHouseHoldRepo := mock.NewMockHouseHoldRepository(ctrl)
+
+		firstPage := domainmodel.ShoppingAmounts{}
+		for i := 1; i <= domainmodel.MaxShoppingSearchLimit+1; i++ {
+			firstPage = append(firstPage, &domainmodel.ShoppingAmount{ID: domainmodel.ShoppingID(i), CategoryID: 1, Date: "2026-10-01", Amount: 100})
+		}
+		lastPage := domainmodel.ShoppingAmounts{
+			{ID: 101, CategoryID: 1, Date: "2026-10-01", Amount: 100},
+		}
+
+		condition := domainmodel.NewShoppingSearchCondition(10)
+		condition.Sort = domainmodel.ShoppingSearchSortDateAsc
+		condition.Limit = 10
+		gomock.InOrder(
+			mockShoppingRepo.EXPECT().SearchShoppingAmounts(gomock.Any()).DoAndReturn(func(page *domainmodel.ShoppingSearchCondition) (domainmodel.ShoppingAmounts, error) {
+				assert.Equal(t, domainmodel.MaxShoppingSearchLimit, page.Limit)
+				assert.Nil(t, page.Cursor)
+				return firstPage, nil
+			}),
+			mockShoppingRepo.EXPECT().SearchShoppingAmounts(gomock.Any()).DoAndReturn(func(page *domainmodel.ShoppingSearchCondition) (domainmodel.ShoppingAmounts, error) {
+				assert.Equal(t, &domainmodel.ShoppingSearchCursor{Sort: domainmodel.ShoppingSearchSortDateAsc, Date: "2026-10-01", ID: 100}, page.Cursor)
+				return lastPage, nil
+			}),
+		)
+		mockCategoryRepo.EXPECT().FindHouseHoldCategories(domainmodel.HouseHoldID(10), true).Return([]*domainmodel.CategoryLimit{
+			{Category: domainmodel.Category{ID: 1, Name: "食料品"}},
+		}, nil)
+		mockHouseHoldRepo.EXPECT().FindMembers(domainmodel.HouseHoldID(10)).Return([]*domainmodel.HouseHoldMember{}, nil)
+
+		service := NewExportService(mockShoppingRepo, mockCategoryRepo, mockHouseHoldRepo)
+		pages := [][]*domainmodel.ExportRecord{}
+		err := service.ExportShoppingAmounts(condition, func(records []*domainmodel.ExportRecord) error {
+			pages = append(pages, records)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Len(t, pages, 2)
+		assert.Len(t, pages[0], domainmodel.MaxShoppingSearchLimit)
+		assert.Len(t, pages[1], 1)
+		assert.Equal(t, "食料品", pages[1][0].Category)
+		// 呼び出し元の検索条件は変更しない
+		assert.Equal(t, 10, condition.Limit)
+	})
+
+	t.Run("不正な検索条件では書き出さない", func(t *testing.T) {
+		condition := domainmodel.NewShoppingSearchCondition(10)
+		condition.From = "2026-10-31"
+		condition.To = "2026-10-01"
+
+		service := NewExportService(nil, nil, nil)
+		err := service.ExportShoppingAmounts(condition, func(records []*domainmodel.ExportRecord) error {
+			t.Fatal("write must not be called")
+			return nil
+		})
+		var appErr *apperrors.AppError
+		assert.True(t, errors.As(err, &appErr))
+		assert.Equal(t, apperrors.ErrorCodeInvalidInput, appErr.Code)
+	})
+}
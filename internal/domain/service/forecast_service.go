@@ -0,0 +1,66 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainservice
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"time"
+)
+
+type ForecastService interface {
+	// ForecastMonthEnd は基準日の月の月末の支出をカテゴリごとに予測する
+	ForecastMonthEnd(houseHoldID domainmodel.HouseHoldID, asOf time.Time) (*domainmodel.SpendingForecast, error)
+}
+
+type forecastService struct {
+	reportRepository        domainmodel.ReportRepository
+	shoppingRepository      domainmodel.ShoppingRepository
+	categoryRepository      domainmodel.CategoryRepository
+	monthlyBudgetRepository domainmodel.MonthlyBudgetRepository
+}
+
+// ForecastMonthEnd implements ForecastService.
+// 予算は未登録の月も登録せずに前月までの予算を引き継いで算出する
+func (s *forecastService) ForecastMonthEnd(houseHoldID domainmodel.HouseHoldID, asOf time.Time) (*domainmodel.SpendingForecast, error) {
+	month := domainmodel.BudgetMonthOf(asOf)
+
+	results, err := s.shoppingRepository.FetchShoppingAmountItemByHouseholdID(houseHoldID, asOf.Format("2006-01-02"))
+	if err != nil {
+		return nil, err
+	}
+	shoppingAmounts := domainmodel.ShoppingAmounts{}
+	for _, v := range results {
+		shoppingAmounts = append(shoppingAmounts, domainmodel.ConvertShoppingAmountsToShoppingAmount(v))
+	}
+
+	historyFrom, historyTo := domainmodel.ForecastHistoryRange(asOf)
+	history, err := s.reportRepository.SummarizeDayOfMonthSplits(houseHoldID, historyFrom, historyTo, asOf.Day())
+	if err != nil {
+		return nil, err
+	}
+
+	// 表示にはアーカイブ済みのカテゴリも含めた家計簿ごとのカテゴリ設定を用いる
+	categories, err := s.categoryRepository.FindHouseHoldCategories(houseHoldID, true)
+	if err != nil {
+		return nil, err
+	}
+	budgets, err := s.monthlyBudgetRepository.FindByHouseHoldID(houseHoldID, month)
+	if err != nil {
+		return nil, err
+	}
+	actuals, err := s.shoppingRepository.SummarizeShoppingAmountByMonth(houseHoldID, month)
+	if err != nil {
+		return nil, err
+	}
+	categoryBudgets := domainmodel.NewBudgetHistory(budgets, actuals).Summarize(categories, month)
+
+	return domainmodel.NewSpendingForecast(asOf, shoppingAmounts, history, categories, categoryBudgets), nil
+}
+
+func NewForecastService(reportRepository domainmodel.ReportRepository, shoppingRepository domainmodel.ShoppingRepository, categoryRepository domainmodel.CategoryRepository, monthlyBudgetRepository domainmodel.MonthlyBudgetRepository) ForecastService {
+	return &forecastService{
+		reportRepository:        reportRepository,
+		shoppingRepository:      shoppingRepository,
+		categoryRepository:      categoryRepository,
+		monthlyBudgetRepository: monthlyBudgetRepository,
+	}
+}
@@ -0,0 +1,45 @@
+package domainservice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mock "echo-household-budget/internal/domain/mock/domainmodel"
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+)
+
+func TestForecastService_ForecastMonthEnd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	asOf := time.Date(2026, 10, 10, 0, 0, 0, 0, time.Local)
+	mockReportRepo := mock.NewMockReportRepository(ctrl)
+	mockShoppingRepo := mock.NewMockShoppingRepository(ctrl)
+	mockCategoryRepo := mock.NewMockCategoryRepository(ctrl)
+	mockBudgetRepo := mock.NewMockMonthlyBudgetRepository(ctrl)
+
+	mockShoppingRepo.EXPECT().FetchShoppingAmountItemByHouseholdID(domainmodel.HouseHoldID(10), "2026-10-10").Return([]*models.ShoppingAmount{
+		{CategoryID: 1, Amount: 5000, Date: time.Date(2026, 10, 5, 0, 0, 0, 0, time.Local)},
+	}, nil)
+	mockReportRepo.EXPECT().SummarizeDayOfMonthSplits(domainmodel.HouseHoldID(10), "2026-07", "2026-09", 10).Return([]*domainmodel.DayOfMonthSplit{}, nil)
+	mockCategoryRepo.EXPECT().FindHouseHoldCategories(domainmodel.HouseHoldID(10), true).Return([]*domainmodel.CategoryLimit{
+		{Category: domainmodel.Category{ID: 1, Name: "食費"}, LimitAmount: 30000},
+	}, nil)
+	mockBudgetRepo.EXPECT().FindByHouseHoldID(domainmodel.HouseHoldID(10), "2026-10").Return([]*domainmodel.MonthlyBudget{
+		{CategoryID: 1, Month: "2026-10", Amount: 12000},
+	}, nil)
+	mockShoppingRepo.EXPECT().SummarizeShoppingAmountByMonth(domainmodel.HouseHoldID(10), "2026-10").Return([]*domainmodel.MonthlyCategoryAmount{}, nil)
+
+	service := NewForecastService(mockReportRepo, mockShoppingRepo, mockCategoryRepo, mockBudgetRepo)
+	forecast, err := service.ForecastMonthEnd(10, asOf)
+	assert.NoError(t, err)
+	assert.Len(t, forecast.Categories, 1)
+	// 10日で5000円のペースで月末まで支出すると 15500 円になり、予算 12000 円を超える
+	assert.Equal(t, 15500, forecast.Categories[0].Projected)
+	assert.Equal(t, 12000, forecast.Categories[0].Budget)
+	assert.True(t, forecast.Categories[0].IsOverBudget)
+}
@@ -2,47 +2,188 @@ package domainservice
 
 import (
 	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/domain/repository"
 	"echo-household-budget/internal/infrastructure/persistence/models"
+	apperrors "echo-household-budget/internal/shared/errors"
 	"errors"
+	"log"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type HouseHoldService interface {
 	FetchHouseHold(houseHoldID domainmodel.HouseHoldID) (*domainmodel.HouseHold, error)
-	ShareHouseHold(houseHoldID domainmodel.HouseHoldID, inviteUserID domainmodel.UserID) error
+	FetchUserHouseHolds(userID domainmodel.UserID) ([]*domainmodel.BelongingHouseHold, error)
 	FetchShoppingAmount(input FetchShoppingRecordInput) ([]*domainmodel.ShoppingAmount, error)
 	AddUserHouseHold(houseHold *domainmodel.HouseHold) error
-	AddHouseHoldCategory(houseHoldID domainmodel.HouseHoldID, categoryName string, categoryLimitAmount int) error
+	// カテゴリ管理
+	FetchHouseHoldCategories(houseHoldID domainmodel.HouseHoldID, includeArchived bool) ([]*domainmodel.CategoryLimit, error)
+	AddHouseHoldCategory(categoryLimit *domainmodel.CategoryLimit) error
+	UpdateHouseHoldCategory(categoryLimit *domainmodel.CategoryLimit) error
+	ReorderHouseHoldCategories(houseHoldID domainmodel.HouseHoldID, categoryLimitIDs []domainmodel.CategoryLimitID) error
+	ArchiveHouseHoldCategory(houseHoldID domainmodel.HouseHoldID, categoryLimitID domainmodel.CategoryLimitID, archived bool) error
+	// RemoveHouseHoldCategory はカテゴリを operatorID のメンバーの削除としてゴミ箱に移す。カテゴリの支出は削除しない
+	RemoveHouseHoldCategory(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID, categoryLimitID domainmodel.CategoryLimitID) error
+	// 予算管理
+	FetchMonthlyBudgets(houseHoldID domainmodel.HouseHoldID, month string) (domainmodel.CategoryBudgets, error)
+	SetMonthlyBudget(budget *domainmodel.MonthlyBudget) error
 	CreateShoppingAmount(shoppingAmount *domainmodel.ShoppingAmount) error
 	UpdateShoppingAmount(shoppingAmount *domainmodel.ShoppingAmount) error
-	RemoveShoppingAmount(shoppingAmountID domainmodel.ShoppingID) error
+	// RemoveShoppingAmount は支出を operatorID のメンバーの削除としてゴミ箱に移す
+	RemoveShoppingAmount(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID, shoppingAmountID domainmodel.ShoppingID) error
 	SummarizeShoppingAmount(input FetchShoppingRecordInput) (*domainmodel.SummarizeShoppingAmounts, error)
+	// SearchShoppingAmount は検索条件に一致する支出をカーソルで区切って取得する
+	SearchShoppingAmount(condition *domainmodel.ShoppingSearchCondition) (*domainmodel.ShoppingSearchResult, error)
+	// メンバー管理
+	ChangeMemberRole(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID, targetUserID domainmodel.UserID, role domainmodel.HouseHoldRole) error
+	TransferOwnership(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID, newOwnerID domainmodel.UserID) error
+	FetchMembers(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.HouseHoldMember, error)
+	LeaveHouseHold(houseHoldID domainmodel.HouseHoldID, userID domainmodel.UserID) error
+	RemoveMember(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID, targetUserID domainmodel.UserID) error
+	DeleteHouseHold(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID) error
 }
 
 type houseHoldService struct {
-	houseHoldRepository domainmodel.HouseHoldRepository
-	shoppingRepository  domainmodel.ShoppingRepository
-	categoryRepository  domainmodel.CategoryRepository
+	houseHoldRepository     domainmodel.HouseHoldRepository
+	shoppingRepository      domainmodel.ShoppingRepository
+	categoryRepository      domainmodel.CategoryRepository
+	monthlyBudgetRepository domainmodel.MonthlyBudgetRepository
+	budgetAlertService      BudgetAlertService
+	incomeRepository        domainmodel.IncomeRepository
+	paymentMethodRepository domainmodel.PaymentMethodRepository
+	tagRepository           domainmodel.TagRepository
+	exchangeRateRepository  domainmodel.ExchangeRateRepository
+	fileStorage             repository.FileStorageRepository
+}
+
+// FetchHouseHoldCategories implements HouseHoldService.
+func (h *houseHoldService) FetchHouseHoldCategories(houseHoldID domainmodel.HouseHoldID, includeArchived bool) ([]*domainmodel.CategoryLimit, error) {
+	return h.categoryRepository.FindHouseHoldCategories(houseHoldID, includeArchived)
 }
 
 // AddHouseHoldCategory implements HouseHoldService.
-func (h *houseHoldService) AddHouseHoldCategory(houseHoldID domainmodel.HouseHoldID, categoryName string, categoryLimitAmount int) error {
-	category := &domainmodel.Category{
-		Name:  categoryName,
-		Color: "#000000",
+// 追加したカテゴリは並び順の末尾に配置する
+func (h *houseHoldService) AddHouseHoldCategory(categoryLimit *domainmodel.CategoryLimit) error {
+	if err := categoryLimit.Validate(); err != nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	categories, err := h.categoryRepository.FindHouseHoldCategories(categoryLimit.HouseholdBookID, true)
+	if err != nil {
+		return err
+	}
+	sortOrder := 0
+	for _, category := range categories {
+		if category.SortOrder > sortOrder {
+			sortOrder = category.SortOrder
+		}
 	}
 
+	category := &domainmodel.Category{
+		Name:  categoryLimit.Category.Name,
+		Color: categoryLimit.Category.Color,
+		Icon:  categoryLimit.Category.Icon,
+	}
 	if err := h.categoryRepository.CreateMasterCategory(category); err != nil {
 		return err
 	}
 
-	categoryLimit := &domainmodel.CategoryLimit{
-		HouseholdBookID: houseHoldID,
-		Category:        *category,
-		LimitAmount:     categoryLimitAmount,
+	categoryLimit.Category = *category
+	categoryLimit.SortOrder = sortOrder + 1
+
+	return h.categoryRepository.CreateHouseHoldCategory(categoryLimit)
+}
+
+// UpdateHouseHoldCategory implements HouseHoldService.
+func (h *houseHoldService) UpdateHouseHoldCategory(categoryLimit *domainmodel.CategoryLimit) error {
+	if err := categoryLimit.Validate(); err != nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	if err := h.categoryRepository.UpdateHouseHoldCategory(categoryLimit); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "category not found in household", err)
+		}
+		return err
+	}
+
+	// 上限金額の変更は今月以降の予算にのみ反映し、過去の月の予算は変更しない
+	updated, err := h.categoryRepository.FindHouseHoldCategoryByHouseHoldID(categoryLimit.ID)
+	if err != nil {
+		return err
+	}
+	if updated == nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "category not found in household", nil)
+	}
+
+	month := domainmodel.BudgetMonthOf(time.Now())
+	budgets, err := h.monthlyBudgetRepository.FindByHouseHoldID(categoryLimit.HouseholdBookID, month)
+	if err != nil {
+		return err
+	}
+	budget := &domainmodel.MonthlyBudget{
+		HouseHoldID: categoryLimit.HouseholdBookID,
+		CategoryID:  updated.Category.ID,
+		Month:       month,
+		Amount:      categoryLimit.LimitAmount,
+	}
+	if effective := domainmodel.NewBudgetHistory(budgets, nil).EffectiveBudget(updated.Category.ID, month); effective != nil {
+		budget.Rollover = effective.Rollover
+	}
+
+	return h.monthlyBudgetRepository.SaveMonthlyBudget(budget)
+}
+
+// ReorderHouseHoldCategories implements HouseHoldService.
+// 指定されたカテゴリの順に並び順を振り直す
+func (h *houseHoldService) ReorderHouseHoldCategories(houseHoldID domainmodel.HouseHoldID, categoryLimitIDs []domainmodel.CategoryLimitID) error {
+	if len(categoryLimitIDs) == 0 {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, "category order is empty", nil)
+	}
+	seen := make(map[domainmodel.CategoryLimitID]struct{}, len(categoryLimitIDs))
+	for _, categoryLimitID := range categoryLimitIDs {
+		if _, ok := seen[categoryLimitID]; ok {
+			return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, "category order contains duplicates", nil)
+		}
+		seen[categoryLimitID] = struct{}{}
+	}
+
+	if err := h.categoryRepository.UpdateHouseHoldCategorySortOrders(houseHoldID, categoryLimitIDs); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "category not found in household", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ArchiveHouseHoldCategory implements HouseHoldService.
+// アーカイブしたカテゴリは入力の選択肢から外れるが、過去の記録は削除せず集計に残す
+func (h *houseHoldService) ArchiveHouseHoldCategory(houseHoldID domainmodel.HouseHoldID, categoryLimitID domainmodel.CategoryLimitID, archived bool) error {
+	var archivedAt *time.Time
+	if archived {
+		now := time.Now()
+		archivedAt = &now
+	}
+
+	if err := h.categoryRepository.ArchiveHouseHoldCategory(houseHoldID, categoryLimitID, archivedAt); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "category not found in household", err)
+		}
+		return err
 	}
 
-	if err := h.categoryRepository.CreateHouseHoldCategory(categoryLimit); err != nil {
+	return nil
+}
+
+// RemoveHouseHoldCategory implements HouseHoldService.
+func (h *houseHoldService) RemoveHouseHoldCategory(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID, categoryLimitID domainmodel.CategoryLimitID) error {
+	if err := h.categoryRepository.DeleteHouseHoldCategory(houseHoldID, categoryLimitID, domainmodel.NewDeletion(&operatorID)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "category not found in household", err)
+		}
 		return err
 	}
 
@@ -58,6 +199,7 @@ func (h *houseHoldService) AddUserHouseHold(houseHold *domainmodel.HouseHold) er
 	userHouseHold := &domainmodel.UserHouseHold{
 		HouseHoldID: houseHold.ID,
 		UserID:      houseHold.UserID,
+		Role:        domainmodel.HouseHoldRoleOwner,
 	}
 
 	if err := h.houseHoldRepository.CreateUserHouseHold(userHouseHold); err != nil {
@@ -70,6 +212,8 @@ func (h *houseHoldService) AddUserHouseHold(houseHold *domainmodel.HouseHold) er
 type FetchShoppingRecordInput struct {
 	HouseholdID domainmodel.HouseHoldID
 	Date        string
+	// WeekStart は週ごとの内訳とヒートマップで週の始まりとする曜日
+	WeekStart time.Weekday
 }
 
 // SummarizeShoppingAmount implements HouseHoldService.
@@ -84,7 +228,94 @@ func (h *houseHoldService) SummarizeShoppingAmount(input FetchShoppingRecordInpu
 		shoppingAmounts = append(shoppingAmounts, v)
 	}
 
-	return domainmodel.NewSummarizeShoppingAmounts(shoppingAmounts), nil
+	date, err := time.Parse("2006-01-02", input.Date)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, "date must be in YYYY-MM-DD format", err)
+	}
+	month := domainmodel.BudgetMonthOf(date)
+	budgets, err := h.FetchMonthlyBudgets(input.HouseholdID, month)
+	if err != nil {
+		return nil, err
+	}
+	incomes, err := h.incomeRepository.FindIncomes(input.HouseholdID, month)
+	if err != nil {
+		return nil, err
+	}
+	totalIncome := 0
+	for _, income := range incomes {
+		totalIncome += income.Amount
+	}
+
+	houseHold, err := h.houseHoldRepository.FindByHouseHoldID(input.HouseholdID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := domainmodel.NewSummarizeShoppingAmounts(shoppingAmounts)
+	summary.Currency = houseHold.BaseCurrency
+	summary.ApplyBudgets(budgets)
+	summary.Balance = domainmodel.NewMonthlyBalance(totalIncome, summary.TotalAmount)
+	summary.PaymentMethodAmounts = domainmodel.NewPaymentMethodAmounts(shoppingAmounts, incomes)
+	summary.ApplyBreakdown(month, input.WeekStart)
+
+	return summary, nil
+}
+
+// FetchMonthlyBudgets implements HouseHoldService.
+// 指定月の予算が未登録の場合は前月までの予算を引き継いで登録する（未来の月は登録せずに算出のみ行う）
+func (h *houseHoldService) FetchMonthlyBudgets(houseHoldID domainmodel.HouseHoldID, month string) (domainmodel.CategoryBudgets, error) {
+	if _, err := domainmodel.ParseBudgetMonth(month); err != nil {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	categories, err := h.categoryRepository.FindHouseHoldCategories(houseHoldID, false)
+	if err != nil {
+		return nil, err
+	}
+	budgets, err := h.monthlyBudgetRepository.FindByHouseHoldID(houseHoldID, month)
+	if err != nil {
+		return nil, err
+	}
+	actuals, err := h.shoppingRepository.SummarizeShoppingAmountByMonth(houseHoldID, month)
+	if err != nil {
+		return nil, err
+	}
+
+	history := domainmodel.NewBudgetHistory(budgets, actuals)
+	if missing := history.MissingBudgets(houseHoldID, categories, month); len(missing) > 0 {
+		if month <= domainmodel.BudgetMonthOf(time.Now()) {
+			if err := h.monthlyBudgetRepository.CreateMonthlyBudgets(missing); err != nil {
+				return nil, err
+			}
+		}
+		history = domainmodel.NewBudgetHistory(append(budgets, missing...), actuals)
+	}
+
+	return history.Summarize(categories, month), nil
+}
+
+// SetMonthlyBudget implements HouseHoldService.
+func (h *houseHoldService) SetMonthlyBudget(budget *domainmodel.MonthlyBudget) error {
+	if err := budget.Validate(); err != nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	categories, err := h.categoryRepository.FindHouseHoldCategories(budget.HouseHoldID, true)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, category := range categories {
+		if category.Category.ID == budget.CategoryID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "category not found in household", nil)
+	}
+
+	return h.monthlyBudgetRepository.SaveMonthlyBudget(budget)
 }
 
 // CreateShoppingAmount implements HouseHoldService.
@@ -93,6 +324,23 @@ func (h *houseHoldService) CreateShoppingAmount(shoppingAmount *domainmodel.Shop
 	if err != nil {
 		return errors.New("domainservice::CreateShoppingAmount failed to parse date")
 	}
+	if err := validateShoppingCategory(h.categoryRepository, shoppingAmount.HouseholdID, shoppingAmount.CategoryID); err != nil {
+		return err
+	}
+	if err := validatePaymentMethod(h.paymentMethodRepository, shoppingAmount.HouseholdID, shoppingAmount.PaymentMethodID); err != nil {
+		return err
+	}
+	shoppingAmount.TagIDs = domainmodel.UniqueTagIDs(shoppingAmount.TagIDs)
+	if err := validateTags(h.tagRepository, shoppingAmount.HouseholdID, shoppingAmount.TagIDs); err != nil {
+		return err
+	}
+	// 負担額の合計は基準通貨に換算した金額で検証する
+	if err := h.applyExchangeRate(shoppingAmount); err != nil {
+		return err
+	}
+	if err := h.validateShoppingSplit(shoppingAmount); err != nil {
+		return err
+	}
 	model := &models.ShoppingAmount{
 		HouseholdBookID: uint(shoppingAmount.HouseholdID),
 		CategoryID:      uint(shoppingAmount.CategoryID),
@@ -100,9 +348,45 @@ func (h *houseHoldService) CreateShoppingAmount(shoppingAmount *domainmodel.Shop
 		Date:            date,
 		Memo:            shoppingAmount.Memo,
 		AnalyzeID:       shoppingAmount.AnalyzeID,
+		PaidBy:          paidByModel(shoppingAmount.PaidBy),
+		SplitType:       string(shoppingAmount.SplitType),
+		Splits:          splitModels(shoppingAmount.Shares),
+		PaymentMethodID: paymentMethodModel(shoppingAmount.PaymentMethodID),
+		Tags:            tagModels(shoppingAmount.TagIDs),
+	}
+	applyOriginalAmountModel(model, shoppingAmount)
+
+	if err := h.shoppingRepository.RegisterShoppingAmount(model); err != nil {
+		return err
+	}
+
+	h.notifyBudgetAlerts(shoppingAmount.HouseholdID, shoppingAmount.CategoryID, date)
+
+	return nil
+}
+
+// notifyBudgetAlerts は支出を登録したカテゴリの予算消化率を確認し、閾値に達した場合に通知する
+// 通知に失敗しても支出の登録は取り消さない
+func (h *houseHoldService) notifyBudgetAlerts(houseHoldID domainmodel.HouseHoldID, categoryID domainmodel.CategoryID, date time.Time) {
+	if h.budgetAlertService == nil {
+		return
+	}
+
+	month := domainmodel.BudgetMonthOf(date)
+	budgets, err := h.FetchMonthlyBudgets(houseHoldID, month)
+	if err != nil {
+		log.Printf("予算アラートの確認に失敗しました: %v", err)
+		return
 	}
 
-	return h.shoppingRepository.RegisterShoppingAmount(model)
+	for _, budget := range budgets {
+		if budget.Category.ID != categoryID {
+			continue
+		}
+		if _, err := h.budgetAlertService.NotifyReachedThresholds(houseHoldID, month, budget); err != nil {
+			log.Printf("予算アラートの通知に失敗しました: %v", err)
+		}
+	}
 }
 
 // UpdateShoppingAmount implements HouseHoldService.
@@ -111,15 +395,158 @@ func (h *houseHoldService) UpdateShoppingAmount(shoppingAmount *domainmodel.Shop
 	if err != nil {
 		return errors.New("domainservice::UpdateShoppingAmount failed to parse date")
 	}
+	if err := validateShoppingCategory(h.categoryRepository, shoppingAmount.HouseholdID, shoppingAmount.CategoryID); err != nil {
+		return err
+	}
+	if err := validatePaymentMethod(h.paymentMethodRepository, shoppingAmount.HouseholdID, shoppingAmount.PaymentMethodID); err != nil {
+		return err
+	}
+	shoppingAmount.TagIDs = domainmodel.UniqueTagIDs(shoppingAmount.TagIDs)
+	if err := validateTags(h.tagRepository, shoppingAmount.HouseholdID, shoppingAmount.TagIDs); err != nil {
+		return err
+	}
+	// 負担額の合計は基準通貨に換算した金額で検証する
+	if err := h.applyExchangeRate(shoppingAmount); err != nil {
+		return err
+	}
+	if err := h.validateShoppingSplit(shoppingAmount); err != nil {
+		return err
+	}
 	model := &models.ShoppingAmount{
 		Base:            models.Base{ID: uint(shoppingAmount.ID)},
+		HouseholdBookID: uint(shoppingAmount.HouseholdID),
 		CategoryID:      uint(shoppingAmount.CategoryID),
 		Amount:          shoppingAmount.Amount,
 		Date:            date,
 		Memo:            shoppingAmount.Memo,
+		PaidBy:          paidByModel(shoppingAmount.PaidBy),
+		SplitType:       string(shoppingAmount.SplitType),
+		Splits:          splitModels(shoppingAmount.Shares),
+		PaymentMethodID: paymentMethodModel(shoppingAmount.PaymentMethodID),
+		Tags:            tagModels(shoppingAmount.TagIDs),
 	}
+	applyOriginalAmountModel(model, shoppingAmount)
 
-	return h.shoppingRepository.UpdateShoppingAmount(model)
+	if err := h.shoppingRepository.UpdateShoppingAmount(model); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "shopping amount not found in household", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// validateShoppingSplit は支払ったメンバーと負担方法を検証する。負担方法の指定がない場合は均等とする
+// 参加者を指定しない均等の負担は、現在のメンバーを参加者として保存する
+func (h *houseHoldService) validateShoppingSplit(shoppingAmount *domainmodel.ShoppingAmount) error {
+	if shoppingAmount.SplitType == "" {
+		shoppingAmount.SplitType = domainmodel.SplitEqual
+	}
+
+	memberIDs := []domainmodel.UserID{}
+	if shoppingAmount.PaidBy != nil || len(shoppingAmount.Shares) > 0 {
+		members, err := h.houseHoldRepository.FindMembers(shoppingAmount.HouseholdID)
+		if err != nil {
+			return err
+		}
+		for _, member := range members {
+			memberIDs = append(memberIDs, member.UserID)
+		}
+	}
+
+	if err := shoppingAmount.ValidateSplit(memberIDs); err != nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+	shoppingAmount.FillEqualSplitParticipants(memberIDs)
+
+	return nil
+}
+
+// validateShoppingCategory は支出のカテゴリが家計簿で利用中のカテゴリであるかを検証する
+// 他の家計簿のカテゴリ、アーカイブしたカテゴリ、ゴミ箱に移したカテゴリには支出を登録できない
+func validateShoppingCategory(repository domainmodel.CategoryRepository, houseHoldID domainmodel.HouseHoldID, categoryID domainmodel.CategoryID) error {
+	categories, err := repository.FindHouseHoldCategories(houseHoldID, false)
+	if err != nil {
+		return err
+	}
+	for _, category := range categories {
+		if category.Category.ID == categoryID {
+			return nil
+		}
+	}
+
+	return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "category not found in household", nil)
+}
+
+// applyExchangeRate は基準通貨以外で記録した支出を、支出日に適用する換算レートで基準通貨に換算する
+func (h *houseHoldService) applyExchangeRate(shoppingAmount *domainmodel.ShoppingAmount) error {
+	if shoppingAmount.Original == nil {
+		return nil
+	}
+	currency, err := domainmodel.ParseCurrency(string(shoppingAmount.Original.Currency))
+	if err != nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+	shoppingAmount.Original.Currency = currency
+
+	houseHold, err := h.houseHoldRepository.FindByHouseHoldID(shoppingAmount.HouseholdID)
+	if err != nil {
+		return err
+	}
+	if shoppingAmount.Original.Currency == houseHold.BaseCurrency {
+		return shoppingAmount.ApplyExchangeRate(houseHold.BaseCurrency, "")
+	}
+
+	rate, err := h.exchangeRateRepository.FindEffectiveExchangeRate(shoppingAmount.HouseholdID, shoppingAmount.Original.Currency, shoppingAmount.Date)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrExchangeRateNotFound.Error(), domainmodel.ErrExchangeRateNotFound)
+		}
+		return err
+	}
+	if err := shoppingAmount.ApplyExchangeRate(houseHold.BaseCurrency, rate.Rate); err != nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	return nil
+}
+
+// applyOriginalAmountModel は記録した通貨の金額と換算レートを保存するモデルに設定する
+func applyOriginalAmountModel(model *models.ShoppingAmount, shoppingAmount *domainmodel.ShoppingAmount) {
+	if shoppingAmount.Original == nil {
+		return
+	}
+	amount := shoppingAmount.Original.Amount
+	currency := string(shoppingAmount.Original.Currency)
+	rate := shoppingAmount.ExchangeRate
+	model.OriginalAmount = &amount
+	model.OriginalCurrency = &currency
+	model.ExchangeRate = &rate
+}
+
+func paymentMethodModel(paymentMethodID *domainmodel.PaymentMethodID) *uint {
+	if paymentMethodID == nil {
+		return nil
+	}
+	id := uint(*paymentMethodID)
+	return &id
+}
+
+func paidByModel(paidBy *domainmodel.UserID) *uint {
+	if paidBy == nil {
+		return nil
+	}
+	userID := uint(*paidBy)
+	return &userID
+}
+
+func splitModels(shares []domainmodel.ExpenseShare) []models.ShoppingAmountSplit {
+	splits := make([]models.ShoppingAmountSplit, len(shares))
+	for i, share := range shares {
+		splits[i] = models.ShoppingAmountSplit{UserID: uint(share.UserID), Value: share.Value}
+	}
+	return splits
 }
 
 // FetchShoppingAmount implements HouseHoldService.
@@ -133,12 +560,208 @@ func (h *houseHoldService) FetchShoppingAmount(input FetchShoppingRecordInput) (
 	for _, v := range shoppingAmount {
 		shoppingAmounts = append(shoppingAmounts, domainmodel.ConvertShoppingAmountsToShoppingAmount(v))
 	}
+	if err := h.applyHouseHoldCategories(input.HouseholdID, shoppingAmounts); err != nil {
+		return nil, err
+	}
 	return shoppingAmounts, nil
 }
 
+// SearchShoppingAmount implements HouseHoldService.
+func (h *houseHoldService) SearchShoppingAmount(condition *domainmodel.ShoppingSearchCondition) (*domainmodel.ShoppingSearchResult, error) {
+	if err := condition.Validate(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	shoppingAmounts, err := h.shoppingRepository.SearchShoppingAmounts(condition)
+	if err != nil {
+		return nil, err
+	}
+
+	result := domainmodel.NewShoppingSearchResult(condition, shoppingAmounts)
+	if err := h.applyHouseHoldCategories(condition.HouseHoldID, result.ShoppingAmounts); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// applyHouseHoldCategories は支出のカテゴリの表示を家計簿ごとのカテゴリ設定に置き換える
+// 表示にはマスタではなく家計簿ごとのカテゴリ設定を用いる（アーカイブ済みのカテゴリも含む）
+func (h *houseHoldService) applyHouseHoldCategories(houseHoldID domainmodel.HouseHoldID, shoppingAmounts []*domainmodel.ShoppingAmount) error {
+	categories, err := h.categoryRepository.FindHouseHoldCategories(houseHoldID, true)
+	if err != nil {
+		return err
+	}
+	categoryMap := make(map[domainmodel.CategoryID]domainmodel.Category, len(categories))
+	for _, category := range categories {
+		categoryMap[category.Category.ID] = category.Category
+	}
+
+	for _, shoppingAmount := range shoppingAmounts {
+		if category, ok := categoryMap[shoppingAmount.CategoryID]; ok {
+			shoppingAmount.Category = category
+		}
+	}
+	return nil
+}
+
 // RemoveShoppingAmount implements HouseHoldService.
-func (h *houseHoldService) RemoveShoppingAmount(shoppingAmountID domainmodel.ShoppingID) error {
-	return h.shoppingRepository.DeleteShoppingAmount(shoppingAmountID)
+func (h *houseHoldService) RemoveShoppingAmount(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID, shoppingAmountID domainmodel.ShoppingID) error {
+	if err := h.shoppingRepository.DeleteShoppingAmount(houseHoldID, shoppingAmountID, domainmodel.NewDeletion(&operatorID)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "shopping amount not found in household", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ChangeMemberRole implements HouseHoldService.
+// 所有者のみ実行可能。所有者のロールは TransferOwnership でのみ変更できる
+func (h *houseHoldService) ChangeMemberRole(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID, targetUserID domainmodel.UserID, role domainmodel.HouseHoldRole) error {
+	if err := h.authorize(houseHoldID, operatorID, domainmodel.HouseHoldPermissionManageMember); err != nil {
+		return err
+	}
+
+	if role == domainmodel.HouseHoldRoleOwner {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrOwnerRoleChange.Error(), domainmodel.ErrOwnerRoleChange)
+	}
+
+	target, err := h.findMember(houseHoldID, targetUserID)
+	if err != nil {
+		return err
+	}
+	if target.Role == domainmodel.HouseHoldRoleOwner {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrOwnerRoleChange.Error(), domainmodel.ErrOwnerRoleChange)
+	}
+
+	if err := h.houseHoldRepository.UpdateUserHouseHoldRole(houseHoldID, targetUserID, role); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, domainmodel.ErrNotHouseHoldMember.Error(), err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// TransferOwnership implements HouseHoldService.
+// 所有者のみ実行可能。元の所有者は編集者になる
+func (h *houseHoldService) TransferOwnership(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID, newOwnerID domainmodel.UserID) error {
+	if err := h.authorize(houseHoldID, operatorID, domainmodel.HouseHoldPermissionManageMember); err != nil {
+		return err
+	}
+
+	if operatorID == newOwnerID {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, "user is already the owner", nil)
+	}
+
+	if _, err := h.findMember(houseHoldID, newOwnerID); err != nil {
+		return err
+	}
+
+	if err := h.houseHoldRepository.TransferOwnership(houseHoldID, operatorID, newOwnerID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, domainmodel.ErrNotHouseHoldMember.Error(), err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// FetchMembers implements HouseHoldService.
+func (h *houseHoldService) FetchMembers(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.HouseHoldMember, error) {
+	return h.houseHoldRepository.FindMembers(houseHoldID)
+}
+
+// LeaveHouseHold implements HouseHoldService.
+// 所有者は脱退できないため、所有権を移譲するか家計簿を削除する
+func (h *houseHoldService) LeaveHouseHold(houseHoldID domainmodel.HouseHoldID, userID domainmodel.UserID) error {
+	member, err := h.findMember(houseHoldID, userID)
+	if err != nil {
+		return err
+	}
+	if member.Role == domainmodel.HouseHoldRoleOwner {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrOwnerCannotLeave.Error(), domainmodel.ErrOwnerCannotLeave)
+	}
+
+	return h.deleteMember(houseHoldID, userID)
+}
+
+// RemoveMember implements HouseHoldService.
+// 所有者のみ実行可能。自分自身は LeaveHouseHold で脱退する
+func (h *houseHoldService) RemoveMember(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID, targetUserID domainmodel.UserID) error {
+	if err := h.authorize(houseHoldID, operatorID, domainmodel.HouseHoldPermissionManageMember); err != nil {
+		return err
+	}
+
+	if operatorID == targetUserID {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrOwnerCannotLeave.Error(), domainmodel.ErrOwnerCannotLeave)
+	}
+
+	return h.deleteMember(houseHoldID, targetUserID)
+}
+
+// DeleteHouseHold implements HouseHoldService.
+// 所有者のみ実行可能。家計簿に紐づくデータは全て削除される
+// レシートの画像は家計簿の削除を確定した後にストレージから削除する。画像の削除に失敗しても家計簿は削除済みのため、記録のみ行う
+func (h *houseHoldService) DeleteHouseHold(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID) error {
+	if err := h.authorize(houseHoldID, operatorID, domainmodel.HouseHoldPermissionManageMember); err != nil {
+		return err
+	}
+
+	imageFiles, err := h.houseHoldRepository.Delete(houseHoldID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "household not found", err)
+		}
+		return err
+	}
+
+	for _, imageFile := range imageFiles {
+		if err := h.fileStorage.DeleteFile(imageFile); err != nil {
+			log.Printf("削除した家計簿 %d のレシート画像 %s の削除に失敗しました: %v", houseHoldID, imageFile, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteMember は家計簿からメンバーの所属を削除する
+func (h *houseHoldService) deleteMember(houseHoldID domainmodel.HouseHoldID, userID domainmodel.UserID) error {
+	if err := h.houseHoldRepository.DeleteUserHouseHold(houseHoldID, userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, domainmodel.ErrNotHouseHoldMember.Error(), err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// authorize はユーザーのロールが家計簿に対する指定の操作を許可しているかを検証する
+func (h *houseHoldService) authorize(houseHoldID domainmodel.HouseHoldID, userID domainmodel.UserID, permission domainmodel.HouseHoldPermission) error {
+	member, err := h.houseHoldRepository.FindUserHouseHold(userID, houseHoldID)
+	if err != nil {
+		return err
+	}
+	if member == nil || !member.Role.Can(permission) {
+		return apperrors.NewAppError(apperrors.ErrorCodeForbidden, "household permission denied", nil)
+	}
+	return nil
+}
+
+// findMember は家計簿のメンバーを取得し、所属していなければ NotFound を返す
+func (h *houseHoldService) findMember(houseHoldID domainmodel.HouseHoldID, userID domainmodel.UserID) (*domainmodel.UserHouseHold, error) {
+	member, err := h.houseHoldRepository.FindUserHouseHold(userID, houseHoldID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeNotFound, domainmodel.ErrNotHouseHoldMember.Error(), domainmodel.ErrNotHouseHoldMember)
+	}
+	return member, nil
 }
 
 // FetchHouseHold implements HouseHoldService.
@@ -150,20 +773,37 @@ func (h *houseHoldService) FetchHouseHold(houseHoldID domainmodel.HouseHoldID) (
 	return houseHold, nil
 }
 
-// ShareHouseHold implements HouseHoldService.
-func (h *houseHoldService) ShareHouseHold(houseHoldID domainmodel.HouseHoldID, inviteUserID domainmodel.UserID) error {
-	userHouseHold := &domainmodel.UserHouseHold{
-		HouseHoldID: houseHoldID,
-		UserID:      inviteUserID,
+// FetchUserHouseHolds implements HouseHoldService.
+// 既定の家計簿が未設定、または所属していない家計簿を指している場合は、最初に所属した家計簿を既定とする
+func (h *houseHoldService) FetchUserHouseHolds(userID domainmodel.UserID) ([]*domainmodel.BelongingHouseHold, error) {
+	houseHolds, err := h.houseHoldRepository.FindByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, houseHold := range houseHolds {
+		if houseHold.IsDefault {
+			return houseHolds, nil
+		}
+	}
+	if len(houseHolds) > 0 {
+		houseHolds[0].IsDefault = true
 	}
 
-	return h.houseHoldRepository.CreateUserHouseHold(userHouseHold)
+	return houseHolds, nil
 }
 
-func NewHouseHoldService(houseHoldRepository domainmodel.HouseHoldRepository, shoppingRepository domainmodel.ShoppingRepository, categoryRepository domainmodel.CategoryRepository) HouseHoldService {
+func NewHouseHoldService(houseHoldRepository domainmodel.HouseHoldRepository, shoppingRepository domainmodel.ShoppingRepository, categoryRepository domainmodel.CategoryRepository, monthlyBudgetRepository domainmodel.MonthlyBudgetRepository, budgetAlertService BudgetAlertService, incomeRepository domainmodel.IncomeRepository, paymentMethodRepository domainmodel.PaymentMethodRepository, tagRepository domainmodel.TagRepository, exchangeRateRepository domainmodel.ExchangeRateRepository, fileStorage repository.FileStorageRepository) HouseHoldService {
 	return &houseHoldService{
-		houseHoldRepository: houseHoldRepository,
-		shoppingRepository:  shoppingRepository,
-		categoryRepository:  categoryRepository,
+		houseHoldRepository:     houseHoldRepository,
+		shoppingRepository:      shoppingRepository,
+		categoryRepository:      categoryRepository,
+		monthlyBudgetRepository: monthlyBudgetRepository,
+		budgetAlertService:      budgetAlertService,
+		incomeRepository:        incomeRepository,
+		paymentMethodRepository: paymentMethodRepository,
+		tagRepository:           tagRepository,
+		exchangeRateRepository:  exchangeRateRepository,
+		fileStorage:             fileStorage,
 	}
 }
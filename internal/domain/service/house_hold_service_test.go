@@ -0,0 +1,945 @@
+package domainservice
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	mock "echo-household-budget/internal/domain/mock/domainmodel"
+	servicemock "echo-household-budget/internal/domain/mock/domainservice"
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/domain/repository"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+	apperrors "echo-household-budget/internal/shared/errors"
+)
+
+func member(userID domainmodel.UserID, role domainmodel.HouseHoldRole) *domainmodel.UserHouseHold {
+	return &domainmodel.UserHouseHold{UserID: userID, HouseHoldID: 10, Role: role}
+}
+
+func TestHouseHoldService_ChangeMemberRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name         string
+		role         domainmodel.HouseHoldRole
+		mockSetup    func(*mock.MockHouseHoldRepository)
+		expectedCode apperrors.ErrorCode
+	}{
+		{
+			name: "所有者は編集者を閲覧者に変更できる",
+			role: domainmodel.HouseHoldRoleViewer,
+			mockSetup: func(m *mock.MockHouseHoldRepository) {
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(10)).Return(member(1, domainmodel.HouseHoldRoleOwner), nil)
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(2), domainmodel.HouseHoldID(10)).Return(member(2, domainmodel.HouseHoldRoleEditor), nil)
+				m.EXPECT().UpdateUserHouseHoldRole(domainmodel.HouseHoldID(10), domainmodel.UserID(2), domainmodel.HouseHoldRoleViewer).Return(nil)
+			},
+		},
+		{
+			name: "編集者はロールを変更できない",
+			role: domainmodel.HouseHoldRoleViewer,
+			mockSetup: func(m *mock.MockHouseHoldRepository) {
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(10)).Return(member(1, domainmodel.HouseHoldRoleEditor), nil)
+			},
+			expectedCode: apperrors.ErrorCodeForbidden,
+		},
+		{
+			name: "所有者ロールは付与できない",
+			role: domainmodel.HouseHoldRoleOwner,
+			mockSetup: func(m *mock.MockHouseHoldRepository) {
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(10)).Return(member(1, domainmodel.HouseHoldRoleOwner), nil)
+			},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+		{
+			name: "家計簿に所属していないユーザーは変更できない",
+			role: domainmodel.HouseHoldRoleViewer,
+			mockSetup: func(m *mock.MockHouseHoldRepository) {
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(10)).Return(member(1, domainmodel.HouseHoldRoleOwner), nil)
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(2), domainmodel.HouseHoldID(10)).Return(nil, nil)
+			},
+			expectedCode: apperrors.ErrorCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockHouseHoldRepo := mock.NewMockHouseHoldRepository(ctrl)
+			tt.mockSetup(mockHouseHoldRepo)
+
+			service := NewHouseHoldService(mockHouseHoldRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			err := service.ChangeMemberRole(10, 1, 2, tt.role)
+
+			if tt.expectedCode != "" {
+				appErr, ok := apperrors.GetAppError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHouseHoldService_TransferOwnership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name         string
+		newOwnerID   domainmodel.UserID
+		mockSetup    func(*mock.MockHouseHoldRepository)
+		expectedCode apperrors.ErrorCode
+	}{
+		{
+			name:       "所有者は他のメンバーに所有権を移譲できる",
+			newOwnerID: 2,
+			mockSetup: func(m *mock.MockHouseHoldRepository) {
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(10)).Return(member(1, domainmodel.HouseHoldRoleOwner), nil)
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(2), domainmodel.HouseHoldID(10)).Return(member(2, domainmodel.HouseHoldRoleViewer), nil)
+				m.EXPECT().TransferOwnership(domainmodel.HouseHoldID(10), domainmodel.UserID(1), domainmodel.UserID(2)).Return(nil)
+			},
+		},
+		{
+			name:       "閲覧者は所有権を移譲できない",
+			newOwnerID: 2,
+			mockSetup: func(m *mock.MockHouseHoldRepository) {
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(10)).Return(member(1, domainmodel.HouseHoldRoleViewer), nil)
+			},
+			expectedCode: apperrors.ErrorCodeForbidden,
+		},
+		{
+			name:       "自分自身には移譲できない",
+			newOwnerID: 1,
+			mockSetup: func(m *mock.MockHouseHoldRepository) {
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(10)).Return(member(1, domainmodel.HouseHoldRoleOwner), nil)
+			},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockHouseHoldRepo := mock.NewMockHouseHoldRepository(ctrl)
+			tt.mockSetup(mockHouseHoldRepo)
+
+			service := NewHouseHoldService(mockHouseHoldRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			err := service.TransferOwnership(10, 1, tt.newOwnerID)
+
+			if tt.expectedCode != "" {
+				appErr, ok := apperrors.GetAppError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHouseHoldService_LeaveHouseHold(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name         string
+		mockSetup    func(*mock.MockHouseHoldRepository)
+		expectedCode apperrors.ErrorCode
+	}{
+		{
+			name: "編集者は脱退できる",
+			mockSetup: func(m *mock.MockHouseHoldRepository) {
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(2), domainmodel.HouseHoldID(10)).Return(member(2, domainmodel.HouseHoldRoleEditor), nil)
+				m.EXPECT().DeleteUserHouseHold(domainmodel.HouseHoldID(10), domainmodel.UserID(2)).Return(nil)
+			},
+		},
+		{
+			name: "所有者は脱退できない",
+			mockSetup: func(m *mock.MockHouseHoldRepository) {
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(2), domainmodel.HouseHoldID(10)).Return(member(2, domainmodel.HouseHoldRoleOwner), nil)
+			},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockHouseHoldRepo := mock.NewMockHouseHoldRepository(ctrl)
+			tt.mockSetup(mockHouseHoldRepo)
+
+			service := NewHouseHoldService(mockHouseHoldRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			err := service.LeaveHouseHold(10, 2)
+
+			if tt.expectedCode != "" {
+				appErr, ok := apperrors.GetAppError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHouseHoldService_RemoveMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name         string
+		targetUserID domainmodel.UserID
+		mockSetup    func(*mock.MockHouseHoldRepository)
+		expectedCode apperrors.ErrorCode
+	}{
+		{
+			name:         "所有者はメンバーを削除できる",
+			targetUserID: 2,
+			mockSetup: func(m *mock.MockHouseHoldRepository) {
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(10)).Return(member(1, domainmodel.HouseHoldRoleOwner), nil)
+				m.EXPECT().DeleteUserHouseHold(domainmodel.HouseHoldID(10), domainmodel.UserID(2)).Return(nil)
+			},
+		},
+		{
+			name:         "所属していないユーザーは削除できない",
+			targetUserID: 3,
+			mockSetup: func(m *mock.MockHouseHoldRepository) {
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(10)).Return(member(1, domainmodel.HouseHoldRoleOwner), nil)
+				m.EXPECT().DeleteUserHouseHold(domainmodel.HouseHoldID(10), domainmodel.UserID(3)).Return(gorm.ErrRecordNotFound)
+			},
+			expectedCode: apperrors.ErrorCodeNotFound,
+		},
+		{
+			name:         "所有者は自分自身を削除できない",
+			targetUserID: 1,
+			mockSetup: func(m *mock.MockHouseHoldRepository) {
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(10)).Return(member(1, domainmodel.HouseHoldRoleOwner), nil)
+			},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+		{
+			name:         "編集者はメンバーを削除できない",
+			targetUserID: 2,
+			mockSetup: func(m *mock.MockHouseHoldRepository) {
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(10)).Return(member(1, domainmodel.HouseHoldRoleEditor), nil)
+			},
+			expectedCode: apperrors.ErrorCodeForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockHouseHoldRepo := mock.NewMockHouseHoldRepository(ctrl)
+			tt.mockSetup(mockHouseHoldRepo)
+
+			service := NewHouseHoldService(mockHouseHoldRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			err := service.RemoveMember(10, 1, tt.targetUserID)
+
+			if tt.expectedCode != "" {
+				appErr, ok := apperrors.GetAppError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// deletedFileRecorder は削除されたファイルを記録する FileStorageRepository。failures のファイルは削除に失敗する
+type deletedFileRecorder struct {
+	repository.FileStorageRepository
+	deleted  []string
+	failures map[string]bool
+}
+
+func (r *deletedFileRecorder) DeleteFile(fileName string) error {
+	r.deleted = append(r.deleted, fileName)
+	if r.failures[fileName] {
+		return errors.New("delete failed")
+	}
+	return nil
+}
+
+func TestHouseHoldService_DeleteHouseHold(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("家計簿を削除した後にレシートの画像を削除し、画像の削除の失敗はエラーとしない", func(t *testing.T) {
+		mockHouseHoldRepo := mock.NewMockHouseHoldRepository(ctrl)
+		mockHouseHoldRepo.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(10)).Return(member(1, domainmodel.HouseHoldRoleOwner), nil)
+		mockHouseHoldRepo.EXPECT().Delete(domainmodel.HouseHoldID(10)).Return([]string{"receipt-1.jpg", "receipt-2.jpg"}, nil)
+		fileStorage := &deletedFileRecorder{failures: map[string]bool{"receipt-1.jpg": true}}
+
+		service := NewHouseHoldService(mockHouseHoldRepo, nil, nil, nil, nil, nil, nil, nil, nil, fileStorage)
+		assert.NoError(t, service.DeleteHouseHold(10, 1))
+		assert.Equal(t, []string{"receipt-1.jpg", "receipt-2.jpg"}, fileStorage.deleted)
+	})
+
+	t.Run("家計簿の削除に失敗した場合は画像を削除しない", func(t *testing.T) {
+		mockHouseHoldRepo := mock.NewMockHouseHoldRepository(ctrl)
+		mockHouseHoldRepo.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(10)).Return(member(1, domainmodel.HouseHoldRoleOwner), nil)
+		mockHouseHoldRepo.EXPECT().Delete(domainmodel.HouseHoldID(10)).Return(nil, gorm.ErrRecordNotFound)
+		fileStorage := &deletedFileRecorder{}
+
+		service := NewHouseHoldService(mockHouseHoldRepo, nil, nil, nil, nil, nil, nil, nil, nil, fileStorage)
+		err := service.DeleteHouseHold(10, 1)
+		appErr, ok := apperrors.GetAppError(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperrors.ErrorCodeNotFound, appErr.Code)
+		assert.Empty(t, fileStorage.deleted)
+	})
+}
+
+func TestHouseHoldService_FetchUserHouseHolds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// 既定の家計簿がない場合は最初に所属した家計簿を既定とする
+	mockHouseHoldRepo := mock.NewMockHouseHoldRepository(ctrl)
+	mockHouseHoldRepo.EXPECT().FindByUserID(domainmodel.UserID(1)).Return([]*domainmodel.BelongingHouseHold{
+		{ID: 10, Role: domainmodel.HouseHoldRoleOwner},
+		{ID: 20, Role: domainmodel.HouseHoldRoleEditor},
+	}, nil)
+
+	service := NewHouseHoldService(mockHouseHoldRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	houseHolds, err := service.FetchUserHouseHolds(1)
+	assert.NoError(t, err)
+	assert.True(t, houseHolds[0].IsDefault)
+	assert.False(t, houseHolds[1].IsDefault)
+}
+
+func TestHouseHoldService_AddHouseHoldCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// 追加したカテゴリはアーカイブ済みを含めた並び順の末尾に配置する
+	mockCategoryRepo := mock.NewMockCategoryRepository(ctrl)
+	mockCategoryRepo.EXPECT().FindHouseHoldCategories(domainmodel.HouseHoldID(10), true).Return([]*domainmodel.CategoryLimit{
+		{ID: 1, SortOrder: 1},
+		{ID: 2, SortOrder: 3},
+	}, nil)
+	mockCategoryRepo.EXPECT().CreateMasterCategory(gomock.Any()).DoAndReturn(func(category *domainmodel.Category) error {
+		category.ID = 5
+		return nil
+	})
+	mockCategoryRepo.EXPECT().CreateHouseHoldCategory(gomock.Any()).DoAndReturn(func(categoryLimit *domainmodel.CategoryLimit) error {
+		assert.Equal(t, domainmodel.CategoryID(5), categoryLimit.Category.ID)
+		assert.Equal(t, 4, categoryLimit.SortOrder)
+		return nil
+	})
+
+	service := NewHouseHoldService(nil, nil, mockCategoryRepo, nil, nil, nil, nil, nil, nil, nil)
+	err := service.AddHouseHoldCategory(&domainmodel.CategoryLimit{
+		HouseholdBookID: 10,
+		Category:        domainmodel.Category{Name: "旅行", Color: "#0000FF", Icon: "plane"},
+		LimitAmount:     30000,
+	})
+	assert.NoError(t, err)
+
+	// 不正な値の場合は登録しない
+	err = service.AddHouseHoldCategory(&domainmodel.CategoryLimit{
+		HouseholdBookID: 10,
+		Category:        domainmodel.Category{Name: "旅行", Color: "blue"},
+	})
+	appErr, ok := apperrors.GetAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, apperrors.ErrorCodeInvalidInput, appErr.Code)
+}
+
+func TestHouseHoldService_ReorderHouseHoldCategories(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name             string
+		categoryLimitIDs []domainmodel.CategoryLimitID
+		mockSetup        func(*mock.MockCategoryRepository)
+		expectedCode     apperrors.ErrorCode
+	}{
+		{
+			name:             "指定した順に並び替えられる",
+			categoryLimitIDs: []domainmodel.CategoryLimitID{2, 1},
+			mockSetup: func(m *mock.MockCategoryRepository) {
+				m.EXPECT().UpdateHouseHoldCategorySortOrders(domainmodel.HouseHoldID(10), []domainmodel.CategoryLimitID{2, 1}).Return(nil)
+			},
+		},
+		{
+			name:             "重複したカテゴリは指定できない",
+			categoryLimitIDs: []domainmodel.CategoryLimitID{1, 1},
+			mockSetup:        func(m *mock.MockCategoryRepository) {},
+			expectedCode:     apperrors.ErrorCodeInvalidInput,
+		},
+		{
+			name:             "他の家計簿のカテゴリは並び替えられない",
+			categoryLimitIDs: []domainmodel.CategoryLimitID{99},
+			mockSetup: func(m *mock.MockCategoryRepository) {
+				m.EXPECT().UpdateHouseHoldCategorySortOrders(domainmodel.HouseHoldID(10), []domainmodel.CategoryLimitID{99}).Return(gorm.ErrRecordNotFound)
+			},
+			expectedCode: apperrors.ErrorCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCategoryRepo := mock.NewMockCategoryRepository(ctrl)
+			tt.mockSetup(mockCategoryRepo)
+
+			service := NewHouseHoldService(nil, nil, mockCategoryRepo, nil, nil, nil, nil, nil, nil, nil)
+			err := service.ReorderHouseHoldCategories(10, tt.categoryLimitIDs)
+
+			if tt.expectedCode != "" {
+				appErr, ok := apperrors.GetAppError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHouseHoldService_ArchiveHouseHoldCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCategoryRepo := mock.NewMockCategoryRepository(ctrl)
+	mockCategoryRepo.EXPECT().ArchiveHouseHoldCategory(domainmodel.HouseHoldID(10), domainmodel.CategoryLimitID(1), gomock.Not(gomock.Nil())).Return(nil)
+	mockCategoryRepo.EXPECT().ArchiveHouseHoldCategory(domainmodel.HouseHoldID(10), domainmodel.CategoryLimitID(1), gomock.Nil()).Return(nil)
+
+	service := NewHouseHoldService(nil, nil, mockCategoryRepo, nil, nil, nil, nil, nil, nil, nil)
+	assert.NoError(t, service.ArchiveHouseHoldCategory(10, 1, true))
+	assert.NoError(t, service.ArchiveHouseHoldCategory(10, 1, false))
+}
+
+func TestHouseHoldService_RemoveHouseHoldCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCategoryRepo := mock.NewMockCategoryRepository(ctrl)
+	mockCategoryRepo.EXPECT().DeleteHouseHoldCategory(domainmodel.HouseHoldID(10), domainmodel.CategoryLimitID(1), gomock.Any()).
+		DoAndReturn(func(_ domainmodel.HouseHoldID, _ domainmodel.CategoryLimitID, deletion *domainmodel.Deletion) error {
+			// 削除したメンバーを記録する
+			assert.Equal(t, domainmodel.UserID(2), *deletion.DeletedBy)
+			assert.NotEmpty(t, deletion.BatchID)
+			return nil
+		})
+	mockCategoryRepo.EXPECT().DeleteHouseHoldCategory(domainmodel.HouseHoldID(10), domainmodel.CategoryLimitID(99), gomock.Any()).Return(gorm.ErrRecordNotFound)
+
+	service := NewHouseHoldService(nil, nil, mockCategoryRepo, nil, nil, nil, nil, nil, nil, nil)
+	assert.NoError(t, service.RemoveHouseHoldCategory(10, 2, 1))
+
+	// 他の家計簿のカテゴリは削除できない
+	err := service.RemoveHouseHoldCategory(10, 2, 99)
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, apperrors.ErrorCodeNotFound, appErr.Code)
+}
+
+func TestHouseHoldService_FetchMonthlyBudgets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	categories := []*domainmodel.CategoryLimit{
+		{Category: domainmodel.Category{ID: 1, Name: "食費"}, LimitAmount: 30000},
+	}
+	budgets := []*domainmodel.MonthlyBudget{
+		{HouseHoldID: 10, CategoryID: 1, Month: "2026-08", Amount: 20000, Rollover: true},
+	}
+	actuals := []*domainmodel.MonthlyCategoryAmount{
+		{Month: "2026-08", CategoryID: 1, Amount: 15000},
+		{Month: "2026-09", CategoryID: 1, Amount: 8000},
+	}
+
+	mockCategoryRepo := mock.NewMockCategoryRepository(ctrl)
+	mockCategoryRepo.EXPECT().FindHouseHoldCategories(domainmodel.HouseHoldID(10), false).Return(categories, nil)
+	mockShoppingRepo := mock.NewMockShoppingRepository(ctrl)
+	mockShoppingRepo.EXPECT().SummarizeShoppingAmountByMonth(domainmodel.HouseHoldID(10), "2026-09").Return(actuals, nil)
+	mockBudgetRepo := mock.NewMockMonthlyBudgetRepository(ctrl)
+	mockBudgetRepo.EXPECT().FindByHouseHoldID(domainmodel.HouseHoldID(10), "2026-09").Return(budgets, nil)
+	// 予算が未登録の月は前月の予算を引き継いで登録する
+	mockBudgetRepo.EXPECT().CreateMonthlyBudgets([]*domainmodel.MonthlyBudget{
+		{HouseHoldID: 10, CategoryID: 1, Month: "2026-09", Amount: 20000, Rollover: true},
+	}).Return(nil)
+
+	service := NewHouseHoldService(nil, mockShoppingRepo, mockCategoryRepo, mockBudgetRepo, nil, nil, nil, nil, nil, nil)
+	result, err := service.FetchMonthlyBudgets(10, "2026-09")
+	assert.NoError(t, err)
+	assert.Equal(t, domainmodel.CategoryBudgets{
+		{Category: domainmodel.Category{ID: 1, Name: "食費"}, Budget: 20000, CarriedOver: 5000, Actual: 8000, Remaining: 17000, Rollover: true},
+	}, result)
+
+	// 不正な月は受け付けない
+	_, err = service.FetchMonthlyBudgets(10, "2026-9")
+	appErr, ok := apperrors.GetAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, apperrors.ErrorCodeInvalidInput, appErr.Code)
+}
+
+func TestHouseHoldService_SetMonthlyBudget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	categories := []*domainmodel.CategoryLimit{
+		{Category: domainmodel.Category{ID: 1, Name: "食費"}},
+	}
+
+	tests := []struct {
+		name         string
+		budget       *domainmodel.MonthlyBudget
+		mockSetup    func(*mock.MockCategoryRepository, *mock.MockMonthlyBudgetRepository)
+		expectedCode apperrors.ErrorCode
+	}{
+		{
+			name:   "家計簿のカテゴリに予算を設定できる",
+			budget: &domainmodel.MonthlyBudget{HouseHoldID: 10, CategoryID: 1, Month: "2026-10", Amount: 40000, Rollover: true},
+			mockSetup: func(c *mock.MockCategoryRepository, b *mock.MockMonthlyBudgetRepository) {
+				c.EXPECT().FindHouseHoldCategories(domainmodel.HouseHoldID(10), true).Return(categories, nil)
+				b.EXPECT().SaveMonthlyBudget(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:         "負の予算は設定できない",
+			budget:       &domainmodel.MonthlyBudget{HouseHoldID: 10, CategoryID: 1, Month: "2026-10", Amount: -1},
+			mockSetup:    func(c *mock.MockCategoryRepository, b *mock.MockMonthlyBudgetRepository) {},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+		{
+			name:   "他の家計簿のカテゴリには設定できない",
+			budget: &domainmodel.MonthlyBudget{HouseHoldID: 10, CategoryID: 99, Month: "2026-10", Amount: 1000},
+			mockSetup: func(c *mock.MockCategoryRepository, b *mock.MockMonthlyBudgetRepository) {
+				c.EXPECT().FindHouseHoldCategories(domainmodel.HouseHoldID(10), true).Return(categories, nil)
+			},
+			expectedCode: apperrors.ErrorCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCategoryRepo := mock.NewMockCategoryRepository(ctrl)
+			mockBudgetRepo := mock.NewMockMonthlyBudgetRepository(ctrl)
+			tt.mockSetup(mockCategoryRepo, mockBudgetRepo)
+
+			service := NewHouseHoldService(nil, nil, mockCategoryRepo, mockBudgetRepo, nil, nil, nil, nil, nil, nil)
+			err := service.SetMonthlyBudget(tt.budget)
+
+			if tt.expectedCode != "" {
+				appErr, ok := apperrors.GetAppError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHouseHoldService_CreateShoppingAmount_NotifyBudgetAlerts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	categories := []*domainmodel.CategoryLimit{
+		{Category: domainmodel.Category{ID: 1, Name: "食費"}},
+		{Category: domainmodel.Category{ID: 2, Name: "日用品"}},
+	}
+	budgets := []*domainmodel.MonthlyBudget{
+		{HouseHoldID: 10, CategoryID: 1, Month: "2026-10", Amount: 10000},
+		{HouseHoldID: 10, CategoryID: 2, Month: "2026-10", Amount: 5000},
+	}
+	actuals := []*domainmodel.MonthlyCategoryAmount{
+		{Month: "2026-10", CategoryID: 1, Amount: 9000},
+		{Month: "2026-10", CategoryID: 2, Amount: 6000},
+	}
+
+	mockShoppingRepo := mock.NewMockShoppingRepository(ctrl)
+	mockShoppingRepo.EXPECT().RegisterShoppingAmount(gomock.Any()).Return(nil)
+	mockShoppingRepo.EXPECT().SummarizeShoppingAmountByMonth(domainmodel.HouseHoldID(10), "2026-10").Return(actuals, nil)
+	mockCategoryRepo := mock.NewMockCategoryRepository(ctrl)
+	// カテゴリの検証と予算の確認で取得する
+	mockCategoryRepo.EXPECT().FindHouseHoldCategories(domainmodel.HouseHoldID(10), false).Return(categories, nil).Times(2)
+	mockBudgetRepo := mock.NewMockMonthlyBudgetRepository(ctrl)
+	mockBudgetRepo.EXPECT().FindByHouseHoldID(domainmodel.HouseHoldID(10), "2026-10").Return(budgets, nil)
+	// 支出を登録したカテゴリのみ確認する
+	mockBudgetAlertService := servicemock.NewMockBudgetAlertService(ctrl)
+	mockBudgetAlertService.EXPECT().NotifyReachedThresholds(domainmodel.HouseHoldID(10), "2026-10", gomock.Any()).
+		DoAndReturn(func(houseHoldID domainmodel.HouseHoldID, month string, budget *domainmodel.CategoryBudget) ([]*domainmodel.BudgetAlert, error) {
+			assert.Equal(t, domainmodel.CategoryID(1), budget.Category.ID)
+			assert.Equal(t, 9000, budget.Actual)
+			return nil, nil
+		})
+
+	service := NewHouseHoldService(nil, mockShoppingRepo, mockCategoryRepo, mockBudgetRepo, mockBudgetAlertService, nil, nil, nil, nil, nil)
+	err := service.CreateShoppingAmount(domainmodel.NewShoppingAmount(10, 1, 1000, "2026-10-18", "", 0))
+	assert.NoError(t, err)
+}
+
+func TestHouseHoldService_CreateShoppingAmount_ExchangeRate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	houseHold := &domainmodel.HouseHold{ID: 10, BaseCurrency: "JPY"}
+	recorded := func(amount int64, currency domainmodel.Currency) *domainmodel.ShoppingAmount {
+		shoppingAmount := domainmodel.NewShoppingAmount(10, 1, int(amount), "2026-10-18", "", 0)
+		shoppingAmount.Original = &domainmodel.Money{Amount: amount, Currency: currency}
+		return shoppingAmount
+	}
+
+	tests := []struct {
+		name           string
+		shoppingAmount *domainmodel.ShoppingAmount
+		mockSetup      func(*mock.MockShoppingRepository, *mock.MockHouseHoldRepository, *mock.MockExchangeRateRepository)
+		expectedCode   apperrors.ErrorCode
+	}{
+		{
+			name:           "支出日に適用するレートで基準通貨に換算する",
+			shoppingAmount: recorded(1234, "usd"),
+			mockSetup: func(s *mock.MockShoppingRepository, h *mock.MockHouseHoldRepository, e *mock.MockExchangeRateRepository) {
+				h.EXPECT().FindByHouseHoldID(domainmodel.HouseHoldID(10)).Return(houseHold, nil)
+				e.EXPECT().FindEffectiveExchangeRate(domainmodel.HouseHoldID(10), domainmodel.Currency("USD"), "2026-10-18").
+					Return(&domainmodel.ExchangeRate{Currency: "USD", Rate: "149.5", EffectiveDate: "2026-10-01"}, nil)
+				s.EXPECT().RegisterShoppingAmount(gomock.Any()).DoAndReturn(func(model *models.ShoppingAmount) error {
+					// 12.34 USD × 149.5 = 1844.83 円を四捨五入する
+					assert.Equal(t, 1845, model.Amount)
+					assert.Equal(t, int64(1234), *model.OriginalAmount)
+					assert.Equal(t, "USD", *model.OriginalCurrency)
+					assert.Equal(t, "149.5", *model.ExchangeRate)
+					return nil
+				})
+			},
+		},
+		{
+			name: "金額で負担する場合は負担額も換算し、合計を換算後の金額に合わせる",
+			shoppingAmount: func() *domainmodel.ShoppingAmount {
+				shoppingAmount := recorded(1000, "USD")
+				payer := domainmodel.UserID(1)
+				shoppingAmount.PaidBy = &payer
+				shoppingAmount.SplitType = domainmodel.SplitFixed
+				shoppingAmount.Shares = []domainmodel.ExpenseShare{{UserID: 1, Value: 333}, {UserID: 2, Value: 667}}
+				return shoppingAmount
+			}(),
+			mockSetup: func(s *mock.MockShoppingRepository, h *mock.MockHouseHoldRepository, e *mock.MockExchangeRateRepository) {
+				h.EXPECT().FindByHouseHoldID(domainmodel.HouseHoldID(10)).Return(houseHold, nil)
+				h.EXPECT().FindMembers(domainmodel.HouseHoldID(10)).Return([]*domainmodel.HouseHoldMember{{UserID: 1}, {UserID: 2}}, nil)
+				e.EXPECT().FindEffectiveExchangeRate(domainmodel.HouseHoldID(10), domainmodel.Currency("USD"), "2026-10-18").
+					Return(&domainmodel.ExchangeRate{Currency: "USD", Rate: "149.5", EffectiveDate: "2026-10-01"}, nil)
+				s.EXPECT().RegisterShoppingAmount(gomock.Any()).DoAndReturn(func(model *models.ShoppingAmount) error {
+					// 10.00 USD × 149.5 = 1495 円を 3.33 : 6.67 で配分し、端数の 1 円はユーザーIDの小さいメンバーが負担する
+					assert.Equal(t, 1495, model.Amount)
+					assert.Equal(t, []models.ShoppingAmountSplit{{UserID: 1, Value: 498}, {UserID: 2, Value: 997}}, model.Splits)
+					return nil
+				})
+			},
+		},
+		{
+			name:           "基準通貨で記録した場合は換算しない",
+			shoppingAmount: recorded(1000, "JPY"),
+			mockSetup: func(s *mock.MockShoppingRepository, h *mock.MockHouseHoldRepository, e *mock.MockExchangeRateRepository) {
+				h.EXPECT().FindByHouseHoldID(domainmodel.HouseHoldID(10)).Return(houseHold, nil)
+				s.EXPECT().RegisterShoppingAmount(gomock.Any()).DoAndReturn(func(model *models.ShoppingAmount) error {
+					assert.Equal(t, 1000, model.Amount)
+					assert.Nil(t, model.OriginalAmount)
+					assert.Nil(t, model.ExchangeRate)
+					return nil
+				})
+			},
+		},
+		{
+			name:           "レートが登録されていない通貨は登録できない",
+			shoppingAmount: recorded(1000, "EUR"),
+			mockSetup: func(s *mock.MockShoppingRepository, h *mock.MockHouseHoldRepository, e *mock.MockExchangeRateRepository) {
+				h.EXPECT().FindByHouseHoldID(domainmodel.HouseHoldID(10)).Return(houseHold, nil)
+				e.EXPECT().FindEffectiveExchangeRate(domainmodel.HouseHoldID(10), domainmodel.Currency("EUR"), "2026-10-18").Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+		{
+			name:           "対応していない通貨は登録できない",
+			shoppingAmount: recorded(1000, "XXX"),
+			mockSetup: func(s *mock.MockShoppingRepository, h *mock.MockHouseHoldRepository, e *mock.MockExchangeRateRepository) {
+			},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockShoppingRepo := mock.NewMockShoppingRepository(ctrl)
+			mockHouseHoldRepo := mock.NewMockHouseHoldRepository(ctrl)
+			mockExchangeRateRepo := mock.NewMockExchangeRateRepository(ctrl)
+			tt.mockSetup(mockShoppingRepo, mockHouseHoldRepo, mockExchangeRateRepo)
+
+			service := NewHouseHoldService(mockHouseHoldRepo, mockShoppingRepo, newShoppingCategoryRepository(ctrl), nil, nil, nil, nil, nil, mockExchangeRateRepo, nil)
+			err := service.CreateShoppingAmount(tt.shoppingAmount)
+
+			if tt.expectedCode != "" {
+				var appErr *apperrors.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestHouseHoldService_UpdateShoppingAmount_Split(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	payer := domainmodel.UserID(1)
+	members := []*domainmodel.HouseHoldMember{{UserID: 1}, {UserID: 2}}
+
+	tests := []struct {
+		name           string
+		shoppingAmount *domainmodel.ShoppingAmount
+		mockSetup      func(*mock.MockShoppingRepository, *mock.MockHouseHoldRepository)
+		expectedCode   apperrors.ErrorCode
+	}{
+		{
+			name:           "負担の割合を登録できる",
+			shoppingAmount: &domainmodel.ShoppingAmount{ID: 3, HouseholdID: 10, CategoryID: 1, Amount: 1000, Date: "2026-10-18", PaidBy: &payer, SplitType: domainmodel.SplitPercentage, Shares: []domainmodel.ExpenseShare{{UserID: 1, Value: 70}, {UserID: 2, Value: 30}}},
+			mockSetup: func(s *mock.MockShoppingRepository, h *mock.MockHouseHoldRepository) {
+				h.EXPECT().FindMembers(domainmodel.HouseHoldID(10)).Return(members, nil)
+				s.EXPECT().UpdateShoppingAmount(gomock.Any()).DoAndReturn(func(model *models.ShoppingAmount) error {
+					assert.Equal(t, uint(1), *model.PaidBy)
+					assert.Equal(t, "percentage", model.SplitType)
+					assert.Equal(t, []models.ShoppingAmountSplit{{UserID: 1, Value: 70}, {UserID: 2, Value: 30}}, model.Splits)
+					return nil
+				})
+			},
+		},
+		{
+			name:           "参加者を指定しない均等の負担は現在のメンバーを参加者として保存する",
+			shoppingAmount: &domainmodel.ShoppingAmount{ID: 3, HouseholdID: 10, CategoryID: 1, Amount: 1000, Date: "2026-10-18", PaidBy: &payer, SplitType: domainmodel.SplitEqual},
+			mockSetup: func(s *mock.MockShoppingRepository, h *mock.MockHouseHoldRepository) {
+				h.EXPECT().FindMembers(domainmodel.HouseHoldID(10)).Return(members, nil)
+				s.EXPECT().UpdateShoppingAmount(gomock.Any()).DoAndReturn(func(model *models.ShoppingAmount) error {
+					assert.Equal(t, "equal", model.SplitType)
+					assert.Equal(t, []models.ShoppingAmountSplit{{UserID: 1}, {UserID: 2}}, model.Splits)
+					return nil
+				})
+			},
+		},
+		{
+			name:           "支払ったメンバーの指定がない場合はメンバーを確認しない",
+			shoppingAmount: &domainmodel.ShoppingAmount{ID: 3, HouseholdID: 10, CategoryID: 1, Amount: 1000, Date: "2026-10-18"},
+			mockSetup: func(s *mock.MockShoppingRepository, h *mock.MockHouseHoldRepository) {
+				s.EXPECT().UpdateShoppingAmount(gomock.Any()).DoAndReturn(func(model *models.ShoppingAmount) error {
+					assert.Nil(t, model.PaidBy)
+					assert.Equal(t, "equal", model.SplitType)
+					return nil
+				})
+			},
+		},
+		{
+			name:           "金額の合計が一致しない場合は登録できない",
+			shoppingAmount: &domainmodel.ShoppingAmount{ID: 3, HouseholdID: 10, CategoryID: 1, Amount: 1000, Date: "2026-10-18", PaidBy: &payer, SplitType: domainmodel.SplitFixed, Shares: []domainmodel.ExpenseShare{{UserID: 1, Value: 500}}},
+			mockSetup: func(s *mock.MockShoppingRepository, h *mock.MockHouseHoldRepository) {
+				h.EXPECT().FindMembers(domainmodel.HouseHoldID(10)).Return(members, nil)
+			},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockShoppingRepo := mock.NewMockShoppingRepository(ctrl)
+			mockHouseHoldRepo := mock.NewMockHouseHoldRepository(ctrl)
+			tt.mockSetup(mockShoppingRepo, mockHouseHoldRepo)
+
+			service := NewHouseHoldService(mockHouseHoldRepo, mockShoppingRepo, newShoppingCategoryRepository(ctrl), nil, nil, nil, nil, nil, nil, nil)
+			err := service.UpdateShoppingAmount(tt.shoppingAmount)
+
+			if tt.expectedCode != "" {
+				appErr, ok := apperrors.GetAppError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHouseHoldService_UpdateShoppingAmount_Tags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name         string
+		tagIDs       []domainmodel.TagID
+		mockSetup    func(*mock.MockShoppingRepository, *mock.MockTagRepository)
+		expectedCode apperrors.ErrorCode
+	}{
+		{
+			name:   "重複を取り除いてタグを置き換える",
+			tagIDs: []domainmodel.TagID{2, 5, 2},
+			mockSetup: func(s *mock.MockShoppingRepository, r *mock.MockTagRepository) {
+				r.EXPECT().FindByIDs(domainmodel.HouseHoldID(10), []domainmodel.TagID{2, 5}).Return([]*domainmodel.Tag{{ID: 2}, {ID: 5}}, nil)
+				s.EXPECT().UpdateShoppingAmount(gomock.Any()).DoAndReturn(func(model *models.ShoppingAmount) error {
+					assert.Equal(t, []models.Tag{{Base: models.Base{ID: 2}}, {Base: models.Base{ID: 5}}}, model.Tags)
+					return nil
+				})
+			},
+		},
+		{
+			name:   "タグを指定しない場合はタグを外す",
+			tagIDs: nil,
+			mockSetup: func(s *mock.MockShoppingRepository, r *mock.MockTagRepository) {
+				s.EXPECT().UpdateShoppingAmount(gomock.Any()).DoAndReturn(func(model *models.ShoppingAmount) error {
+					assert.Empty(t, model.Tags)
+					return nil
+				})
+			},
+		},
+		{
+			name:   "家計簿にないタグは付けられない",
+			tagIDs: []domainmodel.TagID{2, 99},
+			mockSetup: func(s *mock.MockShoppingRepository, r *mock.MockTagRepository) {
+				r.EXPECT().FindByIDs(domainmodel.HouseHoldID(10), []domainmodel.TagID{2, 99}).Return([]*domainmodel.Tag{{ID: 2}}, nil)
+			},
+			expectedCode: apperrors.ErrorCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockShoppingRepo := mock.NewMockShoppingRepository(ctrl)
+			mockTagRepo := mock.NewMockTagRepository(ctrl)
+			tt.mockSetup(mockShoppingRepo, mockTagRepo)
+
+			service := NewHouseHoldService(nil, mockShoppingRepo, newShoppingCategoryRepository(ctrl), nil, nil, nil, nil, mockTagRepo, nil, nil)
+			err := service.UpdateShoppingAmount(&domainmodel.ShoppingAmount{ID: 3, HouseholdID: 10, CategoryID: 1, Amount: 1000, Date: "2026-10-18", TagIDs: tt.tagIDs})
+
+			if tt.expectedCode != "" {
+				appErr, ok := apperrors.GetAppError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// newShoppingCategoryRepository は食費（カテゴリ 1）のみ利用中の家計簿 10 のカテゴリを返すリポジトリを作成する
+func newShoppingCategoryRepository(ctrl *gomock.Controller) *mock.MockCategoryRepository {
+	mockCategoryRepo := mock.NewMockCategoryRepository(ctrl)
+	mockCategoryRepo.EXPECT().FindHouseHoldCategories(domainmodel.HouseHoldID(10), false).
+		Return([]*domainmodel.CategoryLimit{{HouseholdBookID: 10, Category: domainmodel.Category{ID: 1, Name: "食費"}}}, nil).AnyTimes()
+	return mockCategoryRepo
+}
+
+func TestHouseHoldService_ShoppingAmount_Category(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// 他の家計簿のカテゴリ、アーカイブ・ゴミ箱に移したカテゴリは利用中のカテゴリに含まれない
+	service := NewHouseHoldService(nil, mock.NewMockShoppingRepository(ctrl), newShoppingCategoryRepository(ctrl), nil, nil, nil, nil, nil, nil, nil)
+
+	err := service.CreateShoppingAmount(domainmodel.NewShoppingAmount(10, 2, 1000, "2026-10-18", "", 0))
+	appErr, ok := apperrors.GetAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, apperrors.ErrorCodeNotFound, appErr.Code)
+
+	err = service.UpdateShoppingAmount(&domainmodel.ShoppingAmount{ID: 3, HouseholdID: 10, CategoryID: 2, Amount: 1000, Date: "2026-10-18"})
+	appErr, ok = apperrors.GetAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, apperrors.ErrorCodeNotFound, appErr.Code)
+}
+
+func TestHouseHoldService_SummarizeShoppingAmount_Balance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	categories := []*domainmodel.CategoryLimit{
+		{Category: domainmodel.Category{ID: 1, Name: "食費"}},
+	}
+	shoppingAmounts := []*models.ShoppingAmount{
+		{HouseholdBookID: 10, CategoryID: 1, Amount: 60000, Date: time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)},
+		{HouseholdBookID: 10, CategoryID: 1, Amount: 30000, Date: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)},
+	}
+	budgets := []*domainmodel.MonthlyBudget{
+		{HouseHoldID: 10, CategoryID: 1, Month: "2026-10", Amount: 100000},
+	}
+	incomes := []*domainmodel.Income{
+		{HouseHoldID: 10, IncomeCategoryID: 1, Amount: 250000, Date: "2026-10-25"},
+		{HouseHoldID: 10, IncomeCategoryID: 3, Amount: 50000, Date: "2026-10-28"},
+	}
+
+	mockShoppingRepo := mock.NewMockShoppingRepository(ctrl)
+	mockShoppingRepo.EXPECT().FetchShoppingAmountItemByHouseholdID(domainmodel.HouseHoldID(10), "2026-10-18").Return(shoppingAmounts, nil)
+	mockShoppingRepo.EXPECT().SummarizeShoppingAmountByMonth(domainmodel.HouseHoldID(10), "2026-10").Return(nil, nil)
+	mockCategoryRepo := mock.NewMockCategoryRepository(ctrl)
+	mockCategoryRepo.EXPECT().FindHouseHoldCategories(domainmodel.HouseHoldID(10), true).Return(categories, nil)
+	mockCategoryRepo.EXPECT().FindHouseHoldCategories(domainmodel.HouseHoldID(10), false).Return(categories, nil)
+	mockBudgetRepo := mock.NewMockMonthlyBudgetRepository(ctrl)
+	mockBudgetRepo.EXPECT().FindByHouseHoldID(domainmodel.HouseHoldID(10), "2026-10").Return(budgets, nil)
+	mockIncomeRepo := mock.NewMockIncomeRepository(ctrl)
+	mockIncomeRepo.EXPECT().FindIncomes(domainmodel.HouseHoldID(10), "2026-10").Return(incomes, nil)
+	mockHouseHoldRepo := mock.NewMockHouseHoldRepository(ctrl)
+	mockHouseHoldRepo.EXPECT().FindByHouseHoldID(domainmodel.HouseHoldID(10)).Return(&domainmodel.HouseHold{ID: 10, BaseCurrency: "JPY"}, nil)
+
+	service := NewHouseHoldService(mockHouseHoldRepo, mockShoppingRepo, mockCategoryRepo, mockBudgetRepo, nil, mockIncomeRepo, nil, nil, nil, nil)
+	summary, err := service.SummarizeShoppingAmount(FetchShoppingRecordInput{HouseholdID: 10, Date: "2026-10-18", WeekStart: time.Monday})
+	assert.NoError(t, err)
+	assert.Equal(t, domainmodel.Currency("JPY"), summary.Currency)
+	assert.Equal(t, 90000, summary.TotalAmount)
+	assert.Equal(t, 300000, summary.Balance.TotalIncome)
+	assert.Equal(t, 90000, summary.Balance.TotalExpense)
+	assert.Equal(t, 210000, summary.Balance.NetBalance)
+	assert.Equal(t, 70.0, *summary.Balance.SavingsRate)
+	assert.Len(t, summary.DailyAmounts, 31)
+	assert.Equal(t, 60000, summary.DailyAmounts[4].Amount)
+	assert.Equal(t, "2026-W41", summary.WeeklyAmounts[1].Week)
+	assert.Equal(t, 60000, summary.WeeklyAmounts[1].Amount)
+	assert.Equal(t, "monday", summary.Heatmap.WeekStart)
+}
+
+func TestHouseHoldService_SearchShoppingAmount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	categories := []*domainmodel.CategoryLimit{
+		{Category: domainmodel.Category{ID: 1, Name: "食料品", Color: "#ff0000"}},
+	}
+
+	t.Run("家計簿のカテゴリ設定で表示し、次のページのカーソルを返す", func(t *testing.T) {
+		mockShoppingRepo := mock.NewMockShoppingRepository(ctrl)
+		mockCategoryRepo := mock.NewMockCategoryRepository(ctrl)
+
+		condition := domainmodel.NewShoppingSearchCondition(10)
+		condition.Limit = 1
+		mockShoppingRepo.EXPECT().SearchShoppingAmounts(condition).Return(domainmodel.ShoppingAmounts{
+			{ID: 2, CategoryID: 1, Amount: 500, Date: "2026-10-18", Category: domainmodel.Category{ID: 1, Name: "食費"}},
+			{ID: 1, CategoryID: 1, Amount: 300, Date: "2026-10-17", Category: domainmodel.Category{ID: 1, Name: "食費"}},
+		}, nil)
+		mockCategoryRepo.EXPECT().FindHouseHoldCategories(domainmodel.HouseHoldID(10), true).Return(categories, nil)
+
+		service := NewHouseHoldService(nil, mockShoppingRepo, mockCategoryRepo, nil, nil, nil, nil, nil, nil, nil)
+		result, err := service.SearchShoppingAmount(condition)
+		assert.NoError(t, err)
+		assert.Len(t, result.ShoppingAmounts, 1)
+		assert.Equal(t, "食料品", result.ShoppingAmounts[0].Category.Name)
+		assert.True(t, result.HasMore)
+
+		cursor, err := domainmodel.ParseShoppingSearchCursor(result.NextCursor)
+		assert.NoError(t, err)
+		assert.Equal(t, &domainmodel.ShoppingSearchCursor{Sort: domainmodel.ShoppingSearchSortDateDesc, Date: "2026-10-18", ID: 2}, cursor)
+	})
+
+	t.Run("不正な検索条件では検索しない", func(t *testing.T) {
+		condition := domainmodel.NewShoppingSearchCondition(10)
+		condition.Sort = "memo_asc"
+
+		service := NewHouseHoldService(nil, mock.NewMockShoppingRepository(ctrl), nil, nil, nil, nil, nil, nil, nil, nil)
+		_, err := service.SearchShoppingAmount(condition)
+		appErr, ok := apperrors.GetAppError(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperrors.ErrorCodeInvalidInput, appErr.Code)
+	})
+}
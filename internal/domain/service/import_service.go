@@ -0,0 +1,186 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainservice
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"errors"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+type ImportService interface {
+	// FetchPresets は組み込みのプリセットと家計簿で保存したプリセットを返す
+	FetchPresets(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.ImportPreset, error)
+	SavePreset(preset *domainmodel.ImportPreset) error
+	RemovePreset(houseHoldID domainmodel.HouseHoldID, id domainmodel.ImportPresetID) error
+	// PreviewImport は CSV を解釈し、各行の取り込み内容とエラー、登録済みの支出との重複を返す
+	PreviewImport(input *domainmodel.ImportInput) (*domainmodel.ImportPreview, error)
+	// CommitImport は CSV の支出をまとめて登録する。エラーの行がある場合は1件も登録しない
+	CommitImport(input *domainmodel.ImportInput) (*domainmodel.ImportResult, error)
+}
+
+type importService struct {
+	importPresetRepository domainmodel.ImportPresetRepository
+	shoppingRepository     domainmodel.ShoppingRepository
+	categoryRepository     domainmodel.CategoryRepository
+}
+
+// FetchPresets implements ImportService.
+func (s *importService) FetchPresets(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.ImportPreset, error) {
+	presets, err := s.importPresetRepository.FindByHouseHoldID(houseHoldID)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(domainmodel.BuiltInImportPresets(), presets...), nil
+}
+
+// SavePreset implements ImportService.
+func (s *importService) SavePreset(preset *domainmodel.ImportPreset) error {
+	preset.Name = strings.TrimSpace(preset.Name)
+	preset.Key = ""
+	if err := preset.Validate(); err != nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	presets, err := s.importPresetRepository.FindByHouseHoldID(preset.HouseHoldID)
+	if err != nil {
+		return err
+	}
+	for _, existing := range presets {
+		if existing.Name == preset.Name {
+			return apperrors.NewAppError(apperrors.ErrorCodeConflict, "import preset with the same name already exists", nil)
+		}
+	}
+
+	return s.importPresetRepository.Create(preset)
+}
+
+// RemovePreset implements ImportService.
+func (s *importService) RemovePreset(houseHoldID domainmodel.HouseHoldID, id domainmodel.ImportPresetID) error {
+	if err := s.importPresetRepository.Delete(houseHoldID, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "import preset not found in household", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// PreviewImport implements ImportService.
+func (s *importService) PreviewImport(input *domainmodel.ImportInput) (*domainmodel.ImportPreview, error) {
+	rows, err := s.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return domainmodel.NewImportPreview(rows), nil
+}
+
+// CommitImport implements ImportService.
+// 確認時から CSV と指定が変わっていないことを前提とせず、登録時に改めて解釈して検証する
+func (s *importService) CommitImport(input *domainmodel.ImportInput) (*domainmodel.ImportResult, error) {
+	rows, err := s.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := domainmodel.NewImportPreview(rows)
+	if preview.Errors > 0 {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrImportHasErrors.Error(), domainmodel.ErrImportHasErrors)
+	}
+
+	shoppingAmounts := domainmodel.ImportShoppingAmounts(input.HouseHoldID, rows, input.IncludeDuplicates)
+	if err := s.shoppingRepository.RegisterShoppingAmounts(shoppingAmounts); err != nil {
+		return nil, err
+	}
+
+	result := &domainmodel.ImportResult{Imported: len(shoppingAmounts), Skipped: preview.Skipped}
+	if !input.IncludeDuplicates {
+		result.SkippedDuplicates = preview.Duplicates
+	}
+	return result, nil
+}
+
+// parse は列の対応を決めて CSV を解釈し、登録済みの支出との重複を判定する
+func (s *importService) parse(input *domainmodel.ImportInput) ([]*domainmodel.ImportRow, error) {
+	preset, err := s.resolvePreset(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := preset.Mapping.Validate(input.DefaultCategoryID != nil); err != nil {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	encoding := input.Encoding
+	if encoding == "" {
+		encoding = preset.Mapping.Encoding
+	}
+	records, err := domainmodel.ReadImportCSV(input.File, encoding)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	categories, err := s.categoryRepository.FindHouseHoldCategories(input.HouseHoldID, false)
+	if err != nil {
+		return nil, err
+	}
+	categoryMappings := make(map[string]domainmodel.CategoryID, len(preset.CategoryMappings)+len(input.CategoryMappings))
+	for name, categoryID := range preset.CategoryMappings {
+		categoryMappings[name] = categoryID
+	}
+	for name, categoryID := range input.CategoryMappings {
+		categoryMappings[name] = categoryID
+	}
+	resolver := domainmodel.NewImportCategoryResolver(categories, categoryMappings, input.DefaultCategoryID)
+
+	rows, err := domainmodel.ParseImportRows(records, preset.Mapping, resolver)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	if from, to, ok := domainmodel.ImportPeriod(rows); ok {
+		existing, err := s.shoppingRepository.FindShoppingAmounts(input.HouseHoldID, from, to)
+		if err != nil {
+			return nil, err
+		}
+		domainmodel.MarkImportDuplicates(rows, existing)
+	}
+
+	return rows, nil
+}
+
+// resolvePreset は取り込みに用いるプリセットを返す。列の対応のみを指定した場合はカテゴリの対応のないプリセットとする
+func (s *importService) resolvePreset(input *domainmodel.ImportInput) (*domainmodel.ImportPreset, error) {
+	switch {
+	case input.PresetID != 0:
+		preset, err := s.importPresetRepository.FindByID(input.HouseHoldID, input.PresetID)
+		if err != nil {
+			return nil, err
+		}
+		if preset == nil {
+			return nil, apperrors.NewAppError(apperrors.ErrorCodeNotFound, domainmodel.ErrImportPresetNotFound.Error(), domainmodel.ErrImportPresetNotFound)
+		}
+		return preset, nil
+	case input.PresetKey != "":
+		preset := domainmodel.FindBuiltInImportPreset(input.PresetKey)
+		if preset == nil {
+			return nil, apperrors.NewAppError(apperrors.ErrorCodeNotFound, domainmodel.ErrImportPresetNotFound.Error(), domainmodel.ErrImportPresetNotFound)
+		}
+		return preset, nil
+	case input.Mapping != nil:
+		return &domainmodel.ImportPreset{Mapping: *input.Mapping}, nil
+	}
+	return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrInvalidImportMapping.Error(), domainmodel.ErrInvalidImportMapping)
+}
+
+func NewImportService(importPresetRepository domainmodel.ImportPresetRepository, shoppingRepository domainmodel.ShoppingRepository, categoryRepository domainmodel.CategoryRepository) ImportService {
+	return &importService{
+		importPresetRepository: importPresetRepository,
+		shoppingRepository:     shoppingRepository,
+		categoryRepository:     categoryRepository,
+	}
+}
@@ -0,0 +1,118 @@
+package domainservice
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mock "echo-household-budget/internal/domain/mock/domainmodel"
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+	apperrors "echo-household-budget/internal/shared/errors"
+)
+
+const zaimCSV = "日付,方法,カテゴリ,カテゴリの内訳,支払元,入金先,品目,メモ,お店,通貨,収入,支出,振替,残高調整,通貨変換前の金額,集計の設定\n" +
+	"2026-10-01,payment,食費,食料品,お財布,,牛乳,,スーパー,JPY,0,250,0,0,250,常に集計に含める\n" +
+	"2026-10-02,payment,食費,食料品,お財布,,パン,,スーパー,JPY,0,300,0,0,300,常に集計に含める\n" +
+	"2026-10-25,income,給与,,,銀行,,,,JPY,250000,0,0,0,250000,常に集計に含める\n"
+
+func TestImportService_CommitImport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	categories := []*domainmodel.CategoryLimit{
+		{Category: domainmodel.Category{ID: 1, Name: "食費"}},
+	}
+
+	t.Run("重複する行を除いてまとめて登録する", func(t *testing.T) {
+		mockShoppingRepo := mock.NewMockShoppingRepository(ctrl)
+		mockCategoryRepo := mock.NewMockCategoryRepository(ctrl)
+
+		mockCategoryRepo.EXPECT().FindHouseHoldCategories(domainmodel.HouseHoldID(10), false).Return(categories, nil)
+		mockShoppingRepo.EXPECT().FindShoppingAmounts(domainmodel.HouseHoldID(10), "2026-10-01", "2026-10-02").Return(domainmodel.ShoppingAmounts{
+			{ID: 5, Date: "2026-10-02", Amount: 300, CategoryID: 1},
+		}, nil)
+		mockShoppingRepo.EXPECT().RegisterShoppingAmounts(gomock.Any()).DoAndReturn(func(shoppings []*models.ShoppingAmount) error {
+			assert.Len(t, shoppings, 1)
+			assert.Equal(t, uint(10), shoppings[0].HouseholdBookID)
+			assert.Equal(t, 250, shoppings[0].Amount)
+			assert.Equal(t, "スーパー 牛乳", shoppings[0].Memo)
+			return nil
+		})
+
+		service := NewImportService(nil, mockShoppingRepo, mockCategoryRepo)
+		result, err := service.CommitImport(&domainmodel.ImportInput{
+			HouseHoldID: 10,
+			File:        strings.NewReader(zaimCSV),
+			PresetKey:   domainmodel.ImportPresetZaim,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &domainmodel.ImportResult{Imported: 1, Skipped: 1, SkippedDuplicates: 1}, result)
+	})
+
+	t.Run("エラーの行がある場合は登録しない", func(t *testing.T) {
+		mockShoppingRepo := mock.NewMockShoppingRepository(ctrl)
+		mockCategoryRepo := mock.NewMockCategoryRepository(ctrl)
+		mockPresetRepo := mock.NewMockImportPresetRepository(ctrl)
+
+		// 保存したプリセットのカテゴリの対応が家計簿にないカテゴリを指している
+		mockPresetRepo.EXPECT().FindByID(domainmodel.HouseHoldID(10), domainmodel.ImportPresetID(3)).Return(&domainmodel.ImportPreset{
+			ID:               3,
+			Mapping:          domainmodel.FindBuiltInImportPreset(domainmodel.ImportPresetZaim).Mapping,
+			CategoryMappings: map[string]domainmodel.CategoryID{"食費": 99},
+		}, nil)
+		mockCategoryRepo.EXPECT().FindHouseHoldCategories(domainmodel.HouseHoldID(10), false).Return([]*domainmodel.CategoryLimit{
+			{Category: domainmodel.Category{ID: 2, Name: "日用品"}},
+		}, nil)
+
+		service := NewImportService(mockPresetRepo, mockShoppingRepo, mockCategoryRepo)
+		_, err := service.CommitImport(&domainmodel.ImportInput{
+			HouseHoldID: 10,
+			File:        strings.NewReader(zaimCSV),
+			PresetID:    3,
+		})
+		var appErr *apperrors.AppError
+		assert.True(t, errors.As(err, &appErr))
+		assert.Equal(t, apperrors.ErrorCodeInvalidInput, appErr.Code)
+		assert.Equal(t, domainmodel.ErrImportHasErrors, appErr.Err)
+	})
+
+	t.Run("存在しない組み込みのプリセット", func(t *testing.T) {
+		service := NewImportService(nil, nil, nil)
+		_, err := service.PreviewImport(&domainmodel.ImportInput{HouseHoldID: 10, File: strings.NewReader(zaimCSV), PresetKey: "unknown"})
+		var appErr *apperrors.AppError
+		assert.True(t, errors.As(err, &appErr))
+		assert.Equal(t, apperrors.ErrorCodeNotFound, appErr.Code)
+	})
+}
+
+func TestImportService_SavePreset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mapping := domainmodel.ImportColumnMapping{DateColumn: "日付", AmountColumn: "金額", CategoryColumn: "カテゴリ"}
+
+	t.Run("同じ名前のプリセットは保存できない", func(t *testing.T) {
+		mockPresetRepo := mock.NewMockImportPresetRepository(ctrl)
+		mockPresetRepo.EXPECT().FindByHouseHoldID(domainmodel.HouseHoldID(10)).Return([]*domainmodel.ImportPreset{
+			{ID: 1, HouseHoldID: 10, Name: "スプレッドシート", Mapping: mapping},
+		}, nil)
+
+		service := NewImportService(mockPresetRepo, nil, nil)
+		err := service.SavePreset(&domainmodel.ImportPreset{HouseHoldID: 10, Name: " スプレッドシート ", Mapping: mapping})
+		var appErr *apperrors.AppError
+		assert.True(t, errors.As(err, &appErr))
+		assert.Equal(t, apperrors.ErrorCodeConflict, appErr.Code)
+	})
+
+	t.Run("日付の列がない対応は保存できない", func(t *testing.T) {
+		service := NewImportService(nil, nil, nil)
+		err := service.SavePreset(&domainmodel.ImportPreset{HouseHoldID: 10, Name: "家計簿", Mapping: domainmodel.ImportColumnMapping{AmountColumn: "金額", CategoryColumn: "カテゴリ"}})
+		var appErr *apperrors.AppError
+		assert.True(t, errors.As(err, &appErr))
+		assert.Equal(t, domainmodel.ErrInvalidImportMapping, appErr.Err)
+	})
+}
@@ -0,0 +1,154 @@
+package domainservice
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type IncomeService interface {
+	// 収入カテゴリ
+	FetchIncomeCategories(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.IncomeCategory, error)
+	AddIncomeCategory(category *domainmodel.IncomeCategory) error
+	// 収入
+	FetchIncomes(houseHoldID domainmodel.HouseHoldID, month string) ([]*domainmodel.Income, error)
+	CreateIncome(income *domainmodel.Income) error
+	UpdateIncome(income *domainmodel.Income) error
+	RemoveIncome(houseHoldID domainmodel.HouseHoldID, incomeID domainmodel.IncomeID) error
+}
+
+type incomeService struct {
+	incomeRepository        domainmodel.IncomeRepository
+	houseHoldRepository     domainmodel.HouseHoldRepository
+	paymentMethodRepository domainmodel.PaymentMethodRepository
+}
+
+// FetchIncomeCategories implements IncomeService.
+// 収入カテゴリが一つもない家計簿には、既定の収入カテゴリを登録する
+func (s *incomeService) FetchIncomeCategories(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.IncomeCategory, error) {
+	categories, err := s.incomeRepository.FindIncomeCategories(houseHoldID)
+	if err != nil {
+		return nil, err
+	}
+	if len(categories) > 0 {
+		return categories, nil
+	}
+
+	categories = domainmodel.NewDefaultIncomeCategories(houseHoldID)
+	if err := s.incomeRepository.CreateIncomeCategories(categories); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// AddIncomeCategory implements IncomeService.
+// 追加した収入カテゴリは並び順の末尾に配置する
+func (s *incomeService) AddIncomeCategory(category *domainmodel.IncomeCategory) error {
+	if err := category.Validate(); err != nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	categories, err := s.FetchIncomeCategories(category.HouseHoldID)
+	if err != nil {
+		return err
+	}
+	category.SortOrder = 1
+	for _, c := range categories {
+		if c.SortOrder >= category.SortOrder {
+			category.SortOrder = c.SortOrder + 1
+		}
+	}
+
+	return s.incomeRepository.CreateIncomeCategories([]*domainmodel.IncomeCategory{category})
+}
+
+// FetchIncomes implements IncomeService.
+func (s *incomeService) FetchIncomes(houseHoldID domainmodel.HouseHoldID, month string) ([]*domainmodel.Income, error) {
+	if _, err := domainmodel.ParseBudgetMonth(month); err != nil {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	return s.incomeRepository.FindIncomes(houseHoldID, month)
+}
+
+// CreateIncome implements IncomeService.
+func (s *incomeService) CreateIncome(income *domainmodel.Income) error {
+	if err := s.validateIncome(income); err != nil {
+		return err
+	}
+
+	return s.incomeRepository.CreateIncome(income)
+}
+
+// UpdateIncome implements IncomeService.
+func (s *incomeService) UpdateIncome(income *domainmodel.Income) error {
+	if err := s.validateIncome(income); err != nil {
+		return err
+	}
+
+	if err := s.incomeRepository.UpdateIncome(income); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "income not found in household", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// RemoveIncome implements IncomeService.
+func (s *incomeService) RemoveIncome(houseHoldID domainmodel.HouseHoldID, incomeID domainmodel.IncomeID) error {
+	if err := s.incomeRepository.DeleteIncome(houseHoldID, incomeID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "income not found in household", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// validateIncome は収入の値に加え、収入カテゴリとメンバーが同じ家計簿に属しているかを検証する
+func (s *incomeService) validateIncome(income *domainmodel.Income) error {
+	if err := income.Validate(); err != nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	categories, err := s.incomeRepository.FindIncomeCategories(income.HouseHoldID)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, category := range categories {
+		if category.ID == income.IncomeCategoryID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "income category not found in household", nil)
+	}
+
+	if income.UserID != nil {
+		member, err := s.houseHoldRepository.FindUserHouseHold(*income.UserID, income.HouseHoldID)
+		if err != nil {
+			return err
+		}
+		if member == nil {
+			return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrNotHouseHoldMember.Error(), domainmodel.ErrNotHouseHoldMember)
+		}
+	}
+
+	return validatePaymentMethod(s.paymentMethodRepository, income.HouseHoldID, income.PaymentMethodID)
+}
+
+func NewIncomeService(incomeRepository domainmodel.IncomeRepository, houseHoldRepository domainmodel.HouseHoldRepository, paymentMethodRepository domainmodel.PaymentMethodRepository) IncomeService {
+	return &incomeService{
+		incomeRepository:        incomeRepository,
+		houseHoldRepository:     houseHoldRepository,
+		paymentMethodRepository: paymentMethodRepository,
+	}
+}
@@ -0,0 +1,142 @@
+package domainservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	mock "echo-household-budget/internal/domain/mock/domainmodel"
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+)
+
+func TestIncomeService_FetchIncomeCategories(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// 収入カテゴリが未登録の家計簿には既定のカテゴリを登録する
+	mockIncomeRepo := mock.NewMockIncomeRepository(ctrl)
+	mockIncomeRepo.EXPECT().FindIncomeCategories(domainmodel.HouseHoldID(10)).Return([]*domainmodel.IncomeCategory{}, nil)
+	mockIncomeRepo.EXPECT().CreateIncomeCategories(domainmodel.NewDefaultIncomeCategories(10)).Return(nil)
+
+	service := NewIncomeService(mockIncomeRepo, nil, nil)
+	categories, err := service.FetchIncomeCategories(10)
+	assert.NoError(t, err)
+	assert.Len(t, categories, 3)
+	assert.Equal(t, "給与", categories[0].Name)
+}
+
+func TestIncomeService_AddIncomeCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockIncomeRepo := mock.NewMockIncomeRepository(ctrl)
+	mockIncomeRepo.EXPECT().FindIncomeCategories(domainmodel.HouseHoldID(10)).Return(domainmodel.NewDefaultIncomeCategories(10), nil)
+	mockIncomeRepo.EXPECT().CreateIncomeCategories(gomock.Any()).DoAndReturn(func(categories []*domainmodel.IncomeCategory) error {
+		// 追加したカテゴリは末尾に並ぶ
+		assert.Equal(t, 4, categories[0].SortOrder)
+		return nil
+	})
+
+	service := NewIncomeService(mockIncomeRepo, nil, nil)
+	assert.NoError(t, service.AddIncomeCategory(&domainmodel.IncomeCategory{HouseHoldID: 10, Name: "配当", Color: "#9C27B0"}))
+
+	err := service.AddIncomeCategory(&domainmodel.IncomeCategory{HouseHoldID: 10, Name: "", Color: "#9C27B0"})
+	appErr, ok := apperrors.GetAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, apperrors.ErrorCodeInvalidInput, appErr.Code)
+}
+
+func TestIncomeService_CreateIncome(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	categories := []*domainmodel.IncomeCategory{
+		{ID: 1, HouseHoldID: 10, Name: "給与"},
+	}
+	memberID := domainmodel.UserID(2)
+	outsiderID := domainmodel.UserID(99)
+
+	tests := []struct {
+		name         string
+		income       *domainmodel.Income
+		mockSetup    func(*mock.MockIncomeRepository, *mock.MockHouseHoldRepository)
+		expectedCode apperrors.ErrorCode
+	}{
+		{
+			name:   "メンバーの収入を登録できる",
+			income: &domainmodel.Income{HouseHoldID: 10, IncomeCategoryID: 1, UserID: &memberID, Amount: 300000, Date: "2026-10-25"},
+			mockSetup: func(i *mock.MockIncomeRepository, h *mock.MockHouseHoldRepository) {
+				i.EXPECT().FindIncomeCategories(domainmodel.HouseHoldID(10)).Return(categories, nil)
+				h.EXPECT().FindUserHouseHold(memberID, domainmodel.HouseHoldID(10)).Return(member(memberID, domainmodel.HouseHoldRoleEditor), nil)
+				i.EXPECT().CreateIncome(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:   "家計簿全体の収入はメンバーを確認しない",
+			income: &domainmodel.Income{HouseHoldID: 10, IncomeCategoryID: 1, Amount: 5000, Date: "2026-10-28"},
+			mockSetup: func(i *mock.MockIncomeRepository, h *mock.MockHouseHoldRepository) {
+				i.EXPECT().FindIncomeCategories(domainmodel.HouseHoldID(10)).Return(categories, nil)
+				i.EXPECT().CreateIncome(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:         "金額が0の収入は登録できない",
+			income:       &domainmodel.Income{HouseHoldID: 10, IncomeCategoryID: 1, Amount: 0, Date: "2026-10-25"},
+			mockSetup:    func(i *mock.MockIncomeRepository, h *mock.MockHouseHoldRepository) {},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+		{
+			name:   "他の家計簿の収入カテゴリは指定できない",
+			income: &domainmodel.Income{HouseHoldID: 10, IncomeCategoryID: 99, Amount: 300000, Date: "2026-10-25"},
+			mockSetup: func(i *mock.MockIncomeRepository, h *mock.MockHouseHoldRepository) {
+				i.EXPECT().FindIncomeCategories(domainmodel.HouseHoldID(10)).Return(categories, nil)
+			},
+			expectedCode: apperrors.ErrorCodeNotFound,
+		},
+		{
+			name:   "メンバー以外の収入は登録できない",
+			income: &domainmodel.Income{HouseHoldID: 10, IncomeCategoryID: 1, UserID: &outsiderID, Amount: 300000, Date: "2026-10-25"},
+			mockSetup: func(i *mock.MockIncomeRepository, h *mock.MockHouseHoldRepository) {
+				i.EXPECT().FindIncomeCategories(domainmodel.HouseHoldID(10)).Return(categories, nil)
+				h.EXPECT().FindUserHouseHold(outsiderID, domainmodel.HouseHoldID(10)).Return(nil, nil)
+			},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockIncomeRepo := mock.NewMockIncomeRepository(ctrl)
+			mockHouseHoldRepo := mock.NewMockHouseHoldRepository(ctrl)
+			tt.mockSetup(mockIncomeRepo, mockHouseHoldRepo)
+
+			service := NewIncomeService(mockIncomeRepo, mockHouseHoldRepo, nil)
+			err := service.CreateIncome(tt.income)
+
+			if tt.expectedCode != "" {
+				appErr, ok := apperrors.GetAppError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIncomeService_RemoveIncome(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockIncomeRepo := mock.NewMockIncomeRepository(ctrl)
+	mockIncomeRepo.EXPECT().DeleteIncome(domainmodel.HouseHoldID(10), domainmodel.IncomeID(5)).Return(gorm.ErrRecordNotFound)
+
+	service := NewIncomeService(mockIncomeRepo, nil, nil)
+	err := service.RemoveIncome(10, 5)
+	appErr, ok := apperrors.GetAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, apperrors.ErrorCodeNotFound, appErr.Code)
+}
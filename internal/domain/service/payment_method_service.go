@@ -0,0 +1,221 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainservice
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type PaymentMethodService interface {
+	FetchPaymentMethods(houseHoldID domainmodel.HouseHoldID, includeArchived bool) ([]*domainmodel.PaymentMethod, error)
+	AddPaymentMethod(method *domainmodel.PaymentMethod) error
+	UpdatePaymentMethod(method *domainmodel.PaymentMethod) error
+	ArchivePaymentMethod(houseHoldID domainmodel.HouseHoldID, id domainmodel.PaymentMethodID, archived bool) error
+	// 残高
+	FetchBalances(houseHoldID domainmodel.HouseHoldID, date string) ([]*domainmodel.PaymentMethodBalance, error)
+	FetchLedger(houseHoldID domainmodel.HouseHoldID, id domainmodel.PaymentMethodID, month string) (*domainmodel.PaymentMethodLedger, error)
+	// FetchStatement は指定月に締めるクレジットカードの請求を取得する
+	FetchStatement(houseHoldID domainmodel.HouseHoldID, id domainmodel.PaymentMethodID, month string) (*domainmodel.BillingStatement, error)
+}
+
+type paymentMethodService struct {
+	paymentMethodRepository domainmodel.PaymentMethodRepository
+}
+
+// FetchPaymentMethods implements PaymentMethodService.
+func (s *paymentMethodService) FetchPaymentMethods(houseHoldID domainmodel.HouseHoldID, includeArchived bool) ([]*domainmodel.PaymentMethod, error) {
+	return s.paymentMethodRepository.FindByHouseHoldID(houseHoldID, includeArchived)
+}
+
+// AddPaymentMethod implements PaymentMethodService.
+func (s *paymentMethodService) AddPaymentMethod(method *domainmodel.PaymentMethod) error {
+	if err := s.validatePaymentMethod(method); err != nil {
+		return err
+	}
+
+	return s.paymentMethodRepository.Create(method)
+}
+
+// UpdatePaymentMethod implements PaymentMethodService.
+func (s *paymentMethodService) UpdatePaymentMethod(method *domainmodel.PaymentMethod) error {
+	if err := s.validatePaymentMethod(method); err != nil {
+		return err
+	}
+
+	if err := s.paymentMethodRepository.Update(method); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "payment method not found in household", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ArchivePaymentMethod implements PaymentMethodService.
+func (s *paymentMethodService) ArchivePaymentMethod(houseHoldID domainmodel.HouseHoldID, id domainmodel.PaymentMethodID, archived bool) error {
+	var archivedAt *time.Time
+	if archived {
+		now := time.Now()
+		archivedAt = &now
+	}
+
+	if err := s.paymentMethodRepository.Archive(houseHoldID, id, archivedAt); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "payment method not found in household", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// FetchBalances implements PaymentMethodService.
+// アーカイブ済みの支払い方法も、引き落とし口座の残高に影響するため含める
+func (s *paymentMethodService) FetchBalances(houseHoldID domainmodel.HouseHoldID, date string) ([]*domainmodel.PaymentMethodBalance, error) {
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrInvalidBalanceDate.Error(), domainmodel.ErrInvalidBalanceDate)
+	}
+
+	methods, err := s.paymentMethodRepository.FindByHouseHoldID(houseHoldID, true)
+	if err != nil {
+		return nil, err
+	}
+	amounts, err := s.paymentMethodRepository.SummarizeDailyAmounts(houseHoldID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	return domainmodel.NewPaymentMethodBalances(methods, amounts, date), nil
+}
+
+// FetchLedger implements PaymentMethodService.
+// 期首残高は前月末時点の残高とし、クレジットカードの請求の引き落としを入出金に含める
+func (s *paymentMethodService) FetchLedger(houseHoldID domainmodel.HouseHoldID, id domainmodel.PaymentMethodID, month string) (*domainmodel.PaymentMethodLedger, error) {
+	start, err := domainmodel.ParseBudgetMonth(month)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+	from := start.Format("2006-01-02")
+	to := start.AddDate(0, 1, -1).Format("2006-01-02")
+
+	method, err := s.findPaymentMethod(houseHoldID, id)
+	if err != nil {
+		return nil, err
+	}
+	methods, err := s.paymentMethodRepository.FindByHouseHoldID(houseHoldID, true)
+	if err != nil {
+		return nil, err
+	}
+	amounts, err := s.paymentMethodRepository.SummarizeDailyAmounts(houseHoldID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	openingBalance := 0
+	for _, balance := range domainmodel.NewPaymentMethodBalances(methods, amounts, start.AddDate(0, 0, -1).Format("2006-01-02")) {
+		if balance.PaymentMethod.ID == method.ID {
+			openingBalance = balance.Balance
+		}
+	}
+	if from <= method.OpeningDate && method.OpeningDate <= to {
+		openingBalance = method.OpeningBalance
+	}
+
+	transactionsFrom := from
+	if transactionsFrom < method.OpeningDate {
+		transactionsFrom = method.OpeningDate
+	}
+	transactions, err := s.paymentMethodRepository.FindTransactions(houseHoldID, id, transactionsFrom, to)
+	if err != nil {
+		return nil, err
+	}
+	transactions = append(transactions, domainmodel.BillTransactions(method, methods, amounts, from, to)...)
+
+	return domainmodel.NewPaymentMethodLedger(method, from, to, openingBalance, transactions), nil
+}
+
+// FetchStatement implements PaymentMethodService.
+func (s *paymentMethodService) FetchStatement(houseHoldID domainmodel.HouseHoldID, id domainmodel.PaymentMethodID, month string) (*domainmodel.BillingStatement, error) {
+	start, err := domainmodel.ParseBudgetMonth(month)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	method, err := s.findPaymentMethod(houseHoldID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !method.IsCreditCard() {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrNotCreditCard.Error(), domainmodel.ErrNotCreditCard)
+	}
+
+	closing := method.StatementClosingIn(start)
+	from, to := method.StatementPeriod(closing)
+	transactions, err := s.paymentMethodRepository.FindTransactions(houseHoldID, id, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.NewBillingStatement(closing, transactions), nil
+}
+
+// findPaymentMethod は家計簿の支払い方法を取得する。存在しない場合は NotFound を返す
+func (s *paymentMethodService) findPaymentMethod(houseHoldID domainmodel.HouseHoldID, id domainmodel.PaymentMethodID) (*domainmodel.PaymentMethod, error) {
+	method, err := s.paymentMethodRepository.FindByID(houseHoldID, id)
+	if err != nil {
+		return nil, err
+	}
+	if method == nil {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeNotFound, "payment method not found in household", nil)
+	}
+
+	return method, nil
+}
+
+// validatePaymentMethod は支払い方法の値に加え、引き落とし口座が家計簿のクレジットカード以外の支払い方法であるかを検証する
+func (s *paymentMethodService) validatePaymentMethod(method *domainmodel.PaymentMethod) error {
+	if err := method.Validate(); err != nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+	if method.WithdrawalAccountID == nil {
+		return nil
+	}
+
+	account, err := s.paymentMethodRepository.FindByID(method.HouseHoldID, *method.WithdrawalAccountID)
+	if err != nil {
+		return err
+	}
+	if account == nil || account.IsCreditCard() {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrInvalidWithdrawalAccount.Error(), domainmodel.ErrInvalidWithdrawalAccount)
+	}
+
+	return nil
+}
+
+// validatePaymentMethod は支出・収入に指定した支払い方法が家計簿に属しているかを検証する
+func validatePaymentMethod(repository domainmodel.PaymentMethodRepository, houseHoldID domainmodel.HouseHoldID, id *domainmodel.PaymentMethodID) error {
+	if id == nil {
+		return nil
+	}
+
+	method, err := repository.FindByID(houseHoldID, *id)
+	if err != nil {
+		return err
+	}
+	if method == nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "payment method not found in household", nil)
+	}
+
+	return nil
+}
+
+func NewPaymentMethodService(paymentMethodRepository domainmodel.PaymentMethodRepository) PaymentMethodService {
+	return &paymentMethodService{
+		paymentMethodRepository: paymentMethodRepository,
+	}
+}
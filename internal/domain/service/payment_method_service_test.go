@@ -0,0 +1,138 @@
+package domainservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mock "echo-household-budget/internal/domain/mock/domainmodel"
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+)
+
+func intPtr(v int) *int { return &v }
+
+func TestPaymentMethodService_AddPaymentMethod(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	withdrawalAccountID := domainmodel.PaymentMethodID(1)
+	card := func() *domainmodel.PaymentMethod {
+		return &domainmodel.PaymentMethod{
+			HouseHoldID: 10, Name: "カード", Type: domainmodel.PaymentMethodCreditCard, OpeningDate: "2026-10-01",
+			ClosingDay: intPtr(15), PaymentDay: intPtr(10), WithdrawalAccountID: &withdrawalAccountID,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		method       *domainmodel.PaymentMethod
+		mockSetup    func(*mock.MockPaymentMethodRepository)
+		expectedCode apperrors.ErrorCode
+	}{
+		{
+			name:   "銀行口座から引き落とすクレジットカードを追加できる",
+			method: card(),
+			mockSetup: func(r *mock.MockPaymentMethodRepository) {
+				r.EXPECT().FindByID(domainmodel.HouseHoldID(10), withdrawalAccountID).Return(&domainmodel.PaymentMethod{ID: 1, Type: domainmodel.PaymentMethodBank}, nil)
+				r.EXPECT().Create(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:   "クレジットカードを引き落とし口座に指定できない",
+			method: card(),
+			mockSetup: func(r *mock.MockPaymentMethodRepository) {
+				r.EXPECT().FindByID(domainmodel.HouseHoldID(10), withdrawalAccountID).Return(&domainmodel.PaymentMethod{ID: 1, Type: domainmodel.PaymentMethodCreditCard}, nil)
+			},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+		{
+			name:   "家計簿にない口座を引き落とし口座に指定できない",
+			method: card(),
+			mockSetup: func(r *mock.MockPaymentMethodRepository) {
+				r.EXPECT().FindByID(domainmodel.HouseHoldID(10), withdrawalAccountID).Return(nil, nil)
+			},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+		{
+			name:         "締め日のないクレジットカードは追加できない",
+			method:       &domainmodel.PaymentMethod{HouseHoldID: 10, Name: "カード", Type: domainmodel.PaymentMethodCreditCard, OpeningDate: "2026-10-01"},
+			mockSetup:    func(r *mock.MockPaymentMethodRepository) {},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockPaymentMethodRepository(ctrl)
+			tt.mockSetup(mockRepo)
+
+			service := NewPaymentMethodService(mockRepo)
+			err := service.AddPaymentMethod(tt.method)
+
+			if tt.expectedCode != "" {
+				appErr, ok := apperrors.GetAppError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPaymentMethodService_FetchLedger(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bankID := domainmodel.PaymentMethodID(1)
+	bank := &domainmodel.PaymentMethod{ID: 1, HouseHoldID: 10, Name: "銀行", Type: domainmodel.PaymentMethodBank, OpeningBalance: 100000, OpeningDate: "2026-08-01"}
+	card := &domainmodel.PaymentMethod{
+		ID: 2, HouseHoldID: 10, Name: "カード", Type: domainmodel.PaymentMethodCreditCard, OpeningDate: "2026-08-01",
+		ClosingDay: intPtr(15), PaymentDay: intPtr(10), WithdrawalAccountID: &bankID,
+	}
+
+	mockRepo := mock.NewMockPaymentMethodRepository(ctrl)
+	mockRepo.EXPECT().FindByID(domainmodel.HouseHoldID(10), bankID).Return(bank, nil)
+	mockRepo.EXPECT().FindByHouseHoldID(domainmodel.HouseHoldID(10), true).Return([]*domainmodel.PaymentMethod{bank, card}, nil)
+	mockRepo.EXPECT().SummarizeDailyAmounts(domainmodel.HouseHoldID(10), "2026-09-30").Return([]*domainmodel.PaymentMethodDailyAmount{
+		{PaymentMethodID: 2, Date: "2026-08-10", Expense: 3000},
+		{PaymentMethodID: 1, Date: "2026-08-25", Income: 300000},
+	}, nil)
+	mockRepo.EXPECT().FindTransactions(domainmodel.HouseHoldID(10), bankID, "2026-09-01", "2026-09-30").Return([]*domainmodel.PaymentMethodTransaction{
+		{Type: domainmodel.PaymentMethodTransactionExpense, ID: 5, Date: "2026-09-27", Amount: 80000, Memo: "家賃"},
+	}, nil)
+
+	service := NewPaymentMethodService(mockRepo)
+	ledger, err := service.FetchLedger(10, bankID, "2026-09")
+	assert.NoError(t, err)
+	assert.Equal(t, 400000, ledger.OpeningBalance)
+	assert.Equal(t, 317000, ledger.ClosingBalance)
+	assert.Len(t, ledger.Entries, 2)
+	assert.Equal(t, domainmodel.PaymentMethodTransactionBillWithdrawal, ledger.Entries[0].Type)
+	assert.Equal(t, 397000, ledger.Entries[0].Balance)
+}
+
+func TestPaymentMethodService_FetchStatement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockPaymentMethodRepository(ctrl)
+	mockRepo.EXPECT().FindByID(domainmodel.HouseHoldID(10), domainmodel.PaymentMethodID(1)).
+		Return(&domainmodel.PaymentMethod{ID: 1, Type: domainmodel.PaymentMethodBank, OpeningDate: "2026-08-01"}, nil)
+	mockRepo.EXPECT().FindByID(domainmodel.HouseHoldID(10), domainmodel.PaymentMethodID(9)).Return(nil, nil)
+
+	service := NewPaymentMethodService(mockRepo)
+
+	// クレジットカード以外は請求を持たない
+	_, err := service.FetchStatement(10, 1, "2026-09")
+	appErr, ok := apperrors.GetAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, apperrors.ErrorCodeInvalidInput, appErr.Code)
+
+	_, err = service.FetchStatement(10, 9, "2026-09")
+	appErr, ok = apperrors.GetAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, apperrors.ErrorCodeNotFound, appErr.Code)
+}
@@ -0,0 +1,271 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainservice
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"errors"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type RecurringTransactionService interface {
+	// 定期取引の定義
+	FetchRecurringTransactions(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.RecurringTransaction, error)
+	CreateRecurringTransaction(recurringTransaction *domainmodel.RecurringTransaction) error
+	UpdateRecurringTransaction(recurringTransaction *domainmodel.RecurringTransaction) error
+	RemoveRecurringTransaction(houseHoldID domainmodel.HouseHoldID, id domainmodel.RecurringTransactionID) error
+	// 発生予定
+	FetchUpcomingOccurrences(houseHoldID domainmodel.HouseHoldID, until time.Time) (domainmodel.RecurringOccurrences, error)
+	SkipOccurrence(houseHoldID domainmodel.HouseHoldID, id domainmodel.RecurringTransactionID, date string) error
+	AdjustOccurrence(houseHoldID domainmodel.HouseHoldID, id domainmodel.RecurringTransactionID, date string, amount *int, memo *string) error
+	// MaterializeDueOccurrences は today までの未登録の発生分を支出として登録し、登録した件数を返す
+	MaterializeDueOccurrences(today time.Time) (int, error)
+}
+
+type recurringTransactionService struct {
+	recurringTransactionRepository domainmodel.RecurringTransactionRepository
+	categoryRepository             domainmodel.CategoryRepository
+	houseHoldService               HouseHoldService
+}
+
+// FetchRecurringTransactions implements RecurringTransactionService.
+func (s *recurringTransactionService) FetchRecurringTransactions(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.RecurringTransaction, error) {
+	return s.recurringTransactionRepository.FindByHouseHoldID(houseHoldID)
+}
+
+// CreateRecurringTransaction implements RecurringTransactionService.
+// 開始日が過去の場合でも、過去の発生分は登録せず今日以降の発生分から登録する
+func (s *recurringTransactionService) CreateRecurringTransaction(recurringTransaction *domainmodel.RecurringTransaction) error {
+	if err := s.validateRecurringTransaction(recurringTransaction); err != nil {
+		return err
+	}
+
+	yesterday := time.Now().AddDate(0, 0, -1).Format(domainmodel.RecurringDateLayout)
+	if recurringTransaction.StartDate <= yesterday {
+		recurringTransaction.MaterializedUntil = &yesterday
+	} else {
+		recurringTransaction.MaterializedUntil = nil
+	}
+
+	return s.recurringTransactionRepository.Create(recurringTransaction)
+}
+
+// UpdateRecurringTransaction implements RecurringTransactionService.
+func (s *recurringTransactionService) UpdateRecurringTransaction(recurringTransaction *domainmodel.RecurringTransaction) error {
+	if err := s.validateRecurringTransaction(recurringTransaction); err != nil {
+		return err
+	}
+
+	if err := s.recurringTransactionRepository.Update(recurringTransaction); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "recurring transaction not found in household", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// RemoveRecurringTransaction implements RecurringTransactionService.
+// 登録済みの支出は削除しない
+func (s *recurringTransactionService) RemoveRecurringTransaction(houseHoldID domainmodel.HouseHoldID, id domainmodel.RecurringTransactionID) error {
+	if err := s.recurringTransactionRepository.Delete(houseHoldID, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "recurring transaction not found in household", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// FetchUpcomingOccurrences implements RecurringTransactionService.
+// 未登録の発生分を until まで、スキップ・変更を反映して日付順で返す
+func (s *recurringTransactionService) FetchUpcomingOccurrences(houseHoldID domainmodel.HouseHoldID, until time.Time) (domainmodel.RecurringOccurrences, error) {
+	recurringTransactions, err := s.recurringTransactionRepository.FindByHouseHoldID(houseHoldID)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences, err := s.pendingOccurrences(recurringTransactions, until)
+	if err != nil {
+		return nil, err
+	}
+
+	upcoming := domainmodel.RecurringOccurrences{}
+	for _, recurringTransaction := range recurringTransactions {
+		upcoming = append(upcoming, occurrences[recurringTransaction.ID]...)
+	}
+	upcoming.Sort()
+
+	return upcoming, nil
+}
+
+// SkipOccurrence implements RecurringTransactionService.
+func (s *recurringTransactionService) SkipOccurrence(houseHoldID domainmodel.HouseHoldID, id domainmodel.RecurringTransactionID, date string) error {
+	if err := s.validateOccurrence(houseHoldID, id, date); err != nil {
+		return err
+	}
+
+	return s.recurringTransactionRepository.SaveOverride(&domainmodel.RecurringOccurrenceOverride{
+		RecurringTransactionID: id,
+		Date:                   date,
+		Skipped:                true,
+	})
+}
+
+// AdjustOccurrence implements RecurringTransactionService.
+// 変更した発生分はスキップを取り消す
+func (s *recurringTransactionService) AdjustOccurrence(houseHoldID domainmodel.HouseHoldID, id domainmodel.RecurringTransactionID, date string, amount *int, memo *string) error {
+	if amount != nil && *amount <= 0 {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrInvalidRecurringAmount.Error(), domainmodel.ErrInvalidRecurringAmount)
+	}
+	if err := s.validateOccurrence(houseHoldID, id, date); err != nil {
+		return err
+	}
+
+	return s.recurringTransactionRepository.SaveOverride(&domainmodel.RecurringOccurrenceOverride{
+		RecurringTransactionID: id,
+		Date:                   date,
+		Amount:                 amount,
+		Memo:                   memo,
+	})
+}
+
+// MaterializeDueOccurrences implements RecurringTransactionService.
+// 複数のプロセスで同時に実行しても重複して登録しないよう、登録済みの日付を先に進めてから支出を登録する
+func (s *recurringTransactionService) MaterializeDueOccurrences(today time.Time) (int, error) {
+	todayDate := today.Format(domainmodel.RecurringDateLayout)
+	recurringTransactions, err := s.recurringTransactionRepository.FindDue(todayDate)
+	if err != nil {
+		return 0, err
+	}
+
+	occurrences, err := s.pendingOccurrences(recurringTransactions, today)
+	if err != nil {
+		return 0, err
+	}
+
+	materialized := 0
+	for _, recurringTransaction := range recurringTransactions {
+		claimed, err := s.recurringTransactionRepository.AdvanceMaterializedUntil(recurringTransaction.ID, recurringTransaction.MaterializedUntil, todayDate)
+		if err != nil {
+			log.Printf("定期取引の登録に失敗しました（ID: %d）: %v", recurringTransaction.ID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		for _, occurrence := range occurrences[recurringTransaction.ID] {
+			if occurrence.Skipped {
+				continue
+			}
+			if err := s.houseHoldService.CreateShoppingAmount(occurrence.ToShoppingAmount(recurringTransaction.HouseHoldID)); err != nil {
+				log.Printf("定期取引の登録に失敗しました（ID: %d, 日付: %s）: %v", recurringTransaction.ID, occurrence.Date, err)
+				continue
+			}
+			materialized++
+		}
+	}
+
+	return materialized, nil
+}
+
+// pendingOccurrences は定期取引ごとの未登録の発生分を、スキップ・変更を反映して返す
+func (s *recurringTransactionService) pendingOccurrences(recurringTransactions []*domainmodel.RecurringTransaction, until time.Time) (map[domainmodel.RecurringTransactionID]domainmodel.RecurringOccurrences, error) {
+	dates := make(map[domainmodel.RecurringTransactionID][]time.Time, len(recurringTransactions))
+	ids := []domainmodel.RecurringTransactionID{}
+	var from time.Time
+	for _, recurringTransaction := range recurringTransactions {
+		pending := recurringTransaction.PendingOccurrences(until)
+		if len(pending) == 0 {
+			continue
+		}
+		dates[recurringTransaction.ID] = pending
+		ids = append(ids, recurringTransaction.ID)
+		if from.IsZero() || pending[0].Before(from) {
+			from = pending[0]
+		}
+	}
+
+	occurrences := make(map[domainmodel.RecurringTransactionID]domainmodel.RecurringOccurrences, len(ids))
+	if len(ids) == 0 {
+		return occurrences, nil
+	}
+
+	overrides, err := s.recurringTransactionRepository.FindOverrides(ids, from.Format(domainmodel.RecurringDateLayout), until.Format(domainmodel.RecurringDateLayout))
+	if err != nil {
+		return nil, err
+	}
+	overrideMap := make(map[domainmodel.RecurringTransactionID]map[string]*domainmodel.RecurringOccurrenceOverride)
+	for _, override := range overrides {
+		if _, ok := overrideMap[override.RecurringTransactionID]; !ok {
+			overrideMap[override.RecurringTransactionID] = make(map[string]*domainmodel.RecurringOccurrenceOverride)
+		}
+		overrideMap[override.RecurringTransactionID][override.Date] = override
+	}
+
+	for _, recurringTransaction := range recurringTransactions {
+		for _, date := range dates[recurringTransaction.ID] {
+			override := overrideMap[recurringTransaction.ID][date.Format(domainmodel.RecurringDateLayout)]
+			occurrences[recurringTransaction.ID] = append(occurrences[recurringTransaction.ID], domainmodel.NewRecurringOccurrence(recurringTransaction, date, override))
+		}
+	}
+
+	return occurrences, nil
+}
+
+// validateRecurringTransaction は定義の値に加え、カテゴリが家計簿に属しているかを検証する
+func (s *recurringTransactionService) validateRecurringTransaction(recurringTransaction *domainmodel.RecurringTransaction) error {
+	if err := recurringTransaction.Validate(); err != nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	categories, err := s.categoryRepository.FindHouseHoldCategories(recurringTransaction.HouseHoldID, false)
+	if err != nil {
+		return err
+	}
+	for _, category := range categories {
+		if category.Category.ID == recurringTransaction.CategoryID {
+			return nil
+		}
+	}
+
+	return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "category not found in household", nil)
+}
+
+// validateOccurrence は指定日が未登録の発生日であるかを検証する
+func (s *recurringTransactionService) validateOccurrence(houseHoldID domainmodel.HouseHoldID, id domainmodel.RecurringTransactionID, date string) error {
+	recurringTransaction, err := s.recurringTransactionRepository.FindByID(houseHoldID, id)
+	if err != nil {
+		return err
+	}
+	if recurringTransaction == nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "recurring transaction not found in household", nil)
+	}
+
+	occurrenceDate, err := domainmodel.ParseRecurringDate(date)
+	if err != nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+	if !recurringTransaction.IsOccurrence(occurrenceDate) {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrNotRecurringOccurrence.Error(), domainmodel.ErrNotRecurringOccurrence)
+	}
+	if recurringTransaction.IsMaterialized(occurrenceDate) {
+		return apperrors.NewAppError(apperrors.ErrorCodeConflict, domainmodel.ErrRecurringOccurrencePassed.Error(), domainmodel.ErrRecurringOccurrencePassed)
+	}
+
+	return nil
+}
+
+func NewRecurringTransactionService(recurringTransactionRepository domainmodel.RecurringTransactionRepository, categoryRepository domainmodel.CategoryRepository, houseHoldService HouseHoldService) RecurringTransactionService {
+	return &recurringTransactionService{
+		recurringTransactionRepository: recurringTransactionRepository,
+		categoryRepository:             categoryRepository,
+		houseHoldService:               houseHoldService,
+	}
+}
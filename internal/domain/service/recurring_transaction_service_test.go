@@ -0,0 +1,222 @@
+package domainservice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mock "echo-household-budget/internal/domain/mock/domainmodel"
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+)
+
+// shoppingAmountRecorder は登録された支出を記録する HouseHoldService
+type shoppingAmountRecorder struct {
+	HouseHoldService
+	created []*domainmodel.ShoppingAmount
+}
+
+func (r *shoppingAmountRecorder) CreateShoppingAmount(shoppingAmount *domainmodel.ShoppingAmount) error {
+	r.created = append(r.created, shoppingAmount)
+	return nil
+}
+
+func TestRecurringTransactionService_CreateRecurringTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	categories := []*domainmodel.CategoryLimit{
+		{Category: domainmodel.Category{ID: 1, Name: "住居費"}},
+	}
+	yesterday := time.Now().AddDate(0, 0, -1).Format(domainmodel.RecurringDateLayout)
+
+	tests := []struct {
+		name                      string
+		recurringTransaction      *domainmodel.RecurringTransaction
+		mockSetup                 func(*mock.MockRecurringTransactionRepository, *mock.MockCategoryRepository)
+		expectedMaterializedUntil *string
+		expectedCode              apperrors.ErrorCode
+	}{
+		{
+			name:                 "開始日が過去の場合は過去の発生分を登録しない",
+			recurringTransaction: &domainmodel.RecurringTransaction{HouseHoldID: 10, CategoryID: 1, Amount: 80000, Frequency: domainmodel.RecurrenceMonthly, Interval: 1, DayOfMonth: 27, StartDate: "2020-01-01"},
+			mockSetup: func(r *mock.MockRecurringTransactionRepository, c *mock.MockCategoryRepository) {
+				c.EXPECT().FindHouseHoldCategories(domainmodel.HouseHoldID(10), false).Return(categories, nil)
+				r.EXPECT().Create(gomock.Any()).Return(nil)
+			},
+			expectedMaterializedUntil: &yesterday,
+		},
+		{
+			name:                 "開始日が未来の場合は開始日から登録する",
+			recurringTransaction: &domainmodel.RecurringTransaction{HouseHoldID: 10, CategoryID: 1, Amount: 80000, Frequency: domainmodel.RecurrenceMonthly, Interval: 1, DayOfMonth: 27, StartDate: "2999-01-01"},
+			mockSetup: func(r *mock.MockRecurringTransactionRepository, c *mock.MockCategoryRepository) {
+				c.EXPECT().FindHouseHoldCategories(domainmodel.HouseHoldID(10), false).Return(categories, nil)
+				r.EXPECT().Create(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:                 "繰り返し単位が不正",
+			recurringTransaction: &domainmodel.RecurringTransaction{HouseHoldID: 10, CategoryID: 1, Amount: 80000, Frequency: "daily", Interval: 1, StartDate: "2026-10-01"},
+			mockSetup:            func(r *mock.MockRecurringTransactionRepository, c *mock.MockCategoryRepository) {},
+			expectedCode:         apperrors.ErrorCodeInvalidInput,
+		},
+		{
+			name:                 "他の家計簿のカテゴリは指定できない",
+			recurringTransaction: &domainmodel.RecurringTransaction{HouseHoldID: 10, CategoryID: 99, Amount: 80000, Frequency: domainmodel.RecurrenceWeekly, Interval: 1, StartDate: "2026-10-01"},
+			mockSetup: func(r *mock.MockRecurringTransactionRepository, c *mock.MockCategoryRepository) {
+				c.EXPECT().FindHouseHoldCategories(domainmodel.HouseHoldID(10), false).Return(categories, nil)
+			},
+			expectedCode: apperrors.ErrorCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRecurringRepo := mock.NewMockRecurringTransactionRepository(ctrl)
+			mockCategoryRepo := mock.NewMockCategoryRepository(ctrl)
+			tt.mockSetup(mockRecurringRepo, mockCategoryRepo)
+
+			service := NewRecurringTransactionService(mockRecurringRepo, mockCategoryRepo, nil)
+			err := service.CreateRecurringTransaction(tt.recurringTransaction)
+
+			if tt.expectedCode != "" {
+				appErr, ok := apperrors.GetAppError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedMaterializedUntil, tt.recurringTransaction.MaterializedUntil)
+			}
+		})
+	}
+}
+
+func TestRecurringTransactionService_SkipOccurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	materializedUntil := "2026-10-27"
+	recurringTransaction := &domainmodel.RecurringTransaction{
+		ID: 1, HouseHoldID: 10, CategoryID: 1, Amount: 80000,
+		Frequency: domainmodel.RecurrenceMonthly, Interval: 1, DayOfMonth: 27,
+		StartDate: "2026-10-01", MaterializedUntil: &materializedUntil,
+	}
+
+	tests := []struct {
+		name         string
+		date         string
+		mockSetup    func(*mock.MockRecurringTransactionRepository)
+		expectedCode apperrors.ErrorCode
+	}{
+		{
+			name: "未登録の発生分をスキップできる",
+			date: "2026-11-27",
+			mockSetup: func(r *mock.MockRecurringTransactionRepository) {
+				r.EXPECT().FindByID(domainmodel.HouseHoldID(10), domainmodel.RecurringTransactionID(1)).Return(recurringTransaction, nil)
+				r.EXPECT().SaveOverride(&domainmodel.RecurringOccurrenceOverride{RecurringTransactionID: 1, Date: "2026-11-27", Skipped: true}).Return(nil)
+			},
+		},
+		{
+			name: "発生日以外はスキップできない",
+			date: "2026-11-28",
+			mockSetup: func(r *mock.MockRecurringTransactionRepository) {
+				r.EXPECT().FindByID(domainmodel.HouseHoldID(10), domainmodel.RecurringTransactionID(1)).Return(recurringTransaction, nil)
+			},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+		{
+			name: "登録済みの発生分はスキップできない",
+			date: "2026-10-27",
+			mockSetup: func(r *mock.MockRecurringTransactionRepository) {
+				r.EXPECT().FindByID(domainmodel.HouseHoldID(10), domainmodel.RecurringTransactionID(1)).Return(recurringTransaction, nil)
+			},
+			expectedCode: apperrors.ErrorCodeConflict,
+		},
+		{
+			name: "他の家計簿の定期取引はスキップできない",
+			date: "2026-11-27",
+			mockSetup: func(r *mock.MockRecurringTransactionRepository) {
+				r.EXPECT().FindByID(domainmodel.HouseHoldID(10), domainmodel.RecurringTransactionID(1)).Return(nil, nil)
+			},
+			expectedCode: apperrors.ErrorCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRecurringRepo := mock.NewMockRecurringTransactionRepository(ctrl)
+			tt.mockSetup(mockRecurringRepo)
+
+			service := NewRecurringTransactionService(mockRecurringRepo, nil, nil)
+			err := service.SkipOccurrence(10, 1, tt.date)
+
+			if tt.expectedCode != "" {
+				appErr, ok := apperrors.GetAppError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRecurringTransactionService_FetchUpcomingOccurrences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	materializedUntil := "2026-10-18"
+	recurringTransactions := []*domainmodel.RecurringTransaction{
+		{ID: 1, HouseHoldID: 10, CategoryID: 1, Amount: 80000, Memo: "家賃", Frequency: domainmodel.RecurrenceMonthly, Interval: 1, DayOfMonth: 27, StartDate: "2026-10-01", MaterializedUntil: &materializedUntil},
+		{ID: 2, HouseHoldID: 10, CategoryID: 2, Amount: 1490, Memo: "動画配信", Frequency: domainmodel.RecurrenceMonthly, Interval: 1, DayOfMonth: 1, StartDate: "2026-10-01", MaterializedUntil: &materializedUntil},
+	}
+	amount := 1990
+
+	mockRecurringRepo := mock.NewMockRecurringTransactionRepository(ctrl)
+	mockRecurringRepo.EXPECT().FindByHouseHoldID(domainmodel.HouseHoldID(10)).Return(recurringTransactions, nil)
+	mockRecurringRepo.EXPECT().FindOverrides([]domainmodel.RecurringTransactionID{1, 2}, "2026-10-27", "2026-11-30").Return([]*domainmodel.RecurringOccurrenceOverride{
+		{RecurringTransactionID: 2, Date: "2026-11-01", Amount: &amount},
+		{RecurringTransactionID: 1, Date: "2026-11-27", Skipped: true},
+	}, nil)
+
+	service := NewRecurringTransactionService(mockRecurringRepo, nil, nil)
+	occurrences, err := service.FetchUpcomingOccurrences(10, time.Date(2026, 11, 30, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, domainmodel.RecurringOccurrences{
+		{RecurringTransactionID: 1, CategoryID: 1, Date: "2026-10-27", Amount: 80000, Memo: "家賃"},
+		{RecurringTransactionID: 2, CategoryID: 2, Date: "2026-11-01", Amount: 1990, Memo: "動画配信", Adjusted: true},
+		{RecurringTransactionID: 1, CategoryID: 1, Date: "2026-11-27", Amount: 80000, Memo: "家賃", Skipped: true},
+	}, occurrences)
+}
+
+func TestRecurringTransactionService_MaterializeDueOccurrences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	materializedUntil := "2026-08-31"
+	recurringTransactions := []*domainmodel.RecurringTransaction{
+		{ID: 1, HouseHoldID: 10, CategoryID: 1, Amount: 80000, Memo: "家賃", Frequency: domainmodel.RecurrenceMonthly, Interval: 1, DayOfMonth: 1, StartDate: "2026-08-01", MaterializedUntil: &materializedUntil},
+		{ID: 2, HouseHoldID: 20, CategoryID: 2, Amount: 1490, Memo: "動画配信", Frequency: domainmodel.RecurrenceMonthly, Interval: 1, DayOfMonth: 5, StartDate: "2026-10-01"},
+	}
+
+	mockRecurringRepo := mock.NewMockRecurringTransactionRepository(ctrl)
+	mockRecurringRepo.EXPECT().FindDue("2026-10-18").Return(recurringTransactions, nil)
+	mockRecurringRepo.EXPECT().FindOverrides([]domainmodel.RecurringTransactionID{1, 2}, "2026-09-01", "2026-10-18").Return([]*domainmodel.RecurringOccurrenceOverride{
+		{RecurringTransactionID: 1, Date: "2026-09-01", Skipped: true},
+	}, nil)
+	mockRecurringRepo.EXPECT().AdvanceMaterializedUntil(domainmodel.RecurringTransactionID(1), &materializedUntil, "2026-10-18").Return(true, nil)
+	// 他のプロセスが先に登録した定期取引は登録しない
+	mockRecurringRepo.EXPECT().AdvanceMaterializedUntil(domainmodel.RecurringTransactionID(2), nil, "2026-10-18").Return(false, nil)
+
+	houseHoldService := &shoppingAmountRecorder{}
+	service := NewRecurringTransactionService(mockRecurringRepo, nil, houseHoldService)
+	materialized, err := service.MaterializeDueOccurrences(time.Date(2026, 10, 18, 9, 0, 0, 0, time.Local))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, materialized)
+	// スキップした発生分は登録しない
+	assert.Equal(t, []*domainmodel.ShoppingAmount{
+		domainmodel.NewShoppingAmount(10, 1, 80000, "2026-10-01", "家賃", 0),
+	}, houseHoldService.created)
+}
@@ -0,0 +1,59 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainservice
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+)
+
+type ReportService interface {
+	// FetchTrendReport は期間内の月・カテゴリごとの支出の推移と予算を集計する
+	FetchTrendReport(condition *domainmodel.TrendCondition) (*domainmodel.TrendReport, error)
+}
+
+type reportService struct {
+	reportRepository        domainmodel.ReportRepository
+	shoppingRepository      domainmodel.ShoppingRepository
+	categoryRepository      domainmodel.CategoryRepository
+	monthlyBudgetRepository domainmodel.MonthlyBudgetRepository
+}
+
+// FetchTrendReport implements ReportService.
+// 予算は未登録の月も登録せずに前月までの予算を引き継いで算出する
+func (s *reportService) FetchTrendReport(condition *domainmodel.TrendCondition) (*domainmodel.TrendReport, error) {
+	if err := condition.Validate(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	trends, err := s.reportRepository.SummarizeCategoryTrends(condition)
+	if err != nil {
+		return nil, err
+	}
+
+	// 表示にはアーカイブ済みのカテゴリも含めた家計簿ごとのカテゴリ設定を用いる
+	categories, err := s.categoryRepository.FindHouseHoldCategories(condition.HouseHoldID, true)
+	if err != nil {
+		return nil, err
+	}
+	budgets, err := s.monthlyBudgetRepository.FindByHouseHoldID(condition.HouseHoldID, condition.To)
+	if err != nil {
+		return nil, err
+	}
+	// 繰り越しの算出には予算を設定した月からの実績が必要なため、月・カテゴリごとの集計を用いる
+	actuals, err := s.shoppingRepository.SummarizeShoppingAmountByMonth(condition.HouseHoldID, condition.To)
+	if err != nil {
+		return nil, err
+	}
+
+	history := domainmodel.NewBudgetHistory(budgets, actuals)
+	return domainmodel.NewTrendReport(condition, categories, trends, history), nil
+}
+
+func NewReportService(reportRepository domainmodel.ReportRepository, shoppingRepository domainmodel.ShoppingRepository, categoryRepository domainmodel.CategoryRepository, monthlyBudgetRepository domainmodel.MonthlyBudgetRepository) ReportService {
+	return &reportService{
+		reportRepository:        reportRepository,
+		shoppingRepository:      shoppingRepository,
+		categoryRepository:      categoryRepository,
+		monthlyBudgetRepository: monthlyBudgetRepository,
+	}
+}
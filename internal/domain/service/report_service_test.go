@@ -0,0 +1,72 @@
+package domainservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mock "echo-household-budget/internal/domain/mock/domainmodel"
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+)
+
+func TestReportService_FetchTrendReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name         string
+		condition    *domainmodel.TrendCondition
+		mockSetup    func(*mock.MockReportRepository, *mock.MockShoppingRepository, *mock.MockCategoryRepository, *mock.MockMonthlyBudgetRepository)
+		expectedCode apperrors.ErrorCode
+	}{
+		{
+			name:      "期間内の推移と予算を集計できる",
+			condition: &domainmodel.TrendCondition{HouseHoldID: 10, From: "2026-09", To: "2026-10", Window: 3},
+			mockSetup: func(r *mock.MockReportRepository, s *mock.MockShoppingRepository, c *mock.MockCategoryRepository, b *mock.MockMonthlyBudgetRepository) {
+				r.EXPECT().SummarizeCategoryTrends(gomock.Any()).Return([]*domainmodel.CategoryMonthlyTrend{
+					{Month: "2026-09", Category: domainmodel.Category{ID: 1}, Amount: 28000},
+					{Month: "2026-10", Category: domainmodel.Category{ID: 1}, Amount: 32000},
+				}, nil)
+				c.EXPECT().FindHouseHoldCategories(domainmodel.HouseHoldID(10), true).Return([]*domainmodel.CategoryLimit{
+					{Category: domainmodel.Category{ID: 1, Name: "食費"}, LimitAmount: 30000},
+				}, nil)
+				b.EXPECT().FindByHouseHoldID(domainmodel.HouseHoldID(10), "2026-10").Return([]*domainmodel.MonthlyBudget{}, nil)
+				s.EXPECT().SummarizeShoppingAmountByMonth(domainmodel.HouseHoldID(10), "2026-10").Return([]*domainmodel.MonthlyCategoryAmount{}, nil)
+			},
+		},
+		{
+			name:      "移動平均の月数が不正な場合は集計しない",
+			condition: &domainmodel.TrendCondition{HouseHoldID: 10, From: "2026-09", To: "2026-10", Window: 0},
+			mockSetup: func(r *mock.MockReportRepository, s *mock.MockShoppingRepository, c *mock.MockCategoryRepository, b *mock.MockMonthlyBudgetRepository) {
+			},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReportRepo := mock.NewMockReportRepository(ctrl)
+			mockShoppingRepo := mock.NewMockShoppingRepository(ctrl)
+			mockCategoryRepo := mock.NewMockCategoryRepository(ctrl)
+			mockBudgetRepo := mock.NewMockMonthlyBudgetRepository(ctrl)
+			tt.mockSetup(mockReportRepo, mockShoppingRepo, mockCategoryRepo, mockBudgetRepo)
+
+			service := NewReportService(mockReportRepo, mockShoppingRepo, mockCategoryRepo, mockBudgetRepo)
+			report, err := service.FetchTrendReport(tt.condition)
+			if tt.expectedCode != "" {
+				appErr, ok := apperrors.GetAppError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, report.Categories, 1)
+			assert.Equal(t, "食費", report.Categories[0].Category.Name)
+			assert.Equal(t, 30000, report.Categories[0].Points[1].Budget)
+			assert.Equal(t, 32000, report.Totals[1].Amount)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainservice
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type SettlementService interface {
+	// FetchSettleUpReport は from から to まで（両端を含む）のメンバー間の貸し借りを算出する
+	FetchSettleUpReport(houseHoldID domainmodel.HouseHoldID, from string, to string) (*domainmodel.SettleUpReport, error)
+	RecordSettlement(settlement *domainmodel.Settlement) error
+	RemoveSettlement(houseHoldID domainmodel.HouseHoldID, settlementID domainmodel.SettlementID) error
+}
+
+type settlementService struct {
+	settlementRepository domainmodel.SettlementRepository
+	shoppingRepository   domainmodel.ShoppingRepository
+	houseHoldRepository  domainmodel.HouseHoldRepository
+}
+
+// FetchSettleUpReport implements SettlementService.
+func (s *settlementService) FetchSettleUpReport(houseHoldID domainmodel.HouseHoldID, from string, to string) (*domainmodel.SettleUpReport, error) {
+	fromDate, fromErr := time.Parse("2006-01-02", from)
+	toDate, toErr := time.Parse("2006-01-02", to)
+	if fromErr != nil || toErr != nil || toDate.Before(fromDate) {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrInvalidSettlementPeriod.Error(), domainmodel.ErrInvalidSettlementPeriod)
+	}
+
+	members, err := s.houseHoldRepository.FindMembers(houseHoldID)
+	if err != nil {
+		return nil, err
+	}
+	memberIDs := make([]domainmodel.UserID, len(members))
+	for i, member := range members {
+		memberIDs[i] = member.UserID
+	}
+
+	expenses, err := s.shoppingRepository.FindSharedShoppingAmounts(houseHoldID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	settlements, err := s.settlementRepository.FindSettlements(houseHoldID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return domainmodel.NewSettleUpReport(from, to, memberIDs, expenses, settlements), nil
+}
+
+// RecordSettlement implements SettlementService.
+// 支払ったメンバー、受け取ったメンバーはいずれも家計簿のメンバーであること
+func (s *settlementService) RecordSettlement(settlement *domainmodel.Settlement) error {
+	if err := settlement.Validate(); err != nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	for _, userID := range []domainmodel.UserID{settlement.FromUserID, settlement.ToUserID} {
+		member, err := s.houseHoldRepository.FindUserHouseHold(userID, settlement.HouseHoldID)
+		if err != nil {
+			return err
+		}
+		if member == nil {
+			return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrNotHouseHoldMember.Error(), domainmodel.ErrNotHouseHoldMember)
+		}
+	}
+
+	return s.settlementRepository.CreateSettlement(settlement)
+}
+
+// RemoveSettlement implements SettlementService.
+func (s *settlementService) RemoveSettlement(houseHoldID domainmodel.HouseHoldID, settlementID domainmodel.SettlementID) error {
+	if err := s.settlementRepository.DeleteSettlement(houseHoldID, settlementID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "settlement not found in household", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func NewSettlementService(settlementRepository domainmodel.SettlementRepository, shoppingRepository domainmodel.ShoppingRepository, houseHoldRepository domainmodel.HouseHoldRepository) SettlementService {
+	return &settlementService{
+		settlementRepository: settlementRepository,
+		shoppingRepository:   shoppingRepository,
+		houseHoldRepository:  houseHoldRepository,
+	}
+}
@@ -0,0 +1,108 @@
+package domainservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	mock "echo-household-budget/internal/domain/mock/domainmodel"
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+)
+
+func TestSettlementService_FetchSettleUpReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	payer := domainmodel.UserID(1)
+	mockSettlementRepo := mock.NewMockSettlementRepository(ctrl)
+	mockShoppingRepo := mock.NewMockShoppingRepository(ctrl)
+	mockHouseHoldRepo := mock.NewMockHouseHoldRepository(ctrl)
+	mockHouseHoldRepo.EXPECT().FindMembers(domainmodel.HouseHoldID(10)).Return([]*domainmodel.HouseHoldMember{{UserID: 1}, {UserID: 2}}, nil)
+	mockShoppingRepo.EXPECT().FindSharedShoppingAmounts(domainmodel.HouseHoldID(10), "2026-10-01", "2026-10-31").Return(domainmodel.ShoppingAmounts{
+		{Amount: 3000, PaidBy: &payer, SplitType: domainmodel.SplitEqual, Shares: []domainmodel.ExpenseShare{{UserID: 1}, {UserID: 2}}},
+	}, nil)
+	mockSettlementRepo.EXPECT().FindSettlements(domainmodel.HouseHoldID(10), "2026-10-01", "2026-10-31").Return([]*domainmodel.Settlement{}, nil)
+
+	service := NewSettlementService(mockSettlementRepo, mockShoppingRepo, mockHouseHoldRepo)
+	report, err := service.FetchSettleUpReport(10, "2026-10-01", "2026-10-31")
+	assert.NoError(t, err)
+	assert.Equal(t, []*domainmodel.SettlementTransfer{{FromUserID: 2, ToUserID: 1, Amount: 1500}}, report.Transfers)
+
+	// 期間が不正な場合はリポジトリを参照しない
+	_, err = service.FetchSettleUpReport(10, "2026-10-31", "2026-10-01")
+	appErr, ok := apperrors.GetAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, apperrors.ErrorCodeInvalidInput, appErr.Code)
+}
+
+func TestSettlementService_RecordSettlement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name         string
+		settlement   *domainmodel.Settlement
+		mockSetup    func(*mock.MockSettlementRepository, *mock.MockHouseHoldRepository)
+		expectedCode apperrors.ErrorCode
+	}{
+		{
+			name:       "メンバー間の精算を記録できる",
+			settlement: &domainmodel.Settlement{HouseHoldID: 10, FromUserID: 2, ToUserID: 1, Amount: 1500, Date: "2026-10-18"},
+			mockSetup: func(s *mock.MockSettlementRepository, h *mock.MockHouseHoldRepository) {
+				h.EXPECT().FindUserHouseHold(domainmodel.UserID(2), domainmodel.HouseHoldID(10)).Return(member(2, domainmodel.HouseHoldRoleEditor), nil)
+				h.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(10)).Return(member(1, domainmodel.HouseHoldRoleOwner), nil)
+				s.EXPECT().CreateSettlement(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:         "同じメンバー間の精算は記録できない",
+			settlement:   &domainmodel.Settlement{HouseHoldID: 10, FromUserID: 1, ToUserID: 1, Amount: 1500, Date: "2026-10-18"},
+			mockSetup:    func(s *mock.MockSettlementRepository, h *mock.MockHouseHoldRepository) {},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+		{
+			name:       "メンバー以外との精算は記録できない",
+			settlement: &domainmodel.Settlement{HouseHoldID: 10, FromUserID: 99, ToUserID: 1, Amount: 1500, Date: "2026-10-18"},
+			mockSetup: func(s *mock.MockSettlementRepository, h *mock.MockHouseHoldRepository) {
+				h.EXPECT().FindUserHouseHold(domainmodel.UserID(99), domainmodel.HouseHoldID(10)).Return(nil, nil)
+			},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSettlementRepo := mock.NewMockSettlementRepository(ctrl)
+			mockHouseHoldRepo := mock.NewMockHouseHoldRepository(ctrl)
+			tt.mockSetup(mockSettlementRepo, mockHouseHoldRepo)
+
+			service := NewSettlementService(mockSettlementRepo, nil, mockHouseHoldRepo)
+			err := service.RecordSettlement(tt.settlement)
+
+			if tt.expectedCode != "" {
+				appErr, ok := apperrors.GetAppError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSettlementService_RemoveSettlement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSettlementRepo := mock.NewMockSettlementRepository(ctrl)
+	mockSettlementRepo.EXPECT().DeleteSettlement(domainmodel.HouseHoldID(10), domainmodel.SettlementID(5)).Return(gorm.ErrRecordNotFound)
+
+	service := NewSettlementService(mockSettlementRepo, nil, nil)
+	err := service.RemoveSettlement(10, 5)
+	appErr, ok := apperrors.GetAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, apperrors.ErrorCodeNotFound, appErr.Code)
+}
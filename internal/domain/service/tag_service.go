@@ -0,0 +1,190 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainservice
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type TagService interface {
+	FetchTags(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.Tag, error)
+	AddTag(tag *domainmodel.Tag) error
+	RenameTag(tag *domainmodel.Tag) error
+	// MergeTags は source のタグを target のタグに統合する
+	MergeTags(houseHoldID domainmodel.HouseHoldID, sourceID domainmodel.TagID, targetID domainmodel.TagID) error
+	RemoveTag(houseHoldID domainmodel.HouseHoldID, id domainmodel.TagID) error
+	// TagReceiptItem はレシートの品目のタグを指定したタグに置き換える
+	TagReceiptItem(houseHoldID domainmodel.HouseHoldID, receiptItemID uint, tagIDs []domainmodel.TagID) error
+	// 集計
+	FetchTaggedShoppingAmounts(houseHoldID domainmodel.HouseHoldID, id domainmodel.TagID, from string, to string) (domainmodel.ShoppingAmounts, error)
+	SummarizeByTag(houseHoldID domainmodel.HouseHoldID, from string, to string) (*domainmodel.TagSummary, error)
+}
+
+type tagService struct {
+	tagRepository domainmodel.TagRepository
+}
+
+// FetchTags implements TagService.
+func (s *tagService) FetchTags(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.Tag, error) {
+	return s.tagRepository.FindByHouseHoldID(houseHoldID)
+}
+
+// AddTag implements TagService.
+func (s *tagService) AddTag(tag *domainmodel.Tag) error {
+	if err := s.validateTagName(tag); err != nil {
+		return err
+	}
+
+	return s.tagRepository.Create(tag)
+}
+
+// RenameTag implements TagService.
+func (s *tagService) RenameTag(tag *domainmodel.Tag) error {
+	if err := s.validateTagName(tag); err != nil {
+		return err
+	}
+
+	if err := s.tagRepository.Rename(tag); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "tag not found in household", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MergeTags implements TagService.
+func (s *tagService) MergeTags(houseHoldID domainmodel.HouseHoldID, sourceID domainmodel.TagID, targetID domainmodel.TagID) error {
+	if sourceID == targetID {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrMergeSameTag.Error(), domainmodel.ErrMergeSameTag)
+	}
+	if err := validateTags(s.tagRepository, houseHoldID, []domainmodel.TagID{sourceID, targetID}); err != nil {
+		return err
+	}
+
+	if err := s.tagRepository.Merge(houseHoldID, sourceID, targetID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "tag not found in household", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// RemoveTag implements TagService.
+// 支出・品目からはタグの紐付けのみを外す
+func (s *tagService) RemoveTag(houseHoldID domainmodel.HouseHoldID, id domainmodel.TagID) error {
+	if err := s.tagRepository.Delete(houseHoldID, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "tag not found in household", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// TagReceiptItem implements TagService.
+func (s *tagService) TagReceiptItem(houseHoldID domainmodel.HouseHoldID, receiptItemID uint, tagIDs []domainmodel.TagID) error {
+	tagIDs = domainmodel.UniqueTagIDs(tagIDs)
+	if err := validateTags(s.tagRepository, houseHoldID, tagIDs); err != nil {
+		return err
+	}
+
+	if err := s.tagRepository.ReplaceReceiptItemTags(houseHoldID, receiptItemID, tagIDs); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "receipt item not found in household", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// FetchTaggedShoppingAmounts implements TagService.
+func (s *tagService) FetchTaggedShoppingAmounts(houseHoldID domainmodel.HouseHoldID, id domainmodel.TagID, from string, to string) (domainmodel.ShoppingAmounts, error) {
+	if err := domainmodel.ValidateTagPeriod(from, to); err != nil {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+	if err := validateTags(s.tagRepository, houseHoldID, []domainmodel.TagID{id}); err != nil {
+		return nil, err
+	}
+
+	return s.tagRepository.FindShoppingAmounts(houseHoldID, id, from, to)
+}
+
+// SummarizeByTag implements TagService.
+func (s *tagService) SummarizeByTag(houseHoldID domainmodel.HouseHoldID, from string, to string) (*domainmodel.TagSummary, error) {
+	if err := domainmodel.ValidateTagPeriod(from, to); err != nil {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	tags, err := s.tagRepository.FindByHouseHoldID(houseHoldID)
+	if err != nil {
+		return nil, err
+	}
+	shoppingTotals, err := s.tagRepository.SummarizeShoppingAmounts(houseHoldID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	receiptItemTotals, err := s.tagRepository.SummarizeReceiptItems(houseHoldID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return domainmodel.NewTagSummary(from, to, tags, shoppingTotals, receiptItemTotals), nil
+}
+
+// validateTagName はタグの名前を検証し、家計簿に同じ名前の別のタグがある場合は Conflict を返す
+func (s *tagService) validateTagName(tag *domainmodel.Tag) error {
+	if err := tag.Validate(); err != nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	existing, err := s.tagRepository.FindByName(tag.HouseHoldID, tag.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != tag.ID {
+		return apperrors.NewAppError(apperrors.ErrorCodeConflict, "tag with the same name already exists", nil)
+	}
+
+	return nil
+}
+
+// validateTags は指定したタグがすべて家計簿に属しているかを検証する。重複のないIDを指定する
+func validateTags(repository domainmodel.TagRepository, houseHoldID domainmodel.HouseHoldID, ids []domainmodel.TagID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	tags, err := repository.FindByIDs(houseHoldID, ids)
+	if err != nil {
+		return err
+	}
+	if len(tags) != len(ids) {
+		return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "tag not found in household", nil)
+	}
+
+	return nil
+}
+
+func tagModels(ids []domainmodel.TagID) []models.Tag {
+	tags := make([]models.Tag, len(ids))
+	for i, id := range ids {
+		tags[i] = models.Tag{Base: models.Base{ID: uint(id)}}
+	}
+	return tags
+}
+
+func NewTagService(tagRepository domainmodel.TagRepository) TagService {
+	return &tagService{
+		tagRepository: tagRepository,
+	}
+}
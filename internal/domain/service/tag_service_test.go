@@ -0,0 +1,177 @@
+package domainservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	mock "echo-household-budget/internal/domain/mock/domainmodel"
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+)
+
+func TestTagService_RenameTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name         string
+		tag          *domainmodel.Tag
+		mockSetup    func(*mock.MockTagRepository)
+		expectedCode apperrors.ErrorCode
+	}{
+		{
+			name: "タグの名前を変更できる",
+			tag:  &domainmodel.Tag{ID: 1, HouseHoldID: 10, Name: "旅行2026"},
+			mockSetup: func(r *mock.MockTagRepository) {
+				r.EXPECT().FindByName(domainmodel.HouseHoldID(10), "旅行2026").Return(nil, nil)
+				r.EXPECT().Rename(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "同じ名前のままでも変更できる",
+			tag:  &domainmodel.Tag{ID: 1, HouseHoldID: 10, Name: "旅行2026"},
+			mockSetup: func(r *mock.MockTagRepository) {
+				r.EXPECT().FindByName(domainmodel.HouseHoldID(10), "旅行2026").Return(&domainmodel.Tag{ID: 1, HouseHoldID: 10, Name: "旅行2026"}, nil)
+				r.EXPECT().Rename(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "別のタグと同じ名前には変更できない",
+			tag:  &domainmodel.Tag{ID: 1, HouseHoldID: 10, Name: "子ども"},
+			mockSetup: func(r *mock.MockTagRepository) {
+				r.EXPECT().FindByName(domainmodel.HouseHoldID(10), "子ども").Return(&domainmodel.Tag{ID: 2, HouseHoldID: 10, Name: "子ども"}, nil)
+			},
+			expectedCode: apperrors.ErrorCodeConflict,
+		},
+		{
+			name:         "空の名前には変更できない",
+			tag:          &domainmodel.Tag{ID: 1, HouseHoldID: 10, Name: ""},
+			mockSetup:    func(r *mock.MockTagRepository) {},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+		{
+			name: "家計簿にないタグは変更できない",
+			tag:  &domainmodel.Tag{ID: 99, HouseHoldID: 10, Name: "旅行2026"},
+			mockSetup: func(r *mock.MockTagRepository) {
+				r.EXPECT().FindByName(domainmodel.HouseHoldID(10), "旅行2026").Return(nil, nil)
+				r.EXPECT().Rename(gomock.Any()).Return(gorm.ErrRecordNotFound)
+			},
+			expectedCode: apperrors.ErrorCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTagRepo := mock.NewMockTagRepository(ctrl)
+			tt.mockSetup(mockTagRepo)
+
+			service := NewTagService(mockTagRepo)
+			err := service.RenameTag(tt.tag)
+
+			if tt.expectedCode != "" {
+				appErr, ok := apperrors.GetAppError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTagService_MergeTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name         string
+		sourceID     domainmodel.TagID
+		targetID     domainmodel.TagID
+		mockSetup    func(*mock.MockTagRepository)
+		expectedCode apperrors.ErrorCode
+	}{
+		{
+			name:     "タグを統合できる",
+			sourceID: 1,
+			targetID: 2,
+			mockSetup: func(r *mock.MockTagRepository) {
+				r.EXPECT().FindByIDs(domainmodel.HouseHoldID(10), []domainmodel.TagID{1, 2}).Return([]*domainmodel.Tag{{ID: 1}, {ID: 2}}, nil)
+				r.EXPECT().Merge(domainmodel.HouseHoldID(10), domainmodel.TagID(1), domainmodel.TagID(2)).Return(nil)
+			},
+		},
+		{
+			name:         "同じタグには統合できない",
+			sourceID:     1,
+			targetID:     1,
+			mockSetup:    func(r *mock.MockTagRepository) {},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+		{
+			name:     "統合先のタグが家計簿にない場合は統合できない",
+			sourceID: 1,
+			targetID: 99,
+			mockSetup: func(r *mock.MockTagRepository) {
+				r.EXPECT().FindByIDs(domainmodel.HouseHoldID(10), []domainmodel.TagID{1, 99}).Return([]*domainmodel.Tag{{ID: 1}}, nil)
+			},
+			expectedCode: apperrors.ErrorCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTagRepo := mock.NewMockTagRepository(ctrl)
+			tt.mockSetup(mockTagRepo)
+
+			service := NewTagService(mockTagRepo)
+			err := service.MergeTags(10, tt.sourceID, tt.targetID)
+
+			if tt.expectedCode != "" {
+				appErr, ok := apperrors.GetAppError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTagService_SummarizeByTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	travel := &domainmodel.Tag{ID: 1, HouseHoldID: 10, Name: "旅行2026"}
+	mockTagRepo := mock.NewMockTagRepository(ctrl)
+	mockTagRepo.EXPECT().FindByHouseHoldID(domainmodel.HouseHoldID(10)).Return([]*domainmodel.Tag{travel}, nil)
+	mockTagRepo.EXPECT().SummarizeShoppingAmounts(domainmodel.HouseHoldID(10), "2026-01-01", "2026-12-31").Return([]*domainmodel.TagTotal{{TagID: 1, Amount: 50000, Count: 2}}, nil)
+	mockTagRepo.EXPECT().SummarizeReceiptItems(domainmodel.HouseHoldID(10), "2026-01-01", "2026-12-31").Return([]*domainmodel.TagTotal{{TagID: 1, Amount: 800, Count: 1}}, nil)
+
+	service := NewTagService(mockTagRepo)
+	summary, err := service.SummarizeByTag(10, "2026-01-01", "2026-12-31")
+	assert.NoError(t, err)
+	assert.Equal(t, []*domainmodel.TagAmount{{Tag: travel, Amount: 50800, ShoppingCount: 2, ReceiptItemCount: 1}}, summary.TagAmounts)
+
+	// 期間が不正な場合はリポジトリを参照しない
+	_, err = service.SummarizeByTag(10, "2026-12-31", "2026-01-01")
+	appErr, ok := apperrors.GetAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, apperrors.ErrorCodeInvalidInput, appErr.Code)
+}
+
+func TestTagService_TagReceiptItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTagRepo := mock.NewMockTagRepository(ctrl)
+	mockTagRepo.EXPECT().FindByIDs(domainmodel.HouseHoldID(10), []domainmodel.TagID{2}).Return([]*domainmodel.Tag{{ID: 2}}, nil)
+	mockTagRepo.EXPECT().ReplaceReceiptItemTags(domainmodel.HouseHoldID(10), uint(7), []domainmodel.TagID{2}).Return(gorm.ErrRecordNotFound)
+
+	service := NewTagService(mockTagRepo)
+	err := service.TagReceiptItem(10, 7, []domainmodel.TagID{2, 2})
+	appErr, ok := apperrors.GetAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, apperrors.ErrorCodeNotFound, appErr.Code)
+}
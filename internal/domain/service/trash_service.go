@@ -0,0 +1,97 @@
+//go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/mock_$GOFILE -package=mock
+package domainservice
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type TrashService interface {
+	// FetchTrashItems は家計簿のゴミ箱の記録を削除日時の新しい順に取得する
+	FetchTrashItems(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.TrashItem, error)
+	RestoreTrashItem(houseHoldID domainmodel.HouseHoldID, itemType string, id uint) error
+	// UndoLastDelete は operatorID のメンバーが家計簿で最後に行った削除操作を元に戻し、戻した記録を返す
+	// 一度の操作で削除した記録はまとめて戻す。他のメンバーの削除は戻さない
+	UndoLastDelete(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID) ([]*domainmodel.TrashItem, error)
+	// PurgeExpiredTrashItems は保持期間を過ぎたゴミ箱の記録を物理削除し、削除した件数を返す
+	PurgeExpiredTrashItems(now time.Time) (int64, error)
+}
+
+type trashService struct {
+	trashRepository domainmodel.TrashRepository
+	retention       time.Duration
+}
+
+// FetchTrashItems implements TrashService.
+func (s *trashService) FetchTrashItems(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.TrashItem, error) {
+	items, err := s.trashRepository.FindTrashItems(houseHoldID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		item.ExpiresAt = item.DeletedAt.Add(s.retention)
+	}
+	return items, nil
+}
+
+// RestoreTrashItem implements TrashService.
+func (s *trashService) RestoreTrashItem(houseHoldID domainmodel.HouseHoldID, itemType string, id uint) error {
+	parsed, err := domainmodel.ParseTrashItemType(itemType)
+	if err != nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	if err := s.trashRepository.RestoreTrashItem(houseHoldID, parsed, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "trash item not found in household", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// UndoLastDelete implements TrashService.
+func (s *trashService) UndoLastDelete(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID) ([]*domainmodel.TrashItem, error) {
+	items, err := s.trashRepository.FindTrashItems(houseHoldID)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := domainmodel.LastDeleteBatch(items, operatorID)
+	if len(batch) == 0 {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeNotFound, domainmodel.ErrNothingToUndo.Error(), domainmodel.ErrNothingToUndo)
+	}
+
+	restored, err := s.trashRepository.RestoreDeleteBatch(houseHoldID, batch[0].BatchID)
+	if err != nil {
+		return nil, err
+	}
+	// 一覧を取得した後に他のメンバーがすべて戻した場合は、戻す記録がない
+	if restored == 0 {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeNotFound, domainmodel.ErrNothingToUndo.Error(), domainmodel.ErrNothingToUndo)
+	}
+
+	return batch, nil
+}
+
+// PurgeExpiredTrashItems implements TrashService.
+func (s *trashService) PurgeExpiredTrashItems(now time.Time) (int64, error) {
+	return s.trashRepository.PurgeTrashItems(now.Add(-s.retention))
+}
+
+// NewTrashService はゴミ箱のサービスを作成する。retention が 0 以下の場合は既定の保持期間を用いる
+func NewTrashService(trashRepository domainmodel.TrashRepository, retention time.Duration) TrashService {
+	if retention <= 0 {
+		retention = domainmodel.DefaultTrashRetention
+	}
+	return &trashService{
+		trashRepository: trashRepository,
+		retention:       retention,
+	}
+}
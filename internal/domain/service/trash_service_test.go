@@ -0,0 +1,170 @@
+package domainservice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	mock "echo-household-budget/internal/domain/mock/domainmodel"
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+)
+
+func TestTrashService_FetchTrashItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	deletedAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	mockRepo := mock.NewMockTrashRepository(ctrl)
+	mockRepo.EXPECT().FindTrashItems(domainmodel.HouseHoldID(10)).Return([]*domainmodel.TrashItem{
+		{Type: domainmodel.TrashItemTypeShoppingAmount, ID: 1, DeletedAt: deletedAt},
+	}, nil)
+
+	service := NewTrashService(mockRepo, 7*24*time.Hour)
+	items, err := service.FetchTrashItems(10)
+
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, deletedAt.Add(7*24*time.Hour), items[0].ExpiresAt)
+}
+
+func TestTrashService_RestoreTrashItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name         string
+		itemType     string
+		mockSetup    func(*mock.MockTrashRepository)
+		expectedCode apperrors.ErrorCode
+	}{
+		{
+			name:     "ゴミ箱の記録を元に戻せる",
+			itemType: "shopping_amount",
+			mockSetup: func(r *mock.MockTrashRepository) {
+				r.EXPECT().RestoreTrashItem(domainmodel.HouseHoldID(10), domainmodel.TrashItemTypeShoppingAmount, uint(1)).Return(nil)
+			},
+		},
+		{
+			name:         "不明な種類は戻せない",
+			itemType:     "tag",
+			mockSetup:    func(r *mock.MockTrashRepository) {},
+			expectedCode: apperrors.ErrorCodeInvalidInput,
+		},
+		{
+			name:     "ゴミ箱にない記録は戻せない",
+			itemType: "receipt",
+			mockSetup: func(r *mock.MockTrashRepository) {
+				r.EXPECT().RestoreTrashItem(domainmodel.HouseHoldID(10), domainmodel.TrashItemTypeReceipt, uint(1)).Return(gorm.ErrRecordNotFound)
+			},
+			expectedCode: apperrors.ErrorCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockTrashRepository(ctrl)
+			tt.mockSetup(mockRepo)
+
+			err := NewTrashService(mockRepo, 0).RestoreTrashItem(10, tt.itemType, 1)
+			if tt.expectedCode == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var appErr *apperrors.AppError
+			assert.ErrorAs(t, err, &appErr)
+			assert.Equal(t, tt.expectedCode, appErr.Code)
+		})
+	}
+}
+
+func TestTrashService_UndoLastDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	earlier := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	latest := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	me, other := domainmodel.UserID(1), domainmodel.UserID(2)
+	mockRepo := mock.NewMockTrashRepository(ctrl)
+	mockRepo.EXPECT().FindTrashItems(domainmodel.HouseHoldID(10)).Return([]*domainmodel.TrashItem{
+		// 他のメンバーがより後に削除した記録は戻さない
+		{Type: domainmodel.TrashItemTypeShoppingMemo, ID: 4, DeletedAt: latest.Add(time.Minute), DeletedBy: &other, BatchID: "batch-3"},
+		{Type: domainmodel.TrashItemTypeShoppingAmount, ID: 1, DeletedAt: latest, DeletedBy: &me, BatchID: "batch-2"},
+		{Type: domainmodel.TrashItemTypeShoppingAmount, ID: 2, DeletedAt: latest, DeletedBy: &me, BatchID: "batch-2"},
+		{Type: domainmodel.TrashItemTypeShoppingMemo, ID: 3, DeletedAt: earlier, DeletedBy: &me, BatchID: "batch-1"},
+	}, nil)
+	mockRepo.EXPECT().RestoreDeleteBatch(domainmodel.HouseHoldID(10), domainmodel.DeleteBatchID("batch-2")).Return(int64(2), nil)
+
+	restored, err := NewTrashService(mockRepo, 0).UndoLastDelete(10, me)
+
+	assert.NoError(t, err)
+	assert.Len(t, restored, 2)
+	assert.Equal(t, uint(1), restored[0].ID)
+	assert.Equal(t, uint(2), restored[1].ID)
+}
+
+func TestTrashService_UndoLastDelete_NothingToUndo(t *testing.T) {
+	me, other := domainmodel.UserID(1), domainmodel.UserID(2)
+	tests := []struct {
+		name  string
+		items []*domainmodel.TrashItem
+		setup func(mockRepo *mock.MockTrashRepository)
+	}{
+		{
+			name:  "ゴミ箱が空",
+			items: []*domainmodel.TrashItem{},
+		},
+		{
+			name: "他のメンバーの削除のみ",
+			items: []*domainmodel.TrashItem{
+				{Type: domainmodel.TrashItemTypeShoppingAmount, ID: 1, DeletedBy: &other, BatchID: "batch-1"},
+			},
+		},
+		{
+			name: "他のメンバーが先に戻した",
+			items: []*domainmodel.TrashItem{
+				{Type: domainmodel.TrashItemTypeShoppingAmount, ID: 1, DeletedBy: &me, BatchID: "batch-1"},
+			},
+			setup: func(mockRepo *mock.MockTrashRepository) {
+				mockRepo.EXPECT().RestoreDeleteBatch(domainmodel.HouseHoldID(10), domainmodel.DeleteBatchID("batch-1")).Return(int64(0), nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockTrashRepository(ctrl)
+			mockRepo.EXPECT().FindTrashItems(domainmodel.HouseHoldID(10)).Return(tt.items, nil)
+			if tt.setup != nil {
+				tt.setup(mockRepo)
+			}
+
+			_, err := NewTrashService(mockRepo, 0).UndoLastDelete(10, me)
+
+			var appErr *apperrors.AppError
+			assert.ErrorAs(t, err, &appErr)
+			assert.Equal(t, apperrors.ErrorCodeNotFound, appErr.Code)
+		})
+	}
+}
+
+func TestTrashService_PurgeExpiredTrashItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	mockRepo := mock.NewMockTrashRepository(ctrl)
+	// 保持期間の指定がない場合は既定の 30 日を用いる
+	mockRepo.EXPECT().PurgeTrashItems(now.Add(-domainmodel.DefaultTrashRetention)).Return(int64(4), nil)
+
+	purged, err := NewTrashService(mockRepo, 0).PurgeExpiredTrashItems(now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), purged)
+}
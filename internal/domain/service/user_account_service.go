@@ -3,6 +3,7 @@ package domainservice
 
 import (
 	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
 	"fmt"
 
 	"gorm.io/gorm"
@@ -13,6 +14,7 @@ type UserAccountService interface {
 	IsDuplicateUserAccount(lineUserID domainmodel.LINEUserID) (bool, error)
 	FetchUserAccount(userID domainmodel.UserID) (*domainmodel.UserAccount, error)
 	FetchAllUserAccount() ([]*domainmodel.UserAccount, error)
+	ChangeDefaultHouseHold(userID domainmodel.UserID, houseHoldID domainmodel.HouseHoldID) error
 }
 
 type userAccountService struct {
@@ -35,6 +37,20 @@ func (s *userAccountService) FetchAllUserAccount() ([]*domainmodel.UserAccount,
 	return s.userAccountRepository.FetchAll()
 }
 
+// ChangeDefaultHouseHold implements UserAccountService.
+// 所属している家計簿のみ既定に設定できる
+func (s *userAccountService) ChangeDefaultHouseHold(userID domainmodel.UserID, houseHoldID domainmodel.HouseHoldID) error {
+	userHouseHold, err := s.houseHoldRepository.FindUserHouseHold(userID, houseHoldID)
+	if err != nil {
+		return err
+	}
+	if userHouseHold == nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeForbidden, domainmodel.ErrNotHouseHoldMember.Error(), domainmodel.ErrNotHouseHoldMember)
+	}
+
+	return s.userAccountRepository.UpdateDefaultHouseHold(userID, houseHoldID)
+}
+
 // IsDuplicateUserAccount implements UserAccountService.
 func (s *userAccountService) IsDuplicateUserAccount(lineUserID domainmodel.LINEUserID) (bool, error) {
 	account, err := s.userAccountRepository.FindByLINEUserID(lineUserID)
@@ -65,16 +81,23 @@ func (s *userAccountService) CreateUserAccount(lineUserInfo *domainmodel.LINEUse
 	err = s.houseHoldRepository.CreateUserHouseHold(&domainmodel.UserHouseHold{
 		UserID:      userAccount.ID,
 		HouseHoldID: householdBook.ID,
+		Role:        domainmodel.HouseHoldRoleOwner,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create user household book: %w", err)
 	}
 
+	err = s.userAccountRepository.UpdateDefaultHouseHold(userAccount.ID, householdBook.ID)
+	if err != nil {
+		return fmt.Errorf("failed to set default household book: %w", err)
+	}
+
 	for _, categoryLimit := range householdBook.CategoryLimit {
 		err = s.categoryRepository.CreateHouseHoldCategory(&domainmodel.CategoryLimit{
 			HouseholdBookID: householdBook.ID,
 			Category:        categoryLimit.Category,
 			LimitAmount:     categoryLimit.LimitAmount,
+			SortOrder:       categoryLimit.SortOrder,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to create master category: %w", err)
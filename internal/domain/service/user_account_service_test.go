@@ -30,6 +30,7 @@ func TestUserAccountService_CreateUserAccount(t *testing.T) {
 			},
 			mockSetup: func(m *mock.MockUserAccountRepository, m1 *mock.MockCategoryRepository, m2 *mock.MockHouseHoldRepository) {
 				m.EXPECT().Create(gomock.Any()).Return(nil)
+				m.EXPECT().UpdateDefaultHouseHold(gomock.Any(), gomock.Any()).Return(nil)
 				m1.EXPECT().CreateHouseHoldCategory(gomock.Any()).Return(nil).Times(2)
 				m2.EXPECT().Create(gomock.Any()).Return(nil)
 				m2.EXPECT().CreateUserHouseHold(gomock.Any()).Return(nil)
@@ -122,3 +123,47 @@ func TestUserAccountService_IsDuplicateUserAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestUserAccountService_ChangeDefaultHouseHold(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		mockSetup     func(*mock.MockUserAccountRepository, *mock.MockHouseHoldRepository)
+		expectedError bool
+	}{
+		{
+			name: "所属している家計簿を既定に設定できる",
+			mockSetup: func(m *mock.MockUserAccountRepository, m2 *mock.MockHouseHoldRepository) {
+				m2.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(20)).
+					Return(&domainmodel.UserHouseHold{UserID: 1, HouseHoldID: 20, Role: domainmodel.HouseHoldRoleViewer}, nil)
+				m.EXPECT().UpdateDefaultHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(20)).Return(nil)
+			},
+		},
+		{
+			name: "所属していない家計簿は既定に設定できない",
+			mockSetup: func(m *mock.MockUserAccountRepository, m2 *mock.MockHouseHoldRepository) {
+				m2.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(20)).Return(nil, nil)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockUserAccountRepository(ctrl)
+			mockHouseHoldRepo := mock.NewMockHouseHoldRepository(ctrl)
+			tt.mockSetup(mockRepo, mockHouseHoldRepo)
+
+			service := NewUserAccountService(mockRepo, nil, mockHouseHoldRepo)
+			err := service.ChangeDefaultHouseHold(1, 20)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/domain/repository"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// systemUserName はシステムメッセージの投稿者名
+const systemUserName = "システム"
+
+type budgetAlertNotifier struct {
+	wsManager             *WebSocketManager
+	chatMessageRepository repository.ChatMessageRepository
+}
+
+// NewBudgetAlertNotifier 予算アラートを家計簿のチャットに投稿し、接続中のクライアントに配信する通知のコンストラクタ
+func NewBudgetAlertNotifier(chatMessageRepository repository.ChatMessageRepository) domainmodel.BudgetAlertNotifier {
+	return &budgetAlertNotifier{
+		wsManager:             GetWebSocketManager(),
+		chatMessageRepository: chatMessageRepository,
+	}
+}
+
+// Notify implements domainmodel.BudgetAlertNotifier.
+func (n *budgetAlertNotifier) Notify(alert *domainmodel.BudgetAlert) error {
+	chatMessage := domainmodel.NewSystemChatMessage(int(alert.HouseHoldID), alert.Message())
+	if err := n.chatMessageRepository.Create(chatMessage); err != nil {
+		return fmt.Errorf("予算アラートの投稿に失敗しました: %w", err)
+	}
+
+	messageJSON, err := json.Marshal(ChatMessageTelegraphResponse{
+		ID:          chatMessage.ID,
+		UserID:      chatMessage.UserID,
+		UserName:    systemUserName,
+		Content:     chatMessage.Content,
+		MessageType: string(chatMessage.MessageType),
+		CreatedAt:   chatMessage.CreatedAt.Format(time.RFC3339),
+	})
+	if err != nil {
+		return fmt.Errorf("JSONマーシャリングに失敗しました: %w", err)
+	}
+
+	n.wsManager.BroadcastToHouseHold(int(alert.HouseHoldID), messageJSON)
+	return nil
+}
@@ -1,13 +1,14 @@
 package handler
 
 import (
+	domainmodel "echo-household-budget/internal/domain/model"
 	"echo-household-budget/internal/infrastructure/middleware"
+	apperrors "echo-household-budget/internal/shared/errors"
 	"echo-household-budget/internal/usecase"
 	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
-	"strconv"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -75,7 +76,7 @@ func (h *chatMessageTelegraphHandler) WebSocketChat(c echo.Context) error {
 	defer h.wsManager.RemoveClient(conn)
 
 	// クライアントを管理に追加
-	h.wsManager.AddClient(conn)
+	h.wsManager.AddHouseHoldClient(conn, householdID)
 
 	// 初期化処理
 	if err := h.initializeChatSession(conn, householdID, userID); err != nil {
@@ -83,31 +84,23 @@ func (h *chatMessageTelegraphHandler) WebSocketChat(c echo.Context) error {
 	}
 
 	// メッセージループを開始
-	return h.handleChatMessageLoop(conn, strconv.Itoa(householdID), userID)
+	return h.handleChatMessageLoop(conn, householdID, userID)
 }
 
 // validateWebSocketRequest WebSocketリクエストのパラメータを検証する
+// householdID は HouseHoldMemberMiddleware で所属確認済みのものを利用する
 func (h *chatMessageTelegraphHandler) validateWebSocketRequest(c echo.Context) (int, int, error) {
-	householdID := c.QueryParam("householdID")
-	if householdID == "" {
-		return 0, 0, c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "householdID is required",
-		})
-	}
-
 	user, ok := middleware.GetUserFromContext(c.Request().Context())
 	if !ok {
-		return 0, 0, c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+		return 0, 0, apperrors.NewAppError(apperrors.ErrorCodeUnauthorized, "unauthorized", nil)
 	}
 
-	householdIDInt, err := strconv.Atoi(householdID)
-	if err != nil {
-		return 0, 0, c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid householdID format",
-		})
+	householdID, ok := middleware.GetHouseHoldIDFromContext(c.Request().Context())
+	if !ok {
+		return 0, 0, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, "householdID is required", nil)
 	}
 
-	return householdIDInt, int(user.ID), nil
+	return int(householdID), int(user.ID), nil
 }
 
 // establishWebSocketConnection WebSocket接続を確立する
@@ -132,7 +125,7 @@ func (h *chatMessageTelegraphHandler) initializeChatSession(conn *websocket.Conn
 	welcomeMsg := ChatMessageTelegraphResponse{
 		ID:          1,
 		UserID:      1,
-		UserName:    "システム",
+		UserName:    systemUserName,
 		Content:     "チャットに接続しました",
 		MessageType: "system",
 		CreatedAt:   time.Now().Format(time.RFC3339),
@@ -162,7 +155,7 @@ func (h *chatMessageTelegraphHandler) initializeChatSession(conn *websocket.Conn
 }
 
 // handleChatMessageLoop チャットメッセージループを処理
-func (h *chatMessageTelegraphHandler) handleChatMessageLoop(conn *websocket.Conn, householdID string, userID int) error {
+func (h *chatMessageTelegraphHandler) handleChatMessageLoop(conn *websocket.Conn, householdID int, userID int) error {
 	for {
 		_, msg, err := conn.ReadMessage()
 		if err != nil {
@@ -184,12 +177,14 @@ func (h *chatMessageTelegraphHandler) handleChatMessageLoop(conn *websocket.Conn
 }
 
 // processChatMessage チャットメッセージを処理
-func (h *chatMessageTelegraphHandler) processChatMessage(msg []byte, householdID string, userID int) error {
+func (h *chatMessageTelegraphHandler) processChatMessage(msg []byte, householdID int, userID int) error {
 	var request ChatMessageTelegraphRequest
 	if err := json.Unmarshal(msg, &request); err != nil {
 		log.Println("チャットメッセージJSONデコードエラー:", err)
 		return fmt.Errorf("JSONデコードエラー: %w", err)
 	}
+	// 接続時に所属確認した家計簿以外は操作させない
+	request.HouseholdID = householdID
 
 	// websocketの処理区分による処理分岐
 	switch request.MethodType {
@@ -218,10 +213,14 @@ func (h *chatMessageTelegraphHandler) fetchChatMessage(request ChatMessageTelegr
 	}
 
 	for _, chatMessage := range fetchChatMessageOutput.ChatMessages {
+		userName := chatMessage.User.Name
+		if chatMessage.MessageType == domainmodel.ChatMessageTypeSystem {
+			userName = systemUserName
+		}
 		chatmessage := ChatMessageTelegraphResponse{
 			ID:          chatMessage.ID,
 			UserID:      chatMessage.UserID,
-			UserName:    chatMessage.User.Name,
+			UserName:    userName,
 			Content:     chatMessage.Content,
 			MessageType: string(chatMessage.MessageType),
 			CreatedAt:   chatMessage.CreatedAt.Format(time.RFC3339),
@@ -233,7 +232,7 @@ func (h *chatMessageTelegraphHandler) fetchChatMessage(request ChatMessageTelegr
 			return fmt.Errorf("JSONマーシャリングに失敗しました: %w", err)
 		}
 
-		h.wsManager.BroadcastToAll(messageJSON)
+		h.wsManager.BroadcastToHouseHold(request.HouseholdID, messageJSON)
 	}
 
 	return nil
@@ -267,7 +266,7 @@ func (h *chatMessageTelegraphHandler) registerChatMessage(request ChatMessageTel
 		return fmt.Errorf("JSONマーシャリングに失敗しました: %w", err)
 	}
 
-	// 全クライアントにブロードキャスト
-	h.wsManager.BroadcastToAll(messageJSON)
+	// 同じ家計簿のクライアントにブロードキャスト
+	h.wsManager.BroadcastToHouseHold(request.HouseholdID, messageJSON)
 	return nil
 }
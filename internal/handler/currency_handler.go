@@ -0,0 +1,119 @@
+package handler
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	domainservice "echo-household-budget/internal/domain/service"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ExchangeRateRequest struct {
+	Currency string `json:"currency"`
+	// Rate は 1 単位の Currency が家計簿の基準通貨の何単位にあたるか。小数点以下の桁を落とさないよう文字列で指定する
+	Rate          string `json:"rate"`
+	EffectiveDate string `json:"effectiveDate"`
+}
+
+type BaseCurrencyRequest struct {
+	BaseCurrency string `json:"baseCurrency"`
+}
+
+type currencyHandler struct {
+	service domainservice.CurrencyService
+}
+
+// FetchExchangeRates implements CurrencyHandler.
+func (h *currencyHandler) FetchExchangeRates(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	rates, err := h.service.FetchExchangeRates(houseHoldID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, rates)
+}
+
+// AddExchangeRate implements CurrencyHandler.
+func (h *currencyHandler) AddExchangeRate(c echo.Context) error {
+	req := ExchangeRateRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	rate, err := h.service.AddExchangeRate(houseHoldID, req.Currency, req.Rate, req.EffectiveDate)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, rate)
+}
+
+// RemoveExchangeRate implements CurrencyHandler.
+func (h *currencyHandler) RemoveExchangeRate(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	exchangeRateID, err := strconv.ParseUint(c.Param("exchangeRateID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.service.RemoveExchangeRate(houseHoldID, domainmodel.ExchangeRateID(exchangeRateID)); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// ChangeBaseCurrency implements CurrencyHandler.
+func (h *currencyHandler) ChangeBaseCurrency(c echo.Context) error {
+	req := BaseCurrencyRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionManageMember)
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.ChangeBaseCurrency(houseHoldID, req.BaseCurrency); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+type CurrencyHandler interface {
+	FetchExchangeRates(c echo.Context) error
+	AddExchangeRate(c echo.Context) error
+	RemoveExchangeRate(c echo.Context) error
+	ChangeBaseCurrency(c echo.Context) error
+}
+
+func NewCurrencyHandler(service domainservice.CurrencyService) CurrencyHandler {
+	return &currencyHandler{service: service}
+}
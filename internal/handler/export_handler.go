@@ -0,0 +1,84 @@
+package handler
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	domainservice "echo-household-budget/internal/domain/service"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type exportHandler struct {
+	service domainservice.ExportService
+}
+
+// ExportShoppingRecords implements ExportHandler.
+// 支出の検索と同じ条件で絞り込み、一致するすべての支出をページごとに書き出す
+// 並び順を指定しない場合は日付の古い順とする。件数とカーソルは用いない
+func (h *exportHandler) ExportShoppingRecords(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	format := domainmodel.ExportFormat(c.QueryParam("format"))
+	if format == "" {
+		format = domainmodel.ExportFormatCSV
+	}
+	encoding := domainmodel.ExportEncoding(c.QueryParam("encoding"))
+	if encoding == "" {
+		encoding = domainmodel.ExportEncodingUTF8
+	}
+	if err := domainmodel.ValidateExportFormat(format, encoding); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	condition, err := shoppingSearchCondition(c, houseHoldID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if c.QueryParam("sort") == "" {
+		condition.Sort = domainmodel.ShoppingSearchSortDateAsc
+	}
+
+	res := c.Response()
+	contentType := "text/csv; charset=" + string(encoding)
+	if format == domainmodel.ExportFormatJSON {
+		contentType = echo.MIMEApplicationJSONCharsetUTF8
+	}
+	res.Header().Set(echo.HeaderContentType, contentType)
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="shopping-records-%d.%s"`, houseHoldID, format))
+
+	writer := newShoppingExportWriter(res, format, encoding)
+	err = h.service.ExportShoppingAmounts(condition, func(records []*domainmodel.ExportRecord) error {
+		if err := writer.Write(records); err != nil {
+			return err
+		}
+		res.Flush()
+		return nil
+	})
+	if err != nil {
+		// 書き出しを始めた後はステータスを変更できないため、エラーは記録のみ行う
+		if res.Committed {
+			c.Logger().Error(err)
+			return nil
+		}
+		res.Header().Del(echo.HeaderContentDisposition)
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return writer.Close()
+}
+
+type ExportHandler interface {
+	ExportShoppingRecords(c echo.Context) error
+}
+
+func NewExportHandler(service domainservice.ExportService) ExportHandler {
+	return &exportHandler{service: service}
+}
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+// utf8BOM は Excel が UTF-8 として読み込むよう CSV の先頭に付ける BOM
+const utf8BOM = "\xEF\xBB\xBF"
+
+// shoppingExportWriter はエクスポートする支出をページごとに書き出す
+type shoppingExportWriter interface {
+	// Write は支出を書き出す。最初の呼び出しで BOM や見出しなどの先頭部分も書き出す
+	Write(records []*domainmodel.ExportRecord) error
+	// Close は末尾を書き出し、書き出していない内容を出力する
+	Close() error
+}
+
+func newShoppingExportWriter(w io.Writer, format domainmodel.ExportFormat, enc domainmodel.ExportEncoding) shoppingExportWriter {
+	if format == domainmodel.ExportFormatJSON {
+		return &jsonExportWriter{w: w}
+	}
+	return newCSVExportWriter(w, enc)
+}
+
+type csvExportWriter struct {
+	out     io.Writer
+	closer  io.Closer
+	csv     *csv.Writer
+	started bool
+	bom     bool
+}
+
+func newCSVExportWriter(w io.Writer, enc domainmodel.ExportEncoding) *csvExportWriter {
+	writer := &csvExportWriter{out: w, bom: enc == domainmodel.ExportEncodingUTF8}
+	if enc == domainmodel.ExportEncodingShiftJIS {
+		// Shift_JIS で表せない文字（絵文字など）は置換文字（0x1A）に置き換えて出力する
+		encoder := transform.NewWriter(w, encoding.ReplaceUnsupported(japanese.ShiftJIS.NewEncoder()))
+		writer.out = encoder
+		writer.closer = encoder
+	}
+	writer.csv = csv.NewWriter(writer.out)
+	writer.csv.UseCRLF = true
+	return writer
+}
+
+// Write implements shoppingExportWriter.
+func (w *csvExportWriter) Write(records []*domainmodel.ExportRecord) error {
+	if !w.started {
+		w.started = true
+		if w.bom {
+			if _, err := io.WriteString(w.out, utf8BOM); err != nil {
+				return err
+			}
+		}
+		if err := w.csv.Write(domainmodel.ExportCSVHeader); err != nil {
+			return err
+		}
+	}
+	for _, record := range records {
+		if err := w.csv.WriteAll(record.CSVRows()); err != nil {
+			return err
+		}
+	}
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+// Close implements shoppingExportWriter.
+func (w *csvExportWriter) Close() error {
+	if !w.started {
+		if err := w.Write(nil); err != nil {
+			return err
+		}
+	}
+	if w.closer != nil {
+		return w.closer.Close()
+	}
+	return nil
+}
+
+// jsonExportWriter は支出を1つの JSON の配列として書き出す
+type jsonExportWriter struct {
+	w       io.Writer
+	started bool
+}
+
+// Write implements shoppingExportWriter.
+func (w *jsonExportWriter) Write(records []*domainmodel.ExportRecord) error {
+	for _, record := range records {
+		b, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		separator := ","
+		if !w.started {
+			w.started = true
+			separator = "["
+		}
+		if _, err := io.WriteString(w.w, separator); err != nil {
+			return err
+		}
+		if _, err := w.w.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close implements shoppingExportWriter.
+func (w *jsonExportWriter) Close() error {
+	end := "]"
+	if !w.started {
+		end = "[]"
+	}
+	_, err := io.WriteString(w.w, end)
+	return err
+}
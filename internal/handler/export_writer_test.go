@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"bytes"
+	domainmodel "echo-household-budget/internal/domain/model"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/encoding/japanese"
+)
+
+func TestShoppingExportWriter(t *testing.T) {
+	records := []*domainmodel.ExportRecord{
+		{ID: 1, Date: "2026-10-18", Category: "食費", Amount: 1200, Memo: "牛乳, 卵", Tags: []string{}, ReceiptItems: []*domainmodel.ExportReceiptItem{}},
+	}
+	expectedCSV := "type,id,date,category,amount,memo,payer,payment_method,tags,item_name,item_price\r\n" +
+		"expense,1,2026-10-18,食費,1200,\"牛乳, 卵\",,,,,\r\n"
+
+	t.Run("UTF-8 の CSV は BOM を付ける", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		writer := newShoppingExportWriter(buf, domainmodel.ExportFormatCSV, domainmodel.ExportEncodingUTF8)
+		assert.NoError(t, writer.Write(records))
+		assert.NoError(t, writer.Close())
+		assert.Equal(t, utf8BOM+expectedCSV, buf.String())
+	})
+
+	t.Run("Shift_JIS の CSV は BOM を付けずに変換し、表せない文字は置き換える", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		writer := newShoppingExportWriter(buf, domainmodel.ExportFormatCSV, domainmodel.ExportEncodingShiftJIS)
+		assert.NoError(t, writer.Write(records))
+		assert.NoError(t, writer.Write([]*domainmodel.ExportRecord{
+			{ID: 2, Date: "2026-10-19", Category: "外食", Amount: 800, Memo: "🍣", Tags: []string{}, ReceiptItems: []*domainmodel.ExportReceiptItem{}},
+		}))
+		assert.NoError(t, writer.Close())
+
+		decoded, err := japanese.ShiftJIS.NewDecoder().Bytes(buf.Bytes())
+		assert.NoError(t, err)
+		assert.Equal(t, expectedCSV+"expense,2,2026-10-19,外食,800,\x1a,,,,,\r\n", string(decoded))
+	})
+
+	t.Run("支出がない場合も CSV の見出しを書き出す", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		writer := newShoppingExportWriter(buf, domainmodel.ExportFormatCSV, domainmodel.ExportEncodingUTF8)
+		assert.NoError(t, writer.Close())
+		assert.Equal(t, utf8BOM+"type,id,date,category,amount,memo,payer,payment_method,tags,item_name,item_price\r\n", buf.String())
+	})
+
+	t.Run("JSON はページをまたいで1つの配列として書き出す", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		writer := newShoppingExportWriter(buf, domainmodel.ExportFormatJSON, domainmodel.ExportEncodingUTF8)
+		assert.NoError(t, writer.Write(records))
+		assert.NoError(t, writer.Write([]*domainmodel.ExportRecord{}))
+		assert.NoError(t, writer.Write(records))
+		assert.NoError(t, writer.Close())
+
+		record := `{"id":1,"date":"2026-10-18","categoryID":0,"category":"食費","amount":1200,"memo":"牛乳, 卵","paidBy":null,"payerName":"","paymentMethod":"","tags":[],"receiptItems":[]}`
+		assert.Equal(t, "["+record+","+record+"]", buf.String())
+	})
+
+	t.Run("支出がない場合は空の JSON の配列", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		writer := newShoppingExportWriter(buf, domainmodel.ExportFormatJSON, domainmodel.ExportEncodingUTF8)
+		assert.NoError(t, writer.Write([]*domainmodel.ExportRecord{}))
+		assert.NoError(t, writer.Close())
+		assert.Equal(t, "[]", buf.String())
+	})
+}
@@ -3,9 +3,12 @@ package handler
 import (
 	domainmodel "echo-household-budget/internal/domain/model"
 	domainservice "echo-household-budget/internal/domain/service"
+	"echo-household-budget/internal/infrastructure/middleware"
+	apperrors "echo-household-budget/internal/shared/errors"
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -22,6 +25,13 @@ type CreateShoppingRecordRequest struct {
 	Amount      int    `json:"amount"`
 	Date        string `json:"date"`
 	Memo        string `json:"memo"`
+	// PaymentMethodID は支払い方法。未設定の場合は未指定
+	PaymentMethodID *uint `json:"paymentMethodID"`
+	// TagIDs は支出に付けるタグ。更新時は指定したタグに置き換える
+	TagIDs []uint `json:"tagIDs"`
+	// Currency は Amount の通貨。家計簿の基準通貨以外を指定した場合は Amount をその通貨の補助単位で指定する
+	Currency string `json:"currency"`
+	ShoppingSplitRequest
 }
 
 type UpdateShoppingRecordRequest struct {
@@ -29,18 +39,94 @@ type UpdateShoppingRecordRequest struct {
 	Amount     int    `json:"amount"`
 	Date       string `json:"date"`
 	Memo       string `json:"memo"`
+	// PaymentMethodID は支払い方法。未設定の場合は未指定
+	PaymentMethodID *uint `json:"paymentMethodID"`
+	// TagIDs は支出に付けるタグ。更新時は指定したタグに置き換える
+	TagIDs []uint `json:"tagIDs"`
+	// Currency は Amount の通貨。家計簿の基準通貨以外を指定した場合は Amount をその通貨の補助単位で指定する
+	Currency string `json:"currency"`
+	ShoppingSplitRequest
+}
+
+// ShoppingSplitRequest は支払ったメンバーと負担方法の指定
+type ShoppingSplitRequest struct {
+	PaidBy    *uint  `json:"paidBy"`    // 共通の財布から支払った場合は未指定
+	SplitType string `json:"splitType"` // 未指定の場合は equal
+	Shares    []struct {
+		UserID uint `json:"userID"`
+		Value  int  `json:"value"`
+	} `json:"shares"`
+}
+
+// toOriginalAmount は通貨を指定した場合に記録した通貨の金額を返す。未指定の場合は基準通貨として nil を返す
+func toOriginalAmount(amount int, currency string) *domainmodel.Money {
+	if currency == "" {
+		return nil
+	}
+	original := domainmodel.NewMoney(int64(amount), domainmodel.Currency(currency))
+	return &original
+}
+
+// toPaymentMethodID はリクエストの支払い方法を変換する。未指定の場合は nil を返す
+func toPaymentMethodID(paymentMethodID *uint) *domainmodel.PaymentMethodID {
+	if paymentMethodID == nil {
+		return nil
+	}
+	id := domainmodel.PaymentMethodID(*paymentMethodID)
+	return &id
+}
+
+func (r ShoppingSplitRequest) applyTo(shoppingAmount *domainmodel.ShoppingAmount) {
+	if r.PaidBy != nil {
+		paidBy := domainmodel.UserID(*r.PaidBy)
+		shoppingAmount.PaidBy = &paidBy
+	}
+	if r.SplitType != "" {
+		shoppingAmount.SplitType = domainmodel.SplitType(r.SplitType)
+	}
+	for _, share := range r.Shares {
+		shoppingAmount.Shares = append(shoppingAmount.Shares, domainmodel.ExpenseShare{UserID: domainmodel.UserID(share.UserID), Value: share.Value})
+	}
 }
 
 type AddHouseHoldCategoryRequest struct {
 	HouseholdID         uint   `json:"householdID" param:"householdID"`
 	CategoryName        string `json:"categoryName"`
+	CategoryColor       string `json:"categoryColor"`
+	CategoryIcon        string `json:"categoryIcon"`
 	CategoryLimitAmount int    `json:"categoryLimitAmount"`
 }
 
+type UpdateHouseHoldCategoryRequest struct {
+	CategoryName        string `json:"categoryName"`
+	CategoryColor       string `json:"categoryColor"`
+	CategoryIcon        string `json:"categoryIcon"`
+	CategoryLimitAmount int    `json:"categoryLimitAmount"`
+}
+
+type ReorderHouseHoldCategoriesRequest struct {
+	CategoryLimitIDs []uint `json:"categoryLimitIDs"`
+}
+
+type SetMonthlyBudgetRequest struct {
+	Amount   int  `json:"amount"`
+	Rollover bool `json:"rollover"`
+}
+
+type ChangeMemberRoleRequest struct {
+	Role string `json:"role"`
+}
+
+type TransferOwnershipRequest struct {
+	UserID uint `json:"userID"`
+}
+
 type AddHouseHoldRequest struct {
 	UserID      uint   `json:"id" param:"id"`
 	Title       string `json:"title"`
 	Description string `json:"description"`
+	// BaseCurrency は家計簿の基準通貨。未指定の場合は JPY
+	BaseCurrency string `json:"baseCurrency"`
 }
 
 // AddHouseHold implements HouseHoldHandler.
@@ -50,11 +136,22 @@ func (h *houseHoldHandler) AddHouseHold(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, err.Error())
 	}
 
+	if !h.isLoginUser(c, domainmodel.UserID(req.UserID)) {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "user access denied"})
+	}
+
 	houseHold := domainmodel.HouseHold{
 		UserID:      domainmodel.UserID(req.UserID),
 		Title:       req.Title,
 		Description: req.Description,
 	}
+	if req.BaseCurrency != "" {
+		baseCurrency, err := domainmodel.ParseCurrency(req.BaseCurrency)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, err.Error())
+		}
+		houseHold.BaseCurrency = baseCurrency
+	}
 
 	if err := h.service.AddUserHouseHold(&houseHold); err != nil {
 		return c.JSON(http.StatusInternalServerError, err.Error())
@@ -70,7 +167,230 @@ func (h *houseHoldHandler) AddHouseHoldCategory(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, err.Error())
 	}
 
-	if err := h.service.AddHouseHoldCategory(domainmodel.HouseHoldID(req.HouseholdID), req.CategoryName, req.CategoryLimitAmount); err != nil {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	// 色の指定がない場合は従来どおり黒とする
+	color := req.CategoryColor
+	if color == "" {
+		color = "#000000"
+	}
+
+	categoryLimit := &domainmodel.CategoryLimit{
+		HouseholdBookID: houseHoldID,
+		Category: domainmodel.Category{
+			Name:  req.CategoryName,
+			Color: color,
+			Icon:  req.CategoryIcon,
+		},
+		LimitAmount: req.CategoryLimitAmount,
+	}
+
+	if err := h.service.AddHouseHoldCategory(categoryLimit); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// FetchHouseHoldCategories implements HouseHoldHandler.
+func (h *houseHoldHandler) FetchHouseHoldCategories(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	includeArchived := c.QueryParam("includeArchived") == "true"
+
+	categories, err := h.service.FetchHouseHoldCategories(houseHoldID, includeArchived)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, categories)
+}
+
+// UpdateHouseHoldCategory implements HouseHoldHandler.
+func (h *houseHoldHandler) UpdateHouseHoldCategory(c echo.Context) error {
+	req := UpdateHouseHoldCategoryRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	categoryLimitID, err := strconv.ParseUint(c.Param("categoryLimitID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	categoryLimit := &domainmodel.CategoryLimit{
+		ID:              domainmodel.CategoryLimitID(categoryLimitID),
+		HouseholdBookID: houseHoldID,
+		Category: domainmodel.Category{
+			Name:  req.CategoryName,
+			Color: req.CategoryColor,
+			Icon:  req.CategoryIcon,
+		},
+		LimitAmount: req.CategoryLimitAmount,
+	}
+
+	if err := h.service.UpdateHouseHoldCategory(categoryLimit); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// ReorderHouseHoldCategories implements HouseHoldHandler.
+func (h *houseHoldHandler) ReorderHouseHoldCategories(c echo.Context) error {
+	req := ReorderHouseHoldCategoriesRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	categoryLimitIDs := make([]domainmodel.CategoryLimitID, len(req.CategoryLimitIDs))
+	for i, categoryLimitID := range req.CategoryLimitIDs {
+		categoryLimitIDs[i] = domainmodel.CategoryLimitID(categoryLimitID)
+	}
+
+	if err := h.service.ReorderHouseHoldCategories(houseHoldID, categoryLimitIDs); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// ArchiveHouseHoldCategory implements HouseHoldHandler.
+func (h *houseHoldHandler) ArchiveHouseHoldCategory(c echo.Context) error {
+	return h.changeHouseHoldCategoryArchived(c, true)
+}
+
+// UnarchiveHouseHoldCategory implements HouseHoldHandler.
+func (h *houseHoldHandler) UnarchiveHouseHoldCategory(c echo.Context) error {
+	return h.changeHouseHoldCategoryArchived(c, false)
+}
+
+// changeHouseHoldCategoryArchived はカテゴリのアーカイブ状態を切り替える
+func (h *houseHoldHandler) changeHouseHoldCategoryArchived(c echo.Context, archived bool) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	categoryLimitID, err := strconv.ParseUint(c.Param("categoryLimitID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.service.ArchiveHouseHoldCategory(houseHoldID, domainmodel.CategoryLimitID(categoryLimitID), archived); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// RemoveHouseHoldCategory implements HouseHoldHandler.
+func (h *houseHoldHandler) RemoveHouseHoldCategory(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	categoryLimitID, err := strconv.ParseUint(c.Param("categoryLimitID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	user, ok := middleware.GetUserFromContext(c.Request().Context())
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	if err := h.service.RemoveHouseHoldCategory(houseHoldID, user.ID, domainmodel.CategoryLimitID(categoryLimitID)); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// FetchMonthlyBudgets implements HouseHoldHandler.
+func (h *houseHoldHandler) FetchMonthlyBudgets(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	month := c.QueryParam("month")
+	if month == "" {
+		month = domainmodel.BudgetMonthOf(time.Now())
+	}
+
+	budgets, err := h.service.FetchMonthlyBudgets(houseHoldID, month)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, budgets)
+}
+
+// SetMonthlyBudget implements HouseHoldHandler.
+func (h *houseHoldHandler) SetMonthlyBudget(c echo.Context) error {
+	req := SetMonthlyBudgetRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	categoryID, err := strconv.ParseUint(c.Param("categoryID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	budget := &domainmodel.MonthlyBudget{
+		HouseHoldID: houseHoldID,
+		CategoryID:  domainmodel.CategoryID(categoryID),
+		Month:       c.Param("month"),
+		Amount:      req.Amount,
+		Rollover:    req.Rollover,
+	}
+
+	if err := h.service.SetMonthlyBudget(budget); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
 		return c.JSON(http.StatusInternalServerError, err.Error())
 	}
 
@@ -84,9 +404,21 @@ func (h *houseHoldHandler) CreateShoppingRecord(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, err.Error())
 	}
 
-	shoppingAmount := domainmodel.NewShoppingAmount(domainmodel.HouseHoldID(req.HouseholdID), domainmodel.CategoryID(req.CategoryID), req.Amount, req.Date, req.Memo, 0)
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	shoppingAmount := domainmodel.NewShoppingAmount(houseHoldID, domainmodel.CategoryID(req.CategoryID), req.Amount, req.Date, req.Memo, 0)
+	req.applyTo(shoppingAmount)
+	shoppingAmount.PaymentMethodID = toPaymentMethodID(req.PaymentMethodID)
+	shoppingAmount.TagIDs = toTagIDs(req.TagIDs)
+	shoppingAmount.Original = toOriginalAmount(req.Amount, req.Currency)
 
 	if err := h.service.CreateShoppingAmount(shoppingAmount); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
 		return c.JSON(http.StatusInternalServerError, err.Error())
 	}
 
@@ -100,12 +432,11 @@ func (h *houseHoldHandler) UpdateShoppingRecord(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, err.Error())
 	}
 
-	householdID := c.Param("householdID")
 	shoppingID := c.Param("shoppingID")
 
-	_, err := strconv.ParseUint(householdID, 10, 32)
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, err.Error())
+		return err
 	}
 
 	shoppingIDUint, err := strconv.ParseUint(shoppingID, 10, 32)
@@ -114,14 +445,22 @@ func (h *houseHoldHandler) UpdateShoppingRecord(c echo.Context) error {
 	}
 
 	shoppingAmount := &domainmodel.ShoppingAmount{
-		ID:         domainmodel.ShoppingID(uint(shoppingIDUint)),
-		CategoryID: domainmodel.CategoryID(req.CategoryID),
-		Amount:     req.Amount,
-		Date:       req.Date,
-		Memo:       req.Memo,
+		ID:          domainmodel.ShoppingID(uint(shoppingIDUint)),
+		HouseholdID: houseHoldID,
+		CategoryID:  domainmodel.CategoryID(req.CategoryID),
+		Amount:      req.Amount,
+		Date:        req.Date,
+		Memo:        req.Memo,
 	}
+	req.applyTo(shoppingAmount)
+	shoppingAmount.PaymentMethodID = toPaymentMethodID(req.PaymentMethodID)
+	shoppingAmount.TagIDs = toTagIDs(req.TagIDs)
+	shoppingAmount.Original = toOriginalAmount(req.Amount, req.Currency)
 
 	if err := h.service.UpdateShoppingAmount(shoppingAmount); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
 		return c.JSON(http.StatusInternalServerError, err.Error())
 	}
 
@@ -131,20 +470,25 @@ func (h *houseHoldHandler) UpdateShoppingRecord(c echo.Context) error {
 
 // FetchShoppingRecord implements HouseHoldHandler.
 func (h *houseHoldHandler) FetchShoppingRecord(c echo.Context) error {
-	householdID := c.Param("householdID")
-	householdIDUint, err := strconv.ParseUint(householdID, 10, 32)
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, err.Error())
+		return err
 	}
 
 	date := c.QueryParam("date")
 	if date == "" {
 		date = time.Now().Format("2006-01")
 	}
+	// 週の始まりを指定しない場合は ISO 8601 と同じ月曜日とする
+	weekStart, err := domainmodel.ParseWeekStart(c.QueryParam("weekStart"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
 
 	input := domainservice.FetchShoppingRecordInput{
-		HouseholdID: domainmodel.HouseHoldID(uint(householdIDUint)),
+		HouseholdID: houseHoldID,
 		Date:        date,
+		WeekStart:   weekStart,
 	}
 
 	results, err := h.service.SummarizeShoppingAmount(input)
@@ -156,48 +500,115 @@ func (h *houseHoldHandler) FetchShoppingRecord(c echo.Context) error {
 	return c.JSON(http.StatusOK, results)
 }
 
-// RemoveShoppingRecord implements HouseHoldHandler.
-func (h *houseHoldHandler) RemoveShoppingRecord(c echo.Context) error {
-	householdID := c.Param("householdID")
-	shoppingID := c.Param("shoppingID")
-
-	// TODO : houseHoldIDは不要か？
-	_, err := strconv.ParseUint(householdID, 10, 32)
+// SearchShoppingRecord implements HouseHoldHandler.
+// 期間を指定しない場合は全期間を検索する
+func (h *houseHoldHandler) SearchShoppingRecord(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, err.Error())
+		return err
 	}
 
-	shoppingIDUint, err := strconv.ParseUint(shoppingID, 10, 32)
+	condition, err := shoppingSearchCondition(c, houseHoldID)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, err.Error())
 	}
 
-	if err := h.service.RemoveShoppingAmount(domainmodel.ShoppingID(uint(shoppingIDUint))); err != nil {
+	result, err := h.service.SearchShoppingAmount(condition)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
 		return c.JSON(http.StatusInternalServerError, err.Error())
 	}
 
-	return c.JSON(http.StatusOK, "success")
+	return c.JSON(http.StatusOK, result)
+}
+
+// shoppingSearchCondition はクエリパラメータから支出の検索条件を作成する
+// カテゴリはカンマ区切りで複数指定できる
+func shoppingSearchCondition(c echo.Context, houseHoldID domainmodel.HouseHoldID) (*domainmodel.ShoppingSearchCondition, error) {
+	condition := domainmodel.NewShoppingSearchCondition(houseHoldID)
+	condition.From = c.QueryParam("from")
+	condition.To = c.QueryParam("to")
+	condition.Memo = strings.TrimSpace(c.QueryParam("memo"))
+
+	if param := c.QueryParam("categoryIDs"); param != "" {
+		for _, v := range strings.Split(param, ",") {
+			categoryID, err := strconv.ParseUint(strings.TrimSpace(v), 10, 32)
+			if err != nil {
+				return nil, err
+			}
+			condition.CategoryIDs = append(condition.CategoryIDs, domainmodel.CategoryID(categoryID))
+		}
+	}
+	if param := c.QueryParam("minAmount"); param != "" {
+		minAmount, err := strconv.Atoi(param)
+		if err != nil {
+			return nil, err
+		}
+		condition.MinAmount = &minAmount
+	}
+	if param := c.QueryParam("maxAmount"); param != "" {
+		maxAmount, err := strconv.Atoi(param)
+		if err != nil {
+			return nil, err
+		}
+		condition.MaxAmount = &maxAmount
+	}
+	if param := c.QueryParam("hasReceipt"); param != "" {
+		hasReceipt, err := strconv.ParseBool(param)
+		if err != nil {
+			return nil, err
+		}
+		condition.HasReceipt = &hasReceipt
+	}
+	if param := c.QueryParam("sort"); param != "" {
+		condition.Sort = domainmodel.ShoppingSearchSort(param)
+	}
+	if param := c.QueryParam("limit"); param != "" {
+		limit, err := strconv.Atoi(param)
+		if err != nil {
+			return nil, err
+		}
+		condition.Limit = limit
+	}
+	if param := c.QueryParam("cursor"); param != "" {
+		cursor, err := domainmodel.ParseShoppingSearchCursor(param)
+		if err != nil {
+			return nil, err
+		}
+		condition.Cursor = cursor
+	}
+
+	return condition, nil
 }
 
-// ShareHouseHold implements HouseHoldHandler.
-func (h *houseHoldHandler) ShareHouseHold(c echo.Context) error {
-	householdID := c.Param("householdID")
-	inviteUserID := c.Param("inviteUserID")
+// RemoveShoppingRecord implements HouseHoldHandler.
+func (h *houseHoldHandler) RemoveShoppingRecord(c echo.Context) error {
+	shoppingID := c.Param("shoppingID")
 
-	householdIDUint, err := strconv.ParseUint(householdID, 10, 32)
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, err.Error())
+		return err
 	}
 
-	inviteUserIDUint, err := strconv.ParseUint(inviteUserID, 10, 32)
+	shoppingIDUint, err := strconv.ParseUint(shoppingID, 10, 32)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, err.Error())
 	}
 
-	err = h.service.ShareHouseHold(domainmodel.HouseHoldID(uint(householdIDUint)), domainmodel.UserID(uint(inviteUserIDUint)))
-	if err != nil {
+	user, ok := middleware.GetUserFromContext(c.Request().Context())
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	if err := h.service.RemoveShoppingAmount(houseHoldID, user.ID, domainmodel.ShoppingID(uint(shoppingIDUint))); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
 		return c.JSON(http.StatusInternalServerError, err.Error())
 	}
+
 	return c.JSON(http.StatusOK, "success")
 }
 
@@ -208,6 +619,10 @@ func (h *houseHoldHandler) FetchHouseHoldUser(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, err.Error())
 	}
 
+	if !h.isLoginUser(c, domainmodel.UserID(uint(id))) {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "user access denied"})
+	}
+
 	user, err := h.userService.FetchUserAccount(domainmodel.UserID(uint(id)))
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, err.Error())
@@ -217,30 +632,244 @@ func (h *houseHoldHandler) FetchHouseHoldUser(c echo.Context) error {
 
 // FetchHouseHold implements HouseHoldHandler.
 func (h *houseHoldHandler) FetchHouseHold(c echo.Context) error {
-	houseHoldID := c.Param("id")
-	houseHoldIDUint, err := strconv.ParseUint(houseHoldID, 10, 32)
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, err.Error())
+		return err
 	}
 
-	houseHold, err := h.service.FetchHouseHold(domainmodel.HouseHoldID(uint(houseHoldIDUint)))
+	houseHold, err := h.service.FetchHouseHold(houseHoldID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, err.Error())
 	}
 	return c.JSON(http.StatusOK, houseHold)
 }
 
+// ChangeMemberRole implements HouseHoldHandler.
+func (h *houseHoldHandler) ChangeMemberRole(c echo.Context) error {
+	req := ChangeMemberRoleRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	role, err := domainmodel.ParseHouseHoldRole(req.Role)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	targetUserID, err := strconv.ParseUint(c.Param("userID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionManageMember)
+	if err != nil {
+		return err
+	}
+
+	user, ok := middleware.GetUserFromContext(c.Request().Context())
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	if err := h.service.ChangeMemberRole(houseHoldID, user.ID, domainmodel.UserID(uint(targetUserID)), role); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// TransferOwnership implements HouseHoldHandler.
+func (h *houseHoldHandler) TransferOwnership(c echo.Context) error {
+	req := TransferOwnershipRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionManageMember)
+	if err != nil {
+		return err
+	}
+
+	user, ok := middleware.GetUserFromContext(c.Request().Context())
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	if err := h.service.TransferOwnership(houseHoldID, user.ID, domainmodel.UserID(req.UserID)); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// FetchHouseHolds implements HouseHoldHandler.
+func (h *houseHoldHandler) FetchHouseHolds(c echo.Context) error {
+	user, ok := middleware.GetUserFromContext(c.Request().Context())
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	houseHolds, err := h.service.FetchUserHouseHolds(user.ID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, houseHolds)
+}
+
+// ChangeDefaultHouseHold implements HouseHoldHandler.
+func (h *houseHoldHandler) ChangeDefaultHouseHold(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	user, ok := middleware.GetUserFromContext(c.Request().Context())
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	if err := h.userService.ChangeDefaultHouseHold(user.ID, houseHoldID); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// FetchMembers implements HouseHoldHandler.
+func (h *houseHoldHandler) FetchMembers(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	members, err := h.service.FetchMembers(houseHoldID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, members)
+}
+
+// LeaveHouseHold implements HouseHoldHandler.
+func (h *houseHoldHandler) LeaveHouseHold(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	user, ok := middleware.GetUserFromContext(c.Request().Context())
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	if err := h.service.LeaveHouseHold(houseHoldID, user.ID); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// RemoveMember implements HouseHoldHandler.
+func (h *houseHoldHandler) RemoveMember(c echo.Context) error {
+	targetUserID, err := strconv.ParseUint(c.Param("userID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionManageMember)
+	if err != nil {
+		return err
+	}
+
+	user, ok := middleware.GetUserFromContext(c.Request().Context())
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	if err := h.service.RemoveMember(houseHoldID, user.ID, domainmodel.UserID(uint(targetUserID))); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// DeleteHouseHold implements HouseHoldHandler.
+func (h *houseHoldHandler) DeleteHouseHold(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionManageMember)
+	if err != nil {
+		return err
+	}
+
+	user, ok := middleware.GetUserFromContext(c.Request().Context())
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	if err := h.service.DeleteHouseHold(houseHoldID, user.ID); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// isLoginUser はパスで指定されたユーザーがログインユーザー本人かを判定する
+func (h *houseHoldHandler) isLoginUser(c echo.Context, userID domainmodel.UserID) bool {
+	user, ok := middleware.GetUserFromContext(c.Request().Context())
+	if !ok {
+		return false
+	}
+	return user.ID == userID
+}
+
 type HouseHoldHandler interface {
+	FetchHouseHolds(c echo.Context) error
 	FetchHouseHold(c echo.Context) error
+	ChangeDefaultHouseHold(c echo.Context) error
 	FetchHouseHoldUser(c echo.Context) error
-	ShareHouseHold(c echo.Context) error
-	AddHouseHoldCategory(c echo.Context) error
 	AddHouseHold(c echo.Context) error
+	// カテゴリ管理
+	FetchHouseHoldCategories(c echo.Context) error
+	AddHouseHoldCategory(c echo.Context) error
+	UpdateHouseHoldCategory(c echo.Context) error
+	ReorderHouseHoldCategories(c echo.Context) error
+	ArchiveHouseHoldCategory(c echo.Context) error
+	UnarchiveHouseHoldCategory(c echo.Context) error
+	RemoveHouseHoldCategory(c echo.Context) error
+	// 予算管理
+	FetchMonthlyBudgets(c echo.Context) error
+	SetMonthlyBudget(c echo.Context) error
 	// 買い物記録
 	FetchShoppingRecord(c echo.Context) error
 	CreateShoppingRecord(c echo.Context) error
 	UpdateShoppingRecord(c echo.Context) error
 	RemoveShoppingRecord(c echo.Context) error
+	SearchShoppingRecord(c echo.Context) error
+	// メンバー管理
+	FetchMembers(c echo.Context) error
+	ChangeMemberRole(c echo.Context) error
+	TransferOwnership(c echo.Context) error
+	LeaveHouseHold(c echo.Context) error
+	RemoveMember(c echo.Context) error
+	DeleteHouseHold(c echo.Context) error
 }
 
 func NewHouseHoldHandler(service domainservice.HouseHoldService, userService domainservice.UserAccountService) HouseHoldHandler {
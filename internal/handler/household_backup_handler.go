@@ -0,0 +1,119 @@
+package handler
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/middleware"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"echo-household-budget/internal/usecase"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// maxBackupFileSize は復元するバックアップのファイルサイズの上限
+const maxBackupFileSize = 200 << 20
+
+type houseHoldBackupHandler struct {
+	usecase usecase.HouseHoldBackupUsecase
+}
+
+// ExportBackup implements HouseHoldBackupHandler.
+// メンバーとチャットを含むため、メンバーを管理できるロールに限る
+func (h *houseHoldBackupHandler) ExportBackup(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionManageMember)
+	if err != nil {
+		return err
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "application/zip")
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="household-%d-backup-%s.zip"`, houseHoldID, time.Now().Format("20060102")))
+
+	if err := h.usecase.ExportBackup(houseHoldID, res); err != nil {
+		// 書き出しを始めた後はステータスを変更できないため、エラーは記録のみ行う
+		if res.Committed {
+			c.Logger().Error(err)
+			return nil
+		}
+		res.Header().Del(echo.HeaderContentDisposition)
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+// RestoreBackup implements HouseHoldBackupHandler.
+// バックアップから新しい家計簿を作成し、ログインユーザーを所有者とする
+// 復元は常に複製として新しい家計簿を作成し、既存の家計簿への上書きは行わない。
+// 復元先の家計簿（householdID）や上書き（inPlace）を指定された場合は、複製されたことに気づかないまま使われないようエラーとする
+func (h *houseHoldBackupHandler) RestoreBackup(c echo.Context) error {
+	user, ok := middleware.GetUserFromContext(c.Request().Context())
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	if c.FormValue("householdID") != "" {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, "restore always creates a new household; restoring into an existing household is not supported", nil)
+	}
+	if value := c.FormValue("inPlace"); value != "" {
+		inPlace, err := strconv.ParseBool(value)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, err.Error())
+		}
+		if inPlace {
+			return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, "restore always creates a new household; in-place restore is not supported", nil)
+		}
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	if fileHeader.Size > maxBackupFileSize {
+		return c.JSON(http.StatusBadRequest, errors.New("backup file must be at most 200MB").Error())
+	}
+
+	input := &domainmodel.RestoreBackupInput{
+		UserID: user.ID,
+		Title:  c.FormValue("title"),
+	}
+	if value := c.FormValue("includeMembers"); value != "" {
+		includeMembers, err := strconv.ParseBool(value)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, err.Error())
+		}
+		input.IncludeMembers = includeMembers
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	defer file.Close()
+
+	result, err := h.usecase.RestoreBackup(file, fileHeader.Size, input)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+type HouseHoldBackupHandler interface {
+	ExportBackup(c echo.Context) error
+	RestoreBackup(c echo.Context) error
+}
+
+func NewHouseHoldBackupHandler(usecase usecase.HouseHoldBackupUsecase) HouseHoldBackupHandler {
+	return &houseHoldBackupHandler{usecase: usecase}
+}
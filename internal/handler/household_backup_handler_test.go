@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/middleware"
+	apperrors "echo-household-budget/internal/shared/errors"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestoreBackup_RejectsInPlaceRestore(t *testing.T) {
+	tests := []struct {
+		name string
+		form url.Values
+	}{
+		{name: "復元先の家計簿を指定", form: url.Values{"householdID": {"1"}}},
+		{name: "上書きを指定", form: url.Values{"inPlace": {"true"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/household/restore", strings.NewReader(tt.form.Encode()))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+			req = req.WithContext(context.WithValue(req.Context(), middleware.UserKey, &domainmodel.UserAccount{ID: 1}))
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			// 複製しかできないため、usecase を呼ばずにエラーとする
+			h := NewHouseHoldBackupHandler(nil)
+			err := h.RestoreBackup(c)
+
+			appErr, ok := apperrors.GetAppError(err)
+			assert.True(t, ok)
+			assert.Equal(t, apperrors.ErrorCodeInvalidInput, appErr.Code)
+		})
+	}
+}
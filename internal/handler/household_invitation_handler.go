@@ -0,0 +1,204 @@
+package handler
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/middleware"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"echo-household-budget/internal/usecase"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type (
+	CreateHouseHoldInvitationRequest struct {
+		MaxUses        *int `json:"maxUses"`        // 0は無制限。未指定の場合は1回限り
+		ExpiresInHours int  `json:"expiresInHours"` // 未指定の場合は72時間
+	}
+
+	HouseHoldInvitationResponse struct {
+		ID        uint   `json:"id"`
+		Code      string `json:"code"`
+		URL       string `json:"url"`
+		MaxUses   int    `json:"maxUses"`
+		UsedCount int    `json:"usedCount"`
+		ExpiresAt string `json:"expiresAt"`
+		CreatedAt string `json:"createdAt"`
+	}
+
+	FetchInvitationResponse struct {
+		HouseholdID    uint   `json:"householdID"`
+		HouseholdTitle string `json:"householdTitle"`
+		Status         string `json:"status"`
+		ExpiresAt      string `json:"expiresAt"`
+	}
+
+	AcceptInvitationResponse struct {
+		HouseholdID uint `json:"householdID"`
+	}
+
+	HouseHoldInvitationHandler interface {
+		CreateInvitation(c echo.Context) error
+		FetchInvitations(c echo.Context) error
+		RevokeInvitation(c echo.Context) error
+		FetchInvitation(c echo.Context) error
+		AcceptInvitation(c echo.Context) error
+		DeclineInvitation(c echo.Context) error
+	}
+
+	houseHoldInvitationHandler struct {
+		usecase       usecase.HouseHoldInvitationUsecase
+		invitationURL string
+	}
+)
+
+func NewHouseHoldInvitationHandler(usecase usecase.HouseHoldInvitationUsecase, invitationURL string) HouseHoldInvitationHandler {
+	return &houseHoldInvitationHandler{
+		usecase:       usecase,
+		invitationURL: strings.TrimSuffix(invitationURL, "/"),
+	}
+}
+
+// CreateInvitation implements HouseHoldInvitationHandler.
+func (h *houseHoldInvitationHandler) CreateInvitation(c echo.Context) error {
+	req := CreateHouseHoldInvitationRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	user, ok := middleware.GetUserFromContext(c.Request().Context())
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionManageMember)
+	if err != nil {
+		return err
+	}
+
+	invitation, err := h.usecase.CreateInvitation(usecase.CreateHouseHoldInvitationInput{
+		HouseHoldID: houseHoldID,
+		UserID:      user.ID,
+		MaxUses:     req.MaxUses,
+		ExpiresIn:   time.Duration(req.ExpiresInHours) * time.Hour,
+	})
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, h.makeInvitationResponse(invitation))
+}
+
+// FetchInvitations implements HouseHoldInvitationHandler.
+func (h *houseHoldInvitationHandler) FetchInvitations(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionManageMember)
+	if err != nil {
+		return err
+	}
+
+	invitations, err := h.usecase.FetchPendingInvitations(houseHoldID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	response := make([]HouseHoldInvitationResponse, len(invitations))
+	for i, invitation := range invitations {
+		response[i] = h.makeInvitationResponse(invitation)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// RevokeInvitation implements HouseHoldInvitationHandler.
+func (h *houseHoldInvitationHandler) RevokeInvitation(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionManageMember)
+	if err != nil {
+		return err
+	}
+
+	invitationID, err := strconv.ParseUint(c.Param("invitationID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	if err := h.usecase.RevokeInvitation(houseHoldID, domainmodel.HouseHoldInvitationID(invitationID)); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// FetchInvitation implements HouseHoldInvitationHandler.
+func (h *houseHoldInvitationHandler) FetchInvitation(c echo.Context) error {
+	output, err := h.usecase.FetchInvitation(c.Param("code"))
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, FetchInvitationResponse{
+		HouseholdID:    uint(output.Invitation.HouseHoldID),
+		HouseholdTitle: output.HouseHoldTitle,
+		Status:         string(output.Status),
+		ExpiresAt:      output.Invitation.ExpiresAt.Format(time.RFC3339),
+	})
+}
+
+// AcceptInvitation implements HouseHoldInvitationHandler.
+func (h *houseHoldInvitationHandler) AcceptInvitation(c echo.Context) error {
+	user, ok := middleware.GetUserFromContext(c.Request().Context())
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	houseHoldID, err := h.usecase.AcceptInvitation(c.Param("code"), user.ID)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, AcceptInvitationResponse{HouseholdID: uint(houseHoldID)})
+}
+
+// DeclineInvitation implements HouseHoldInvitationHandler.
+func (h *houseHoldInvitationHandler) DeclineInvitation(c echo.Context) error {
+	user, ok := middleware.GetUserFromContext(c.Request().Context())
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	if err := h.usecase.DeclineInvitation(c.Param("code"), user.ID); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+func (h *houseHoldInvitationHandler) makeInvitationResponse(invitation *domainmodel.HouseHoldInvitation) HouseHoldInvitationResponse {
+	return HouseHoldInvitationResponse{
+		ID:        uint(invitation.ID),
+		Code:      invitation.Code,
+		URL:       fmt.Sprintf("%s/%s", h.invitationURL, invitation.Code),
+		MaxUses:   invitation.MaxUses,
+		UsedCount: invitation.UsedCount,
+		ExpiresAt: invitation.ExpiresAt.Format(time.RFC3339),
+		CreatedAt: invitation.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,22 @@
+package handler
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/middleware"
+	apperrors "echo-household-budget/internal/shared/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+// authorizeHouseHold は HouseHoldMemberMiddleware で検証済みの所属情報から、
+// ログインユーザーのロールが指定の操作を許可しているかを確認し、対象の家計簿IDを返す
+func authorizeHouseHold(c echo.Context, permission domainmodel.HouseHoldPermission) (domainmodel.HouseHoldID, error) {
+	member, ok := middleware.GetHouseHoldMemberFromContext(c.Request().Context())
+	if !ok {
+		return 0, apperrors.NewAppError(apperrors.ErrorCodeForbidden, "household access denied", nil)
+	}
+	if !member.Role.Can(permission) {
+		return 0, apperrors.NewAppError(apperrors.ErrorCodeForbidden, "household permission denied", nil)
+	}
+	return member.HouseHoldID, nil
+}
@@ -0,0 +1,221 @@
+package handler
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	domainservice "echo-household-budget/internal/domain/service"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// maxImportFileSize は取り込む CSV のファイルサイズの上限
+const maxImportFileSize = 10 << 20
+
+type ImportPresetRequest struct {
+	Name             string                          `json:"name"`
+	Mapping          domainmodel.ImportColumnMapping `json:"mapping"`
+	CategoryMappings map[string]uint                 `json:"categoryMappings"`
+}
+
+type importHandler struct {
+	service domainservice.ImportService
+}
+
+// FetchImportPresets implements ImportHandler.
+func (h *importHandler) FetchImportPresets(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	presets, err := h.service.FetchPresets(houseHoldID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, presets)
+}
+
+// AddImportPreset implements ImportHandler.
+func (h *importHandler) AddImportPreset(c echo.Context) error {
+	req := ImportPresetRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	preset := &domainmodel.ImportPreset{
+		HouseHoldID:      houseHoldID,
+		Name:             req.Name,
+		Mapping:          req.Mapping,
+		CategoryMappings: toCategoryMappings(req.CategoryMappings),
+	}
+	if err := h.service.SavePreset(preset); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, preset)
+}
+
+// RemoveImportPreset implements ImportHandler.
+func (h *importHandler) RemoveImportPreset(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	presetID, err := strconv.ParseUint(c.Param("presetID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.service.RemovePreset(houseHoldID, domainmodel.ImportPresetID(presetID)); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// PreviewImport implements ImportHandler.
+func (h *importHandler) PreviewImport(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	input, closeFile, err := importInput(c, houseHoldID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	defer closeFile()
+
+	preview, err := h.service.PreviewImport(input)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, preview)
+}
+
+// CommitImport implements ImportHandler.
+func (h *importHandler) CommitImport(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	input, closeFile, err := importInput(c, houseHoldID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+	defer closeFile()
+
+	result, err := h.service.CommitImport(input)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// importInput は multipart のフォームから CSV の取り込みの指定を作成する
+// mapping と categoryMappings は JSON で指定する
+func importInput(c echo.Context, houseHoldID domainmodel.HouseHoldID) (*domainmodel.ImportInput, func(), error) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return nil, nil, err
+	}
+	if fileHeader.Size > maxImportFileSize {
+		return nil, nil, errors.New("import file must be at most 10MB")
+	}
+
+	input := &domainmodel.ImportInput{
+		HouseHoldID: houseHoldID,
+		PresetKey:   c.FormValue("preset"),
+		Encoding:    domainmodel.ExportEncoding(c.FormValue("encoding")),
+	}
+	if value := c.FormValue("presetID"); value != "" {
+		presetID, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return nil, nil, err
+		}
+		input.PresetID = domainmodel.ImportPresetID(presetID)
+	}
+	if value := c.FormValue("mapping"); value != "" {
+		mapping := &domainmodel.ImportColumnMapping{}
+		if err := json.Unmarshal([]byte(value), mapping); err != nil {
+			return nil, nil, err
+		}
+		input.Mapping = mapping
+	}
+	if value := c.FormValue("categoryMappings"); value != "" {
+		categoryMappings := map[string]uint{}
+		if err := json.Unmarshal([]byte(value), &categoryMappings); err != nil {
+			return nil, nil, err
+		}
+		input.CategoryMappings = toCategoryMappings(categoryMappings)
+	}
+	if value := c.FormValue("defaultCategoryID"); value != "" {
+		categoryID, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return nil, nil, err
+		}
+		defaultCategoryID := domainmodel.CategoryID(categoryID)
+		input.DefaultCategoryID = &defaultCategoryID
+	}
+	if value := c.FormValue("includeDuplicates"); value != "" {
+		includeDuplicates, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		input.IncludeDuplicates = includeDuplicates
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, nil, err
+	}
+	input.File = file
+	return input, func() { file.Close() }, nil
+}
+
+// toCategoryMappings はリクエストのカテゴリの対応を変換する
+func toCategoryMappings(mappings map[string]uint) map[string]domainmodel.CategoryID {
+	output := make(map[string]domainmodel.CategoryID, len(mappings))
+	for name, categoryID := range mappings {
+		output[name] = domainmodel.CategoryID(categoryID)
+	}
+	return output
+}
+
+type ImportHandler interface {
+	FetchImportPresets(c echo.Context) error
+	AddImportPreset(c echo.Context) error
+	RemoveImportPreset(c echo.Context) error
+	PreviewImport(c echo.Context) error
+	CommitImport(c echo.Context) error
+}
+
+func NewImportHandler(service domainservice.ImportService) ImportHandler {
+	return &importHandler{service: service}
+}
@@ -0,0 +1,206 @@
+package handler
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	domainservice "echo-household-budget/internal/domain/service"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type (
+	AddIncomeCategoryRequest struct {
+		Name  string `json:"name"`
+		Color string `json:"color"`
+	}
+
+	IncomeRequest struct {
+		IncomeCategoryID uint   `json:"incomeCategoryID"`
+		UserID           *uint  `json:"userID"` // 家計簿全体の収入の場合は未指定
+		Amount           int    `json:"amount"`
+		Date             string `json:"date"`
+		Memo             string `json:"memo"`
+		PaymentMethodID  *uint  `json:"paymentMethodID"` // 入金先が未設定の場合は未指定
+	}
+)
+
+type incomeHandler struct {
+	service domainservice.IncomeService
+}
+
+// FetchIncomeCategories implements IncomeHandler.
+func (h *incomeHandler) FetchIncomeCategories(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	categories, err := h.service.FetchIncomeCategories(houseHoldID)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, categories)
+}
+
+// AddIncomeCategory implements IncomeHandler.
+func (h *incomeHandler) AddIncomeCategory(c echo.Context) error {
+	req := AddIncomeCategoryRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	category := &domainmodel.IncomeCategory{
+		HouseHoldID: houseHoldID,
+		Name:        req.Name,
+		Color:       req.Color,
+	}
+
+	if err := h.service.AddIncomeCategory(category); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, category)
+}
+
+// FetchIncomes implements IncomeHandler.
+func (h *incomeHandler) FetchIncomes(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	month := c.QueryParam("month")
+	if month == "" {
+		month = domainmodel.BudgetMonthOf(time.Now())
+	}
+
+	incomes, err := h.service.FetchIncomes(houseHoldID, month)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, incomes)
+}
+
+// CreateIncome implements IncomeHandler.
+func (h *incomeHandler) CreateIncome(c echo.Context) error {
+	req := IncomeRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	income := req.toIncome(houseHoldID)
+	if err := h.service.CreateIncome(income); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, income)
+}
+
+// UpdateIncome implements IncomeHandler.
+func (h *incomeHandler) UpdateIncome(c echo.Context) error {
+	req := IncomeRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	incomeID, err := strconv.ParseUint(c.Param("incomeID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	income := req.toIncome(houseHoldID)
+	income.ID = domainmodel.IncomeID(incomeID)
+	if err := h.service.UpdateIncome(income); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// RemoveIncome implements IncomeHandler.
+func (h *incomeHandler) RemoveIncome(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	incomeID, err := strconv.ParseUint(c.Param("incomeID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.service.RemoveIncome(houseHoldID, domainmodel.IncomeID(incomeID)); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+func (r IncomeRequest) toIncome(houseHoldID domainmodel.HouseHoldID) *domainmodel.Income {
+	income := &domainmodel.Income{
+		HouseHoldID:      houseHoldID,
+		IncomeCategoryID: domainmodel.IncomeCategoryID(r.IncomeCategoryID),
+		Amount:           r.Amount,
+		Date:             r.Date,
+		Memo:             r.Memo,
+		PaymentMethodID:  toPaymentMethodID(r.PaymentMethodID),
+	}
+	if r.UserID != nil {
+		userID := domainmodel.UserID(*r.UserID)
+		income.UserID = &userID
+	}
+	return income
+}
+
+type IncomeHandler interface {
+	// 収入カテゴリ
+	FetchIncomeCategories(c echo.Context) error
+	AddIncomeCategory(c echo.Context) error
+	// 収入
+	FetchIncomes(c echo.Context) error
+	CreateIncome(c echo.Context) error
+	UpdateIncome(c echo.Context) error
+	RemoveIncome(c echo.Context) error
+}
+
+func NewIncomeHandler(service domainservice.IncomeService) IncomeHandler {
+	return &incomeHandler{service: service}
+}
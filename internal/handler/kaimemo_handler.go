@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"strconv"
 
 	domainmodel "echo-household-budget/internal/domain/model"
 
@@ -28,13 +27,16 @@ type kaimemoHandler struct {
 type WebSocketMessageProcessor struct {
 	shoppingUsecase usecase.ShoppingUsecase
 	wsManager       *WebSocketManager
+	// userID は接続したユーザー。買い物メモの削除を記録する
+	userID domainmodel.UserID
 }
 
 // NewWebSocketMessageProcessor WebSocketMessageProcessorのコンストラクタ
-func NewWebSocketMessageProcessor(shoppingUsecase usecase.ShoppingUsecase, wsManager *WebSocketManager) *WebSocketMessageProcessor {
+func NewWebSocketMessageProcessor(shoppingUsecase usecase.ShoppingUsecase, wsManager *WebSocketManager, userID domainmodel.UserID) *WebSocketMessageProcessor {
 	return &WebSocketMessageProcessor{
 		shoppingUsecase: shoppingUsecase,
 		wsManager:       wsManager,
+		userID:          userID,
 	}
 }
 
@@ -53,20 +55,21 @@ func (p *WebSocketMessageProcessor) ProcessMessage(msg []byte, householdID uint)
 	case model.CreateKaimemo:
 		return p.handleCreateShopping(request, householdID)
 	case model.RemoveKaimemo:
-		return p.handleDeleteShopping(request)
+		return p.handleDeleteShopping(request, householdID)
 	default:
 		return fmt.Errorf("未対応のメソッドタイプ: %s", request.MethodType)
 	}
 }
 
 // handleCreateShopping 買い物メモの作成を処理
+// メッセージの家計簿IDは使わず、接続時に所属を確認した家計簿に作成する
 func (p *WebSocketMessageProcessor) handleCreateShopping(request model.TelegraphRequest, householdID uint) error {
-	if request.HouseholdBookID == nil || request.Tag == nil || request.Name == nil {
+	if request.Tag == nil || request.Name == nil {
 		return fmt.Errorf("必須パラメータが不足しています")
 	}
 
 	shopping := domainmodel.NewShoppingMemo(
-		domainmodel.HouseHoldID(*request.HouseholdBookID),
+		domainmodel.HouseHoldID(householdID),
 		domainmodel.CategoryID(*request.Tag),
 		*request.Name,
 		"",
@@ -81,12 +84,12 @@ func (p *WebSocketMessageProcessor) handleCreateShopping(request model.Telegraph
 }
 
 // handleDeleteShopping 買い物メモの削除を処理
-func (p *WebSocketMessageProcessor) handleDeleteShopping(request model.TelegraphRequest) error {
+func (p *WebSocketMessageProcessor) handleDeleteShopping(request model.TelegraphRequest, householdID uint) error {
 	if request.ID == nil {
 		return fmt.Errorf("削除対象のIDが指定されていません")
 	}
 
-	if err := p.shoppingUsecase.DeleteShopping(domainmodel.ShoppingID(*request.ID)); err != nil {
+	if err := p.shoppingUsecase.DeleteShopping(domainmodel.HouseHoldID(householdID), p.userID, domainmodel.ShoppingID(*request.ID)); err != nil {
 		log.Printf("買い物メモ削除エラー: %v", err)
 		return fmt.Errorf("買い物メモの削除に失敗しました: %w", err)
 	}
@@ -94,7 +97,7 @@ func (p *WebSocketMessageProcessor) handleDeleteShopping(request model.Telegraph
 	return nil
 }
 
-// broadcastUpdatedData 更新されたデータを全クライアントにブロードキャスト
+// broadcastUpdatedData 更新されたデータを家計簿に接続しているクライアントにブロードキャスト
 func (p *WebSocketMessageProcessor) broadcastUpdatedData(householdID uint) error {
 	res, err := p.shoppingUsecase.FetchShopping(domainmodel.HouseHoldID(householdID))
 	if err != nil {
@@ -108,24 +111,21 @@ func (p *WebSocketMessageProcessor) broadcastUpdatedData(householdID uint) error
 		return fmt.Errorf("JSONマーシャリングに失敗しました: %w", err)
 	}
 
-	p.wsManager.BroadcastToAll(resJSON)
+	p.wsManager.BroadcastToHouseHold(int(householdID), resJSON)
 	return nil
 }
 
 // WebsocketTelegraph implements KaimemoHandler.
+// 買い物メモを作成・削除できるメンバーのみ接続できる
 func (k *kaimemoHandler) WebsocketTelegraph(c echo.Context) error {
-	tempUserID := c.QueryParam("tempUserID")
-	if tempUserID == "" {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "tempUserID is required",
-		})
+	user, ok := middleware.GetUserFromContext(c.Request().Context())
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
 	}
 
-	tempUserIDUint, err := strconv.ParseUint(tempUserID, 10, 32)
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid tempUserID format",
-		})
+		return err
 	}
 
 	// WebSocket接続の確立
@@ -138,21 +138,21 @@ func (k *kaimemoHandler) WebsocketTelegraph(c echo.Context) error {
 		return fmt.Errorf("WebSocket接続の確立に失敗しました: %w", err)
 	}
 
-	// クライアントを管理に追加
-	k.wsManager.AddClient(conn)
+	// クライアントを家計簿に紐づけて管理に追加
+	k.wsManager.AddHouseHoldClient(conn, int(houseHoldID))
 	defer k.wsManager.RemoveClient(conn)
 
 	// 初期データの送信
-	if err := k.sendInitialData(conn, uint(tempUserIDUint)); err != nil {
+	if err := k.sendInitialData(conn, uint(houseHoldID)); err != nil {
 		log.Printf("初期データ送信エラー: %v", err)
 		return err
 	}
 
 	// メッセージプロセッサーの初期化
-	processor := NewWebSocketMessageProcessor(k.shoppingUsecase, k.wsManager)
+	processor := NewWebSocketMessageProcessor(k.shoppingUsecase, k.wsManager, user.ID)
 
 	// メッセージループ
-	return k.handleMessageLoop(conn, processor, uint(tempUserIDUint))
+	return k.handleMessageLoop(conn, processor, uint(houseHoldID))
 }
 
 // sendInitialData 初期データを送信
@@ -210,7 +210,7 @@ func (k *kaimemoHandler) CreateKaimemoAmount(c echo.Context) error {
 		})
 	}
 
-	if err := k.service.CreateKaimemoAmount(req); err != nil {
+	if err := k.service.CreateKaimemoAmount(c.Request().Context(), req); err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"error": "Failed to create kaimemo amount",
 		})
@@ -237,7 +237,7 @@ func (k *kaimemoHandler) FetchKaimemoSummaryRecord(c echo.Context) error {
 		})
 	}
 
-	res, err := k.service.FetchKaimemoSummaryRecord(tempUserID)
+	res, err := k.service.FetchKaimemoSummaryRecord(ctx, tempUserID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"error": "Failed to fetch kaimemo summary record",
@@ -263,7 +263,7 @@ func (k *kaimemoHandler) RemoveKaimemoAmount(c echo.Context) error {
 		})
 	}
 
-	if err := k.service.RemoveKaimemoAmount(id, req.TempUserID); err != nil {
+	if err := k.service.RemoveKaimemoAmount(c.Request().Context(), id, req.TempUserID); err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"error": "Failed to remove kaimemo",
 		})
@@ -281,7 +281,7 @@ func (k *kaimemoHandler) CreateKaimemo(c echo.Context) error {
 		})
 	}
 
-	if err := k.service.CreateKaimemo(req); err != nil {
+	if err := k.service.CreateKaimemo(c.Request().Context(), req); err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"error": "Failed to create kaimemo",
 		})
@@ -299,7 +299,7 @@ func (k *kaimemoHandler) FetchKaimemo(c echo.Context) error {
 		})
 	}
 
-	res, err := k.service.FetchKaimemo(tempUserID)
+	res, err := k.service.FetchKaimemo(c.Request().Context(), tempUserID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"error": "Failed to fetch kaimemo",
@@ -325,7 +325,7 @@ func (k *kaimemoHandler) RemoveKaimemo(c echo.Context) error {
 		})
 	}
 
-	if err := k.service.RemoveKaimemo(id, req.TempUserID); err != nil {
+	if err := k.service.RemoveKaimemo(c.Request().Context(), id, req.TempUserID); err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"error": "Failed to remove kaimemo",
 		})
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"echo-household-budget/internal/usecase"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type NotionSyncSettingRequest struct {
+	DatabaseID     string                               `json:"databaseID"`
+	ConflictPolicy domainmodel.NotionSyncConflictPolicy `json:"conflictPolicy"`
+	Enabled        bool                                 `json:"enabled"`
+}
+
+type notionSyncHandler struct {
+	usecase usecase.NotionSyncUsecase
+}
+
+// FetchNotionSyncSetting implements NotionSyncHandler.
+func (h *notionSyncHandler) FetchNotionSyncSetting(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionManageMember)
+	if err != nil {
+		return err
+	}
+
+	setting, err := h.usecase.FetchSetting(houseHoldID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, setting)
+}
+
+// SaveNotionSyncSetting implements NotionSyncHandler.
+// 同期先のデータベースは家計簿の外部に支出を公開するため、メンバーを管理できるロールに限る
+func (h *notionSyncHandler) SaveNotionSyncSetting(c echo.Context) error {
+	req := NotionSyncSettingRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionManageMember)
+	if err != nil {
+		return err
+	}
+
+	setting := &domainmodel.NotionSyncSetting{
+		HouseHoldID:    houseHoldID,
+		DatabaseID:     req.DatabaseID,
+		ConflictPolicy: req.ConflictPolicy,
+		Enabled:        req.Enabled,
+	}
+	if err := h.usecase.SaveSetting(setting); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, setting)
+}
+
+// SyncNotion implements NotionSyncHandler.
+func (h *notionSyncHandler) SyncNotion(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.usecase.Sync(c.Request().Context(), houseHoldID)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+type NotionSyncHandler interface {
+	FetchNotionSyncSetting(c echo.Context) error
+	SaveNotionSyncSetting(c echo.Context) error
+	SyncNotion(c echo.Context) error
+}
+
+func NewNotionSyncHandler(usecase usecase.NotionSyncUsecase) NotionSyncHandler {
+	return &notionSyncHandler{usecase: usecase}
+}
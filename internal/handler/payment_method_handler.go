@@ -0,0 +1,227 @@
+package handler
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	domainservice "echo-household-budget/internal/domain/service"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type PaymentMethodRequest struct {
+	Name           string `json:"name"`
+	Type           string `json:"type"`
+	OpeningBalance int    `json:"openingBalance"`
+	OpeningDate    string `json:"openingDate"`
+	// ClosingDay, PaymentDay, WithdrawalAccountID はクレジットカードのみ指定する
+	ClosingDay          *int  `json:"closingDay"`
+	PaymentDay          *int  `json:"paymentDay"`
+	WithdrawalAccountID *uint `json:"withdrawalAccountID"`
+}
+
+type paymentMethodHandler struct {
+	service domainservice.PaymentMethodService
+}
+
+// FetchPaymentMethods implements PaymentMethodHandler.
+func (h *paymentMethodHandler) FetchPaymentMethods(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	includeArchived := c.QueryParam("includeArchived") == "true"
+
+	methods, err := h.service.FetchPaymentMethods(houseHoldID, includeArchived)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, methods)
+}
+
+// AddPaymentMethod implements PaymentMethodHandler.
+func (h *paymentMethodHandler) AddPaymentMethod(c echo.Context) error {
+	req := PaymentMethodRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	method := req.toPaymentMethod(houseHoldID)
+	if err := h.service.AddPaymentMethod(method); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, method)
+}
+
+// UpdatePaymentMethod implements PaymentMethodHandler.
+func (h *paymentMethodHandler) UpdatePaymentMethod(c echo.Context) error {
+	req := PaymentMethodRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	paymentMethodID, err := strconv.ParseUint(c.Param("paymentMethodID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	method := req.toPaymentMethod(houseHoldID)
+	method.ID = domainmodel.PaymentMethodID(paymentMethodID)
+	if err := h.service.UpdatePaymentMethod(method); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// ArchivePaymentMethod implements PaymentMethodHandler.
+func (h *paymentMethodHandler) ArchivePaymentMethod(c echo.Context) error {
+	return h.changePaymentMethodArchived(c, true)
+}
+
+// UnarchivePaymentMethod implements PaymentMethodHandler.
+func (h *paymentMethodHandler) UnarchivePaymentMethod(c echo.Context) error {
+	return h.changePaymentMethodArchived(c, false)
+}
+
+// changePaymentMethodArchived は支払い方法のアーカイブ状態を切り替える
+func (h *paymentMethodHandler) changePaymentMethodArchived(c echo.Context, archived bool) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	paymentMethodID, err := strconv.ParseUint(c.Param("paymentMethodID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.service.ArchivePaymentMethod(houseHoldID, domainmodel.PaymentMethodID(paymentMethodID), archived); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// FetchBalances implements PaymentMethodHandler.
+// 日付を指定しない場合は今日時点の残高を返す
+func (h *paymentMethodHandler) FetchBalances(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	date := c.QueryParam("date")
+	if date == "" {
+		date = time.Now().Format("2006-01-02")
+	}
+
+	balances, err := h.service.FetchBalances(houseHoldID, date)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, balances)
+}
+
+// FetchLedger implements PaymentMethodHandler.
+func (h *paymentMethodHandler) FetchLedger(c echo.Context) error {
+	return h.fetchByMonth(c, func(houseHoldID domainmodel.HouseHoldID, id domainmodel.PaymentMethodID, month string) (interface{}, error) {
+		return h.service.FetchLedger(houseHoldID, id, month)
+	})
+}
+
+// FetchStatement implements PaymentMethodHandler.
+func (h *paymentMethodHandler) FetchStatement(c echo.Context) error {
+	return h.fetchByMonth(c, func(houseHoldID domainmodel.HouseHoldID, id domainmodel.PaymentMethodID, month string) (interface{}, error) {
+		return h.service.FetchStatement(houseHoldID, id, month)
+	})
+}
+
+// fetchByMonth は支払い方法の指定月（省略した場合は今月）の情報を取得する
+func (h *paymentMethodHandler) fetchByMonth(c echo.Context, fetch func(domainmodel.HouseHoldID, domainmodel.PaymentMethodID, string) (interface{}, error)) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	paymentMethodID, err := strconv.ParseUint(c.Param("paymentMethodID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	month := c.QueryParam("month")
+	if month == "" {
+		month = domainmodel.BudgetMonthOf(time.Now())
+	}
+
+	result, err := fetch(houseHoldID, domainmodel.PaymentMethodID(paymentMethodID), month)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func (r PaymentMethodRequest) toPaymentMethod(houseHoldID domainmodel.HouseHoldID) *domainmodel.PaymentMethod {
+	method := &domainmodel.PaymentMethod{
+		HouseHoldID:    houseHoldID,
+		Name:           r.Name,
+		Type:           domainmodel.PaymentMethodType(r.Type),
+		OpeningBalance: r.OpeningBalance,
+		OpeningDate:    r.OpeningDate,
+		ClosingDay:     r.ClosingDay,
+		PaymentDay:     r.PaymentDay,
+	}
+	if r.WithdrawalAccountID != nil {
+		withdrawalAccountID := domainmodel.PaymentMethodID(*r.WithdrawalAccountID)
+		method.WithdrawalAccountID = &withdrawalAccountID
+	}
+	return method
+}
+
+type PaymentMethodHandler interface {
+	FetchPaymentMethods(c echo.Context) error
+	AddPaymentMethod(c echo.Context) error
+	UpdatePaymentMethod(c echo.Context) error
+	ArchivePaymentMethod(c echo.Context) error
+	UnarchivePaymentMethod(c echo.Context) error
+	// 残高・明細
+	FetchBalances(c echo.Context) error
+	FetchLedger(c echo.Context) error
+	FetchStatement(c echo.Context) error
+}
+
+func NewPaymentMethodHandler(service domainservice.PaymentMethodService) PaymentMethodHandler {
+	return &paymentMethodHandler{service: service}
+}
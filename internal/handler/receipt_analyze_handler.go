@@ -3,9 +3,12 @@ package handler
 
 import (
 	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/middleware"
+	apperrors "echo-household-budget/internal/shared/errors"
 	"echo-household-budget/internal/usecase"
 	"log"
 	"net/http"
+	"strconv"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/labstack/echo/v4"
@@ -16,7 +19,7 @@ type receiptAnalyzeHandler struct {
 }
 
 type CreateReceiptRequest struct {
-	HouseholdID uint   `json:"householdID" param:"houseHoldID"`
+	HouseholdID uint   `json:"householdID" param:"householdID"`
 	ImageData   string `json:"imageData"`
 	CategoryID  uint   `json:"categoryID"`
 }
@@ -26,6 +29,8 @@ type CreateReceiptAnalyzeResultRequest struct {
 	CategoryID uint                 `json:"categoryID"`
 	S3FilePath string               `json:"s3FilePath"`
 	Items      []ReceiptAnalyzeItem `json:"items"`
+	// Currency はレシートの通貨。total と price はこの通貨の補助単位で指定する。未指定の場合は家計簿の基準通貨
+	Currency string `json:"currency"`
 }
 
 type ReceiptAnalyzeItem struct {
@@ -48,8 +53,13 @@ func (r *receiptAnalyzeHandler) CreateReceiptAnalyzeReception(c echo.Context) er
 		})
 	}
 
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
 	receipt := &domainmodel.ReceiptAnalyzeReception{
-		HouseholdBookID: domainmodel.HouseHoldID(req.HouseholdID),
+		HouseholdBookID: houseHoldID,
 		ImageData:       req.ImageData,
 		CategoryID:      domainmodel.CategoryID(req.CategoryID),
 	}
@@ -75,6 +85,22 @@ func (r *receiptAnalyzeHandler) CreateReceiptAnalyzeResult(c echo.Context) error
 
 	spew.Dump(req)
 
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	var currency domainmodel.Currency
+	if req.Currency != "" {
+		parsed, err := domainmodel.ParseCurrency(req.Currency)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"error": err.Error(),
+			})
+		}
+		currency = parsed
+	}
+
 	// TODO：ここ、わざわざハンドラーでやらない方がいい｜具体的には、ドメインモデルで、変換処理をしたらいい？
 	items := make([]domainmodel.ReceiptAnalyzeItem, len(req.Items))
 	for i, item := range req.Items {
@@ -85,13 +111,18 @@ func (r *receiptAnalyzeHandler) CreateReceiptAnalyzeResult(c echo.Context) error
 	}
 
 	result := &domainmodel.ReceiptAnalyze{
-		TotalPrice: req.Total,
-		CategoryID: domainmodel.CategoryID(req.CategoryID),
-		S3FilePath: req.S3FilePath,
-		Items:      items,
+		TotalPrice:      req.Total,
+		CategoryID:      domainmodel.CategoryID(req.CategoryID),
+		S3FilePath:      req.S3FilePath,
+		HouseholdBookID: houseHoldID,
+		Items:           items,
+		Currency:        currency,
 	}
 
 	if err := r.usecase.CreateReceiptAnalyzeResult(result); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
 		log.Println(err)
 		spew.Dump(err)
 		return c.JSON(http.StatusInternalServerError, map[string]string{
@@ -103,6 +134,33 @@ func (r *receiptAnalyzeHandler) CreateReceiptAnalyzeResult(c echo.Context) error
 
 }
 
+// RemoveReceiptAnalyze implements ReceiptAnalyzeHandler.
+func (r *receiptAnalyzeHandler) RemoveReceiptAnalyze(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	receiptID, err := strconv.ParseUint(c.Param("receiptID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	user, ok := middleware.GetUserFromContext(c.Request().Context())
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	if err := r.usecase.RemoveReceiptAnalyze(houseHoldID, user.ID, uint(receiptID)); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
 // FindByID implements ReceiptAnalyzeHandler.
 func (r *receiptAnalyzeHandler) FindByID(c echo.Context) error {
 	panic("unimplemented")
@@ -112,6 +170,7 @@ type ReceiptAnalyzeHandler interface {
 	CreateReceiptAnalyzeResult(c echo.Context) error
 	CreateReceiptAnalyzeReception(c echo.Context) error
 	FindByID(c echo.Context) error
+	RemoveReceiptAnalyze(c echo.Context) error
 }
 
 func NewReceiptAnalyzeHandler(usecase usecase.ReceiptAnalyzeUsecase) ReceiptAnalyzeHandler {
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -9,6 +10,8 @@ import (
 	"testing"
 
 	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/middleware"
+	apperrors "echo-household-budget/internal/shared/errors"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -38,6 +41,11 @@ func (m *MockReceiptAnalyzeUsecase) FindByID(id domainmodel.HouseHoldID) (*domai
 	return args.Get(0).(*domainmodel.ReceiptAnalyze), args.Error(1)
 }
 
+func (m *MockReceiptAnalyzeUsecase) RemoveReceiptAnalyze(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID, id uint) error {
+	args := m.Called(houseHoldID, operatorID, id)
+	return args.Error(0)
+}
+
 func TestCreateReceiptAnalyzeReception(t *testing.T) {
 	// テストケース
 	tests := []struct {
@@ -146,11 +154,13 @@ func TestCreateReceiptAnalyzeReception(t *testing.T) {
 
 func TestCreateReceiptAnalyzeResult(t *testing.T) {
 	tests := []struct {
-		name           string
-		requestBody    map[string]interface{}
-		mockSetup      func(*MockReceiptAnalyzeUsecase)
-		expectedStatus int
-		skip           bool
+		name            string
+		requestBody     map[string]interface{}
+		mockSetup       func(*MockReceiptAnalyzeUsecase)
+		role            domainmodel.HouseHoldRole
+		expectedStatus  int
+		expectedErrCode apperrors.ErrorCode
+		skip            bool
 	}{
 		{
 			name: "正常系：リクエストのバインディングと処理が成功",
@@ -190,6 +200,16 @@ func TestCreateReceiptAnalyzeResult(t *testing.T) {
 			expectedStatus: http.StatusInternalServerError,
 			skip:           false,
 		},
+		{
+			name: "異常系：閲覧のみのメンバーは分析結果を登録できない",
+			requestBody: map[string]interface{}{
+				"total": 1000,
+			},
+			mockSetup:       func(mockUsecase *MockReceiptAnalyzeUsecase) {},
+			role:            domainmodel.HouseHoldRoleViewer,
+			expectedErrCode: apperrors.ErrorCodeForbidden,
+			skip:            false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -204,10 +224,24 @@ func TestCreateReceiptAnalyzeResult(t *testing.T) {
 			reqBody, _ := json.Marshal(tt.requestBody)
 			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(reqBody))
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			// ServiceTokenMiddleware で家計簿の編集者として認証済みの状態にする
+			role := tt.role
+			if role == "" {
+				role = domainmodel.HouseHoldRoleEditor
+			}
+			ctx := context.WithValue(req.Context(), middleware.HouseHoldKey, domainmodel.HouseHoldID(1))
+			ctx = context.WithValue(ctx, middleware.HouseHoldMemberKey, &domainmodel.UserHouseHold{HouseHoldID: 1, Role: role})
+			req = req.WithContext(ctx)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
-			_ = handler.CreateReceiptAnalyzeResult(c)
-			assert.Equal(t, tt.expectedStatus, rec.Code)
+			err := handler.CreateReceiptAnalyzeResult(c)
+			if tt.expectedErrCode != "" {
+				appErr, ok := apperrors.GetAppError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedErrCode, appErr.Code)
+			} else {
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
 			mockUsecase.AssertExpectations(t)
 		})
 	}
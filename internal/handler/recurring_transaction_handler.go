@@ -0,0 +1,229 @@
+package handler
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	domainservice "echo-household-budget/internal/domain/service"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type (
+	RecurringTransactionRequest struct {
+		CategoryID uint    `json:"categoryID"`
+		Amount     int     `json:"amount"`
+		Memo       string  `json:"memo"`
+		Frequency  string  `json:"frequency"` // monthly, yearly, weekly
+		Interval   int     `json:"interval"`  // 未指定の場合は1
+		DayOfMonth int     `json:"dayOfMonth"`
+		StartDate  string  `json:"startDate"`
+		EndDate    *string `json:"endDate"`
+	}
+
+	AdjustOccurrenceRequest struct {
+		Amount *int    `json:"amount"`
+		Memo   *string `json:"memo"`
+	}
+)
+
+type recurringTransactionHandler struct {
+	service domainservice.RecurringTransactionService
+}
+
+// FetchRecurringTransactions implements RecurringTransactionHandler.
+func (h *recurringTransactionHandler) FetchRecurringTransactions(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	recurringTransactions, err := h.service.FetchRecurringTransactions(houseHoldID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, recurringTransactions)
+}
+
+// CreateRecurringTransaction implements RecurringTransactionHandler.
+func (h *recurringTransactionHandler) CreateRecurringTransaction(c echo.Context) error {
+	req := RecurringTransactionRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	recurringTransaction := req.toRecurringTransaction(houseHoldID)
+	if err := h.service.CreateRecurringTransaction(recurringTransaction); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, recurringTransaction)
+}
+
+// UpdateRecurringTransaction implements RecurringTransactionHandler.
+func (h *recurringTransactionHandler) UpdateRecurringTransaction(c echo.Context) error {
+	req := RecurringTransactionRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	recurringTransactionID, err := strconv.ParseUint(c.Param("recurringTransactionID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	recurringTransaction := req.toRecurringTransaction(houseHoldID)
+	recurringTransaction.ID = domainmodel.RecurringTransactionID(recurringTransactionID)
+	if err := h.service.UpdateRecurringTransaction(recurringTransaction); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// RemoveRecurringTransaction implements RecurringTransactionHandler.
+func (h *recurringTransactionHandler) RemoveRecurringTransaction(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	recurringTransactionID, err := strconv.ParseUint(c.Param("recurringTransactionID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.service.RemoveRecurringTransaction(houseHoldID, domainmodel.RecurringTransactionID(recurringTransactionID)); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// FetchUpcomingOccurrences implements RecurringTransactionHandler.
+func (h *recurringTransactionHandler) FetchUpcomingOccurrences(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	until := time.Now().AddDate(0, 1, 0)
+	if param := c.QueryParam("until"); param != "" {
+		until, err = domainmodel.ParseRecurringDate(param)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, err.Error())
+		}
+	}
+
+	occurrences, err := h.service.FetchUpcomingOccurrences(houseHoldID, until)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, occurrences)
+}
+
+// SkipOccurrence implements RecurringTransactionHandler.
+func (h *recurringTransactionHandler) SkipOccurrence(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	recurringTransactionID, err := strconv.ParseUint(c.Param("recurringTransactionID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.service.SkipOccurrence(houseHoldID, domainmodel.RecurringTransactionID(recurringTransactionID), c.Param("date")); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// AdjustOccurrence implements RecurringTransactionHandler.
+func (h *recurringTransactionHandler) AdjustOccurrence(c echo.Context) error {
+	req := AdjustOccurrenceRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	recurringTransactionID, err := strconv.ParseUint(c.Param("recurringTransactionID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.service.AdjustOccurrence(houseHoldID, domainmodel.RecurringTransactionID(recurringTransactionID), c.Param("date"), req.Amount, req.Memo); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+func (r RecurringTransactionRequest) toRecurringTransaction(houseHoldID domainmodel.HouseHoldID) *domainmodel.RecurringTransaction {
+	interval := r.Interval
+	if interval == 0 {
+		interval = 1
+	}
+	return &domainmodel.RecurringTransaction{
+		HouseHoldID: houseHoldID,
+		CategoryID:  domainmodel.CategoryID(r.CategoryID),
+		Amount:      r.Amount,
+		Memo:        r.Memo,
+		Frequency:   domainmodel.RecurrenceFrequency(r.Frequency),
+		Interval:    interval,
+		DayOfMonth:  r.DayOfMonth,
+		StartDate:   r.StartDate,
+		EndDate:     r.EndDate,
+	}
+}
+
+type RecurringTransactionHandler interface {
+	// 定期取引の定義
+	FetchRecurringTransactions(c echo.Context) error
+	CreateRecurringTransaction(c echo.Context) error
+	UpdateRecurringTransaction(c echo.Context) error
+	RemoveRecurringTransaction(c echo.Context) error
+	// 発生予定
+	FetchUpcomingOccurrences(c echo.Context) error
+	SkipOccurrence(c echo.Context) error
+	AdjustOccurrence(c echo.Context) error
+}
+
+func NewRecurringTransactionHandler(service domainservice.RecurringTransactionService) RecurringTransactionHandler {
+	return &recurringTransactionHandler{service: service}
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	domainservice "echo-household-budget/internal/domain/service"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type reportHandler struct {
+	service         domainservice.ReportService
+	forecastService domainservice.ForecastService
+}
+
+// FetchTrendReport implements ReportHandler.
+// 期間を指定しない場合は今月までの12か月とする。カテゴリはカンマ区切りで複数指定できる
+func (h *reportHandler) FetchTrendReport(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	condition := domainmodel.NewTrendCondition(houseHoldID, time.Now())
+	if from := c.QueryParam("from"); from != "" {
+		condition.From = from
+	}
+	if to := c.QueryParam("to"); to != "" {
+		condition.To = to
+	}
+	if param := c.QueryParam("categoryIDs"); param != "" {
+		for _, v := range strings.Split(param, ",") {
+			categoryID, err := strconv.ParseUint(strings.TrimSpace(v), 10, 32)
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, err.Error())
+			}
+			condition.CategoryIDs = append(condition.CategoryIDs, domainmodel.CategoryID(categoryID))
+		}
+	}
+	if param := c.QueryParam("window"); param != "" {
+		window, err := strconv.Atoi(param)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, err.Error())
+		}
+		condition.Window = window
+	}
+
+	report, err := h.service.FetchTrendReport(condition)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, report)
+}
+
+// FetchForecast implements ReportHandler.
+// 基準日を指定しない場合は今日とする
+func (h *reportHandler) FetchForecast(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	asOf := time.Now()
+	if date := c.QueryParam("date"); date != "" {
+		asOf, err = time.ParseInLocation("2006-01-02", date, time.Local)
+		if err != nil {
+			return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrInvalidForecastDate.Error(), err)
+		}
+	}
+
+	forecast, err := h.forecastService.ForecastMonthEnd(houseHoldID, asOf)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, forecast)
+}
+
+type ReportHandler interface {
+	FetchTrendReport(c echo.Context) error
+	FetchForecast(c echo.Context) error
+}
+
+func NewReportHandler(service domainservice.ReportService, forecastService domainservice.ForecastService) ReportHandler {
+	return &reportHandler{service: service, forecastService: forecastService}
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	domainservice "echo-household-budget/internal/domain/service"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type RecordSettlementRequest struct {
+	FromUserID uint   `json:"fromUserID"`
+	ToUserID   uint   `json:"toUserID"`
+	Amount     int    `json:"amount"`
+	Date       string `json:"date"`
+	Memo       string `json:"memo"`
+}
+
+type settlementHandler struct {
+	service domainservice.SettlementService
+}
+
+// FetchSettleUpReport implements SettlementHandler.
+// 期間を指定しない場合は今月の初日から末日までとする
+func (h *settlementHandler) FetchSettleUpReport(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	from, to := queryPeriod(c)
+	report, err := h.service.FetchSettleUpReport(houseHoldID, from, to)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, report)
+}
+
+// queryPeriod はクエリパラメータの期間を返す。指定がない場合は今月の初日・末日とする
+func queryPeriod(c echo.Context) (string, string) {
+	now := time.Now()
+	firstDay := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
+	from := c.QueryParam("from")
+	if from == "" {
+		from = firstDay.Format("2006-01-02")
+	}
+	to := c.QueryParam("to")
+	if to == "" {
+		to = firstDay.AddDate(0, 1, -1).Format("2006-01-02")
+	}
+	return from, to
+}
+
+// RecordSettlement implements SettlementHandler.
+func (h *settlementHandler) RecordSettlement(c echo.Context) error {
+	req := RecordSettlementRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	settlement := &domainmodel.Settlement{
+		HouseHoldID: houseHoldID,
+		FromUserID:  domainmodel.UserID(req.FromUserID),
+		ToUserID:    domainmodel.UserID(req.ToUserID),
+		Amount:      req.Amount,
+		Date:        req.Date,
+		Memo:        req.Memo,
+	}
+	if err := h.service.RecordSettlement(settlement); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, settlement)
+}
+
+// RemoveSettlement implements SettlementHandler.
+func (h *settlementHandler) RemoveSettlement(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	settlementID, err := strconv.ParseUint(c.Param("settlementID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.service.RemoveSettlement(houseHoldID, domainmodel.SettlementID(settlementID)); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+type SettlementHandler interface {
+	FetchSettleUpReport(c echo.Context) error
+	RecordSettlement(c echo.Context) error
+	RemoveSettlement(c echo.Context) error
+}
+
+func NewSettlementHandler(service domainservice.SettlementService) SettlementHandler {
+	return &settlementHandler{service: service}
+}
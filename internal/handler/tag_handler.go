@@ -0,0 +1,241 @@
+package handler
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	domainservice "echo-household-budget/internal/domain/service"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TagRequest struct {
+	Name string `json:"name"`
+}
+
+type MergeTagRequest struct {
+	// TargetTagID は統合先のタグ
+	TargetTagID uint `json:"targetTagID"`
+}
+
+type TagReceiptItemRequest struct {
+	TagIDs []uint `json:"tagIDs"`
+}
+
+type tagHandler struct {
+	service domainservice.TagService
+}
+
+// FetchTags implements TagHandler.
+func (h *tagHandler) FetchTags(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	tags, err := h.service.FetchTags(houseHoldID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, tags)
+}
+
+// AddTag implements TagHandler.
+func (h *tagHandler) AddTag(c echo.Context) error {
+	req := TagRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	tag := domainmodel.NewTag(houseHoldID, req.Name)
+	if err := h.service.AddTag(tag); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, tag)
+}
+
+// RenameTag implements TagHandler.
+func (h *tagHandler) RenameTag(c echo.Context) error {
+	req := TagRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	tagID, err := strconv.ParseUint(c.Param("tagID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	tag := domainmodel.NewTag(houseHoldID, req.Name)
+	tag.ID = domainmodel.TagID(tagID)
+	if err := h.service.RenameTag(tag); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, tag)
+}
+
+// MergeTag implements TagHandler.
+func (h *tagHandler) MergeTag(c echo.Context) error {
+	req := MergeTagRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	tagID, err := strconv.ParseUint(c.Param("tagID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.service.MergeTags(houseHoldID, domainmodel.TagID(tagID), domainmodel.TagID(req.TargetTagID)); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// RemoveTag implements TagHandler.
+func (h *tagHandler) RemoveTag(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	tagID, err := strconv.ParseUint(c.Param("tagID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.service.RemoveTag(houseHoldID, domainmodel.TagID(tagID)); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// TagReceiptItem implements TagHandler.
+func (h *tagHandler) TagReceiptItem(c echo.Context) error {
+	req := TagReceiptItemRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	receiptItemID, err := strconv.ParseUint(c.Param("receiptItemID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.service.TagReceiptItem(houseHoldID, uint(receiptItemID), toTagIDs(req.TagIDs)); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// FetchTaggedShoppingRecords implements TagHandler.
+// 期間を指定しない場合は今月の初日から末日までとする
+func (h *tagHandler) FetchTaggedShoppingRecords(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	tagID, err := strconv.ParseUint(c.Param("tagID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	from, to := queryPeriod(c)
+	shoppingAmounts, err := h.service.FetchTaggedShoppingAmounts(houseHoldID, domainmodel.TagID(tagID), from, to)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, shoppingAmounts)
+}
+
+// SummarizeByTag implements TagHandler.
+// 期間を指定しない場合は今月の初日から末日までとする
+func (h *tagHandler) SummarizeByTag(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	from, to := queryPeriod(c)
+	summary, err := h.service.SummarizeByTag(houseHoldID, from, to)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, summary)
+}
+
+// toTagIDs はリクエストのタグを変換する
+func toTagIDs(tagIDs []uint) []domainmodel.TagID {
+	ids := make([]domainmodel.TagID, len(tagIDs))
+	for i, tagID := range tagIDs {
+		ids[i] = domainmodel.TagID(tagID)
+	}
+	return ids
+}
+
+type TagHandler interface {
+	FetchTags(c echo.Context) error
+	AddTag(c echo.Context) error
+	RenameTag(c echo.Context) error
+	MergeTag(c echo.Context) error
+	RemoveTag(c echo.Context) error
+	TagReceiptItem(c echo.Context) error
+	// 集計
+	FetchTaggedShoppingRecords(c echo.Context) error
+	SummarizeByTag(c echo.Context) error
+}
+
+func NewTagHandler(service domainservice.TagService) TagHandler {
+	return &tagHandler{service: service}
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	domainservice "echo-household-budget/internal/domain/service"
+	"echo-household-budget/internal/infrastructure/middleware"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type trashHandler struct {
+	service domainservice.TrashService
+}
+
+// FetchTrashItems implements TrashHandler.
+func (h *trashHandler) FetchTrashItems(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionView)
+	if err != nil {
+		return err
+	}
+
+	items, err := h.service.FetchTrashItems(houseHoldID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, items)
+}
+
+// RestoreTrashItem implements TrashHandler.
+func (h *trashHandler) RestoreTrashItem(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	itemID, err := strconv.ParseUint(c.Param("itemID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.service.RestoreTrashItem(houseHoldID, c.Param("itemType"), uint(itemID)); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, "success")
+}
+
+// UndoLastDelete implements TrashHandler.
+func (h *trashHandler) UndoLastDelete(c echo.Context) error {
+	houseHoldID, err := authorizeHouseHold(c, domainmodel.HouseHoldPermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	user, ok := middleware.GetUserFromContext(c.Request().Context())
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	restored, err := h.service.UndoLastDelete(houseHoldID, user.ID)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		return c.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, restored)
+}
+
+type TrashHandler interface {
+	FetchTrashItems(c echo.Context) error
+	RestoreTrashItem(c echo.Context) error
+	UndoLastDelete(c echo.Context) error
+}
+
+func NewTrashHandler(service domainservice.TrashService) TrashHandler {
+	return &trashHandler{service: service}
+}
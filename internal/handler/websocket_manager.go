@@ -9,8 +9,9 @@ import (
 )
 
 // WebSocketManager WebSocket接続を管理する構造体
+// clients の値は接続が紐づく家計簿ID。メッセージは接続した家計簿のクライアントにのみ送信する
 type WebSocketManager struct {
-	clients map[*websocket.Conn]bool
+	clients map[*websocket.Conn]int
 	mutex   sync.RWMutex
 }
 
@@ -23,7 +24,7 @@ var (
 func GetWebSocketManager() *WebSocketManager {
 	wsManagerOnce.Do(func() {
 		wsManagerInstance = &WebSocketManager{
-			clients: make(map[*websocket.Conn]bool),
+			clients: make(map[*websocket.Conn]int),
 		}
 	})
 	return wsManagerInstance
@@ -34,11 +35,11 @@ func NewWebSocketManager() *WebSocketManager {
 	return GetWebSocketManager()
 }
 
-// AddClient クライアントを追加
-func (wm *WebSocketManager) AddClient(conn *websocket.Conn) {
+// AddHouseHoldClient 家計簿に紐づくクライアントを追加
+func (wm *WebSocketManager) AddHouseHoldClient(conn *websocket.Conn, householdID int) {
 	wm.mutex.Lock()
 	defer wm.mutex.Unlock()
-	wm.clients[conn] = true
+	wm.clients[conn] = householdID
 	log.Printf("クライアントが追加されました。現在の接続数: %d", len(wm.clients))
 }
 
@@ -51,20 +52,15 @@ func (wm *WebSocketManager) RemoveClient(conn *websocket.Conn) {
 	log.Printf("クライアントが削除されました。現在の接続数: %d", len(wm.clients))
 }
 
-// BroadcastToAll 全クライアントにメッセージをブロードキャスト
-func (wm *WebSocketManager) BroadcastToAll(message []byte) {
+// BroadcastToHouseHold 指定した家計簿に紐づくクライアントにのみメッセージをブロードキャスト
+func (wm *WebSocketManager) BroadcastToHouseHold(householdID int, message []byte) {
 	wm.mutex.RLock()
 	defer wm.mutex.RUnlock()
 
-	clientCount := len(wm.clients)
-	if clientCount == 0 {
-		log.Println("ブロードキャスト: 接続中のクライアントがありません")
-		return
-	}
-
-	log.Printf("ブロードキャスト: %d個のクライアントにメッセージを送信", clientCount)
-
-	for client := range wm.clients {
+	for client, clientHouseholdID := range wm.clients {
+		if clientHouseholdID != householdID {
+			continue
+		}
 		if err := client.WriteMessage(websocket.TextMessage, message); err != nil {
 			log.Printf("ブロードキャストエラー: %v", err)
 			// エラーが発生したクライアントを削除
@@ -56,6 +56,10 @@ func getHTTPStatus(code errors.ErrorCode) int {
 		return http.StatusNotFound
 	case errors.ErrorCodeUnauthorized:
 		return http.StatusUnauthorized
+	case errors.ErrorCodeForbidden:
+		return http.StatusForbidden
+	case errors.ErrorCodeConflict:
+		return http.StatusConflict
 	case errors.ErrorCodeDatabaseError:
 		return http.StatusInternalServerError
 	case errors.ErrorCodeExternalService:
@@ -129,6 +129,16 @@ func TestGetHTTPStatus(t *testing.T) {
 			code:     apperrors.ErrorCodeUnauthorized,
 			expected: http.StatusUnauthorized,
 		},
+		{
+			name:     "Forbidden",
+			code:     apperrors.ErrorCodeForbidden,
+			expected: http.StatusForbidden,
+		},
+		{
+			name:     "Conflict",
+			code:     apperrors.ErrorCodeConflict,
+			expected: http.StatusConflict,
+		},
 		{
 			name:     "DatabaseError",
 			code:     apperrors.ErrorCodeDatabaseError,
@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+
+	"github.com/labstack/echo/v4"
+)
+
+// HouseHoldContextKey はコンテキストに家計簿IDを格納する際のキー
+type HouseHoldContextKey string
+
+const (
+	// HouseHoldKey はコンテキストに家計簿IDを格納する際のキー
+	HouseHoldKey HouseHoldContextKey = "household"
+	// HouseHoldMemberKey はコンテキストに家計簿の所属情報（ロールを含む）を格納する際のキー
+	HouseHoldMemberKey HouseHoldContextKey = "household_member"
+)
+
+// HouseHoldMemberMiddleware はパスまたはクエリの householdID を解決し、
+// ログインユーザーがその家計簿に所属しているかを user_households で検証するミドルウェア
+// AuthMiddleware の後に適用すること
+func HouseHoldMemberMiddleware(houseHoldRepository domainmodel.HouseHoldRepository) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			householdID := c.Param("householdID")
+			if householdID == "" {
+				householdID = c.QueryParam("householdID")
+			}
+			// 家計簿を対象としないエンドポイントはそのまま通す
+			if householdID == "" {
+				return next(c)
+			}
+
+			householdIDUint, err := strconv.ParseUint(householdID, 10, 32)
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, map[string]string{
+					"error": "invalid householdID format",
+				})
+			}
+
+			user, ok := GetUserFromContext(c.Request().Context())
+			if !ok {
+				return c.JSON(http.StatusUnauthorized, map[string]string{
+					"error": "middleware not logged in",
+				})
+			}
+
+			userHouseHold, err := houseHoldRepository.FindUserHouseHold(user.ID, domainmodel.HouseHoldID(householdIDUint))
+			if err != nil {
+				return c.JSON(http.StatusInternalServerError, map[string]string{
+					"error": err.Error(),
+				})
+			}
+			if userHouseHold == nil {
+				return c.JSON(http.StatusForbidden, map[string]string{
+					"error": "middleware household access denied",
+				})
+			}
+
+			// コンテキストに検証済みの家計簿IDと所属情報を格納
+			ctx := context.WithValue(c.Request().Context(), HouseHoldKey, userHouseHold.HouseHoldID)
+			ctx = context.WithValue(ctx, HouseHoldMemberKey, userHouseHold)
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
+
+// GetHouseHoldIDFromContext はコンテキストから検証済みの家計簿IDを取得するヘルパー関数
+func GetHouseHoldIDFromContext(ctx context.Context) (domainmodel.HouseHoldID, bool) {
+	houseHoldID, ok := ctx.Value(HouseHoldKey).(domainmodel.HouseHoldID)
+	return houseHoldID, ok
+}
+
+// GetHouseHoldMemberFromContext はコンテキストからログインユーザーの家計簿の所属情報を取得するヘルパー関数
+func GetHouseHoldMemberFromContext(ctx context.Context) (*domainmodel.UserHouseHold, bool) {
+	member, ok := ctx.Value(HouseHoldMemberKey).(*domainmodel.UserHouseHold)
+	return member, ok
+}
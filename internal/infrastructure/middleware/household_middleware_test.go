@@ -0,0 +1,125 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mock "echo-household-budget/internal/domain/mock/domainmodel"
+	domainmodel "echo-household-budget/internal/domain/model"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHouseHoldMemberMiddleware(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name            string
+		paramID         string
+		queryID         string
+		mockSetup       func(*mock.MockHouseHoldRepository)
+		expectedStatus  int
+		expectedNext    bool
+		expectedHouseID domainmodel.HouseHoldID
+		expectedRole    domainmodel.HouseHoldRole
+	}{
+		{
+			name:    "正常系：パスの家計簿に所属している",
+			paramID: "10",
+			mockSetup: func(m *mock.MockHouseHoldRepository) {
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(10)).
+					Return(&domainmodel.UserHouseHold{UserID: 1, HouseHoldID: 10, Role: domainmodel.HouseHoldRoleOwner}, nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedNext:    true,
+			expectedHouseID: 10,
+			expectedRole:    domainmodel.HouseHoldRoleOwner,
+		},
+		{
+			name:    "正常系：クエリの家計簿に所属している",
+			queryID: "20",
+			mockSetup: func(m *mock.MockHouseHoldRepository) {
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(20)).
+					Return(&domainmodel.UserHouseHold{UserID: 1, HouseHoldID: 20, Role: domainmodel.HouseHoldRoleViewer}, nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedNext:    true,
+			expectedHouseID: 20,
+			expectedRole:    domainmodel.HouseHoldRoleViewer,
+		},
+		{
+			name:           "正常系：家計簿を対象としないリクエストはそのまま通す",
+			mockSetup:      func(m *mock.MockHouseHoldRepository) {},
+			expectedStatus: http.StatusOK,
+			expectedNext:   true,
+		},
+		{
+			name:    "異常系：家計簿に所属していない",
+			paramID: "30",
+			mockSetup: func(m *mock.MockHouseHoldRepository) {
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(30)).Return(nil, nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "異常系：householdIDの形式が不正",
+			paramID:        "invalid",
+			mockSetup:      func(m *mock.MockHouseHoldRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "異常系：リポジトリでエラー",
+			paramID: "10",
+			mockSetup: func(m *mock.MockHouseHoldRepository) {
+				m.EXPECT().FindUserHouseHold(domainmodel.UserID(1), domainmodel.HouseHoldID(10)).Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockHouseHoldRepository(ctrl)
+			tt.mockSetup(mockRepo)
+
+			e := echo.New()
+			target := "/"
+			if tt.queryID != "" {
+				target = "/?householdID=" + tt.queryID
+			}
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			req = req.WithContext(context.WithValue(req.Context(), UserKey, &domainmodel.UserAccount{ID: 1}))
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			if tt.paramID != "" {
+				c.SetParamNames("householdID")
+				c.SetParamValues(tt.paramID)
+			}
+
+			called := false
+			var houseHoldID domainmodel.HouseHoldID
+			var role domainmodel.HouseHoldRole
+			next := func(c echo.Context) error {
+				called = true
+				houseHoldID, _ = GetHouseHoldIDFromContext(c.Request().Context())
+				if member, ok := GetHouseHoldMemberFromContext(c.Request().Context()); ok {
+					role = member.Role
+				}
+				return c.NoContent(http.StatusOK)
+			}
+
+			err := HouseHoldMemberMiddleware(mockRepo)(next)(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectedNext, called)
+			assert.Equal(t, tt.expectedHouseID, houseHoldID)
+			assert.Equal(t, tt.expectedRole, role)
+		})
+	}
+}
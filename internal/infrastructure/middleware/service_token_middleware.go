@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"strconv"
+	"strings"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ServiceTokenMiddleware は LINE のセッションを持たないサービス（レシート分析のワーカーなど）からのリクエストを
+// Authorization ヘッダーの Bearer トークンで検証し、パスの家計簿を編集できる所属情報をコンテキストに格納するミドルウェア
+// token が空の場合はすべてのリクエストを拒否する
+func ServiceTokenMiddleware(token string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			bearer, found := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+			if token == "" || !found || subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) != 1 {
+				return c.JSON(http.StatusUnauthorized, map[string]string{
+					"error": "middleware service token invalid",
+				})
+			}
+
+			householdIDUint, err := strconv.ParseUint(c.Param("householdID"), 10, 32)
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, map[string]string{
+					"error": "invalid householdID format",
+				})
+			}
+
+			// サービスはユーザーではないため、編集者として家計簿に所属しているものとして扱う
+			member := &domainmodel.UserHouseHold{
+				HouseHoldID: domainmodel.HouseHoldID(householdIDUint),
+				Role:        domainmodel.HouseHoldRoleEditor,
+			}
+			ctx := context.WithValue(c.Request().Context(), HouseHoldKey, member.HouseHoldID)
+			ctx = context.WithValue(ctx, HouseHoldMemberKey, member)
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
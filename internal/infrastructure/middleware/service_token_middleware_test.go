@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceTokenMiddleware(t *testing.T) {
+	tests := []struct {
+		name            string
+		token           string
+		authorization   string
+		paramID         string
+		expectedStatus  int
+		expectedNext    bool
+		expectedHouseID domainmodel.HouseHoldID
+	}{
+		{
+			name:            "正常系：トークンが一致する",
+			token:           "secret",
+			authorization:   "Bearer secret",
+			paramID:         "10",
+			expectedStatus:  http.StatusOK,
+			expectedNext:    true,
+			expectedHouseID: 10,
+		},
+		{
+			name:           "異常系：トークンが一致しない",
+			token:          "secret",
+			authorization:  "Bearer other",
+			paramID:        "10",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "異常系：Authorization ヘッダーがない",
+			token:          "secret",
+			paramID:        "10",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "異常系：トークンを設定していない場合はすべて拒否する",
+			token:          "",
+			authorization:  "Bearer ",
+			paramID:        "10",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "異常系：householdIDの形式が不正",
+			token:          "secret",
+			authorization:  "Bearer secret",
+			paramID:        "invalid",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tt.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("householdID")
+			c.SetParamValues(tt.paramID)
+
+			called := false
+			var houseHoldID domainmodel.HouseHoldID
+			var role domainmodel.HouseHoldRole
+			next := func(c echo.Context) error {
+				called = true
+				houseHoldID, _ = GetHouseHoldIDFromContext(c.Request().Context())
+				if member, ok := GetHouseHoldMemberFromContext(c.Request().Context()); ok {
+					role = member.Role
+				}
+				return c.NoContent(http.StatusOK)
+			}
+
+			err := ServiceTokenMiddleware(tt.token)(next)(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectedNext, called)
+			assert.Equal(t, tt.expectedHouseID, houseHoldID)
+			if tt.expectedNext {
+				assert.Equal(t, domainmodel.HouseHoldRoleEditor, role)
+			}
+		})
+	}
+}
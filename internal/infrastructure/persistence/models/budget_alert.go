@@ -0,0 +1,14 @@
+package models
+
+// BudgetAlert は予算アラートモデル
+type BudgetAlert struct {
+	Base
+	HouseholdBookID uint   `gorm:"not null;uniqueIndex:idx_budget_alerts_household_category_month_threshold"`
+	CategoryID      uint   `gorm:"not null;uniqueIndex:idx_budget_alerts_household_category_month_threshold"`
+	Month           string `gorm:"type:char(7);not null;uniqueIndex:idx_budget_alerts_household_category_month_threshold"`
+	Threshold       int    `gorm:"not null;uniqueIndex:idx_budget_alerts_household_category_month_threshold"`
+	Budget          int    `gorm:"not null"`
+	Actual          int    `gorm:"not null"`
+}
+
+func (BudgetAlert) TableName() string { return "budget_alerts" }
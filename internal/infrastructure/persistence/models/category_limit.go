@@ -1,13 +1,27 @@
 package models
 
-// CategoryLimit はカテゴリ予算モデル
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CategoryLimit はカテゴリ予算モデル（家計簿ごとのカテゴリ設定を兼ねる）
 type CategoryLimit struct {
 	Base
-	HouseholdBookID uint          `gorm:"not null"`
-	CategoryID      uint          `gorm:"not null"`
-	LimitAmount     int           `gorm:"not null"`
-	HouseholdBook   HouseholdBook `gorm:"foreignKey:HouseholdBookID"`
-	Category        Category      `gorm:"foreignKey:CategoryID"`
+	HouseholdBookID uint   `gorm:"not null"`
+	CategoryID      uint   `gorm:"not null"`
+	LimitAmount     int    `gorm:"not null"`
+	Name            string `gorm:"type:varchar(255);not null"`
+	Color           string `gorm:"type:varchar(7);not null"`
+	Icon            string `gorm:"type:varchar(64);not null"`
+	SortOrder       int    `gorm:"not null"`
+	ArchivedAt      *time.Time
+	DeletedAt       gorm.DeletedAt `gorm:"index"`
+	DeletedBy       *uint          `gorm:"<-:update"`
+	DeleteBatchID   *string        `gorm:"<-:update;type:varchar(36);index"`
+	HouseholdBook   HouseholdBook  `gorm:"foreignKey:HouseholdBookID"`
+	Category        Category       `gorm:"foreignKey:CategoryID"`
 }
 
 func (CategoryLimit) TableName() string { return "category_limits" }
@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// ExchangeRate は家計簿の基準通貨への換算レートモデル
+type ExchangeRate struct {
+	Base
+	HouseholdBookID uint      `gorm:"not null;uniqueIndex:idx_exchange_rates_household_currency_date"`
+	Currency        string    `gorm:"type:varchar(3);not null;uniqueIndex:idx_exchange_rates_household_currency_date"`
+	Rate            string    `gorm:"type:numeric(20,10);not null"`
+	EffectiveDate   time.Time `gorm:"type:date;not null;uniqueIndex:idx_exchange_rates_household_currency_date"`
+}
+
+func (ExchangeRate) TableName() string { return "exchange_rates" }
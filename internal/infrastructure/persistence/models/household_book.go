@@ -5,6 +5,7 @@ type HouseholdBook struct {
 	Base
 	Title          string          `gorm:"type:varchar(255);not null"`
 	Description    string          `gorm:"type:text"`
+	BaseCurrency   string          `gorm:"type:varchar(3);not null;default:JPY"`
 	CategoryLimits []CategoryLimit `gorm:"foreignKey:HouseholdBookID"`
 	Users          []UserAccount   `gorm:"many2many:user_households;foreignKey:ID;joinForeignKey:HouseholdID;References:ID;joinReferences:UserID"`
 }
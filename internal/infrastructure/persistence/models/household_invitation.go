@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// HouseholdInvitation は家計簿招待モデル
+type HouseholdInvitation struct {
+	Base
+	HouseholdID uint       `gorm:"not null;index"`
+	Code        string     `gorm:"type:varchar(64);not null;uniqueIndex"`
+	CreatedBy   uint       `gorm:"not null"`
+	MaxUses     int        `gorm:"not null"` // 0は無制限。既定値を指定すると 0 が既定値に置き換わるため指定しない
+	UsedCount   int        `gorm:"not null;default:0"`
+	ExpiresAt   time.Time  `gorm:"not null"`
+	RevokedAt   *time.Time `gorm:"default:null"`
+}
+
+func (HouseholdInvitation) TableName() string { return "household_invitations" }
+
+// HouseholdInvitationResponse は招待への応答モデル
+type HouseholdInvitationResponse struct {
+	Base
+	InvitationID uint   `gorm:"not null"`
+	UserID       uint   `gorm:"not null"`
+	Status       string `gorm:"type:varchar(16);not null"`
+}
+
+func (HouseholdInvitationResponse) TableName() string { return "household_invitation_responses" }
@@ -0,0 +1,13 @@
+package models
+
+// ImportPreset は CSV の取り込みのプリセットモデル
+type ImportPreset struct {
+	Base
+	HouseholdBookID uint   `gorm:"not null;uniqueIndex:idx_import_presets_household_book_id_name"`
+	Name            string `gorm:"type:varchar(50);not null;uniqueIndex:idx_import_presets_household_book_id_name"`
+	// Mapping, CategoryMappings は列の対応とカテゴリの対応の JSON
+	Mapping          string `gorm:"type:jsonb;not null"`
+	CategoryMappings string `gorm:"type:jsonb;not null;default:'{}'"`
+}
+
+func (ImportPreset) TableName() string { return "import_presets" }
@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// IncomeCategory は収入カテゴリモデル
+type IncomeCategory struct {
+	Base
+	HouseholdBookID uint   `gorm:"not null;index"`
+	Name            string `gorm:"type:varchar(255);not null"`
+	Color           string `gorm:"type:varchar(7);not null"`
+	SortOrder       int    `gorm:"not null"`
+}
+
+func (IncomeCategory) TableName() string { return "income_categories" }
+
+// Income は収入モデル
+type Income struct {
+	Base
+	HouseholdBookID  uint      `gorm:"not null;index"`
+	IncomeCategoryID uint      `gorm:"not null;index"`
+	UserID           *uint     `gorm:"default:null"`
+	Amount           int       `gorm:"not null"`
+	Date             time.Time `gorm:"not null"`
+	Memo             string    `gorm:"type:text"`
+	PaymentMethodID  *uint     `gorm:"default:null"`
+	IncomeCategory   IncomeCategory
+	PaymentMethod    *PaymentMethod
+}
+
+func (Income) TableName() string { return "incomes" }
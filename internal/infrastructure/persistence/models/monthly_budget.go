@@ -0,0 +1,13 @@
+package models
+
+// MonthlyBudget は家計簿・カテゴリ・月ごとの予算モデル
+type MonthlyBudget struct {
+	Base
+	HouseholdBookID uint   `gorm:"not null;uniqueIndex:idx_monthly_budgets_household_category_month"`
+	CategoryID      uint   `gorm:"not null;uniqueIndex:idx_monthly_budgets_household_category_month"`
+	Month           string `gorm:"type:char(7);not null;uniqueIndex:idx_monthly_budgets_household_category_month"`
+	Amount          int    `gorm:"not null;default:0"`
+	Rollover        bool   `gorm:"not null;default:false"`
+}
+
+func (MonthlyBudget) TableName() string { return "monthly_budgets" }
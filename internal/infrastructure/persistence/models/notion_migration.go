@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// KaimemoUserMapping は Notion の買い物メモの tempUserID と移行先のユーザー・家計簿の対応モデル
+type KaimemoUserMapping struct {
+	TempUserID      string `gorm:"type:varchar(255);primaryKey"`
+	UserID          uint   `gorm:"not null"`
+	HouseholdBookID uint   `gorm:"not null"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (KaimemoUserMapping) TableName() string { return "kaimemo_user_mappings" }
+
+// NotionMigratedPage は Postgres に移行した Notion のページモデル
+type NotionMigratedPage struct {
+	NotionPageID     string `gorm:"type:varchar(64);primaryKey"`
+	Source           string `gorm:"type:varchar(16);not null"`
+	HouseholdBookID  uint   `gorm:"not null;index"`
+	ShoppingMemoID   *uint
+	ShoppingAmountID *uint
+	CreatedAt        time.Time
+}
+
+func (NotionMigratedPage) TableName() string { return "notion_migrated_pages" }
@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+// NotionSyncSetting は家計簿の支出を Notion のデータベースと同期する設定モデル
+type NotionSyncSetting struct {
+	HouseholdBookID uint   `gorm:"primaryKey;autoIncrement:false"`
+	DatabaseID      string `gorm:"type:varchar(64);not null"`
+	ConflictPolicy  string `gorm:"type:varchar(16);not null;default:latest"`
+	Enabled         bool   `gorm:"not null;default:false"`
+	LastSyncedAt    *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (NotionSyncSetting) TableName() string { return "notion_sync_settings" }
+
+// NotionSyncLink は同期した支出と Notion のページの対応モデル
+type NotionSyncLink struct {
+	ID               uint      `gorm:"primarykey"`
+	HouseholdBookID  uint      `gorm:"not null;index"`
+	ShoppingAmountID uint      `gorm:"not null;uniqueIndex"`
+	NotionPageID     string    `gorm:"type:varchar(64);not null;uniqueIndex"`
+	SnapshotDate     time.Time `gorm:"type:date;not null"`
+	SnapshotAmount   int       `gorm:"not null"`
+	SnapshotTag      string    `gorm:"type:varchar(255);not null"`
+	SnapshotMemo     string    `gorm:"type:text;not null;default:''"`
+	SyncedAt         time.Time
+}
+
+func (NotionSyncLink) TableName() string { return "notion_sync_links" }
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// PaymentMethod は支払い方法・口座モデル
+type PaymentMethod struct {
+	Base
+	HouseholdBookID     uint       `gorm:"not null;index"`
+	Name                string     `gorm:"type:varchar(255);not null"`
+	Type                string     `gorm:"type:varchar(16);not null"`
+	OpeningBalance      int        `gorm:"not null;default:0"`
+	OpeningDate         time.Time  `gorm:"type:date;not null"`
+	ClosingDay          *int       `gorm:"default:null"`
+	PaymentDay          *int       `gorm:"default:null"`
+	WithdrawalAccountID *uint      `gorm:"default:null"`
+	ArchivedAt          *time.Time `gorm:"default:null"`
+}
+
+func (PaymentMethod) TableName() string { return "payment_methods" }
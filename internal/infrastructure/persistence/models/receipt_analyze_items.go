@@ -6,6 +6,7 @@ type ReceiptAnalyzeItems struct {
 	ReceiptAnalyze   ReceiptAnalyzes `gorm:"foreignKey:ReceiptAnalyzeID"`
 	Name             string          `gorm:"not null"`
 	Price            int             `gorm:"not null"`
+	Tags             []Tag           `gorm:"many2many:receipt_analyze_item_tags;joinForeignKey:ReceiptAnalyzeItemID"`
 }
 
 func (ReceiptAnalyzeItems) TableName() string {
@@ -1,11 +1,17 @@
 package models
 
+import "gorm.io/gorm"
+
 type ReceiptAnalyzes struct {
 	ID              int                   `gorm:"primary_key"`
 	ImageURL        string                `gorm:"not null"`
 	AnalyzeStatus   string                `gorm:"not null"`
 	TotalPrice      int                   `gorm:"not null"`
+	Currency        *string               `gorm:"type:varchar(3);default:null"`
 	HouseholdBookID int                   `gorm:"not null"`
+	DeletedAt       gorm.DeletedAt        `gorm:"index"`
+	DeletedBy       *uint                 `gorm:"<-:update"`
+	DeleteBatchID   *string               `gorm:"<-:update;type:varchar(36);index"`
 	HouseholdBook   HouseholdBook         `gorm:"foreignKey:HouseholdBookID"`
 	Items           []ReceiptAnalyzeItems `gorm:"foreignKey:ReceiptAnalyzeID;references:ID"`
 }
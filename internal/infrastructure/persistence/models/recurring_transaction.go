@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// RecurringTransaction は定期取引モデル
+type RecurringTransaction struct {
+	Base
+	HouseholdBookID   uint       `gorm:"not null;index"`
+	CategoryID        uint       `gorm:"not null"`
+	Amount            int        `gorm:"not null"`
+	Memo              string     `gorm:"type:text"`
+	Frequency         string     `gorm:"type:varchar(16);not null"`
+	Interval          int        `gorm:"not null;default:1"`
+	DayOfMonth        int        `gorm:"not null;default:0"`
+	StartDate         time.Time  `gorm:"type:date;not null"`
+	EndDate           *time.Time `gorm:"type:date"`
+	MaterializedUntil *time.Time `gorm:"type:date"`
+}
+
+func (RecurringTransaction) TableName() string { return "recurring_transactions" }
+
+// RecurringOccurrenceOverride は定期取引の発生日ごとのスキップ・変更モデル
+type RecurringOccurrenceOverride struct {
+	Base
+	RecurringTransactionID uint      `gorm:"not null;uniqueIndex:idx_recurring_occurrence_overrides_transaction_date"`
+	Date                   time.Time `gorm:"type:date;not null;uniqueIndex:idx_recurring_occurrence_overrides_transaction_date"`
+	Skipped                bool      `gorm:"not null;default:false"`
+	Amount                 *int
+	Memo                   *string `gorm:"type:text"`
+}
+
+func (RecurringOccurrenceOverride) TableName() string { return "recurring_occurrence_overrides" }
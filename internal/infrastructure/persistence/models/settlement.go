@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// Settlement はメンバー間の精算の支払いモデル
+type Settlement struct {
+	Base
+	HouseholdBookID uint      `gorm:"not null;index"`
+	FromUserID      uint      `gorm:"not null"`
+	ToUserID        uint      `gorm:"not null"`
+	Amount          int       `gorm:"not null"`
+	Date            time.Time `gorm:"type:date;not null"`
+	Memo            string    `gorm:"type:text"`
+}
+
+func (Settlement) TableName() string { return "settlements" }
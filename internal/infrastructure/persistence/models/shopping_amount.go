@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 // ShoppingAmount は買い物金額モデル
 type ShoppingAmount struct {
@@ -11,9 +15,35 @@ type ShoppingAmount struct {
 	Date            time.Time `gorm:"not null"`
 	Memo            string    `gorm:"type:text"`
 	AnalyzeID       int       `gorm:"default:0"`
-	Analyze         *ReceiptAnalyzes
-	HouseholdBook   HouseholdBook
-	Category        Category
+	PaidBy          *uint     `gorm:"default:null"`
+	SplitType       string    `gorm:"type:varchar(16);not null;default:equal"`
+	PaymentMethodID *uint     `gorm:"default:null"`
+	// OriginalAmount, OriginalCurrency, ExchangeRate は基準通貨以外で記録した場合の金額と換算レート
+	OriginalAmount   *int64  `gorm:"default:null"`
+	OriginalCurrency *string `gorm:"type:varchar(3);default:null"`
+	ExchangeRate     *string `gorm:"type:numeric(20,10);default:null"`
+	// DeletedAt はゴミ箱に移した日時。保持期間を過ぎると物理削除する
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+	// DeletedBy はゴミ箱に移したメンバー、DeleteBatchID はゴミ箱に移した削除操作。削除時のみ設定する
+	DeletedBy     *uint   `gorm:"<-:update"`
+	DeleteBatchID *string `gorm:"<-:update;type:varchar(36);index"`
+	Analyze       *ReceiptAnalyzes
+	HouseholdBook HouseholdBook
+	Category      Category
+	PaymentMethod *PaymentMethod
+	Splits        []ShoppingAmountSplit
+	Tags          []Tag `gorm:"many2many:shopping_amount_tags"`
 }
 
 func (ShoppingAmount) TableName() string { return "shopping_amounts" }
+
+// ShoppingAmountSplit は支出のメンバーごとの負担モデル
+type ShoppingAmountSplit struct {
+	Base
+	ShoppingAmountID uint `gorm:"not null;index"`
+	UserID           uint `gorm:"not null"`
+	// Value は負担の割合（%）または金額
+	Value int `gorm:"not null"`
+}
+
+func (ShoppingAmountSplit) TableName() string { return "shopping_amount_splits" }
@@ -1,13 +1,18 @@
 package models
 
+import "gorm.io/gorm"
+
 // ShoppingMemo は買い物メモモデル
 type ShoppingMemo struct {
 	Base
-	HouseholdBookID uint   `gorm:"not null;index"`
-	CategoryID      uint   `gorm:"index"`
-	Title           string `gorm:"type:varchar(255);not null"`
-	Memo            string `gorm:"type:text"`
-	IsCompleted     bool   `gorm:"not null;default:false;index"`
+	HouseholdBookID uint           `gorm:"not null;index"`
+	CategoryID      uint           `gorm:"index"`
+	Title           string         `gorm:"type:varchar(255);not null"`
+	Memo            string         `gorm:"type:text"`
+	IsCompleted     bool           `gorm:"not null;default:false;index"`
+	DeletedAt       gorm.DeletedAt `gorm:"index"`
+	DeletedBy       *uint          `gorm:"<-:update"`
+	DeleteBatchID   *string        `gorm:"<-:update;type:varchar(36);index"`
 	Category        *Category
 }
 
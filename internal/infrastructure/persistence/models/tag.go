@@ -0,0 +1,26 @@
+package models
+
+// Tag は家計簿ごとのタグモデル
+type Tag struct {
+	Base
+	HouseholdBookID uint   `gorm:"not null;uniqueIndex:idx_tags_household_book_id_name"`
+	Name            string `gorm:"type:varchar(50);not null;uniqueIndex:idx_tags_household_book_id_name"`
+}
+
+func (Tag) TableName() string { return "tags" }
+
+// ShoppingAmountTag は支出とタグの中間テーブルモデル
+type ShoppingAmountTag struct {
+	ShoppingAmountID uint `gorm:"primaryKey"`
+	TagID            uint `gorm:"primaryKey"`
+}
+
+func (ShoppingAmountTag) TableName() string { return "shopping_amount_tags" }
+
+// ReceiptAnalyzeItemTag はレシートの品目とタグの中間テーブルモデル
+type ReceiptAnalyzeItemTag struct {
+	ReceiptAnalyzeItemID uint `gorm:"primaryKey"`
+	TagID                uint `gorm:"primaryKey"`
+}
+
+func (ReceiptAnalyzeItemTag) TableName() string { return "receipt_analyze_item_tags" }
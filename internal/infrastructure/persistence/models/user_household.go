@@ -4,6 +4,7 @@ type UserHouseHold struct {
 	Base
 	UserID        uint          `gorm:"not null;index:idx_user_households_user_id"`
 	HouseholdID   uint          `gorm:"not null;index:idx_user_households_household_id"`
+	Role          string        `gorm:"type:varchar(16);not null;default:editor"`
 	UserAccount   UserAccount   `gorm:"foreignKey:UserID;references:ID"`
 	HouseholdBook HouseholdBook `gorm:"foreignKey:HouseholdID;references:ID"`
 }
@@ -0,0 +1,838 @@
+package repository
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// backupBatchSize は件数の多い支出とチャットをまとめて登録する件数
+const backupBatchSize = 500
+
+type BackupRepository struct {
+	db *gorm.DB
+}
+
+// FindHouseHoldBackup implements domainmodel.BackupRepository.
+// アーカイブしたカテゴリ・支払い方法、完了した買い物メモも含める
+func (r *BackupRepository) FindHouseHoldBackup(houseHoldID domainmodel.HouseHoldID) (*domainmodel.HouseHoldBackup, error) {
+	houseHold := &models.HouseholdBook{}
+	if err := r.db.Where("id = ?", houseHoldID).First(houseHold).Error; err != nil {
+		return nil, err
+	}
+
+	backup := &domainmodel.HouseHoldBackup{
+		Manifest: domainmodel.BackupManifest{
+			CreatedAt:    time.Now(),
+			HouseHoldID:  houseHoldID,
+			Title:        houseHold.Title,
+			Description:  houseHold.Description,
+			BaseCurrency: domainmodel.Currency(houseHold.BaseCurrency),
+		},
+	}
+
+	categoryLimits := []*models.CategoryLimit{}
+	if err := r.db.Where("household_book_id = ?", houseHoldID).Order("sort_order, id").Find(&categoryLimits).Error; err != nil {
+		return nil, err
+	}
+	for _, categoryLimit := range categoryLimits {
+		backup.Categories = append(backup.Categories, &domainmodel.BackupCategory{
+			ID:          domainmodel.CategoryID(categoryLimit.CategoryID),
+			Name:        categoryLimit.Name,
+			Color:       categoryLimit.Color,
+			Icon:        categoryLimit.Icon,
+			LimitAmount: categoryLimit.LimitAmount,
+			SortOrder:   categoryLimit.SortOrder,
+			ArchivedAt:  categoryLimit.ArchivedAt,
+		})
+	}
+
+	budgets := []*models.MonthlyBudget{}
+	if err := r.db.Where("household_book_id = ?", houseHoldID).Order("month, category_id").Find(&budgets).Error; err != nil {
+		return nil, err
+	}
+	for _, budget := range budgets {
+		backup.MonthlyBudgets = append(backup.MonthlyBudgets, &domainmodel.BackupMonthlyBudget{
+			CategoryID: domainmodel.CategoryID(budget.CategoryID),
+			Month:      budget.Month,
+			Amount:     budget.Amount,
+			Rollover:   budget.Rollover,
+		})
+	}
+
+	tags := []*models.Tag{}
+	if err := r.db.Where("household_book_id = ?", houseHoldID).Order("id").Find(&tags).Error; err != nil {
+		return nil, err
+	}
+	for _, tag := range tags {
+		backup.Tags = append(backup.Tags, &domainmodel.BackupTag{ID: domainmodel.TagID(tag.ID), Name: tag.Name})
+	}
+
+	paymentMethods := []*models.PaymentMethod{}
+	if err := r.db.Where("household_book_id = ?", houseHoldID).Order("id").Find(&paymentMethods).Error; err != nil {
+		return nil, err
+	}
+	for _, paymentMethod := range paymentMethods {
+		backupPaymentMethod := &domainmodel.BackupPaymentMethod{
+			ID:             domainmodel.PaymentMethodID(paymentMethod.ID),
+			Name:           paymentMethod.Name,
+			Type:           paymentMethod.Type,
+			OpeningBalance: paymentMethod.OpeningBalance,
+			OpeningDate:    paymentMethod.OpeningDate.Format("2006-01-02"),
+			ClosingDay:     paymentMethod.ClosingDay,
+			PaymentDay:     paymentMethod.PaymentDay,
+			ArchivedAt:     paymentMethod.ArchivedAt,
+		}
+		if paymentMethod.WithdrawalAccountID != nil {
+			withdrawalAccountID := domainmodel.PaymentMethodID(*paymentMethod.WithdrawalAccountID)
+			backupPaymentMethod.WithdrawalAccountID = &withdrawalAccountID
+		}
+		backup.PaymentMethods = append(backup.PaymentMethods, backupPaymentMethod)
+	}
+
+	memos := []*models.ShoppingMemo{}
+	if err := r.db.Where("household_book_id = ?", houseHoldID).Order("id").Find(&memos).Error; err != nil {
+		return nil, err
+	}
+	for _, memo := range memos {
+		backup.ShoppingMemos = append(backup.ShoppingMemos, &domainmodel.BackupShoppingMemo{
+			CategoryID:  domainmodel.CategoryID(memo.CategoryID),
+			Title:       memo.Title,
+			Memo:        memo.Memo,
+			IsCompleted: memo.IsCompleted,
+		})
+	}
+
+	receipts := []*models.ReceiptAnalyzes{}
+	if err := r.db.Where("household_book_id = ?", houseHoldID).
+		Preload("Items", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Preload("Items.Tags").
+		Order("id").
+		Find(&receipts).Error; err != nil {
+		return nil, err
+	}
+	for _, receipt := range receipts {
+		backupReceipt := &domainmodel.BackupReceipt{
+			ID:            uint(receipt.ID),
+			ImageFile:     receipt.ImageURL,
+			AnalyzeStatus: receipt.AnalyzeStatus,
+			TotalPrice:    receipt.TotalPrice,
+			Currency:      receiptCurrency(receipt.Currency),
+		}
+		for _, item := range receipt.Items {
+			backupReceipt.Items = append(backupReceipt.Items, &domainmodel.BackupReceiptItem{
+				Name:   item.Name,
+				Price:  item.Price,
+				TagIDs: backupTagIDs(item.Tags),
+			})
+		}
+		backup.Receipts = append(backup.Receipts, backupReceipt)
+	}
+
+	shoppings := []*models.ShoppingAmount{}
+	if err := r.db.Where("household_book_id = ?", houseHoldID).
+		Preload("Splits", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Preload("Tags").
+		Order("date, id").
+		Find(&shoppings).Error; err != nil {
+		return nil, err
+	}
+	for _, shopping := range shoppings {
+		backupShopping := &domainmodel.BackupShoppingAmount{
+			CategoryID: domainmodel.CategoryID(shopping.CategoryID),
+			Amount:     shopping.Amount,
+			Date:       shopping.Date.Format("2006-01-02"),
+			Memo:       shopping.Memo,
+			ReceiptID:  uint(shopping.AnalyzeID),
+			SplitType:  shopping.SplitType,
+			TagIDs:     backupTagIDs(shopping.Tags),
+			CreatedAt:  shopping.CreatedAt,
+		}
+		if shopping.PaidBy != nil {
+			paidBy := domainmodel.UserID(*shopping.PaidBy)
+			backupShopping.PaidBy = &paidBy
+		}
+		if shopping.PaymentMethodID != nil {
+			paymentMethodID := domainmodel.PaymentMethodID(*shopping.PaymentMethodID)
+			backupShopping.PaymentMethodID = &paymentMethodID
+		}
+		for _, split := range shopping.Splits {
+			backupShopping.Splits = append(backupShopping.Splits, &domainmodel.BackupShoppingSplit{UserID: domainmodel.UserID(split.UserID), Value: split.Value})
+		}
+		if shopping.OriginalAmount != nil && shopping.OriginalCurrency != nil && shopping.ExchangeRate != nil {
+			original := domainmodel.NewMoney(*shopping.OriginalAmount, domainmodel.Currency(*shopping.OriginalCurrency))
+			backupShopping.Original = &original
+			backupShopping.ExchangeRate, _ = domainmodel.NormalizeExchangeRate(*shopping.ExchangeRate)
+		}
+		backup.ShoppingAmounts = append(backup.ShoppingAmounts, backupShopping)
+	}
+
+	exchangeRates := []*models.ExchangeRate{}
+	if err := r.db.Where("household_book_id = ?", houseHoldID).Order("currency, effective_date").Find(&exchangeRates).Error; err != nil {
+		return nil, err
+	}
+	for _, exchangeRate := range exchangeRates {
+		rate := convertExchangeRate(exchangeRate)
+		backup.ExchangeRates = append(backup.ExchangeRates, &domainmodel.BackupExchangeRate{
+			Currency:      rate.Currency,
+			Rate:          rate.Rate,
+			EffectiveDate: rate.EffectiveDate,
+		})
+	}
+
+	incomeCategories := []*models.IncomeCategory{}
+	if err := r.db.Where("household_book_id = ?", houseHoldID).Order("sort_order, id").Find(&incomeCategories).Error; err != nil {
+		return nil, err
+	}
+	for _, incomeCategory := range incomeCategories {
+		backup.IncomeCategories = append(backup.IncomeCategories, &domainmodel.BackupIncomeCategory{
+			ID:        domainmodel.IncomeCategoryID(incomeCategory.ID),
+			Name:      incomeCategory.Name,
+			Color:     incomeCategory.Color,
+			SortOrder: incomeCategory.SortOrder,
+		})
+	}
+
+	incomes := []*models.Income{}
+	if err := r.db.Where("household_book_id = ?", houseHoldID).Order("date, id").Find(&incomes).Error; err != nil {
+		return nil, err
+	}
+	for _, income := range incomes {
+		backupIncome := &domainmodel.BackupIncome{
+			IncomeCategoryID: domainmodel.IncomeCategoryID(income.IncomeCategoryID),
+			Amount:           income.Amount,
+			Date:             income.Date.Format("2006-01-02"),
+			Memo:             income.Memo,
+		}
+		if income.UserID != nil {
+			userID := domainmodel.UserID(*income.UserID)
+			backupIncome.UserID = &userID
+		}
+		if income.PaymentMethodID != nil {
+			paymentMethodID := domainmodel.PaymentMethodID(*income.PaymentMethodID)
+			backupIncome.PaymentMethodID = &paymentMethodID
+		}
+		backup.Incomes = append(backup.Incomes, backupIncome)
+	}
+
+	recurrings := []*models.RecurringTransaction{}
+	if err := r.db.Where("household_book_id = ?", houseHoldID).Order("id").Find(&recurrings).Error; err != nil {
+		return nil, err
+	}
+	for _, recurring := range recurrings {
+		backupRecurring := &domainmodel.BackupRecurringTransaction{
+			CategoryID:        domainmodel.CategoryID(recurring.CategoryID),
+			Amount:            recurring.Amount,
+			Memo:              recurring.Memo,
+			Frequency:         domainmodel.RecurrenceFrequency(recurring.Frequency),
+			Interval:          recurring.Interval,
+			DayOfMonth:        recurring.DayOfMonth,
+			StartDate:         recurring.StartDate.Format("2006-01-02"),
+			EndDate:           formatBackupDate(recurring.EndDate),
+			MaterializedUntil: formatBackupDate(recurring.MaterializedUntil),
+		}
+		overrides := []*models.RecurringOccurrenceOverride{}
+		if err := r.db.Where("recurring_transaction_id = ?", recurring.ID).Order("date").Find(&overrides).Error; err != nil {
+			return nil, err
+		}
+		for _, override := range overrides {
+			backupRecurring.Overrides = append(backupRecurring.Overrides, &domainmodel.BackupRecurringOverride{
+				Date:    override.Date.Format("2006-01-02"),
+				Skipped: override.Skipped,
+				Amount:  override.Amount,
+				Memo:    override.Memo,
+			})
+		}
+		backup.RecurringTransactions = append(backup.RecurringTransactions, backupRecurring)
+	}
+
+	settlements := []*models.Settlement{}
+	if err := r.db.Where("household_book_id = ?", houseHoldID).Order("date, id").Find(&settlements).Error; err != nil {
+		return nil, err
+	}
+	for _, settlement := range settlements {
+		backup.Settlements = append(backup.Settlements, &domainmodel.BackupSettlement{
+			FromUserID: domainmodel.UserID(settlement.FromUserID),
+			ToUserID:   domainmodel.UserID(settlement.ToUserID),
+			Amount:     settlement.Amount,
+			Date:       settlement.Date.Format("2006-01-02"),
+			Memo:       settlement.Memo,
+		})
+	}
+
+	chatMessages := []*models.ChatMessage{}
+	if err := r.db.Where("household_id = ?", houseHoldID).Order("created_at, id").Find(&chatMessages).Error; err != nil {
+		return nil, err
+	}
+	for _, chatMessage := range chatMessages {
+		backup.ChatMessages = append(backup.ChatMessages, &domainmodel.BackupChatMessage{
+			UserID:      domainmodel.UserID(chatMessage.UserID),
+			MessageType: domainmodel.ChatMessageType(chatMessage.MessageType),
+			Content:     chatMessage.Content,
+			CreatedAt:   chatMessage.CreatedAt,
+		})
+	}
+
+	memberships := []*models.UserHouseHold{}
+	if err := r.db.Where("household_id = ?", houseHoldID).Order("id").Find(&memberships).Error; err != nil {
+		return nil, err
+	}
+	for _, membership := range memberships {
+		backup.Memberships = append(backup.Memberships, &domainmodel.BackupMembership{
+			UserID: domainmodel.UserID(membership.UserID),
+			Role:   domainmodel.HouseHoldRole(membership.Role),
+		})
+	}
+
+	return backup, nil
+}
+
+// RestoreHouseHoldBackup implements domainmodel.BackupRepository.
+// バックアップの ID は新しい ID に振り直す。ユーザーは振り直せないため、存在しないユーザーへの参照は
+// ユーザーを削除した場合と同様に、支払者と収入のメンバーは未設定とし、負担の割合とチャットの投稿、精算は復元しない
+func (r *BackupRepository) RestoreHouseHoldBackup(backup *domainmodel.HouseHoldBackup, input *domainmodel.RestoreBackupInput, images map[string]string) (*domainmodel.RestoreBackupResult, error) {
+	result := &domainmodel.RestoreBackupResult{Counts: map[string]int{}}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		title := input.Title
+		if title == "" {
+			title = backup.Manifest.Title
+		}
+		baseCurrency := backup.Manifest.BaseCurrency
+		if baseCurrency == "" {
+			baseCurrency = domainmodel.DefaultCurrency
+		}
+		houseHold := &models.HouseholdBook{Title: title, Description: backup.Manifest.Description, BaseCurrency: string(baseCurrency)}
+		if err := tx.Omit(clause.Associations).Create(houseHold).Error; err != nil {
+			return err
+		}
+		result.HouseHoldID = domainmodel.HouseHoldID(houseHold.ID)
+
+		for _, rate := range backup.ExchangeRates {
+			effectiveDate, err := time.Parse("2006-01-02", rate.EffectiveDate)
+			if err != nil {
+				return err
+			}
+			model := &models.ExchangeRate{HouseholdBookID: houseHold.ID, Currency: string(rate.Currency), Rate: rate.Rate, EffectiveDate: effectiveDate}
+			if err := tx.Create(model).Error; err != nil {
+				return err
+			}
+		}
+		result.Counts[domainmodel.BackupExchangeRatesFile] = len(backup.ExchangeRates)
+
+		users, err := existingBackupUsers(tx, backup)
+		if err != nil {
+			return err
+		}
+
+		memberships := []*models.UserHouseHold{{UserID: uint(input.UserID), HouseholdID: houseHold.ID, Role: string(domainmodel.HouseHoldRoleOwner)}}
+		if input.IncludeMembers {
+			coMembers, err := backupCoMembers(tx, backup, input.UserID)
+			if err != nil {
+				return err
+			}
+			for _, membership := range backup.Memberships {
+				if membership.UserID == input.UserID || !coMembers[membership.UserID] {
+					continue
+				}
+				// 所有者は復元したユーザーのため、元の所有者は編集者とする
+				role := membership.Role
+				if role == domainmodel.HouseHoldRoleOwner {
+					role = domainmodel.HouseHoldRoleEditor
+				}
+				memberships = append(memberships, &models.UserHouseHold{UserID: uint(membership.UserID), HouseholdID: houseHold.ID, Role: string(role)})
+			}
+		}
+		if err := tx.Omit(clause.Associations).Create(memberships).Error; err != nil {
+			return err
+		}
+		result.Counts[domainmodel.BackupMembershipsFile] = len(memberships)
+
+		categoryIDs := map[domainmodel.CategoryID]uint{}
+		for _, category := range backup.Categories {
+			categoryID, err := restoreMasterCategory(tx, category)
+			if err != nil {
+				return err
+			}
+			categoryIDs[category.ID] = categoryID
+
+			categoryLimit := &models.CategoryLimit{
+				HouseholdBookID: houseHold.ID,
+				CategoryID:      categoryID,
+				LimitAmount:     category.LimitAmount,
+				Name:            category.Name,
+				Color:           category.Color,
+				Icon:            category.Icon,
+				SortOrder:       category.SortOrder,
+				ArchivedAt:      category.ArchivedAt,
+			}
+			if err := tx.Omit(clause.Associations).Create(categoryLimit).Error; err != nil {
+				return err
+			}
+		}
+		result.Counts[domainmodel.BackupCategoriesFile] = len(backup.Categories)
+
+		for _, budget := range backup.MonthlyBudgets {
+			model := &models.MonthlyBudget{
+				HouseholdBookID: houseHold.ID,
+				CategoryID:      categoryIDs[budget.CategoryID],
+				Month:           budget.Month,
+				Amount:          budget.Amount,
+				Rollover:        budget.Rollover,
+			}
+			if err := tx.Create(model).Error; err != nil {
+				return err
+			}
+		}
+		result.Counts[domainmodel.BackupMonthlyBudgetsFile] = len(backup.MonthlyBudgets)
+
+		tagIDs := map[domainmodel.TagID]uint{}
+		for _, tag := range backup.Tags {
+			model := &models.Tag{HouseholdBookID: houseHold.ID, Name: tag.Name}
+			if err := tx.Create(model).Error; err != nil {
+				return err
+			}
+			tagIDs[tag.ID] = model.ID
+		}
+		result.Counts[domainmodel.BackupTagsFile] = len(backup.Tags)
+
+		paymentMethodIDs, err := restorePaymentMethods(tx, houseHold.ID, backup.PaymentMethods)
+		if err != nil {
+			return err
+		}
+		result.Counts[domainmodel.BackupPaymentMethodsFile] = len(backup.PaymentMethods)
+
+		for _, memo := range backup.ShoppingMemos {
+			model := &models.ShoppingMemo{
+				HouseholdBookID: houseHold.ID,
+				CategoryID:      categoryIDs[memo.CategoryID],
+				Title:           memo.Title,
+				Memo:            memo.Memo,
+				IsCompleted:     memo.IsCompleted,
+			}
+			if err := tx.Omit(clause.Associations).Create(model).Error; err != nil {
+				return err
+			}
+		}
+		result.Counts[domainmodel.BackupShoppingMemosFile] = len(backup.ShoppingMemos)
+
+		receiptIDs := map[uint]int{}
+		for _, receipt := range backup.Receipts {
+			model := &models.ReceiptAnalyzes{
+				ImageURL:        images[receipt.ImageFile],
+				AnalyzeStatus:   receipt.AnalyzeStatus,
+				TotalPrice:      receipt.TotalPrice,
+				HouseholdBookID: int(houseHold.ID),
+			}
+			if receipt.Currency != "" {
+				currency := string(receipt.Currency)
+				model.Currency = &currency
+			}
+			if err := tx.Omit(clause.Associations).Create(model).Error; err != nil {
+				return err
+			}
+			receiptIDs[receipt.ID] = model.ID
+
+			for _, item := range receipt.Items {
+				itemModel := &models.ReceiptAnalyzeItems{ReceiptAnalyzeID: model.ID, Name: item.Name, Price: item.Price}
+				if err := tx.Omit(clause.Associations).Create(itemModel).Error; err != nil {
+					return err
+				}
+				for _, tagID := range item.TagIDs {
+					if err := tx.Create(&models.ReceiptAnalyzeItemTag{ReceiptAnalyzeItemID: uint(itemModel.ID), TagID: tagIDs[tagID]}).Error; err != nil {
+						return err
+					}
+				}
+			}
+		}
+		result.Counts[domainmodel.BackupReceiptsFile] = len(backup.Receipts)
+
+		memberIDs := make([]uint, len(memberships))
+		for i, membership := range memberships {
+			memberIDs[i] = membership.UserID
+		}
+		if err := restoreShoppingAmounts(tx, houseHold.ID, backup.ShoppingAmounts, users, memberIDs, categoryIDs, tagIDs, paymentMethodIDs, receiptIDs); err != nil {
+			return err
+		}
+		result.Counts[domainmodel.BackupShoppingAmountsFile] = len(backup.ShoppingAmounts)
+
+		incomeCategoryIDs := map[domainmodel.IncomeCategoryID]uint{}
+		for _, incomeCategory := range backup.IncomeCategories {
+			model := &models.IncomeCategory{HouseholdBookID: houseHold.ID, Name: incomeCategory.Name, Color: incomeCategory.Color, SortOrder: incomeCategory.SortOrder}
+			if err := tx.Create(model).Error; err != nil {
+				return err
+			}
+			incomeCategoryIDs[incomeCategory.ID] = model.ID
+		}
+		result.Counts[domainmodel.BackupIncomeCategoriesFile] = len(backup.IncomeCategories)
+
+		for _, income := range backup.Incomes {
+			date, err := time.Parse("2006-01-02", income.Date)
+			if err != nil {
+				return err
+			}
+			model := &models.Income{
+				HouseholdBookID:  houseHold.ID,
+				IncomeCategoryID: incomeCategoryIDs[income.IncomeCategoryID],
+				Amount:           income.Amount,
+				Date:             date,
+				Memo:             income.Memo,
+			}
+			if income.UserID != nil && users[*income.UserID] {
+				userID := uint(*income.UserID)
+				model.UserID = &userID
+			}
+			if income.PaymentMethodID != nil {
+				paymentMethodID := paymentMethodIDs[*income.PaymentMethodID]
+				model.PaymentMethodID = &paymentMethodID
+			}
+			if err := tx.Omit(clause.Associations).Create(model).Error; err != nil {
+				return err
+			}
+		}
+		result.Counts[domainmodel.BackupIncomesFile] = len(backup.Incomes)
+
+		if err := restoreRecurringTransactions(tx, houseHold.ID, backup.RecurringTransactions, categoryIDs); err != nil {
+			return err
+		}
+		result.Counts[domainmodel.BackupRecurringTransactionsFile] = len(backup.RecurringTransactions)
+
+		settlements := 0
+		for _, settlement := range backup.Settlements {
+			if !users[settlement.FromUserID] || !users[settlement.ToUserID] {
+				result.SkippedSettlements++
+				continue
+			}
+			date, err := time.Parse("2006-01-02", settlement.Date)
+			if err != nil {
+				return err
+			}
+			model := &models.Settlement{
+				HouseholdBookID: houseHold.ID,
+				FromUserID:      uint(settlement.FromUserID),
+				ToUserID:        uint(settlement.ToUserID),
+				Amount:          settlement.Amount,
+				Date:            date,
+				Memo:            settlement.Memo,
+			}
+			if err := tx.Create(model).Error; err != nil {
+				return err
+			}
+			settlements++
+		}
+		result.Counts[domainmodel.BackupSettlementsFile] = settlements
+
+		chatMessages := []*models.ChatMessage{}
+		for _, chatMessage := range backup.ChatMessages {
+			// AI とシステムのメッセージはユーザーID 0 で投稿している
+			if chatMessage.UserID != 0 && !users[chatMessage.UserID] {
+				result.SkippedChatMessages++
+				continue
+			}
+			chatMessages = append(chatMessages, &models.ChatMessage{
+				HouseholdID: int(houseHold.ID),
+				UserID:      int(chatMessage.UserID),
+				MessageType: string(chatMessage.MessageType),
+				Content:     chatMessage.Content,
+				CreatedAt:   chatMessage.CreatedAt,
+			})
+		}
+		if len(chatMessages) > 0 {
+			if err := tx.Omit(clause.Associations).CreateInBatches(chatMessages, backupBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		result.Counts[domainmodel.BackupChatMessagesFile] = len(chatMessages)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// existingBackupUsers はバックアップが参照するユーザーのうち、存在するユーザーを返す
+func existingBackupUsers(tx *gorm.DB, backup *domainmodel.HouseHoldBackup) (map[domainmodel.UserID]bool, error) {
+	userIDs := []domainmodel.UserID{}
+	for _, membership := range backup.Memberships {
+		userIDs = append(userIDs, membership.UserID)
+	}
+	for _, shopping := range backup.ShoppingAmounts {
+		if shopping.PaidBy != nil {
+			userIDs = append(userIDs, *shopping.PaidBy)
+		}
+		for _, split := range shopping.Splits {
+			userIDs = append(userIDs, split.UserID)
+		}
+	}
+	for _, chatMessage := range backup.ChatMessages {
+		userIDs = append(userIDs, chatMessage.UserID)
+	}
+	for _, income := range backup.Incomes {
+		if income.UserID != nil {
+			userIDs = append(userIDs, *income.UserID)
+		}
+	}
+	for _, settlement := range backup.Settlements {
+		userIDs = append(userIDs, settlement.FromUserID, settlement.ToUserID)
+	}
+
+	users := map[domainmodel.UserID]bool{}
+	if len(userIDs) == 0 {
+		return users, nil
+	}
+	existing := []uint{}
+	if err := tx.Model(&models.UserAccount{}).Where("id IN ?", userIDs).Pluck("id", &existing).Error; err != nil {
+		return nil, err
+	}
+	for _, id := range existing {
+		users[domainmodel.UserID(id)] = true
+	}
+	return users, nil
+}
+
+// backupCoMembers はバックアップのメンバーのうち、現在 userID のユーザーと同じ家計簿のメンバーであるユーザーを返す
+// バックアップの zip は利用者が編集できるため、面識のないユーザーを家計簿のメンバーに加えないよう制限する
+func backupCoMembers(tx *gorm.DB, backup *domainmodel.HouseHoldBackup, userID domainmodel.UserID) (map[domainmodel.UserID]bool, error) {
+	userIDs := []domainmodel.UserID{}
+	for _, membership := range backup.Memberships {
+		if membership.UserID != userID {
+			userIDs = append(userIDs, membership.UserID)
+		}
+	}
+
+	coMembers := map[domainmodel.UserID]bool{}
+	if len(userIDs) == 0 {
+		return coMembers, nil
+	}
+	houseHoldIDs := tx.Model(&models.UserHouseHold{}).Select("household_id").Where("user_id = ?", userID)
+	existing := []uint{}
+	if err := tx.Model(&models.UserHouseHold{}).Where("user_id IN ? AND household_id IN (?)", userIDs, houseHoldIDs).Distinct().Pluck("user_id", &existing).Error; err != nil {
+		return nil, err
+	}
+	for _, id := range existing {
+		coMembers[domainmodel.UserID(id)] = true
+	}
+	return coMembers, nil
+}
+
+// restoreMasterCategory は家計簿のカテゴリが参照するカテゴリを用意する
+// 既定のカテゴリは家計簿間で共有しているため存在すれば再利用し、それ以外は家計簿ごとに作成する
+func restoreMasterCategory(tx *gorm.DB, category *domainmodel.BackupCategory) (uint, error) {
+	if category.ID == domainmodel.CategoryIDFood || category.ID == domainmodel.CategoryIDNecessary {
+		var count int64
+		if err := tx.Model(&models.Category{}).Where("id = ?", category.ID).Count(&count).Error; err != nil {
+			return 0, err
+		}
+		if count > 0 {
+			return uint(category.ID), nil
+		}
+	}
+
+	model := &models.Category{Name: category.Name, Color: category.Color}
+	if err := tx.Create(model).Error; err != nil {
+		return 0, err
+	}
+	return model.ID, nil
+}
+
+// restorePaymentMethods は支払い方法を作成する。引き落とし口座は支払い方法同士の参照のため、作成後に設定する
+func restorePaymentMethods(tx *gorm.DB, houseHoldID uint, paymentMethods []*domainmodel.BackupPaymentMethod) (map[domainmodel.PaymentMethodID]uint, error) {
+	paymentMethodIDs := map[domainmodel.PaymentMethodID]uint{}
+	for _, paymentMethod := range paymentMethods {
+		openingDate, err := time.Parse("2006-01-02", paymentMethod.OpeningDate)
+		if err != nil {
+			return nil, err
+		}
+		model := &models.PaymentMethod{
+			HouseholdBookID: houseHoldID,
+			Name:            paymentMethod.Name,
+			Type:            paymentMethod.Type,
+			OpeningBalance:  paymentMethod.OpeningBalance,
+			OpeningDate:     openingDate,
+			ClosingDay:      paymentMethod.ClosingDay,
+			PaymentDay:      paymentMethod.PaymentDay,
+			ArchivedAt:      paymentMethod.ArchivedAt,
+		}
+		if err := tx.Create(model).Error; err != nil {
+			return nil, err
+		}
+		paymentMethodIDs[paymentMethod.ID] = model.ID
+	}
+
+	for _, paymentMethod := range paymentMethods {
+		if paymentMethod.WithdrawalAccountID == nil {
+			continue
+		}
+		if err := tx.Model(&models.PaymentMethod{}).
+			Where("id = ?", paymentMethodIDs[paymentMethod.ID]).
+			Update("withdrawal_account_id", paymentMethodIDs[*paymentMethod.WithdrawalAccountID]).Error; err != nil {
+			return nil, err
+		}
+	}
+	return paymentMethodIDs, nil
+}
+
+// restoreShoppingAmounts は支出をまとめて作成し、作成した ID で負担の割合とタグを登録する
+// 均等の負担の参加者を保存する前のバックアップは参加者を含まないため、復元した家計簿のメンバー（memberIDs）を参加者とする
+func restoreShoppingAmounts(tx *gorm.DB, houseHoldID uint, shoppings []*domainmodel.BackupShoppingAmount, users map[domainmodel.UserID]bool, memberIDs []uint, categoryIDs map[domainmodel.CategoryID]uint, tagIDs map[domainmodel.TagID]uint, paymentMethodIDs map[domainmodel.PaymentMethodID]uint, receiptIDs map[uint]int) error {
+	if len(shoppings) == 0 {
+		return nil
+	}
+
+	shoppingModels := make([]*models.ShoppingAmount, len(shoppings))
+	for i, shopping := range shoppings {
+		date, err := time.Parse("2006-01-02", shopping.Date)
+		if err != nil {
+			return err
+		}
+		model := &models.ShoppingAmount{
+			Base:            models.Base{CreatedAt: shopping.CreatedAt},
+			HouseholdBookID: houseHoldID,
+			CategoryID:      categoryIDs[shopping.CategoryID],
+			Amount:          shopping.Amount,
+			Date:            date,
+			Memo:            shopping.Memo,
+			AnalyzeID:       receiptIDs[shopping.ReceiptID],
+			SplitType:       shopping.SplitType,
+		}
+		if shopping.PaidBy != nil && users[*shopping.PaidBy] {
+			paidBy := uint(*shopping.PaidBy)
+			model.PaidBy = &paidBy
+		}
+		if shopping.PaymentMethodID != nil {
+			paymentMethodID := paymentMethodIDs[*shopping.PaymentMethodID]
+			model.PaymentMethodID = &paymentMethodID
+		}
+		if shopping.Original != nil {
+			originalAmount := shopping.Original.Amount
+			originalCurrency := string(shopping.Original.Currency)
+			exchangeRate := shopping.ExchangeRate
+			model.OriginalAmount = &originalAmount
+			model.OriginalCurrency = &originalCurrency
+			model.ExchangeRate = &exchangeRate
+		}
+		shoppingModels[i] = model
+	}
+	if err := tx.Omit(clause.Associations).CreateInBatches(shoppingModels, backupBatchSize).Error; err != nil {
+		return err
+	}
+
+	splits := []*models.ShoppingAmountSplit{}
+	shoppingTags := []*models.ShoppingAmountTag{}
+	for i, shopping := range shoppings {
+		for _, split := range shopping.Splits {
+			if !users[split.UserID] {
+				continue
+			}
+			splits = append(splits, &models.ShoppingAmountSplit{ShoppingAmountID: shoppingModels[i].ID, UserID: uint(split.UserID), Value: split.Value})
+		}
+		if len(shopping.Splits) == 0 && shopping.SplitType == string(domainmodel.SplitEqual) && shoppingModels[i].PaidBy != nil {
+			for _, memberID := range memberIDs {
+				splits = append(splits, &models.ShoppingAmountSplit{ShoppingAmountID: shoppingModels[i].ID, UserID: memberID})
+			}
+		}
+		for _, tagID := range shopping.TagIDs {
+			shoppingTags = append(shoppingTags, &models.ShoppingAmountTag{ShoppingAmountID: shoppingModels[i].ID, TagID: tagIDs[tagID]})
+		}
+	}
+	if len(splits) > 0 {
+		if err := tx.CreateInBatches(splits, backupBatchSize).Error; err != nil {
+			return err
+		}
+	}
+	if len(shoppingTags) > 0 {
+		if err := tx.CreateInBatches(shoppingTags, backupBatchSize).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreRecurringTransactions は定期取引と発生日ごとの変更を作成する
+// 登録済みの発生日は支出として復元しているため、MaterializedUntil も引き継いで二重に登録しないようにする
+func restoreRecurringTransactions(tx *gorm.DB, houseHoldID uint, recurrings []*domainmodel.BackupRecurringTransaction, categoryIDs map[domainmodel.CategoryID]uint) error {
+	for _, recurring := range recurrings {
+		startDate, err := time.Parse("2006-01-02", recurring.StartDate)
+		if err != nil {
+			return err
+		}
+		endDate, err := parseBackupDate(recurring.EndDate)
+		if err != nil {
+			return err
+		}
+		materializedUntil, err := parseBackupDate(recurring.MaterializedUntil)
+		if err != nil {
+			return err
+		}
+		model := &models.RecurringTransaction{
+			HouseholdBookID:   houseHoldID,
+			CategoryID:        categoryIDs[recurring.CategoryID],
+			Amount:            recurring.Amount,
+			Memo:              recurring.Memo,
+			Frequency:         string(recurring.Frequency),
+			Interval:          recurring.Interval,
+			DayOfMonth:        recurring.DayOfMonth,
+			StartDate:         startDate,
+			EndDate:           endDate,
+			MaterializedUntil: materializedUntil,
+		}
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+
+		for _, override := range recurring.Overrides {
+			date, err := time.Parse("2006-01-02", override.Date)
+			if err != nil {
+				return err
+			}
+			overrideModel := &models.RecurringOccurrenceOverride{
+				RecurringTransactionID: model.ID,
+				Date:                   date,
+				Skipped:                override.Skipped,
+				Amount:                 override.Amount,
+				Memo:                   override.Memo,
+			}
+			if err := tx.Create(overrideModel).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func formatBackupDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+	formatted := date.Format("2006-01-02")
+	return &formatted
+}
+
+func parseBackupDate(date *string) (*time.Time, error) {
+	if date == nil {
+		return nil, nil
+	}
+	parsed, err := time.Parse("2006-01-02", *date)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+func backupTagIDs(tags []models.Tag) []domainmodel.TagID {
+	tagIDs := make([]domainmodel.TagID, len(tags))
+	for i, tag := range tags {
+		tagIDs[i] = domainmodel.TagID(tag.ID)
+	}
+	return tagIDs
+}
+
+func NewBackupRepository(db *gorm.DB) domainmodel.BackupRepository {
+	return &BackupRepository{db: db}
+}
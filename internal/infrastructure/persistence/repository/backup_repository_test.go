@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+)
+
+func TestBackupRepository_RestoreHouseHoldBackup(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewBackupRepository(gormDB)
+
+	// ユーザー 4 は存在しないため、支払者と収入のメンバーは未設定とし、チャットの投稿と精算は復元しない
+	// 版 1 のバックアップは基準通貨を持たないため、JPY の家計簿として復元する
+	paidBy := domainmodel.UserID(4)
+	owner := domainmodel.UserID(3)
+	createdAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	materializedUntil := "2026-10-01"
+	backup := &domainmodel.HouseHoldBackup{
+		Manifest:   domainmodel.BackupManifest{Title: "我が家", Description: "家族の家計簿"},
+		Categories: []*domainmodel.BackupCategory{{ID: 30, Name: "趣味", Color: "#0000FF", LimitAmount: 5000, SortOrder: 1}},
+		Tags:       []*domainmodel.BackupTag{{ID: 7, Name: "まとめ買い"}},
+		ShoppingAmounts: []*domainmodel.BackupShoppingAmount{
+			{CategoryID: 30, Amount: 1200, Date: "2026-10-01", PaidBy: &paidBy, SplitType: "equal", TagIDs: []domainmodel.TagID{7}, CreatedAt: createdAt,
+				Original: &domainmodel.Money{Amount: 800, Currency: "USD"}, ExchangeRate: "150"},
+			// 均等の負担の参加者を保存する前のバックアップは、復元した家計簿のメンバーを参加者とする
+			{CategoryID: 30, Amount: 600, Date: "2026-10-02", PaidBy: &owner, SplitType: "equal", CreatedAt: createdAt},
+		},
+		ExchangeRates: []*domainmodel.BackupExchangeRate{{Currency: "USD", Rate: "150", EffectiveDate: "2026-10-01"}},
+		ChatMessages: []*domainmodel.BackupChatMessage{
+			{UserID: 4, MessageType: domainmodel.ChatMessageTypeUser, Content: "こんにちは", CreatedAt: createdAt},
+			{UserID: 0, MessageType: domainmodel.ChatMessageTypeSystem, Content: "予算の80%を超えました", CreatedAt: createdAt},
+		},
+		IncomeCategories: []*domainmodel.BackupIncomeCategory{{ID: 9, Name: "給与", Color: "#00FF00", SortOrder: 1}},
+		Incomes: []*domainmodel.BackupIncome{
+			{IncomeCategoryID: 9, UserID: &paidBy, Amount: 300000, Date: "2026-10-25"},
+		},
+		RecurringTransactions: []*domainmodel.BackupRecurringTransaction{
+			{CategoryID: 30, Amount: 980, Frequency: domainmodel.RecurrenceMonthly, Interval: 1, DayOfMonth: 1, StartDate: "2026-09-01", MaterializedUntil: &materializedUntil,
+				Overrides: []*domainmodel.BackupRecurringOverride{{Date: "2026-11-01", Skipped: true}}},
+		},
+		Settlements: []*domainmodel.BackupSettlement{
+			{FromUserID: 5, ToUserID: 3, Amount: 600, Date: "2026-10-05"},
+			{FromUserID: 4, ToUserID: 3, Amount: 600, Date: "2026-10-05"},
+		},
+		Memberships: []*domainmodel.BackupMembership{
+			{UserID: 3, Role: domainmodel.HouseHoldRoleOwner},
+			{UserID: 4, Role: domainmodel.HouseHoldRoleEditor},
+			{UserID: 5, Role: domainmodel.HouseHoldRoleViewer},
+			{UserID: 6, Role: domainmodel.HouseHoldRoleEditor},
+		},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "household_books" .* RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "我が家", "家族の家計簿", "JPY").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(20))
+	mock.ExpectQuery(`INSERT INTO "exchange_rates" .* RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 20, "USD", "150", time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`SELECT "id" FROM "user_accounts" WHERE id IN \(\$1,\$2,\$3,\$4,\$5,\$6,\$7,\$8,\$9,\$10,\$11,\$12,\$13\)`).
+		WithArgs(3, 4, 5, 6, 4, 3, 4, 0, 4, 5, 3, 4, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(5).AddRow(6))
+	// 現在ユーザー 3 と同じ家計簿のメンバーであるユーザー 5 のみメンバーに加え、面識のないユーザー 6 は加えない
+	mock.ExpectQuery(`SELECT DISTINCT "user_id" FROM "user_households" WHERE user_id IN \(\$1,\$2,\$3\) AND household_id IN \(SELECT "household_id" FROM "user_households" WHERE user_id = \$4\)`).
+		WithArgs(4, 5, 6, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(5))
+	// 元の所有者（ユーザー 3）は復元したユーザーのため、所有者として登録する
+	mock.ExpectQuery(`INSERT INTO "user_households" .* VALUES \(\$1,\$2,\$3,\$4,\$5\),\(\$6,\$7,\$8,\$9,\$10\) RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 3, 20, "owner", sqlmock.AnyArg(), sqlmock.AnyArg(), 5, 20, "viewer").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+	mock.ExpectQuery(`INSERT INTO "categories" .* RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "趣味", "#0000FF").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(31))
+	mock.ExpectQuery(`INSERT INTO "category_limits" .* RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 20, 31, 5000, "趣味", "#0000FF", "", 1, nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`INSERT INTO "tags" .* RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 20, "まとめ買い").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(8))
+	mock.ExpectQuery(`INSERT INTO "shopping_amounts" .* RETURNING "id"`).
+		WithArgs(createdAt, sqlmock.AnyArg(), 20, 31, 1200, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), "", 0, "equal", nil, 800, "USD", "150",
+			createdAt, sqlmock.AnyArg(), 20, 31, 600, time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC), "", 0, "equal", nil, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "paid_by", "payment_method_id"}).AddRow(50, nil, nil).AddRow(51, 3, nil))
+	mock.ExpectQuery(`INSERT INTO "shopping_amount_splits" .* RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 51, 3, 0, sqlmock.AnyArg(), sqlmock.AnyArg(), 51, 5, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+	mock.ExpectExec(`INSERT INTO "shopping_amount_tags" \("shopping_amount_id","tag_id"\) VALUES \(\$1,\$2\)`).
+		WithArgs(50, 8).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO "income_categories" .* RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 20, "給与", "#00FF00", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+	mock.ExpectQuery(`INSERT INTO "incomes" .* RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 20, 10, 300000, time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC), "").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "payment_method_id"}).AddRow(1, nil, nil))
+	mock.ExpectQuery(`INSERT INTO "recurring_transactions" .* RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 20, 31, 980, "", "monthly", 1, 1,
+			time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC), nil, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectQuery(`INSERT INTO "recurring_occurrence_overrides" .* RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 2, time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC), true, nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`INSERT INTO "settlements" .* RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 20, 5, 3, 600, time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC), "").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`INSERT INTO "chat_messages" .* RETURNING "id"`).
+		WithArgs(20, 0, "system", "予算の80%を超えました", createdAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	result, err := repo.RestoreHouseHoldBackup(backup, &domainmodel.RestoreBackupInput{UserID: 3, IncludeMembers: true}, map[string]string{})
+	assert.NoError(t, err)
+	assert.Equal(t, domainmodel.HouseHoldID(20), result.HouseHoldID)
+	assert.Equal(t, 1, result.SkippedChatMessages)
+	assert.Equal(t, 1, result.SkippedSettlements)
+	assert.Equal(t, 1, result.Counts[domainmodel.BackupSettlementsFile])
+	assert.Equal(t, 1, result.Counts[domainmodel.BackupRecurringTransactionsFile])
+	assert.Equal(t, 1, result.Counts[domainmodel.BackupChatMessagesFile])
+	assert.Equal(t, 2, result.Counts[domainmodel.BackupMembershipsFile])
+	assert.Equal(t, 1, result.Counts[domainmodel.BackupExchangeRatesFile])
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
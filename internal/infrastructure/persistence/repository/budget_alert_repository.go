@@ -0,0 +1,49 @@
+package repository
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BudgetAlertRepository struct {
+	db *gorm.DB
+}
+
+// CreateIfNotExists implements domainmodel.BudgetAlertRepository.
+// 同時に支出が登録された場合でも一度だけ通知されるよう、一意制約で重複を判定する
+func (r *BudgetAlertRepository) CreateIfNotExists(alert *domainmodel.BudgetAlert) (bool, error) {
+	model := &models.BudgetAlert{
+		HouseholdBookID: uint(alert.HouseHoldID),
+		CategoryID:      uint(alert.CategoryID),
+		Month:           alert.Month,
+		Threshold:       alert.Threshold,
+		Budget:          alert.Budget,
+		Actual:          alert.Actual,
+	}
+
+	result := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "household_book_id"}, {Name: "category_id"}, {Name: "month"}, {Name: "threshold"}},
+		DoNothing: true,
+	}).Create(model)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	alert.ID = domainmodel.BudgetAlertID(model.ID)
+	alert.CreatedAt = model.CreatedAt
+
+	return true, nil
+}
+
+func NewBudgetAlertRepository(db *gorm.DB) domainmodel.BudgetAlertRepository {
+	return &BudgetAlertRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+)
+
+func TestBudgetAlertRepository_CreateIfNotExists(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewBudgetAlertRepository(gormDB)
+
+	alert := &domainmodel.BudgetAlert{HouseHoldID: 1, CategoryID: 2, Month: "2026-10", Threshold: 80, Budget: 30000, Actual: 24000}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "budget_alerts" .* ON CONFLICT \("household_book_id","category_id","month","threshold"\) DO NOTHING RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1, 2, "2026-10", 80, 30000, 24000).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	mock.ExpectCommit()
+
+	created, err := repo.CreateIfNotExists(alert)
+	assert.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, domainmodel.BudgetAlertID(5), alert.ID)
+
+	// 同じ月・閾値のアラートが登録済みの場合は登録しない
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "budget_alerts" .* ON CONFLICT \("household_book_id","category_id","month","threshold"\) DO NOTHING RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1, 2, "2026-10", 80, 30000, 24000).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectCommit()
+
+	created, err = repo.CreateIfNotExists(&domainmodel.BudgetAlert{HouseHoldID: 1, CategoryID: 2, Month: "2026-10", Threshold: 80, Budget: 30000, Actual: 24000})
+	assert.NoError(t, err)
+	assert.False(t, created)
+}
@@ -4,6 +4,7 @@ import (
 	domainmodel "echo-household-budget/internal/domain/model"
 	"echo-household-budget/internal/infrastructure/persistence/models"
 	"errors"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -26,12 +27,18 @@ func (r *CategoryRepository) CreateHouseHoldCategory(categoryLimit *domainmodel.
 		HouseholdBookID: uint(categoryLimit.HouseholdBookID),
 		CategoryID:      uint(categoryLimit.Category.ID),
 		LimitAmount:     categoryLimit.LimitAmount,
+		Name:            categoryLimit.Category.Name,
+		Color:           categoryLimit.Category.Color,
+		Icon:            categoryLimit.Category.Icon,
+		SortOrder:       categoryLimit.SortOrder,
 	}
 
 	if err := r.db.Create(model).Error; err != nil {
 		return err
 	}
 
+	categoryLimit.ID = domainmodel.CategoryLimitID(model.ID)
+
 	return nil
 }
 
@@ -52,8 +59,9 @@ func (r *CategoryRepository) CreateMasterCategory(category *domainmodel.Category
 }
 
 // DeleteHouseHoldCategory implements domainmodel.CategoryRepository.
-func (r *CategoryRepository) DeleteHouseHoldCategory(categoryLimitID domainmodel.CategoryLimitID) error {
-	result := r.db.Delete(&models.CategoryLimit{}, categoryLimitID)
+// カテゴリはゴミ箱に移し、保持期間が過ぎるまでは復元できるようにする
+func (r *CategoryRepository) DeleteHouseHoldCategory(houseHoldID domainmodel.HouseHoldID, categoryLimitID domainmodel.CategoryLimitID, deletion *domainmodel.Deletion) error {
+	result := softDelete(r.db.Where("id = ? AND household_book_id = ?", categoryLimitID, houseHoldID), &models.CategoryLimit{}, deletion)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -89,16 +97,27 @@ func (r *CategoryRepository) FindHouseHoldCategoryByHouseHoldID(categoryLimitID
 		return nil, err
 	}
 
-	return &domainmodel.CategoryLimit{
-		ID:              domainmodel.CategoryLimitID(model.ID),
-		HouseholdBookID: domainmodel.HouseHoldID(model.HouseholdBookID),
-		Category: domainmodel.Category{
-			ID:    domainmodel.CategoryID(model.CategoryID),
-			Name:  model.Category.Name,
-			Color: model.Category.Color,
-		},
-		LimitAmount: model.LimitAmount,
-	}, nil
+	return convertCategoryLimit(&model), nil
+}
+
+// FindHouseHoldCategories implements domainmodel.CategoryRepository.
+func (r *CategoryRepository) FindHouseHoldCategories(houseHoldID domainmodel.HouseHoldID, includeArchived bool) ([]*domainmodel.CategoryLimit, error) {
+	query := r.db.Where("household_book_id = ?", houseHoldID)
+	if !includeArchived {
+		query = query.Scopes(activeCategoryLimits)
+	}
+
+	categoryLimits := []*models.CategoryLimit{}
+	if err := query.Order("sort_order, id").Find(&categoryLimits).Error; err != nil {
+		return nil, err
+	}
+
+	output := make([]*domainmodel.CategoryLimit, len(categoryLimits))
+	for i, categoryLimit := range categoryLimits {
+		output[i] = convertCategoryLimit(categoryLimit)
+	}
+
+	return output, nil
 }
 
 // FindMasterCategoryByID implements domainmodel.CategoryRepository.
@@ -119,22 +138,53 @@ func (r *CategoryRepository) FindMasterCategoryByID(categoryID domainmodel.Categ
 }
 
 // UpdateHouseHoldCategory implements domainmodel.CategoryRepository.
+// 家計簿ごとの名前・色・アイコン・上限金額を更新する（他の家計簿のカテゴリは更新しない）
 func (r *CategoryRepository) UpdateHouseHoldCategory(categoryLimit *domainmodel.CategoryLimit) error {
-	model := &models.CategoryLimit{
-		Base: models.Base{
-			ID: uint(categoryLimit.ID),
-		},
-		HouseholdBookID: uint(categoryLimit.HouseholdBookID),
-		CategoryID:      uint(categoryLimit.Category.ID),
-		LimitAmount:     categoryLimit.LimitAmount,
+	result := r.db.Model(&models.CategoryLimit{}).
+		Where("id = ? AND household_book_id = ?", categoryLimit.ID, categoryLimit.HouseholdBookID).
+		Updates(map[string]interface{}{
+			"name":         categoryLimit.Category.Name,
+			"color":        categoryLimit.Category.Color,
+			"icon":         categoryLimit.Category.Icon,
+			"limit_amount": categoryLimit.LimitAmount,
+		})
+
+	if result.Error != nil {
+		return result.Error
 	}
 
-	result := r.db.Model(model).Updates(map[string]interface{}{
-		"household_book_id": categoryLimit.HouseholdBookID,
-		"category_id":       categoryLimit.Category.ID,
-		"limit_amount":      categoryLimit.LimitAmount,
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// UpdateHouseHoldCategorySortOrders implements domainmodel.CategoryRepository.
+// categoryLimitIDs の順に並び順を振り直す
+func (r *CategoryRepository) UpdateHouseHoldCategorySortOrders(houseHoldID domainmodel.HouseHoldID, categoryLimitIDs []domainmodel.CategoryLimitID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for i, categoryLimitID := range categoryLimitIDs {
+			result := tx.Model(&models.CategoryLimit{}).
+				Where("id = ? AND household_book_id = ?", categoryLimitID, houseHoldID).
+				Update("sort_order", i+1)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return gorm.ErrRecordNotFound
+			}
+		}
+		return nil
 	})
+}
 
+// ArchiveHouseHoldCategory implements domainmodel.CategoryRepository.
+// archivedAt に nil を指定するとアーカイブを解除する
+func (r *CategoryRepository) ArchiveHouseHoldCategory(houseHoldID domainmodel.HouseHoldID, categoryLimitID domainmodel.CategoryLimitID, archivedAt *time.Time) error {
+	result := r.db.Model(&models.CategoryLimit{}).
+		Where("id = ? AND household_book_id = ?", categoryLimitID, houseHoldID).
+		Update("archived_at", archivedAt)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -171,3 +221,26 @@ func (r *CategoryRepository) UpdateMasterCategory(category *domainmodel.Category
 
 	return nil
 }
+
+// activeCategoryLimits はアーカイブされていないカテゴリに絞り込むスコープ
+func activeCategoryLimits(db *gorm.DB) *gorm.DB {
+	return db.Where("archived_at IS NULL")
+}
+
+// convertCategoryLimit は家計簿のカテゴリ設定をドメインモデルに変換する
+// 表示に用いる名前・色・アイコンはマスタではなく家計簿ごとの値を用いる
+func convertCategoryLimit(model *models.CategoryLimit) *domainmodel.CategoryLimit {
+	return &domainmodel.CategoryLimit{
+		ID:              domainmodel.CategoryLimitID(model.ID),
+		HouseholdBookID: domainmodel.HouseHoldID(model.HouseholdBookID),
+		Category: domainmodel.Category{
+			ID:    domainmodel.CategoryID(model.CategoryID),
+			Name:  model.Name,
+			Color: model.Color,
+			Icon:  model.Icon,
+		},
+		LimitAmount: model.LimitAmount,
+		SortOrder:   model.SortOrder,
+		ArchivedAt:  model.ArchivedAt,
+	}
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
@@ -142,12 +143,14 @@ func TestCategoryRepository_CreateHouseHoldCategory(t *testing.T) {
 	// SQLクエリのモック
 	mock.ExpectBegin()
 	mock.ExpectQuery(`INSERT INTO "category_limits"`).
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), categoryLimit.HouseholdBookID, categoryLimit.Category.ID, categoryLimit.LimitAmount).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), categoryLimit.HouseholdBookID, categoryLimit.Category.ID, categoryLimit.LimitAmount,
+			categoryLimit.Category.Name, categoryLimit.Category.Color, categoryLimit.Category.Icon, categoryLimit.SortOrder, nil, nil).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 	mock.ExpectCommit()
 
 	err := repo.CreateHouseHoldCategory(categoryLimit)
 	assert.NoError(t, err)
+	assert.Equal(t, domainmodel.CategoryLimitID(1), categoryLimit.ID)
 }
 
 func TestCategoryRepository_FindHouseHoldCategoryByHouseHoldID(t *testing.T) {
@@ -155,7 +158,7 @@ func TestCategoryRepository_FindHouseHoldCategoryByHouseHoldID(t *testing.T) {
 	repo := NewCategoryRepository(gormDB)
 
 	// SQLクエリのモック
-	mock.ExpectQuery(`SELECT \* FROM "category_limits" WHERE "category_limits"."id" = \$1 ORDER BY "category_limits"."id" LIMIT \$2`).
+	mock.ExpectQuery(`SELECT \* FROM "category_limits" WHERE "category_limits"."id" = \$1 AND "category_limits"."deleted_at" IS NULL ORDER BY "category_limits"."id" LIMIT \$2`).
 		WithArgs(1, 1).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "household_book_id", "category_id", "limit_amount", "created_at", "updated_at"}).
 			AddRow(1, 1, 1, 10000, nil, nil))
@@ -169,6 +172,90 @@ func TestCategoryRepository_FindHouseHoldCategoryByHouseHoldID(t *testing.T) {
 	assert.Equal(t, 10000, categoryLimit.LimitAmount)
 }
 
+func TestCategoryRepository_FindHouseHoldCategories(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewCategoryRepository(gormDB)
+
+	// アーカイブ済みを除外する場合
+	mock.ExpectQuery(`SELECT \* FROM "category_limits" WHERE household_book_id = \$1 AND archived_at IS NULL AND "category_limits"."deleted_at" IS NULL ORDER BY sort_order, id`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "household_book_id", "category_id", "limit_amount", "name", "color", "icon", "sort_order", "archived_at"}).
+			AddRow(2, 1, 2, 5000, "日用品", "#00FF00", "cart", 1, nil).
+			AddRow(1, 1, 1, 10000, "食費", "#FF0000", "", 2, nil))
+
+	categoryLimits, err := repo.FindHouseHoldCategories(1, false)
+	assert.NoError(t, err)
+	assert.Len(t, categoryLimits, 2)
+	assert.Equal(t, domainmodel.CategoryLimitID(2), categoryLimits[0].ID)
+	assert.Equal(t, "日用品", categoryLimits[0].Category.Name)
+	assert.Equal(t, "cart", categoryLimits[0].Category.Icon)
+	assert.Equal(t, 1, categoryLimits[0].SortOrder)
+
+	// アーカイブ済みを含める場合
+	mock.ExpectQuery(`SELECT \* FROM "category_limits" WHERE household_book_id = \$1 AND "category_limits"."deleted_at" IS NULL ORDER BY sort_order, id`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "household_book_id", "category_id", "limit_amount", "name", "color", "icon", "sort_order", "archived_at"}).
+			AddRow(3, 1, 3, 0, "旅行", "#0000FF", "", 3, time.Now()))
+
+	categoryLimits, err = repo.FindHouseHoldCategories(1, true)
+	assert.NoError(t, err)
+	assert.Len(t, categoryLimits, 1)
+	assert.True(t, categoryLimits[0].IsArchived())
+}
+
+func TestCategoryRepository_UpdateHouseHoldCategorySortOrders(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewCategoryRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "category_limits" SET "sort_order"=\$1,"updated_at"=\$2 WHERE \(id = \$3 AND household_book_id = \$4\) AND "category_limits"."deleted_at" IS NULL`).
+		WithArgs(1, sqlmock.AnyArg(), 2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "category_limits" SET "sort_order"=\$1,"updated_at"=\$2 WHERE \(id = \$3 AND household_book_id = \$4\) AND "category_limits"."deleted_at" IS NULL`).
+		WithArgs(2, sqlmock.AnyArg(), 1, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.UpdateHouseHoldCategorySortOrders(1, []domainmodel.CategoryLimitID{2, 1})
+	assert.NoError(t, err)
+
+	// 他の家計簿のカテゴリが含まれる場合はロールバックする
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "category_limits" SET "sort_order"=\$1,"updated_at"=\$2 WHERE \(id = \$3 AND household_book_id = \$4\) AND "category_limits"."deleted_at" IS NULL`).
+		WithArgs(1, sqlmock.AnyArg(), 999, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err = repo.UpdateHouseHoldCategorySortOrders(1, []domainmodel.CategoryLimitID{999, 1})
+	assert.Equal(t, gorm.ErrRecordNotFound, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCategoryRepository_ArchiveHouseHoldCategory(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewCategoryRepository(gormDB)
+
+	archivedAt := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "category_limits" SET "archived_at"=\$1,"updated_at"=\$2 WHERE \(id = \$3 AND household_book_id = \$4\) AND "category_limits"."deleted_at" IS NULL`).
+		WithArgs(&archivedAt, sqlmock.AnyArg(), 1, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.ArchiveHouseHoldCategory(1, 1, &archivedAt)
+	assert.NoError(t, err)
+
+	// 他の家計簿のカテゴリは更新できない
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "category_limits" SET "archived_at"=\$1,"updated_at"=\$2 WHERE \(id = \$3 AND household_book_id = \$4\) AND "category_limits"."deleted_at" IS NULL`).
+		WithArgs(nil, sqlmock.AnyArg(), 1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err = repo.ArchiveHouseHoldCategory(2, 1, nil)
+	assert.Equal(t, gorm.ErrRecordNotFound, err)
+}
+
 func TestCategoryRepository_UpdateHouseHoldCategory(t *testing.T) {
 	gormDB, mock := setupTest(t)
 	repo := NewCategoryRepository(gormDB)
@@ -186,8 +273,8 @@ func TestCategoryRepository_UpdateHouseHoldCategory(t *testing.T) {
 
 	// SQLクエリのモック
 	mock.ExpectBegin()
-	mock.ExpectExec(`UPDATE "category_limits" SET "category_id"=\$1,"household_book_id"=\$2,"limit_amount"=\$3,"updated_at"=\$4 WHERE "id" = \$5`).
-		WithArgs(categoryLimit.Category.ID, categoryLimit.HouseholdBookID, categoryLimit.LimitAmount, sqlmock.AnyArg(), categoryLimit.ID).
+	mock.ExpectExec(`UPDATE "category_limits" SET "color"=\$1,"icon"=\$2,"limit_amount"=\$3,"name"=\$4,"updated_at"=\$5 WHERE \(id = \$6 AND household_book_id = \$7\) AND "category_limits"."deleted_at" IS NULL`).
+		WithArgs(categoryLimit.Category.Color, categoryLimit.Category.Icon, categoryLimit.LimitAmount, categoryLimit.Category.Name, sqlmock.AnyArg(), categoryLimit.ID, categoryLimit.HouseholdBookID).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -201,13 +288,14 @@ func TestCategoryRepository_DeleteHouseHoldCategory(t *testing.T) {
 
 	// SQLクエリのモック
 	mock.ExpectBegin()
-	mock.ExpectExec(`DELETE FROM "category_limits" WHERE "category_limits"."id" = \$1`).
-		WithArgs(1).
+	mock.ExpectExec(`UPDATE "category_limits" SET "delete_batch_id"=\$1,"deleted_at"=\$2,"deleted_by"=\$3,"updated_at"=\$4 WHERE \(id = \$5 AND household_book_id = \$6\) AND "category_limits"."deleted_at" IS NULL`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, sqlmock.AnyArg(), 1, 1).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
-	err := repo.DeleteHouseHoldCategory(1)
+	err := repo.DeleteHouseHoldCategory(1, 1, domainmodel.NewDeletion(nil))
 	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestCategoryRepository_HouseHoldCategoryNotFound(t *testing.T) {
@@ -215,7 +303,7 @@ func TestCategoryRepository_HouseHoldCategoryNotFound(t *testing.T) {
 	repo := NewCategoryRepository(gormDB)
 
 	// FindHouseHoldCategoryByHouseHoldID - Not Found
-	mock.ExpectQuery(`SELECT \* FROM "category_limits" WHERE "category_limits"."id" = \$1 ORDER BY "category_limits"."id" LIMIT \$2`).
+	mock.ExpectQuery(`SELECT \* FROM "category_limits" WHERE "category_limits"."id" = \$1 AND "category_limits"."deleted_at" IS NULL ORDER BY "category_limits"."id" LIMIT \$2`).
 		WithArgs(999, 1).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "household_book_id", "category_id", "limit_amount"}))
 
@@ -236,8 +324,8 @@ func TestCategoryRepository_HouseHoldCategoryNotFound(t *testing.T) {
 	}
 
 	mock.ExpectBegin()
-	mock.ExpectExec(`UPDATE "category_limits" SET "category_id"=\$1,"household_book_id"=\$2,"limit_amount"=\$3,"updated_at"=\$4 WHERE "id" = \$5`).
-		WithArgs(categoryLimit.Category.ID, categoryLimit.HouseholdBookID, categoryLimit.LimitAmount, sqlmock.AnyArg(), categoryLimit.ID).
+	mock.ExpectExec(`UPDATE "category_limits" SET "color"=\$1,"icon"=\$2,"limit_amount"=\$3,"name"=\$4,"updated_at"=\$5 WHERE \(id = \$6 AND household_book_id = \$7\) AND "category_limits"."deleted_at" IS NULL`).
+		WithArgs(categoryLimit.Category.Color, categoryLimit.Category.Icon, categoryLimit.LimitAmount, categoryLimit.Category.Name, sqlmock.AnyArg(), categoryLimit.ID, categoryLimit.HouseholdBookID).
 		WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectCommit()
 
@@ -246,11 +334,11 @@ func TestCategoryRepository_HouseHoldCategoryNotFound(t *testing.T) {
 
 	// DeleteHouseHoldCategory - Not Found
 	mock.ExpectBegin()
-	mock.ExpectExec(`DELETE FROM "category_limits" WHERE "category_limits"."id" = \$1`).
-		WithArgs(999).
+	mock.ExpectExec(`UPDATE "category_limits" SET "delete_batch_id"=\$1,"deleted_at"=\$2,"deleted_by"=\$3,"updated_at"=\$4 WHERE \(id = \$5 AND household_book_id = \$6\) AND "category_limits"."deleted_at" IS NULL`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, sqlmock.AnyArg(), 999, 1).
 		WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectCommit()
 
-	err = repo.DeleteHouseHoldCategory(999)
+	err = repo.DeleteHouseHoldCategory(1, 999, domainmodel.NewDeletion(nil))
 	assert.Equal(t, gorm.ErrRecordNotFound, err)
 }
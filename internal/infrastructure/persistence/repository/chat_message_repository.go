@@ -61,5 +61,10 @@ func (r *chatMessageRepository) Create(input *domainmodel.ChatMessage) error {
 		Content:     input.Content,
 	}
 
-	return r.db.Create(chatMessage).Error
+	if err := r.db.Create(chatMessage).Error; err != nil {
+		return err
+	}
+
+	input.ID = chatMessage.ID
+	return nil
 }
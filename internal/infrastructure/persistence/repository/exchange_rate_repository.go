@@ -0,0 +1,98 @@
+package repository
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ExchangeRateRepository struct {
+	db *gorm.DB
+}
+
+// FindExchangeRates implements domainmodel.ExchangeRateRepository.
+func (r *ExchangeRateRepository) FindExchangeRates(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.ExchangeRate, error) {
+	rates := []models.ExchangeRate{}
+	if err := r.db.Where("household_book_id = ?", houseHoldID).Order("currency, effective_date, id").Find(&rates).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*domainmodel.ExchangeRate, len(rates))
+	for i := range rates {
+		result[i] = convertExchangeRate(&rates[i])
+	}
+	return result, nil
+}
+
+// FindEffectiveExchangeRate implements domainmodel.ExchangeRateRepository.
+// date 以前で最も新しい適用日のレートを返す
+func (r *ExchangeRateRepository) FindEffectiveExchangeRate(houseHoldID domainmodel.HouseHoldID, currency domainmodel.Currency, date string) (*domainmodel.ExchangeRate, error) {
+	rate := &models.ExchangeRate{}
+	if err := r.db.Where("household_book_id = ? AND currency = ? AND effective_date <= ?", houseHoldID, string(currency), date).
+		Order("effective_date DESC").
+		First(rate).Error; err != nil {
+		return nil, err
+	}
+
+	return convertExchangeRate(rate), nil
+}
+
+// SaveExchangeRate implements domainmodel.ExchangeRateRepository.
+func (r *ExchangeRateRepository) SaveExchangeRate(rate *domainmodel.ExchangeRate) error {
+	effectiveDate, err := time.Parse("2006-01-02", rate.EffectiveDate)
+	if err != nil {
+		return domainmodel.ErrInvalidExchangeRateDate
+	}
+	model := &models.ExchangeRate{
+		HouseholdBookID: uint(rate.HouseHoldID),
+		Currency:        string(rate.Currency),
+		Rate:            rate.Rate,
+		EffectiveDate:   effectiveDate,
+	}
+	if err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "household_book_id"}, {Name: "currency"}, {Name: "effective_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+	}).Create(model).Error; err != nil {
+		return err
+	}
+
+	rate.ID = domainmodel.ExchangeRateID(model.ID)
+
+	return nil
+}
+
+// DeleteExchangeRate implements domainmodel.ExchangeRateRepository.
+// 削除したレートで換算済みの支出は、支出に保存したレートのまま変更しない
+func (r *ExchangeRateRepository) DeleteExchangeRate(houseHoldID domainmodel.HouseHoldID, id domainmodel.ExchangeRateID) error {
+	result := r.db.Where("id = ? AND household_book_id = ?", id, houseHoldID).Delete(&models.ExchangeRate{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func convertExchangeRate(model *models.ExchangeRate) *domainmodel.ExchangeRate {
+	rate, err := domainmodel.NormalizeExchangeRate(model.Rate)
+	if err != nil {
+		rate = model.Rate
+	}
+	return &domainmodel.ExchangeRate{
+		ID:            domainmodel.ExchangeRateID(model.ID),
+		HouseHoldID:   domainmodel.HouseHoldID(model.HouseholdBookID),
+		Currency:      domainmodel.Currency(model.Currency),
+		Rate:          rate,
+		EffectiveDate: model.EffectiveDate.Format("2006-01-02"),
+	}
+}
+
+func NewExchangeRateRepository(db *gorm.DB) domainmodel.ExchangeRateRepository {
+	return &ExchangeRateRepository{db: db}
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+)
+
+func TestExchangeRateRepository_FindEffectiveExchangeRate(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewExchangeRateRepository(gormDB)
+
+	mock.ExpectQuery(`SELECT \* FROM "exchange_rates" WHERE household_book_id = \$1 AND currency = \$2 AND effective_date <= \$3 ORDER BY effective_date DESC`).
+		WithArgs(10, "USD", "2026-10-18", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "household_book_id", "currency", "rate", "effective_date"}).
+			AddRow(3, 10, "USD", "149.5000000000", time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)))
+
+	rate, err := repo.FindEffectiveExchangeRate(10, "USD", "2026-10-18")
+	assert.NoError(t, err)
+	assert.Equal(t, &domainmodel.ExchangeRate{ID: 3, HouseHoldID: 10, Currency: "USD", Rate: "149.5", EffectiveDate: "2026-10-01"}, rate)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	domainmodel "echo-household-budget/internal/domain/model"
 	"echo-household-budget/internal/infrastructure/persistence/models"
+	"errors"
 
 	"gorm.io/gorm"
 )
@@ -13,9 +14,13 @@ type HouseHoldRepository struct {
 
 // Create implements domainmodel.HouseHoldRepository.
 func (h *HouseHoldRepository) Create(houseHold *domainmodel.HouseHold) error {
+	if houseHold.BaseCurrency == "" {
+		houseHold.BaseCurrency = domainmodel.DefaultCurrency
+	}
 	model := &models.HouseholdBook{
-		Title:       houseHold.Title,
-		Description: houseHold.Description,
+		Title:        houseHold.Title,
+		Description:  houseHold.Description,
+		BaseCurrency: string(houseHold.BaseCurrency),
 	}
 
 	if err := h.db.Create(model).Error; err != nil {
@@ -32,6 +37,7 @@ func (h *HouseHoldRepository) CreateUserHouseHold(userHouseHold *domainmodel.Use
 	model := &models.UserHouseHold{
 		UserID:      uint(userHouseHold.UserID),
 		HouseholdID: uint(userHouseHold.HouseHoldID),
+		Role:        string(userHouseHold.Role),
 	}
 
 	if err := h.db.Create(model).Error; err != nil {
@@ -43,44 +49,280 @@ func (h *HouseHoldRepository) CreateUserHouseHold(userHouseHold *domainmodel.Use
 	return nil
 }
 
+// FindUserHouseHold implements domainmodel.HouseHoldRepository.
+// 家計簿に所属していない場合は nil を返す
+func (h *HouseHoldRepository) FindUserHouseHold(userID domainmodel.UserID, houseHoldID domainmodel.HouseHoldID) (*domainmodel.UserHouseHold, error) {
+	model := &models.UserHouseHold{}
+	if err := h.db.Where("user_id = ? AND household_id = ?", userID, houseHoldID).First(model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &domainmodel.UserHouseHold{
+		UserID:      domainmodel.UserID(model.UserID),
+		HouseHoldID: domainmodel.HouseHoldID(model.HouseholdID),
+		Role:        domainmodel.HouseHoldRole(model.Role),
+	}, nil
+}
+
+// UpdateUserHouseHoldRole implements domainmodel.HouseHoldRepository.
+func (h *HouseHoldRepository) UpdateUserHouseHoldRole(houseHoldID domainmodel.HouseHoldID, userID domainmodel.UserID, role domainmodel.HouseHoldRole) error {
+	result := h.db.Model(&models.UserHouseHold{}).
+		Where("user_id = ? AND household_id = ?", userID, houseHoldID).
+		Update("role", string(role))
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// TransferOwnership implements domainmodel.HouseHoldRepository.
+// 所有者は家計簿に一人のため、現在の所有者を編集者に変更した上で新しい所有者を設定する
+func (h *HouseHoldRepository) TransferOwnership(houseHoldID domainmodel.HouseHoldID, fromUserID domainmodel.UserID, toUserID domainmodel.UserID) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.UserHouseHold{}).
+			Where("user_id = ? AND household_id = ? AND role = ?", fromUserID, houseHoldID, string(domainmodel.HouseHoldRoleOwner)).
+			Update("role", string(domainmodel.HouseHoldRoleEditor))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		result = tx.Model(&models.UserHouseHold{}).
+			Where("user_id = ? AND household_id = ?", toUserID, houseHoldID).
+			Update("role", string(domainmodel.HouseHoldRoleOwner))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	})
+}
+
+// FindMembers implements domainmodel.HouseHoldRepository.
+func (h *HouseHoldRepository) FindMembers(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.HouseHoldMember, error) {
+	rows := []struct {
+		UserID     uint
+		Name       string
+		PictureURL string
+		Role       string
+	}{}
+	if err := h.db.Model(&models.UserHouseHold{}).
+		Select("user_households.user_id, user_accounts.name, user_accounts.picture_url, user_households.role").
+		Joins("JOIN user_accounts ON user_accounts.id = user_households.user_id").
+		Where("user_households.household_id = ?", houseHoldID).
+		Order("user_households.id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	members := make([]*domainmodel.HouseHoldMember, len(rows))
+	for i, row := range rows {
+		members[i] = &domainmodel.HouseHoldMember{
+			UserID:     domainmodel.UserID(row.UserID),
+			Name:       row.Name,
+			PictureURL: row.PictureURL,
+			Role:       domainmodel.HouseHoldRole(row.Role),
+		}
+	}
+
+	return members, nil
+}
+
+// DeleteUserHouseHold implements domainmodel.HouseHoldRepository.
+func (h *HouseHoldRepository) DeleteUserHouseHold(houseHoldID domainmodel.HouseHoldID, userID domainmodel.UserID) error {
+	result := h.db.Where("user_id = ? AND household_id = ?", userID, houseHoldID).Delete(&models.UserHouseHold{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// UpdateBaseCurrency implements domainmodel.HouseHoldRepository.
+// 金額は基準通貨の補助単位で保存しているため、基準通貨を変更すると登録済みの金額の意味が変わる
+// 金額が登録済みの家計簿は変更しない。ゴミ箱の記録も復元すると変更前の基準通貨の金額に戻るため数える
+func (h *HouseHoldRepository) UpdateBaseCurrency(houseHoldID domainmodel.HouseHoldID, currency domainmodel.Currency) error {
+	return h.db.Transaction(func(tx *gorm.DB) error {
+		amounts := []struct {
+			model interface{}
+			query string
+		}{
+			{&models.ShoppingAmount{}, "household_book_id = ?"},
+			{&models.Income{}, "household_book_id = ?"},
+			{&models.Settlement{}, "household_book_id = ?"},
+			{&models.RecurringTransaction{}, "household_book_id = ?"},
+			// 予算・上限・開始残高は既定の 0 のままであれば変更できる
+			{&models.MonthlyBudget{}, "household_book_id = ? AND amount <> 0"},
+			{&models.CategoryLimit{}, "household_book_id = ? AND limit_amount <> 0"},
+			{&models.PaymentMethod{}, "household_book_id = ? AND opening_balance <> 0"},
+		}
+		for _, amount := range amounts {
+			var count int64
+			if err := tx.Unscoped().Model(amount.model).Where(amount.query, houseHoldID).Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return domainmodel.ErrBaseCurrencyInUse
+			}
+		}
+
+		result := tx.Model(&models.HouseholdBook{}).Where("id = ?", houseHoldID).Update("base_currency", string(currency))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		// 基準通貨と同じ通貨のレートは換算に使わないため削除する
+		return tx.Where("household_book_id = ? AND currency = ?", houseHoldID, string(currency)).Delete(&models.ExchangeRate{}).Error
+	})
+}
+
 // Delete implements domainmodel.HouseHoldRepository.
-func (h *HouseHoldRepository) Delete(houseHoldID domainmodel.HouseHoldID) error {
-	panic("unimplemented")
+// 家計簿に紐づく記録・収入・予算・カテゴリ上限・タグ・レシート・チャット履歴・招待・所属を一つのトランザクションで削除する
+// ゴミ箱に移した記録も含めて物理削除する。レシートの画像のファイル名は削除する前に取得して返す
+func (h *HouseHoldRepository) Delete(houseHoldID domainmodel.HouseHoldID) ([]string, error) {
+	imageFiles := []string{}
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		tx = tx.Unscoped().Session(&gorm.Session{})
+		if err := tx.Model(&models.ReceiptAnalyzes{}).Where("household_book_id = ? AND image_url <> ''", houseHoldID).Distinct().Pluck("image_url", &imageFiles).Error; err != nil {
+			return err
+		}
+
+		shoppingAmountIDs := tx.Model(&models.ShoppingAmount{}).Select("id").Where("household_book_id = ?", houseHoldID)
+		receiptAnalyzeIDs := tx.Model(&models.ReceiptAnalyzes{}).Select("id").Where("household_book_id = ?", houseHoldID)
+		receiptItemIDs := tx.Model(&models.ReceiptAnalyzeItems{}).Select("id").Where("receipt_analyze_id IN (?)", receiptAnalyzeIDs)
+		invitationIDs := tx.Model(&models.HouseholdInvitation{}).Select("id").Where("household_id = ?", houseHoldID)
+		recurringTransactionIDs := tx.Model(&models.RecurringTransaction{}).Select("id").Where("household_book_id = ?", houseHoldID)
+
+		// 外部キーの参照元から順に削除する
+		targets := []struct {
+			model interface{}
+			query string
+			arg   interface{}
+		}{
+			{&models.ShoppingAmountTag{}, "shopping_amount_id IN (?)", shoppingAmountIDs},
+			{&models.ShoppingAmountSplit{}, "shopping_amount_id IN (?)", shoppingAmountIDs},
+			{&models.ShoppingAmount{}, "household_book_id = ?", houseHoldID},
+			{&models.Settlement{}, "household_book_id = ?", houseHoldID},
+			{&models.ReceiptAnalyzeItemTag{}, "receipt_analyze_item_id IN (?)", receiptItemIDs},
+			{&models.ReceiptAnalyzeItems{}, "receipt_analyze_id IN (?)", receiptAnalyzeIDs},
+			{&models.ReceiptAnalyzes{}, "household_book_id = ?", houseHoldID},
+			{&models.ShoppingMemo{}, "household_book_id = ?", houseHoldID},
+			{&models.RecurringOccurrenceOverride{}, "recurring_transaction_id IN (?)", recurringTransactionIDs},
+			{&models.RecurringTransaction{}, "household_book_id = ?", houseHoldID},
+			{&models.Income{}, "household_book_id = ?", houseHoldID},
+			{&models.IncomeCategory{}, "household_book_id = ?", houseHoldID},
+			{&models.PaymentMethod{}, "household_book_id = ?", houseHoldID},
+			{&models.Tag{}, "household_book_id = ?", houseHoldID},
+			{&models.ExchangeRate{}, "household_book_id = ?", houseHoldID},
+			{&models.BudgetAlert{}, "household_book_id = ?", houseHoldID},
+			{&models.MonthlyBudget{}, "household_book_id = ?", houseHoldID},
+			{&models.CategoryLimit{}, "household_book_id = ?", houseHoldID},
+			{&models.ChatMessage{}, "household_id = ?", houseHoldID},
+			{&models.HouseholdInvitationResponse{}, "invitation_id IN (?)", invitationIDs},
+			{&models.HouseholdInvitation{}, "household_id = ?", houseHoldID},
+			{&models.UserHouseHold{}, "household_id = ?", houseHoldID},
+		}
+		for _, target := range targets {
+			if err := tx.Where(target.query, target.arg).Delete(target.model).Error; err != nil {
+				return err
+			}
+		}
+
+		result := tx.Where("id = ?", houseHoldID).Delete(&models.HouseholdBook{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return imageFiles, nil
 }
 
 // FindByHouseHoldID implements domainmodel.HouseHoldRepository.
 func (h *HouseHoldRepository) FindByHouseHoldID(houseHoldID domainmodel.HouseHoldID) (*domainmodel.HouseHold, error) {
 	model := &models.HouseholdBook{}
 	if err := h.db.Where("id = ?", houseHoldID).
-		Preload("CategoryLimits").
-		Preload("CategoryLimits.Category").
+		Preload("CategoryLimits", func(db *gorm.DB) *gorm.DB {
+			return db.Scopes(activeCategoryLimits).Order("sort_order, id")
+		}).
 		First(model).Error; err != nil {
 		return nil, err
 	}
 
 	categoryLimits := make([]*domainmodel.CategoryLimit, len(model.CategoryLimits))
-	for i, categoryLimit := range model.CategoryLimits {
-		categoryLimits[i] = &domainmodel.CategoryLimit{
-			Category: domainmodel.Category{
-				ID:    domainmodel.CategoryID(categoryLimit.Category.ID),
-				Name:  categoryLimit.Category.Name,
-				Color: categoryLimit.Category.Color,
-			},
-			LimitAmount: categoryLimit.LimitAmount,
-		}
+	for i := range model.CategoryLimits {
+		categoryLimits[i] = convertCategoryLimit(&model.CategoryLimits[i])
 	}
 
 	return &domainmodel.HouseHold{
 		ID:            domainmodel.HouseHoldID(model.ID),
 		Title:         model.Title,
 		Description:   model.Description,
+		BaseCurrency:  domainmodel.Currency(model.BaseCurrency),
 		CategoryLimit: categoryLimits,
 	}, nil
 }
 
 // FindByUserID implements domainmodel.HouseHoldRepository.
-func (h *HouseHoldRepository) FindByUserID(userID domainmodel.UserID) (*domainmodel.HouseHold, error) {
-	panic("unimplemented")
+// 自分が作成した家計簿と共有された家計簿を、所属した順に返す
+func (h *HouseHoldRepository) FindByUserID(userID domainmodel.UserID) ([]*domainmodel.BelongingHouseHold, error) {
+	rows := []struct {
+		ID          uint
+		Title       string
+		Description string
+		Role        string
+		IsDefault   bool
+	}{}
+	if err := h.db.Model(&models.UserHouseHold{}).
+		Select("household_books.id, household_books.title, household_books.description, user_households.role, "+
+			"COALESCE(user_accounts.default_household_id = household_books.id, false) AS is_default").
+		Joins("JOIN household_books ON household_books.id = user_households.household_id").
+		Joins("JOIN user_accounts ON user_accounts.id = user_households.user_id").
+		Where("user_households.user_id = ?", userID).
+		Order("user_households.id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	houseHolds := make([]*domainmodel.BelongingHouseHold, len(rows))
+	for i, row := range rows {
+		houseHolds[i] = &domainmodel.BelongingHouseHold{
+			ID:          domainmodel.HouseHoldID(row.ID),
+			Title:       row.Title,
+			Description: row.Description,
+			Role:        domainmodel.HouseHoldRole(row.Role),
+			IsDefault:   row.IsDefault,
+		}
+	}
+
+	return houseHolds, nil
 }
 
 // Update implements domainmodel.HouseHoldRepository.
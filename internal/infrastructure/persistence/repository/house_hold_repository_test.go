@@ -2,10 +2,12 @@ package repository
 
 import (
 	domainmodel "echo-household-budget/internal/domain/model"
+	"errors"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
 )
 
 func TestHouseHoldRepository_Create(t *testing.T) {
@@ -19,13 +21,14 @@ func TestHouseHoldRepository_Create(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectQuery("INSERT INTO \"household_books\"").
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), houseHold.Title, sqlmock.AnyArg()).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), houseHold.Title, sqlmock.AnyArg(), "JPY").
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 	mock.ExpectCommit()
 
 	err := repo.Create(houseHold)
 	assert.NoError(t, err)
 	assert.Equal(t, domainmodel.HouseHoldID(1), houseHold.ID)
+	assert.Equal(t, domainmodel.DefaultCurrency, houseHold.BaseCurrency)
 }
 
 func TestHouseHoldRepository_CreateUserHouseHold(t *testing.T) {
@@ -35,11 +38,12 @@ func TestHouseHoldRepository_CreateUserHouseHold(t *testing.T) {
 	userHouseHold := &domainmodel.UserHouseHold{
 		UserID:      1,
 		HouseHoldID: 1,
+		Role:        domainmodel.HouseHoldRoleOwner,
 	}
 
 	mock.ExpectBegin()
 	mock.ExpectQuery("INSERT INTO \"user_households\"").
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), userHouseHold.UserID, userHouseHold.HouseHoldID).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), userHouseHold.UserID, userHouseHold.HouseHoldID, "owner").
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 	mock.ExpectCommit()
 
@@ -47,3 +51,266 @@ func TestHouseHoldRepository_CreateUserHouseHold(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, domainmodel.HouseHoldID(1), userHouseHold.HouseHoldID)
 }
+
+func TestHouseHoldRepository_FindUserHouseHold(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewHouseHoldRepository(gormDB)
+
+	mock.ExpectQuery(`SELECT \* FROM "user_households" WHERE user_id = \$1 AND household_id = \$2 ORDER BY "user_households"."id" LIMIT \$3`).
+		WithArgs(1, 10, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "household_id", "role"}).AddRow(1, 1, 10, "viewer"))
+
+	userHouseHold, err := repo.FindUserHouseHold(1, 10)
+	assert.NoError(t, err)
+	assert.NotNil(t, userHouseHold)
+	assert.Equal(t, domainmodel.UserID(1), userHouseHold.UserID)
+	assert.Equal(t, domainmodel.HouseHoldID(10), userHouseHold.HouseHoldID)
+	assert.Equal(t, domainmodel.HouseHoldRoleViewer, userHouseHold.Role)
+}
+
+func TestHouseHoldRepository_FindUserHouseHold_NotMember(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewHouseHoldRepository(gormDB)
+
+	mock.ExpectQuery(`SELECT \* FROM "user_households" WHERE user_id = \$1 AND household_id = \$2 ORDER BY "user_households"."id" LIMIT \$3`).
+		WithArgs(1, 99, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "household_id"}))
+
+	userHouseHold, err := repo.FindUserHouseHold(1, 99)
+	assert.NoError(t, err)
+	assert.Nil(t, userHouseHold)
+}
+
+func TestHouseHoldRepository_UpdateUserHouseHoldRole_NotMember(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewHouseHoldRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "user_households" SET "role"=\$1,"updated_at"=\$2 WHERE user_id = \$3 AND household_id = \$4`).
+		WithArgs("viewer", sqlmock.AnyArg(), domainmodel.UserID(2), domainmodel.HouseHoldID(10)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err := repo.UpdateUserHouseHoldRole(10, 2, domainmodel.HouseHoldRoleViewer)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHouseHoldRepository_TransferOwnership(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewHouseHoldRepository(gormDB)
+
+	// 現在の所有者を編集者に変更してから、新しい所有者を設定する
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "user_households" SET "role"=\$1,"updated_at"=\$2 WHERE user_id = \$3 AND household_id = \$4 AND role = \$5`).
+		WithArgs("editor", sqlmock.AnyArg(), domainmodel.UserID(1), domainmodel.HouseHoldID(10), "owner").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "user_households" SET "role"=\$1,"updated_at"=\$2 WHERE user_id = \$3 AND household_id = \$4`).
+		WithArgs("owner", sqlmock.AnyArg(), domainmodel.UserID(2), domainmodel.HouseHoldID(10)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.TransferOwnership(10, 1, 2)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHouseHoldRepository_TransferOwnership_NewOwnerNotMember(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewHouseHoldRepository(gormDB)
+
+	// 新しい所有者が所属していない場合はロールバックし、元の所有者のロールを維持する
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "user_households" SET "role"=\$1,"updated_at"=\$2 WHERE user_id = \$3 AND household_id = \$4 AND role = \$5`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "user_households" SET "role"=\$1,"updated_at"=\$2 WHERE user_id = \$3 AND household_id = \$4`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err := repo.TransferOwnership(10, 1, 99)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHouseHoldRepository_FindMembers(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewHouseHoldRepository(gormDB)
+
+	mock.ExpectQuery(`SELECT user_households.user_id, user_accounts.name, user_accounts.picture_url, user_households.role FROM "user_households" JOIN user_accounts ON user_accounts.id = user_households.user_id WHERE user_households.household_id = \$1 ORDER BY user_households.id`).
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "name", "picture_url", "role"}).
+			AddRow(1, "オーナー", "https://example.com/1.jpg", "owner").
+			AddRow(2, "メンバー", "https://example.com/2.jpg", "viewer"))
+
+	members, err := repo.FindMembers(10)
+	assert.NoError(t, err)
+	assert.Len(t, members, 2)
+	assert.Equal(t, &domainmodel.HouseHoldMember{UserID: 1, Name: "オーナー", PictureURL: "https://example.com/1.jpg", Role: domainmodel.HouseHoldRoleOwner}, members[0])
+	assert.Equal(t, &domainmodel.HouseHoldMember{UserID: 2, Name: "メンバー", PictureURL: "https://example.com/2.jpg", Role: domainmodel.HouseHoldRoleViewer}, members[1])
+}
+
+func TestHouseHoldRepository_DeleteUserHouseHold_NotMember(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewHouseHoldRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "user_households" WHERE user_id = \$1 AND household_id = \$2`).
+		WithArgs(domainmodel.UserID(2), domainmodel.HouseHoldID(10)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err := repo.DeleteUserHouseHold(10, 2)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHouseHoldRepository_Delete(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewHouseHoldRepository(gormDB)
+
+	// レシートの画像のファイル名を取得してから、関連データを参照元から順に削除し、最後に家計簿を削除する
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT DISTINCT "image_url" FROM "receipt_analyzes" WHERE household_book_id = \$1 AND image_url <> ''`).
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"image_url"}).AddRow("receipt-1.jpg").AddRow("receipt-2.jpg"))
+	mock.ExpectExec(`DELETE FROM "shopping_amount_tags" WHERE shopping_amount_id IN \(SELECT "id" FROM "shopping_amounts" WHERE household_book_id = \$1\)`).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`DELETE FROM "shopping_amount_splits" WHERE shopping_amount_id IN \(SELECT "id" FROM "shopping_amounts" WHERE household_book_id = \$1\)`).WillReturnResult(sqlmock.NewResult(0, 4))
+	mock.ExpectExec(`DELETE FROM "shopping_amounts" WHERE household_book_id = \$1`).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`DELETE FROM "settlements" WHERE household_book_id = \$1`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "receipt_analyze_item_tags" WHERE receipt_analyze_item_id IN \(SELECT "id" FROM "receipt_analyze_items" WHERE receipt_analyze_id IN \(SELECT "id" FROM "receipt_analyzes" WHERE household_book_id = \$1\)\)`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "receipt_analyze_items" WHERE receipt_analyze_id IN \(SELECT "id" FROM "receipt_analyzes" WHERE household_book_id = \$1\)`).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`DELETE FROM "receipt_analyzes" WHERE household_book_id = \$1`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "shopping_memos" WHERE household_book_id = \$1`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM "recurring_occurrence_overrides" WHERE recurring_transaction_id IN \(SELECT "id" FROM "recurring_transactions" WHERE household_book_id = \$1\)`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "recurring_transactions" WHERE household_book_id = \$1`).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`DELETE FROM "incomes" WHERE household_book_id = \$1`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "income_categories" WHERE household_book_id = \$1`).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`DELETE FROM "payment_methods" WHERE household_book_id = \$1`).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`DELETE FROM "tags" WHERE household_book_id = \$1`).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`DELETE FROM "exchange_rates" WHERE household_book_id = \$1`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "budget_alerts" WHERE household_book_id = \$1`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "monthly_budgets" WHERE household_book_id = \$1`).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`DELETE FROM "category_limits" WHERE household_book_id = \$1`).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`DELETE FROM "chat_messages" WHERE household_id = \$1`).WillReturnResult(sqlmock.NewResult(0, 5))
+	mock.ExpectExec(`DELETE FROM "household_invitation_responses" WHERE invitation_id IN \(SELECT "id" FROM "household_invitations" WHERE household_id = \$1\)`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM "household_invitations" WHERE household_id = \$1`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM "user_households" WHERE household_id = \$1`).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`DELETE FROM "household_books" WHERE id = \$1`).WithArgs(domainmodel.HouseHoldID(10)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	imageFiles, err := repo.Delete(10)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"receipt-1.jpg", "receipt-2.jpg"}, imageFiles)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHouseHoldRepository_Delete_RollbackOnError(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewHouseHoldRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT DISTINCT "image_url" FROM "receipt_analyzes"`).WillReturnRows(sqlmock.NewRows([]string{"image_url"}).AddRow("receipt-1.jpg"))
+	mock.ExpectExec(`DELETE FROM "shopping_amount_tags"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM "shopping_amount_splits"`).WillReturnResult(sqlmock.NewResult(0, 4))
+	mock.ExpectExec(`DELETE FROM "shopping_amounts" WHERE household_book_id = \$1`).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`DELETE FROM "settlements"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM "receipt_analyze_item_tags"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM "receipt_analyze_items"`).WillReturnError(errors.New("db error"))
+	mock.ExpectRollback()
+
+	imageFiles, err := repo.Delete(10)
+	assert.Error(t, err)
+	assert.Nil(t, imageFiles)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHouseHoldRepository_FindByUserID(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewHouseHoldRepository(gormDB)
+
+	mock.ExpectQuery(`SELECT household_books.id, household_books.title, household_books.description, user_households.role, COALESCE\(user_accounts.default_household_id = household_books.id, false\) AS is_default FROM "user_households" JOIN household_books ON household_books.id = user_households.household_id JOIN user_accounts ON user_accounts.id = user_households.user_id WHERE user_households.user_id = \$1 ORDER BY user_households.id`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "role", "is_default"}).
+			AddRow(10, "個人", "", "owner", false).
+			AddRow(20, "家族", "", "editor", true))
+
+	houseHolds, err := repo.FindByUserID(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []*domainmodel.BelongingHouseHold{
+		{ID: 10, Title: "個人", Role: domainmodel.HouseHoldRoleOwner, IsDefault: false},
+		{ID: 20, Title: "家族", Role: domainmodel.HouseHoldRoleEditor, IsDefault: true},
+	}, houseHolds)
+}
+
+func TestHouseHoldRepository_UpdateBaseCurrency(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewHouseHoldRepository(gormDB)
+
+	expectNoAmounts := func(houseHoldID int) {
+		for _, query := range []string{
+			`SELECT count\(\*\) FROM "shopping_amounts" WHERE household_book_id = \$1`,
+			`SELECT count\(\*\) FROM "incomes" WHERE household_book_id = \$1`,
+			`SELECT count\(\*\) FROM "settlements" WHERE household_book_id = \$1`,
+			`SELECT count\(\*\) FROM "recurring_transactions" WHERE household_book_id = \$1`,
+			`SELECT count\(\*\) FROM "monthly_budgets" WHERE household_book_id = \$1 AND amount <> 0`,
+			`SELECT count\(\*\) FROM "category_limits" WHERE household_book_id = \$1 AND limit_amount <> 0`,
+			`SELECT count\(\*\) FROM "payment_methods" WHERE household_book_id = \$1 AND opening_balance <> 0`,
+		} {
+			mock.ExpectQuery(query).
+				WithArgs(houseHoldID).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		}
+	}
+
+	t.Run("金額がない家計簿の基準通貨を変更し、同じ通貨のレートを削除する", func(t *testing.T) {
+		mock.ExpectBegin()
+		expectNoAmounts(10)
+		mock.ExpectExec(`UPDATE "household_books" SET "base_currency"=\$1,"updated_at"=\$2 WHERE id = \$3`).
+			WithArgs("USD", sqlmock.AnyArg(), 10).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM "exchange_rates" WHERE household_book_id = \$1 AND currency = \$2`).
+			WithArgs(10, "USD").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.UpdateBaseCurrency(10, "USD"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("支出がある家計簿の基準通貨は変更しない", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT count\(\*\) FROM "shopping_amounts" WHERE household_book_id = \$1`).
+			WithArgs(10).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+		mock.ExpectRollback()
+
+		assert.ErrorIs(t, repo.UpdateBaseCurrency(10, "USD"), domainmodel.ErrBaseCurrencyInUse)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("収入がある家計簿の基準通貨は変更しない", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT count\(\*\) FROM "shopping_amounts" WHERE household_book_id = \$1`).
+			WithArgs(10).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(`SELECT count\(\*\) FROM "incomes" WHERE household_book_id = \$1`).
+			WithArgs(10).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectRollback()
+
+		assert.ErrorIs(t, repo.UpdateBaseCurrency(10, "USD"), domainmodel.ErrBaseCurrencyInUse)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("家計簿がない場合は変更しない", func(t *testing.T) {
+		mock.ExpectBegin()
+		expectNoAmounts(99)
+		mock.ExpectExec(`UPDATE "household_books" SET "base_currency"=\$1,"updated_at"=\$2 WHERE id = \$3`).
+			WithArgs("USD", sqlmock.AnyArg(), 99).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		assert.ErrorIs(t, repo.UpdateBaseCurrency(99, "USD"), gorm.ErrRecordNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/domain/repository"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type houseHoldInvitationRepository struct {
+	db *gorm.DB
+}
+
+func NewHouseHoldInvitationRepository(db *gorm.DB) repository.HouseHoldInvitationRepository {
+	return &houseHoldInvitationRepository{db: db}
+}
+
+// Create implements repository.HouseHoldInvitationRepository.
+func (r *houseHoldInvitationRepository) Create(invitation *domainmodel.HouseHoldInvitation) error {
+	model := &models.HouseholdInvitation{
+		HouseholdID: uint(invitation.HouseHoldID),
+		Code:        invitation.Code,
+		CreatedBy:   uint(invitation.CreatedBy),
+		MaxUses:     invitation.MaxUses,
+		UsedCount:   invitation.UsedCount,
+		ExpiresAt:   invitation.ExpiresAt,
+	}
+
+	if err := r.db.Create(model).Error; err != nil {
+		return err
+	}
+
+	invitation.ID = domainmodel.HouseHoldInvitationID(model.ID)
+	invitation.CreatedAt = model.CreatedAt
+
+	return nil
+}
+
+// FindByCode implements repository.HouseHoldInvitationRepository.
+// 該当する招待が存在しない場合は nil を返す
+func (r *houseHoldInvitationRepository) FindByCode(code string) (*domainmodel.HouseHoldInvitation, error) {
+	model := &models.HouseholdInvitation{}
+	if err := r.db.Where("code = ?", code).First(model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return convertHouseHoldInvitation(model), nil
+}
+
+// FindPendingByHouseHoldID implements repository.HouseHoldInvitationRepository.
+func (r *houseHoldInvitationRepository) FindPendingByHouseHoldID(houseHoldID domainmodel.HouseHoldID, now time.Time) ([]*domainmodel.HouseHoldInvitation, error) {
+	invitations := []*models.HouseholdInvitation{}
+	if err := r.db.
+		Where("household_id = ? AND revoked_at IS NULL AND expires_at > ?", houseHoldID, now).
+		Where("max_uses = 0 OR used_count < max_uses").
+		Order("created_at DESC").
+		Find(&invitations).Error; err != nil {
+		return nil, err
+	}
+
+	output := make([]*domainmodel.HouseHoldInvitation, len(invitations))
+	for i, invitation := range invitations {
+		output[i] = convertHouseHoldInvitation(invitation)
+	}
+
+	return output, nil
+}
+
+// Revoke implements repository.HouseHoldInvitationRepository.
+func (r *houseHoldInvitationRepository) Revoke(houseHoldID domainmodel.HouseHoldID, invitationID domainmodel.HouseHoldInvitationID, now time.Time) error {
+	result := r.db.Model(&models.HouseholdInvitation{}).
+		Where("id = ? AND household_id = ? AND revoked_at IS NULL", invitationID, houseHoldID).
+		Update("revoked_at", now)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// Accept implements repository.HouseHoldInvitationRepository.
+func (r *houseHoldInvitationRepository) Accept(invitation *domainmodel.HouseHoldInvitation, userID domainmodel.UserID, now time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&models.UserHouseHold{}).
+			Where("user_id = ? AND household_id = ?", userID, invitation.HouseHoldID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return domainmodel.ErrAlreadyMember
+		}
+
+		// 同時に承諾された場合や検証後に期限が切れた場合でも承諾しないよう、条件付きで使用回数を加算する
+		result := tx.Model(&models.HouseholdInvitation{}).
+			Where("id = ? AND revoked_at IS NULL AND expires_at > ? AND (max_uses = 0 OR used_count < max_uses)", invitation.ID, now).
+			Update("used_count", gorm.Expr("used_count + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			// 期限は変更されないため、期限切れ以外は使用回数の上限に達したものとする
+			if !now.Before(invitation.ExpiresAt) {
+				return domainmodel.ErrInvitationExpired
+			}
+			return domainmodel.ErrInvitationUsedUp
+		}
+
+		if err := tx.Create(&models.UserHouseHold{
+			UserID:      uint(userID),
+			HouseholdID: uint(invitation.HouseHoldID),
+			Role:        string(domainmodel.HouseHoldRoleEditor),
+		}).Error; err != nil {
+			return err
+		}
+
+		return r.saveResponse(tx, invitation.ID, userID, domainmodel.InvitationResponseAccepted)
+	})
+}
+
+// Decline implements repository.HouseHoldInvitationRepository.
+func (r *houseHoldInvitationRepository) Decline(invitation *domainmodel.HouseHoldInvitation, userID domainmodel.UserID) error {
+	return r.saveResponse(r.db, invitation.ID, userID, domainmodel.InvitationResponseDeclined)
+}
+
+// saveResponse は招待への応答を記録する（同じユーザーの応答は上書きする）
+func (r *houseHoldInvitationRepository) saveResponse(tx *gorm.DB, invitationID domainmodel.HouseHoldInvitationID, userID domainmodel.UserID, status domainmodel.InvitationResponseStatus) error {
+	model := &models.HouseholdInvitationResponse{}
+	err := tx.Where("invitation_id = ? AND user_id = ?", invitationID, userID).First(model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return tx.Create(&models.HouseholdInvitationResponse{
+			InvitationID: uint(invitationID),
+			UserID:       uint(userID),
+			Status:       string(status),
+		}).Error
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Model(model).Update("status", string(status)).Error
+}
+
+func convertHouseHoldInvitation(model *models.HouseholdInvitation) *domainmodel.HouseHoldInvitation {
+	return &domainmodel.HouseHoldInvitation{
+		ID:          domainmodel.HouseHoldInvitationID(model.ID),
+		HouseHoldID: domainmodel.HouseHoldID(model.HouseholdID),
+		Code:        model.Code,
+		CreatedBy:   domainmodel.UserID(model.CreatedBy),
+		MaxUses:     model.MaxUses,
+		UsedCount:   model.UsedCount,
+		ExpiresAt:   model.ExpiresAt,
+		RevokedAt:   model.RevokedAt,
+		CreatedAt:   model.CreatedAt,
+	}
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+)
+
+func TestHouseHoldInvitationRepository_Create(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewHouseHoldInvitationRepository(gormDB)
+
+	expiresAt := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	// 使用回数の上限 0（無制限）は既定値に置き換えずに登録する
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "household_invitations" .* RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 2, "abc", 3, 0, 0, expiresAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "revoked_at"}).AddRow(1, nil))
+	mock.ExpectCommit()
+
+	invitation := domainmodel.NewHouseHoldInvitation(2, 3, "abc", 0, expiresAt)
+	assert.NoError(t, repo.Create(invitation))
+	assert.Equal(t, domainmodel.HouseHoldInvitationID(1), invitation.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHouseHoldInvitationRepository_FindByCode(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewHouseHoldInvitationRepository(gormDB)
+
+	expiresAt := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	// SQLクエリのモック
+	mock.ExpectQuery(`SELECT \* FROM "household_invitations" WHERE code = \$1 ORDER BY "household_invitations"."id" LIMIT \$2`).
+		WithArgs("abc", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "household_id", "code", "created_by", "max_uses", "used_count", "expires_at", "revoked_at"}).
+			AddRow(1, 2, "abc", 3, 5, 1, expiresAt, nil))
+
+	invitation, err := repo.FindByCode("abc")
+	assert.NoError(t, err)
+	assert.NotNil(t, invitation)
+	assert.Equal(t, domainmodel.HouseHoldInvitationID(1), invitation.ID)
+	assert.Equal(t, domainmodel.HouseHoldID(2), invitation.HouseHoldID)
+	assert.Equal(t, domainmodel.UserID(3), invitation.CreatedBy)
+	assert.Equal(t, 5, invitation.MaxUses)
+	assert.Equal(t, 1, invitation.UsedCount)
+	assert.Nil(t, invitation.RevokedAt)
+}
+
+func TestHouseHoldInvitationRepository_FindByCode_NotFound(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewHouseHoldInvitationRepository(gormDB)
+
+	// SQLクエリのモック
+	mock.ExpectQuery(`SELECT \* FROM "household_invitations" WHERE code = \$1`).
+		WithArgs("unknown", 1).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	invitation, err := repo.FindByCode("unknown")
+	assert.NoError(t, err)
+	assert.Nil(t, invitation)
+}
+
+func TestHouseHoldInvitationRepository_Revoke_NotFound(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewHouseHoldInvitationRepository(gormDB)
+
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	// 別の家計簿の招待や取り消し済みの招待は更新されない
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "household_invitations" SET "revoked_at"=\$1,"updated_at"=\$2 WHERE id = \$3 AND household_id = \$4 AND revoked_at IS NULL`).
+		WithArgs(now, sqlmock.AnyArg(), domainmodel.HouseHoldInvitationID(1), domainmodel.HouseHoldID(2)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err := repo.Revoke(domainmodel.HouseHoldID(2), domainmodel.HouseHoldInvitationID(1), now)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHouseHoldInvitationRepository_Accept(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewHouseHoldInvitationRepository(gormDB)
+
+	expiresAt := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	invitation := &domainmodel.HouseHoldInvitation{ID: 1, HouseHoldID: 2, MaxUses: 5, ExpiresAt: expiresAt}
+	expectUseCount := func(now time.Time, rowsAffected int64) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT count\(\*\) FROM "user_households" WHERE user_id = \$1 AND household_id = \$2`).
+			WithArgs(domainmodel.UserID(4), domainmodel.HouseHoldID(2)).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectExec(`UPDATE "household_invitations" SET "used_count"=used_count \+ 1,"updated_at"=\$1 WHERE id = \$2 AND revoked_at IS NULL AND expires_at > \$3 AND \(max_uses = 0 OR used_count < max_uses\)`).
+			WithArgs(sqlmock.AnyArg(), domainmodel.HouseHoldInvitationID(1), now).
+			WillReturnResult(sqlmock.NewResult(0, rowsAffected))
+	}
+
+	t.Run("期限内で上限に達していない場合は家計簿に参加する", func(t *testing.T) {
+		now := expiresAt.Add(-time.Hour)
+		expectUseCount(now, 1)
+		mock.ExpectQuery(`INSERT INTO "user_households" .* RETURNING "id"`).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 4, 2, "editor").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+		mock.ExpectQuery(`SELECT \* FROM "household_invitation_responses" WHERE invitation_id = \$1 AND user_id = \$2`).
+			WillReturnError(gorm.ErrRecordNotFound)
+		mock.ExpectQuery(`INSERT INTO "household_invitation_responses" .* RETURNING "id"`).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1, 4, "accepted").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.Accept(invitation, 4, now))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("検証後に期限が切れた場合は参加しない", func(t *testing.T) {
+		now := expiresAt
+		expectUseCount(now, 0)
+		mock.ExpectRollback()
+
+		assert.ErrorIs(t, repo.Accept(invitation, 4, now), domainmodel.ErrInvitationExpired)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("同時に承諾され上限に達した場合は参加しない", func(t *testing.T) {
+		now := expiresAt.Add(-time.Hour)
+		expectUseCount(now, 0)
+		mock.ExpectRollback()
+
+		assert.ErrorIs(t, repo.Accept(invitation, 4, now), domainmodel.ErrInvitationUsedUp)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
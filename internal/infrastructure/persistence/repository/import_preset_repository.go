@@ -0,0 +1,96 @@
+package repository
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+	"encoding/json"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type ImportPresetRepository struct {
+	db *gorm.DB
+}
+
+// FindByHouseHoldID implements domainmodel.ImportPresetRepository.
+func (r *ImportPresetRepository) FindByHouseHoldID(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.ImportPreset, error) {
+	presets := []*models.ImportPreset{}
+	if err := r.db.Where("household_book_id = ?", houseHoldID).Order("name, id").Find(&presets).Error; err != nil {
+		return nil, err
+	}
+
+	output := make([]*domainmodel.ImportPreset, len(presets))
+	for i, preset := range presets {
+		converted, err := domainmodel.ConvertImportPreset(preset)
+		if err != nil {
+			return nil, err
+		}
+		output[i] = converted
+	}
+
+	return output, nil
+}
+
+// FindByID implements domainmodel.ImportPresetRepository.
+func (r *ImportPresetRepository) FindByID(houseHoldID domainmodel.HouseHoldID, id domainmodel.ImportPresetID) (*domainmodel.ImportPreset, error) {
+	model := &models.ImportPreset{}
+	if err := r.db.Where("id = ? AND household_book_id = ?", id, houseHoldID).First(model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return domainmodel.ConvertImportPreset(model)
+}
+
+// Create implements domainmodel.ImportPresetRepository.
+func (r *ImportPresetRepository) Create(preset *domainmodel.ImportPreset) error {
+	mapping, err := json.Marshal(preset.Mapping)
+	if err != nil {
+		return err
+	}
+	categoryMappings := preset.CategoryMappings
+	if categoryMappings == nil {
+		categoryMappings = map[string]domainmodel.CategoryID{}
+	}
+	categoryMappingsJSON, err := json.Marshal(categoryMappings)
+	if err != nil {
+		return err
+	}
+
+	model := &models.ImportPreset{
+		HouseholdBookID:  uint(preset.HouseHoldID),
+		Name:             preset.Name,
+		Mapping:          string(mapping),
+		CategoryMappings: string(categoryMappingsJSON),
+	}
+	if err := r.db.Create(model).Error; err != nil {
+		return err
+	}
+
+	preset.ID = domainmodel.ImportPresetID(model.ID)
+
+	return nil
+}
+
+// Delete implements domainmodel.ImportPresetRepository.
+func (r *ImportPresetRepository) Delete(houseHoldID domainmodel.HouseHoldID, id domainmodel.ImportPresetID) error {
+	result := r.db.Where("id = ? AND household_book_id = ?", id, houseHoldID).Delete(&models.ImportPreset{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func NewImportPresetRepository(db *gorm.DB) domainmodel.ImportPresetRepository {
+	return &ImportPresetRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+)
+
+func TestImportPresetRepository_FindByHouseHoldID(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewImportPresetRepository(gormDB)
+
+	mock.ExpectQuery(`SELECT \* FROM "import_presets" WHERE household_book_id = \$1 ORDER BY name, id`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "household_book_id", "name", "mapping", "category_mappings"}).
+			AddRow(1, 1, "スプレッドシート", `{"dateColumn":"日付","amountColumn":"金額","categoryColumn":"カテゴリ","memoColumns":["メモ"]}`, `{"食料品":3}`))
+
+	presets, err := repo.FindByHouseHoldID(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []*domainmodel.ImportPreset{
+		{
+			ID: 1, HouseHoldID: 1, Name: "スプレッドシート",
+			Mapping:          domainmodel.ImportColumnMapping{DateColumn: "日付", AmountColumn: "金額", CategoryColumn: "カテゴリ", MemoColumns: []string{"メモ"}},
+			CategoryMappings: map[string]domainmodel.CategoryID{"食料品": 3},
+		},
+	}, presets)
+}
+
+func TestImportPresetRepository_Create(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewImportPresetRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "import_presets" \("created_at","updated_at","household_book_id","name","mapping","category_mappings"\) VALUES \(\$1,\$2,\$3,\$4,\$5,\$6\) RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1, "スプレッドシート",
+			`{"encoding":"","dateColumn":"日付","dateLayout":"","amountColumn":"金額","negativeExpense":false,"categoryColumn":"カテゴリ","memoColumns":null,"skipRules":null}`,
+			`{}`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	mock.ExpectCommit()
+
+	preset := &domainmodel.ImportPreset{
+		HouseHoldID: 1,
+		Name:        "スプレッドシート",
+		Mapping:     domainmodel.ImportColumnMapping{DateColumn: "日付", AmountColumn: "金額", CategoryColumn: "カテゴリ"},
+	}
+	assert.NoError(t, repo.Create(preset))
+	assert.Equal(t, domainmodel.ImportPresetID(5), preset.ID)
+}
+
+func TestShoppingRepository_RegisterShoppingAmounts(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewShoppingRepository(gormDB)
+
+	date := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "shopping_amounts" .* VALUES \(.*\),\(.*\) RETURNING "id"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+	mock.ExpectCommit()
+
+	err := repo.RegisterShoppingAmounts(domainmodel.ImportShoppingAmounts(1, []*domainmodel.ImportRow{
+		{Status: domainmodel.ImportRowValid, Date: date.Format("2006-01-02"), Amount: 100, Category: &domainmodel.Category{ID: 1}},
+		{Status: domainmodel.ImportRowValid, Date: date.Format("2006-01-02"), Amount: 200, Category: &domainmodel.Category{ID: 1}},
+	}, false))
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
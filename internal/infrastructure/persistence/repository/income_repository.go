@@ -0,0 +1,195 @@
+package repository
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type IncomeRepository struct {
+	db *gorm.DB
+}
+
+// FindIncomeCategories implements domainmodel.IncomeRepository.
+func (r *IncomeRepository) FindIncomeCategories(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.IncomeCategory, error) {
+	categories := []*models.IncomeCategory{}
+	if err := r.db.Where("household_book_id = ?", houseHoldID).Order("sort_order, id").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	output := make([]*domainmodel.IncomeCategory, len(categories))
+	for i, category := range categories {
+		output[i] = convertIncomeCategory(category)
+	}
+
+	return output, nil
+}
+
+// CreateIncomeCategories implements domainmodel.IncomeRepository.
+func (r *IncomeRepository) CreateIncomeCategories(categories []*domainmodel.IncomeCategory) error {
+	if len(categories) == 0 {
+		return nil
+	}
+
+	records := make([]*models.IncomeCategory, len(categories))
+	for i, category := range categories {
+		records[i] = &models.IncomeCategory{
+			HouseholdBookID: uint(category.HouseHoldID),
+			Name:            category.Name,
+			Color:           category.Color,
+			SortOrder:       category.SortOrder,
+		}
+	}
+
+	if err := r.db.Create(&records).Error; err != nil {
+		return err
+	}
+
+	for i, record := range records {
+		categories[i].ID = domainmodel.IncomeCategoryID(record.ID)
+	}
+
+	return nil
+}
+
+// FindIncomes implements domainmodel.IncomeRepository.
+func (r *IncomeRepository) FindIncomes(houseHoldID domainmodel.HouseHoldID, month string) ([]*domainmodel.Income, error) {
+	start, err := domainmodel.ParseBudgetMonth(month)
+	if err != nil {
+		return nil, err
+	}
+
+	incomes := []*models.Income{}
+	if err := r.db.Where("household_book_id = ? AND date >= ? AND date < ?", houseHoldID, start, start.AddDate(0, 1, 0)).
+		Preload("IncomeCategory").
+		Preload("PaymentMethod").
+		Order("date, id").
+		Find(&incomes).Error; err != nil {
+		return nil, err
+	}
+
+	output := make([]*domainmodel.Income, len(incomes))
+	for i, income := range incomes {
+		output[i] = &domainmodel.Income{
+			ID:               domainmodel.IncomeID(income.ID),
+			HouseHoldID:      domainmodel.HouseHoldID(income.HouseholdBookID),
+			IncomeCategoryID: domainmodel.IncomeCategoryID(income.IncomeCategoryID),
+			IncomeCategory:   *convertIncomeCategory(&income.IncomeCategory),
+			Amount:           income.Amount,
+			Date:             income.Date.Format("2006-01-02"),
+			Memo:             income.Memo,
+			PaymentMethod:    domainmodel.ConvertPaymentMethod(income.PaymentMethod),
+		}
+		if income.UserID != nil {
+			userID := domainmodel.UserID(*income.UserID)
+			output[i].UserID = &userID
+		}
+		if income.PaymentMethodID != nil {
+			paymentMethodID := domainmodel.PaymentMethodID(*income.PaymentMethodID)
+			output[i].PaymentMethodID = &paymentMethodID
+		}
+	}
+
+	return output, nil
+}
+
+// CreateIncome implements domainmodel.IncomeRepository.
+func (r *IncomeRepository) CreateIncome(income *domainmodel.Income) error {
+	model, err := newIncomeModel(income)
+	if err != nil {
+		return err
+	}
+
+	if err := r.db.Create(model).Error; err != nil {
+		return err
+	}
+
+	income.ID = domainmodel.IncomeID(model.ID)
+
+	return nil
+}
+
+// UpdateIncome implements domainmodel.IncomeRepository.
+func (r *IncomeRepository) UpdateIncome(income *domainmodel.Income) error {
+	model, err := newIncomeModel(income)
+	if err != nil {
+		return err
+	}
+
+	result := r.db.Model(&models.Income{}).
+		Where("id = ? AND household_book_id = ?", income.ID, income.HouseHoldID).
+		Updates(map[string]interface{}{
+			"income_category_id": model.IncomeCategoryID,
+			"user_id":            model.UserID,
+			"amount":             model.Amount,
+			"date":               model.Date,
+			"memo":               model.Memo,
+			"payment_method_id":  model.PaymentMethodID,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// DeleteIncome implements domainmodel.IncomeRepository.
+func (r *IncomeRepository) DeleteIncome(houseHoldID domainmodel.HouseHoldID, incomeID domainmodel.IncomeID) error {
+	result := r.db.Where("id = ? AND household_book_id = ?", incomeID, houseHoldID).Delete(&models.Income{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func newIncomeModel(income *domainmodel.Income) (*models.Income, error) {
+	date, err := time.Parse("2006-01-02", income.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	model := &models.Income{
+		HouseholdBookID:  uint(income.HouseHoldID),
+		IncomeCategoryID: uint(income.IncomeCategoryID),
+		Amount:           income.Amount,
+		Date:             date,
+		Memo:             income.Memo,
+	}
+	if income.UserID != nil {
+		userID := uint(*income.UserID)
+		model.UserID = &userID
+	}
+	if income.PaymentMethodID != nil {
+		paymentMethodID := uint(*income.PaymentMethodID)
+		model.PaymentMethodID = &paymentMethodID
+	}
+
+	return model, nil
+}
+
+func convertIncomeCategory(model *models.IncomeCategory) *domainmodel.IncomeCategory {
+	return &domainmodel.IncomeCategory{
+		ID:          domainmodel.IncomeCategoryID(model.ID),
+		HouseHoldID: domainmodel.HouseHoldID(model.HouseholdBookID),
+		Name:        model.Name,
+		Color:       model.Color,
+		SortOrder:   model.SortOrder,
+	}
+}
+
+func NewIncomeRepository(db *gorm.DB) domainmodel.IncomeRepository {
+	return &IncomeRepository{
+		db: db,
+	}
+}
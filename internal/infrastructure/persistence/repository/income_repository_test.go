@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+)
+
+func TestIncomeRepository_FindIncomeCategories(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewIncomeRepository(gormDB)
+
+	mock.ExpectQuery(`SELECT \* FROM "income_categories" WHERE household_book_id = \$1 ORDER BY sort_order, id`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "household_book_id", "name", "color", "sort_order"}).
+			AddRow(1, 1, "給与", "#4CAF50", 1).
+			AddRow(2, 1, "賞与", "#2196F3", 2))
+
+	categories, err := repo.FindIncomeCategories(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []*domainmodel.IncomeCategory{
+		{ID: 1, HouseHoldID: 1, Name: "給与", Color: "#4CAF50", SortOrder: 1},
+		{ID: 2, HouseHoldID: 1, Name: "賞与", Color: "#2196F3", SortOrder: 2},
+	}, categories)
+}
+
+func TestIncomeRepository_CreateIncomeCategories(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewIncomeRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "income_categories"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1, "給与", "#4CAF50", 1,
+			sqlmock.AnyArg(), sqlmock.AnyArg(), 1, "賞与", "#2196F3", 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+	mock.ExpectCommit()
+
+	categories := []*domainmodel.IncomeCategory{
+		{HouseHoldID: 1, Name: "給与", Color: "#4CAF50", SortOrder: 1},
+		{HouseHoldID: 1, Name: "賞与", Color: "#2196F3", SortOrder: 2},
+	}
+	assert.NoError(t, repo.CreateIncomeCategories(categories))
+	assert.Equal(t, domainmodel.IncomeCategoryID(1), categories[0].ID)
+	assert.Equal(t, domainmodel.IncomeCategoryID(2), categories[1].ID)
+
+	// 登録するカテゴリがない場合はクエリを発行しない
+	assert.NoError(t, repo.CreateIncomeCategories(nil))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIncomeRepository_FindIncomes(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewIncomeRepository(gormDB)
+
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`SELECT \* FROM "incomes" WHERE household_book_id = \$1 AND date >= \$2 AND date < \$3 ORDER BY date, id`).
+		WithArgs(1, start, start.AddDate(0, 1, 0)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "household_book_id", "income_category_id", "user_id", "amount", "date", "memo"}).
+			AddRow(1, 1, 1, 2, 300000, time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC), "10月分").
+			AddRow(2, 1, 3, nil, 5000, time.Date(2026, 10, 28, 0, 0, 0, 0, time.UTC), ""))
+	mock.ExpectQuery(`SELECT \* FROM "income_categories" WHERE "income_categories"."id" IN \(\$1,\$2\)`).
+		WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "household_book_id", "name", "color", "sort_order"}).
+			AddRow(1, 1, "給与", "#4CAF50", 1).
+			AddRow(3, 1, "副収入", "#FF9800", 3))
+
+	incomes, err := repo.FindIncomes(1, "2026-10")
+	assert.NoError(t, err)
+	userID := domainmodel.UserID(2)
+	assert.Equal(t, []*domainmodel.Income{
+		{
+			ID: 1, HouseHoldID: 1, IncomeCategoryID: 1,
+			IncomeCategory: domainmodel.IncomeCategory{ID: 1, HouseHoldID: 1, Name: "給与", Color: "#4CAF50", SortOrder: 1},
+			UserID:         &userID, Amount: 300000, Date: "2026-10-25", Memo: "10月分",
+		},
+		{
+			ID: 2, HouseHoldID: 1, IncomeCategoryID: 3,
+			IncomeCategory: domainmodel.IncomeCategory{ID: 3, HouseHoldID: 1, Name: "副収入", Color: "#FF9800", SortOrder: 3},
+			Amount:         5000, Date: "2026-10-28",
+		},
+	}, incomes)
+}
+
+func TestIncomeRepository_CreateIncome(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewIncomeRepository(gormDB)
+
+	userID := domainmodel.UserID(2)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "incomes"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1, 1, 300000, time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC), "10月分", 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id"}).AddRow(5, 2))
+	mock.ExpectCommit()
+
+	income := &domainmodel.Income{HouseHoldID: 1, IncomeCategoryID: 1, UserID: &userID, Amount: 300000, Date: "2026-10-25", Memo: "10月分"}
+	assert.NoError(t, repo.CreateIncome(income))
+	assert.Equal(t, domainmodel.IncomeID(5), income.ID)
+}
+
+func TestIncomeRepository_UpdateIncome(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewIncomeRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "incomes" SET .* WHERE id = \$8 AND household_book_id = \$9`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	// 他の家計簿の収入は更新できない
+	income := &domainmodel.Income{ID: 5, HouseHoldID: 2, IncomeCategoryID: 1, Amount: 300000, Date: "2026-10-25"}
+	assert.ErrorIs(t, repo.UpdateIncome(income), gorm.ErrRecordNotFound)
+}
+
+func TestIncomeRepository_DeleteIncome(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewIncomeRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "incomes" WHERE id = \$1 AND household_book_id = \$2`).
+		WithArgs(5, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.DeleteIncome(1, 5))
+}
@@ -0,0 +1,256 @@
+package repository
+
+import (
+	"context"
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+	model "echo-household-budget/internal/model"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrKaimemoUserNotMapped は tempUserID に対応する家計簿が登録されていない場合のエラー
+var ErrKaimemoUserNotMapped = errors.New("tempUserID is not mapped to a household")
+
+// kaimemoRepository は買い物メモを家計簿の shopping_memos と shopping_amounts に保存する KaimemoRepository の実装
+// tempUserID は kaimemo_user_mappings で家計簿に対応付け、タグは家計簿のカテゴリ名として扱う
+type kaimemoRepository struct {
+	db *gorm.DB
+}
+
+// kaimemoRecord は買い物メモ・支出とカテゴリ名の取得結果
+type kaimemoRecord struct {
+	ID          uint
+	Title       string
+	IsCompleted bool
+	Amount      int
+	Date        time.Time
+	Tag         string
+}
+
+// FetchKaimemo implements KaimemoRepository.
+// 対応付けられていない tempUserID は Notion と同様に空の一覧を返す
+func (k *kaimemoRepository) FetchKaimemo(ctx context.Context, userID string) ([]model.KaimemoResponse, error) {
+	db := k.db.WithContext(ctx)
+	houseHoldID, err := k.findHouseHoldID(db, userID)
+	if err != nil {
+		if errors.Is(err, ErrKaimemoUserNotMapped) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	records := []kaimemoRecord{}
+	if err := db.Model(&models.ShoppingMemo{}).
+		Select("shopping_memos.id, shopping_memos.title, shopping_memos.is_completed, category_limits.name AS tag").
+		Joins("LEFT JOIN category_limits ON category_limits.category_id = shopping_memos.category_id AND category_limits.household_book_id = shopping_memos.household_book_id AND category_limits.deleted_at IS NULL").
+		Where("shopping_memos.household_book_id = ?", houseHoldID).
+		Order("shopping_memos.id").
+		Scan(&records).Error; err != nil {
+		return nil, err
+	}
+
+	var kaimemoResponses []model.KaimemoResponse
+	for _, record := range records {
+		kaimemoResponses = append(kaimemoResponses, model.KaimemoResponse{
+			ID:   strconv.FormatUint(uint64(record.ID), 10),
+			Tag:  record.Tag,
+			Name: record.Title,
+			Done: record.IsCompleted,
+		})
+	}
+
+	return kaimemoResponses, nil
+}
+
+// InsertKaimemo implements KaimemoRepository.
+func (k *kaimemoRepository) InsertKaimemo(ctx context.Context, req model.CreateKaimemoRequest) error {
+	return k.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		houseHoldID, err := k.findHouseHoldID(tx, req.TempUserID)
+		if err != nil {
+			return err
+		}
+		categoryID, err := findOrCreateKaimemoCategory(tx, houseHoldID, req.Tag)
+		if err != nil {
+			return err
+		}
+
+		return tx.Omit(clause.Associations).Create(&models.ShoppingMemo{
+			HouseholdBookID: houseHoldID,
+			CategoryID:      categoryID,
+			Title:           req.Name,
+		}).Error
+	})
+}
+
+// RemoveKaimemo implements KaimemoRepository.
+// Notion でページをアーカイブした場合と同様に、一覧に表示されないよう削除する
+func (k *kaimemoRepository) RemoveKaimemo(ctx context.Context, id string, userID string) error {
+	return k.remove(ctx, &models.ShoppingMemo{}, id, userID)
+}
+
+// FetchKaimemoAmountRecords implements KaimemoRepository.
+// 対応付けられていない tempUserID は Notion と同様に空の記録を返す
+func (k *kaimemoRepository) FetchKaimemoAmountRecords(ctx context.Context, userID string) (*model.KaimemoAmountRecords, error) {
+	db := k.db.WithContext(ctx)
+	houseHoldID, err := k.findHouseHoldID(db, userID)
+	if err != nil {
+		if errors.Is(err, ErrKaimemoUserNotMapped) {
+			return &model.KaimemoAmountRecords{}, nil
+		}
+		return nil, err
+	}
+
+	records := []kaimemoRecord{}
+	if err := db.Model(&models.ShoppingAmount{}).
+		Select("shopping_amounts.id, shopping_amounts.amount, shopping_amounts.date, category_limits.name AS tag").
+		Joins("LEFT JOIN category_limits ON category_limits.category_id = shopping_amounts.category_id AND category_limits.household_book_id = shopping_amounts.household_book_id AND category_limits.deleted_at IS NULL").
+		Where("shopping_amounts.household_book_id = ?", houseHoldID).
+		Order("shopping_amounts.date, shopping_amounts.id").
+		Scan(&records).Error; err != nil {
+		return nil, err
+	}
+
+	var kaimemoAmounts []model.KaimemoAmount
+	for _, record := range records {
+		kaimemoAmounts = append(kaimemoAmounts, model.KaimemoAmount{
+			ID:     strconv.FormatUint(uint64(record.ID), 10),
+			Date:   record.Date.Format("2006-01-02"),
+			Tag:    record.Tag,
+			Amount: record.Amount,
+		})
+	}
+
+	return &model.KaimemoAmountRecords{
+		Records: kaimemoAmounts,
+	}, nil
+}
+
+// InsertKaimemoAmount implements KaimemoRepository.
+func (k *kaimemoRepository) InsertKaimemoAmount(ctx context.Context, req model.CreateKaimemoAmountRequest) error {
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		return err
+	}
+
+	return k.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		houseHoldID, err := k.findHouseHoldID(tx, req.TempUserID)
+		if err != nil {
+			return err
+		}
+		categoryID, err := findOrCreateKaimemoCategory(tx, houseHoldID, req.Tag)
+		if err != nil {
+			return err
+		}
+
+		return tx.Omit(clause.Associations).Create(&models.ShoppingAmount{
+			HouseholdBookID: houseHoldID,
+			CategoryID:      categoryID,
+			Amount:          req.Amount,
+			Date:            date,
+			SplitType:       string(domainmodel.SplitEqual),
+		}).Error
+	})
+}
+
+// RemoveKaimemoAmount implements KaimemoRepository.
+func (k *kaimemoRepository) RemoveKaimemoAmount(ctx context.Context, id string, userID string) error {
+	return k.remove(ctx, &models.ShoppingAmount{}, id, userID)
+}
+
+// remove は tempUserID に対応する家計簿の記録を、対応するユーザーの削除としてゴミ箱に移す
+func (k *kaimemoRepository) remove(ctx context.Context, record interface{}, id string, userID string) error {
+	db := k.db.WithContext(ctx)
+	recordID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return err
+	}
+	mapping, err := k.findUserMapping(db, userID)
+	if err != nil {
+		return err
+	}
+
+	deletedBy := domainmodel.UserID(mapping.UserID)
+	result := softDelete(db.Where("id = ? AND household_book_id = ?", recordID, mapping.HouseholdBookID), record, domainmodel.NewDeletion(&deletedBy))
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// findHouseHoldID は tempUserID に対応する家計簿を返す
+func (k *kaimemoRepository) findHouseHoldID(db *gorm.DB, tempUserID string) (uint, error) {
+	mapping, err := k.findUserMapping(db, tempUserID)
+	if err != nil {
+		return 0, err
+	}
+	return mapping.HouseholdBookID, nil
+}
+
+// findUserMapping は tempUserID に対応するユーザーと家計簿を返す
+func (k *kaimemoRepository) findUserMapping(db *gorm.DB, tempUserID string) (*models.KaimemoUserMapping, error) {
+	mapping := &models.KaimemoUserMapping{}
+	if err := db.Where("temp_user_id = ?", tempUserID).First(mapping).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrKaimemoUserNotMapped
+		}
+		return nil, err
+	}
+	return mapping, nil
+}
+
+// findOrCreateKaimemoCategory はタグと同じ名前の家計簿のカテゴリを返す。
+// Notion のセレクトと同様に、存在しない場合は並び順の末尾にカテゴリを作成する
+func findOrCreateKaimemoCategory(tx *gorm.DB, houseHoldID uint, tag string) (uint, error) {
+	name := strings.TrimSpace(tag)
+	if name == "" {
+		name = domainmodel.DefaultKaimemoCategory
+	}
+
+	categoryLimits := []*models.CategoryLimit{}
+	if err := tx.Where("household_book_id = ? AND name = ?", houseHoldID, name).
+		Order("archived_at IS NOT NULL, sort_order, id").
+		Find(&categoryLimits).Error; err != nil {
+		return 0, err
+	}
+	if len(categoryLimits) > 0 {
+		return categoryLimits[0].CategoryID, nil
+	}
+
+	var sortOrder int
+	if err := tx.Model(&models.CategoryLimit{}).
+		Select("COALESCE(MAX(sort_order), 0)").
+		Where("household_book_id = ?", houseHoldID).
+		Scan(&sortOrder).Error; err != nil {
+		return 0, err
+	}
+
+	category := &models.Category{Name: name, Color: domainmodel.KaimemoCategoryColor}
+	if err := tx.Create(category).Error; err != nil {
+		return 0, err
+	}
+	if err := tx.Omit(clause.Associations).Create(&models.CategoryLimit{
+		HouseholdBookID: houseHoldID,
+		CategoryID:      category.ID,
+		Name:            name,
+		Color:           domainmodel.KaimemoCategoryColor,
+		SortOrder:       sortOrder + 1,
+	}).Error; err != nil {
+		return 0, err
+	}
+
+	return category.ID, nil
+}
+
+func NewKaimemoRepository(db *gorm.DB) KaimemoRepository {
+	return &kaimemoRepository{db: db}
+}
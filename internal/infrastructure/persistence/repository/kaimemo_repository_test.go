@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	model "echo-household-budget/internal/model"
+)
+
+func expectKaimemoUserMapping(mock sqlmock.Sqlmock, tempUserID string, houseHoldID int) {
+	rows := sqlmock.NewRows([]string{"temp_user_id", "user_id", "household_book_id"})
+	if houseHoldID != 0 {
+		rows.AddRow(tempUserID, 1, houseHoldID)
+	}
+	mock.ExpectQuery(`SELECT \* FROM "kaimemo_user_mappings" WHERE temp_user_id = \$1`).
+		WithArgs(tempUserID, 1).
+		WillReturnRows(rows)
+}
+
+func TestKaimemoRepository_FetchKaimemo(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewKaimemoRepository(gormDB)
+
+	expectKaimemoUserMapping(mock, "temp-1", 3)
+	mock.ExpectQuery(`SELECT shopping_memos.id, shopping_memos.title, shopping_memos.is_completed, category_limits.name AS tag FROM "shopping_memos" LEFT JOIN category_limits .* WHERE shopping_memos.household_book_id = \$1 AND "shopping_memos"."deleted_at" IS NULL ORDER BY shopping_memos.id`).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "is_completed", "tag"}).
+			AddRow(1, "牛乳", false, "食費").
+			AddRow(2, "洗剤", true, "日用品"))
+
+	res, err := repo.FetchKaimemo(context.Background(), "temp-1")
+	assert.NoError(t, err)
+	assert.Equal(t, []model.KaimemoResponse{
+		{ID: "1", Tag: "食費", Name: "牛乳", Done: false},
+		{ID: "2", Tag: "日用品", Name: "洗剤", Done: true},
+	}, res)
+
+	t.Run("対応付けられていない tempUserID は空の一覧を返す", func(t *testing.T) {
+		gormDB, mock := setupTest(t)
+		repo := NewKaimemoRepository(gormDB)
+
+		expectKaimemoUserMapping(mock, "unknown", 0)
+
+		res, err := repo.FetchKaimemo(context.Background(), "unknown")
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+	})
+}
+
+func TestKaimemoRepository_FetchKaimemoAmountRecords(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewKaimemoRepository(gormDB)
+
+	expectKaimemoUserMapping(mock, "temp-1", 3)
+	mock.ExpectQuery(`SELECT shopping_amounts.id, shopping_amounts.amount, shopping_amounts.date, category_limits.name AS tag FROM "shopping_amounts" LEFT JOIN category_limits .* WHERE shopping_amounts.household_book_id = \$1 AND "shopping_amounts"."deleted_at" IS NULL ORDER BY shopping_amounts.date, shopping_amounts.id`).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "amount", "date", "tag"}).
+			AddRow(5, 1200, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), "食費"))
+
+	res, err := repo.FetchKaimemoAmountRecords(context.Background(), "temp-1")
+	assert.NoError(t, err)
+	assert.Equal(t, []model.KaimemoAmount{{ID: "5", Date: "2024-05-01", Tag: "食費", Amount: 1200}}, res.Records)
+}
+
+func TestKaimemoRepository_InsertKaimemo(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewKaimemoRepository(gormDB)
+
+	// タグと同じ名前のカテゴリがないため、並び順の末尾に作成する
+	mock.ExpectBegin()
+	expectKaimemoUserMapping(mock, "temp-1", 3)
+	mock.ExpectQuery(`SELECT \* FROM "category_limits" WHERE \(household_book_id = \$1 AND name = \$2\) AND "category_limits"."deleted_at" IS NULL ORDER BY archived_at IS NOT NULL, sort_order, id`).
+		WithArgs(3, "日用品").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`SELECT COALESCE\(MAX\(sort_order\), 0\) FROM "category_limits" WHERE household_book_id = \$1`).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(4))
+	mock.ExpectQuery(`INSERT INTO "categories" \("created_at","updated_at","name","color"\) VALUES \(\$1,\$2,\$3,\$4\) RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "日用品", "#9E9E9E").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(9))
+	mock.ExpectQuery(`INSERT INTO "category_limits" .* RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 3, 9, 0, "日用品", "#9E9E9E", "", 5, nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(20))
+	mock.ExpectQuery(`INSERT INTO "shopping_memos" .* RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 3, 9, "洗剤", "", false, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(11))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.InsertKaimemo(context.Background(), model.CreateKaimemoRequest{TempUserID: "temp-1", Tag: "日用品", Name: "洗剤"}))
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	t.Run("対応付けられていない tempUserID は登録しない", func(t *testing.T) {
+		gormDB, mock := setupTest(t)
+		repo := NewKaimemoRepository(gormDB)
+
+		mock.ExpectBegin()
+		expectKaimemoUserMapping(mock, "unknown", 0)
+		mock.ExpectRollback()
+
+		err := repo.InsertKaimemo(context.Background(), model.CreateKaimemoRequest{TempUserID: "unknown", Tag: "食費", Name: "牛乳"})
+		assert.ErrorIs(t, err, ErrKaimemoUserNotMapped)
+	})
+}
+
+func TestKaimemoRepository_RemoveKaimemoAmount(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewKaimemoRepository(gormDB)
+
+	expectKaimemoUserMapping(mock, "temp-1", 3)
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "shopping_amounts" SET "delete_batch_id"=\$1,"deleted_at"=\$2,"deleted_by"=\$3,"updated_at"=\$4 WHERE \(id = \$5 AND household_book_id = \$6\) AND "shopping_amounts"."deleted_at" IS NULL`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1, sqlmock.AnyArg(), 5, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.RemoveKaimemoAmount(context.Background(), "5", "temp-1"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	// Notion のページ ID のような数値でない ID は削除しない
+	assert.Error(t, repo.RemoveKaimemoAmount(context.Background(), "59833787-2cf9-4fdf-8782-e53db20768a5", "temp-1"))
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MonthlyBudgetRepository struct {
+	db *gorm.DB
+}
+
+// monthlyBudgetConflictColumns は予算を一意に特定する列
+var monthlyBudgetConflictColumns = []clause.Column{{Name: "household_book_id"}, {Name: "category_id"}, {Name: "month"}}
+
+// FindByHouseHoldID implements domainmodel.MonthlyBudgetRepository.
+func (r *MonthlyBudgetRepository) FindByHouseHoldID(houseHoldID domainmodel.HouseHoldID, untilMonth string) ([]*domainmodel.MonthlyBudget, error) {
+	budgets := []*models.MonthlyBudget{}
+	if err := r.db.Where("household_book_id = ? AND month <= ?", houseHoldID, untilMonth).
+		Order("month, id").
+		Find(&budgets).Error; err != nil {
+		return nil, err
+	}
+
+	output := make([]*domainmodel.MonthlyBudget, len(budgets))
+	for i, budget := range budgets {
+		output[i] = convertMonthlyBudget(budget)
+	}
+
+	return output, nil
+}
+
+// CreateMonthlyBudgets implements domainmodel.MonthlyBudgetRepository.
+// 同じ月の予算が同時に引き継がれた場合でも重複しないよう、登録済みの予算は無視する
+func (r *MonthlyBudgetRepository) CreateMonthlyBudgets(budgets []*domainmodel.MonthlyBudget) error {
+	if len(budgets) == 0 {
+		return nil
+	}
+
+	records := make([]*models.MonthlyBudget, len(budgets))
+	for i, budget := range budgets {
+		records[i] = &models.MonthlyBudget{
+			HouseholdBookID: uint(budget.HouseHoldID),
+			CategoryID:      uint(budget.CategoryID),
+			Month:           budget.Month,
+			Amount:          budget.Amount,
+			Rollover:        budget.Rollover,
+		}
+	}
+
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   monthlyBudgetConflictColumns,
+		DoNothing: true,
+	}).Create(&records).Error
+}
+
+// SaveMonthlyBudget implements domainmodel.MonthlyBudgetRepository.
+func (r *MonthlyBudgetRepository) SaveMonthlyBudget(budget *domainmodel.MonthlyBudget) error {
+	model := &models.MonthlyBudget{
+		HouseholdBookID: uint(budget.HouseHoldID),
+		CategoryID:      uint(budget.CategoryID),
+		Month:           budget.Month,
+		Amount:          budget.Amount,
+		Rollover:        budget.Rollover,
+	}
+
+	if err := r.db.Clauses(clause.OnConflict{
+		Columns:   monthlyBudgetConflictColumns,
+		DoUpdates: clause.AssignmentColumns([]string{"amount", "rollover", "updated_at"}),
+	}).Create(model).Error; err != nil {
+		return err
+	}
+
+	budget.ID = domainmodel.MonthlyBudgetID(model.ID)
+
+	return nil
+}
+
+func convertMonthlyBudget(model *models.MonthlyBudget) *domainmodel.MonthlyBudget {
+	return &domainmodel.MonthlyBudget{
+		ID:          domainmodel.MonthlyBudgetID(model.ID),
+		HouseHoldID: domainmodel.HouseHoldID(model.HouseholdBookID),
+		CategoryID:  domainmodel.CategoryID(model.CategoryID),
+		Month:       model.Month,
+		Amount:      model.Amount,
+		Rollover:    model.Rollover,
+	}
+}
+
+func NewMonthlyBudgetRepository(db *gorm.DB) domainmodel.MonthlyBudgetRepository {
+	return &MonthlyBudgetRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+)
+
+func TestMonthlyBudgetRepository_FindByHouseHoldID(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewMonthlyBudgetRepository(gormDB)
+
+	mock.ExpectQuery(`SELECT \* FROM "monthly_budgets" WHERE household_book_id = \$1 AND month <= \$2 ORDER BY month, id`).
+		WithArgs(1, "2026-10").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "household_book_id", "category_id", "month", "amount", "rollover"}).
+			AddRow(1, 1, 1, "2026-09", 30000, true).
+			AddRow(2, 1, 1, "2026-10", 20000, false))
+
+	budgets, err := repo.FindByHouseHoldID(1, "2026-10")
+	assert.NoError(t, err)
+	assert.Equal(t, []*domainmodel.MonthlyBudget{
+		{ID: 1, HouseHoldID: 1, CategoryID: 1, Month: "2026-09", Amount: 30000, Rollover: true},
+		{ID: 2, HouseHoldID: 1, CategoryID: 1, Month: "2026-10", Amount: 20000, Rollover: false},
+	}, budgets)
+}
+
+func TestMonthlyBudgetRepository_CreateMonthlyBudgets(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewMonthlyBudgetRepository(gormDB)
+
+	// 登録済みの予算は上書きしない
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "monthly_budgets" .* ON CONFLICT \("household_book_id","category_id","month"\) DO NOTHING RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1, 1, "2026-10", 30000, true,
+			sqlmock.AnyArg(), sqlmock.AnyArg(), 1, 2, "2026-10", 5000, false).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+	mock.ExpectCommit()
+
+	err := repo.CreateMonthlyBudgets([]*domainmodel.MonthlyBudget{
+		{HouseHoldID: 1, CategoryID: 1, Month: "2026-10", Amount: 30000, Rollover: true},
+		{HouseHoldID: 1, CategoryID: 2, Month: "2026-10", Amount: 5000},
+	})
+	assert.NoError(t, err)
+
+	// 登録する予算がない場合はクエリを発行しない
+	assert.NoError(t, repo.CreateMonthlyBudgets(nil))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMonthlyBudgetRepository_SaveMonthlyBudget(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewMonthlyBudgetRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "monthly_budgets" .* ON CONFLICT \("household_book_id","category_id","month"\) DO UPDATE SET "amount"="excluded"."amount","rollover"="excluded"."rollover","updated_at"="excluded"."updated_at" RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1, 1, "2026-10", 40000, true).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	mock.ExpectCommit()
+
+	budget := &domainmodel.MonthlyBudget{HouseHoldID: 1, CategoryID: 1, Month: "2026-10", Amount: 40000, Rollover: true}
+	err := repo.SaveMonthlyBudget(budget)
+	assert.NoError(t, err)
+	assert.Equal(t, domainmodel.MonthlyBudgetID(3), budget.ID)
+}
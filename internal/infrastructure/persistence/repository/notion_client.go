@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jomei/notionapi"
+)
+
+const (
+	// notionRequestTimeout は Notion の API の1回の呼び出しのタイムアウト
+	notionRequestTimeout = 30 * time.Second
+	// notionMaxRetries はレート制限（429）を受けた場合に再試行する回数の上限
+	notionMaxRetries = 5
+	// notionMaxRetryWait は再試行までの待ち時間の上限
+	notionMaxRetryWait = 30 * time.Second
+)
+
+// newNotionClient はレート制限を受けた場合に待ってから再試行する Notion のクライアントを作成する
+// notionapi の再試行はリクエストの本文を送り直さないため、HTTP の層で再試行する
+func newNotionClient(apiKey string) *notionapi.Client {
+	return notionapi.NewClient(
+		notionapi.Token(apiKey),
+		notionapi.WithHTTPClient(&http.Client{
+			Transport: &notionRetryTransport{base: http.DefaultTransport, maxRetries: notionMaxRetries, wait: waitContext},
+		}),
+	)
+}
+
+// withNotionTimeout は Notion の API の1回の呼び出しにタイムアウトを設定する
+func withNotionTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, notionRequestTimeout)
+}
+
+// notionRetryTransport は 429 の応答を Retry-After ヘッダーの秒数だけ待ってから再試行する
+type notionRetryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	wait       func(ctx context.Context, d time.Duration) error
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *notionRetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		res, err := t.base.RoundTrip(req)
+		if err != nil || res.StatusCode != http.StatusTooManyRequests || attempt >= t.maxRetries {
+			return res, err
+		}
+
+		delay := notionRetryDelay(res.Header.Get("Retry-After"), attempt)
+		res.Body.Close()
+		if err := t.wait(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// notionRetryDelay は再試行までの待ち時間を返す。Retry-After がない場合は指数的に待ち時間を延ばす
+func notionRetryDelay(retryAfter string, attempt int) time.Duration {
+	delay := time.Second << attempt
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		delay = time.Duration(seconds) * time.Second
+	}
+	if delay > notionMaxRetryWait {
+		delay = notionMaxRetryWait
+	}
+	return delay
+}
+
+// waitContext は d だけ待つ。ctx がキャンセルされた場合は待たずにエラーを返す
+func waitContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
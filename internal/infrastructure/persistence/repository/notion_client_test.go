@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func newNotionResponse(status int, retryAfter string) *http.Response {
+	res := &http.Response{StatusCode: status, Header: http.Header{}, Body: io.NopCloser(bytes.NewReader(nil))}
+	if retryAfter != "" {
+		res.Header.Set("Retry-After", retryAfter)
+	}
+	return res
+}
+
+func TestNotionRetryTransport(t *testing.T) {
+	// 429 を受けた場合は Retry-After の秒数だけ待ち、同じ本文で再試行する
+	bodies := []string{}
+	statuses := []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK}
+	waits := []time.Duration{}
+	transport := &notionRetryTransport{
+		base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			bodies = append(bodies, string(body))
+			res := newNotionResponse(statuses[len(bodies)-1], "2")
+			if len(bodies) == 2 {
+				res.Header.Del("Retry-After")
+			}
+			return res, nil
+		}),
+		maxRetries: 5,
+		wait: func(ctx context.Context, d time.Duration) error {
+			waits = append(waits, d)
+			return nil
+		},
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "https://api.notion.com/v1/pages", bytes.NewBufferString(`{"a":1}`))
+	assert.NoError(t, err)
+	res, err := transport.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, []string{`{"a":1}`, `{"a":1}`, `{"a":1}`}, bodies)
+	assert.Equal(t, []time.Duration{2 * time.Second, 2 * time.Second}, waits)
+
+	t.Run("再試行の上限に達した場合は 429 の応答を返す", func(t *testing.T) {
+		attempts := 0
+		transport := &notionRetryTransport{
+			base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				attempts++
+				return newNotionResponse(http.StatusTooManyRequests, "1"), nil
+			}),
+			maxRetries: 2,
+			wait:       func(ctx context.Context, d time.Duration) error { return nil },
+		}
+
+		req, _ := http.NewRequest(http.MethodGet, "https://api.notion.com/v1/databases/x", nil)
+		res, err := transport.RoundTrip(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("待っている間にリクエストがキャンセルされた場合は再試行しない", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		transport := &notionRetryTransport{
+			base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				return newNotionResponse(http.StatusTooManyRequests, "1"), nil
+			}),
+			maxRetries: 2,
+			wait:       waitContext,
+		}
+
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.notion.com/v1/databases/x", nil)
+		_, err := transport.RoundTrip(req)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestNotionRetryDelay(t *testing.T) {
+	assert.Equal(t, 3*time.Second, notionRetryDelay("3", 0))
+	assert.Equal(t, 4*time.Second, notionRetryDelay("", 2))
+	assert.Equal(t, notionMaxRetryWait, notionRetryDelay("120", 0))
+	assert.Equal(t, notionMaxRetryWait, notionRetryDelay("", 10))
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotionMigrationRepository struct {
+	db *gorm.DB
+}
+
+// FindMigratedPageIDs implements domainmodel.NotionMigrationRepository.
+func (r *NotionMigrationRepository) FindMigratedPageIDs() (map[string]bool, error) {
+	pageIDs := []string{}
+	if err := r.db.Model(&models.NotionMigratedPage{}).Pluck("notion_page_id", &pageIDs).Error; err != nil {
+		return nil, err
+	}
+
+	migrated := make(map[string]bool, len(pageIDs))
+	for _, pageID := range pageIDs {
+		migrated[pageID] = true
+	}
+
+	return migrated, nil
+}
+
+// SaveKaimemoUserMapping implements domainmodel.NotionMigrationRepository.
+func (r *NotionMigrationRepository) SaveKaimemoUserMapping(mapping *domainmodel.KaimemoUserMapping) error {
+	model := &models.KaimemoUserMapping{
+		TempUserID:      mapping.TempUserID,
+		UserID:          uint(mapping.UserID),
+		HouseholdBookID: uint(mapping.HouseHoldID),
+	}
+
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "temp_user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"user_id", "household_book_id", "updated_at"}),
+	}).Create(model).Error
+}
+
+// RegisterMigratedShoppingMemo implements domainmodel.NotionMigrationRepository.
+func (r *NotionMigrationRepository) RegisterMigratedShoppingMemo(pageID string, memo *domainmodel.ShoppingMemo) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		model := &models.ShoppingMemo{
+			HouseholdBookID: uint(memo.HouseholdID),
+			CategoryID:      uint(memo.CategoryID),
+			Title:           memo.Title,
+			Memo:            memo.Memo,
+			IsCompleted:     bool(memo.IsCompleted),
+		}
+		if err := tx.Omit(clause.Associations).Create(model).Error; err != nil {
+			return err
+		}
+		memo.ID = domainmodel.ShoppingID(model.ID)
+
+		return tx.Create(&models.NotionMigratedPage{
+			NotionPageID:    pageID,
+			Source:          domainmodel.NotionMigrationSourceKaimemo,
+			HouseholdBookID: model.HouseholdBookID,
+			ShoppingMemoID:  &model.ID,
+		}).Error
+	})
+}
+
+// RegisterMigratedShoppingAmount implements domainmodel.NotionMigrationRepository.
+func (r *NotionMigrationRepository) RegisterMigratedShoppingAmount(pageID string, amount *domainmodel.ShoppingAmount) error {
+	date, err := time.Parse("2006-01-02", amount.Date)
+	if err != nil {
+		return err
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		model := &models.ShoppingAmount{
+			HouseholdBookID: uint(amount.HouseholdID),
+			CategoryID:      uint(amount.CategoryID),
+			Amount:          amount.Amount,
+			Date:            date,
+			Memo:            amount.Memo,
+			SplitType:       string(amount.SplitType),
+		}
+		if err := tx.Omit(clause.Associations).Create(model).Error; err != nil {
+			return err
+		}
+		amount.ID = domainmodel.ShoppingID(model.ID)
+
+		return tx.Create(&models.NotionMigratedPage{
+			NotionPageID:     pageID,
+			Source:           domainmodel.NotionMigrationSourceKaimemoAmount,
+			HouseholdBookID:  model.HouseholdBookID,
+			ShoppingAmountID: &model.ID,
+		}).Error
+	})
+}
+
+// SummarizeMigratedRecords implements domainmodel.NotionMigrationRepository.
+// 移行した後に削除された記録は集計に含めない
+func (r *NotionMigrationRepository) SummarizeMigratedRecords(houseHoldID domainmodel.HouseHoldID) (*domainmodel.NotionMigrationTotal, error) {
+	var memoCount int64
+	if err := r.db.Model(&models.NotionMigratedPage{}).
+		Joins("JOIN shopping_memos ON shopping_memos.id = notion_migrated_pages.shopping_memo_id AND shopping_memos.deleted_at IS NULL").
+		Where("notion_migrated_pages.household_book_id = ?", houseHoldID).
+		Count(&memoCount).Error; err != nil {
+		return nil, err
+	}
+
+	var amounts struct {
+		Count  int
+		Amount int
+	}
+	if err := r.db.Model(&models.NotionMigratedPage{}).
+		Select("COUNT(shopping_amounts.id) AS count, COALESCE(SUM(shopping_amounts.amount), 0) AS amount").
+		Joins("JOIN shopping_amounts ON shopping_amounts.id = notion_migrated_pages.shopping_amount_id AND shopping_amounts.deleted_at IS NULL").
+		Where("notion_migrated_pages.household_book_id = ?", houseHoldID).
+		Scan(&amounts).Error; err != nil {
+		return nil, err
+	}
+
+	return &domainmodel.NotionMigrationTotal{
+		MemoCount:   int(memoCount),
+		AmountCount: amounts.Count,
+		Amount:      amounts.Amount,
+	}, nil
+}
+
+func NewNotionMigrationRepository(db *gorm.DB) domainmodel.NotionMigrationRepository {
+	return &NotionMigrationRepository{
+		db: db,
+	}
+}
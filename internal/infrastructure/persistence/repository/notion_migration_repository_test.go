@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+)
+
+func TestNotionMigrationRepository_RegisterMigratedShoppingAmount(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewNotionMigrationRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "shopping_amounts" .* RETURNING`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(12))
+	mock.ExpectExec(`INSERT INTO "notion_migrated_pages" \("notion_page_id","source","household_book_id","shopping_memo_id","shopping_amount_id","created_at"\) VALUES \(\$1,\$2,\$3,\$4,\$5,\$6\)`).
+		WithArgs("page-1", domainmodel.NotionMigrationSourceKaimemoAmount, 3, nil, 12, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	amount := domainmodel.NewShoppingAmount(3, 1, 1200, "2024-05-01", "", 0)
+	assert.NoError(t, repo.RegisterMigratedShoppingAmount("page-1", amount))
+	assert.Equal(t, domainmodel.ShoppingID(12), amount.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotionMigrationRepository_SummarizeMigratedRecords(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewNotionMigrationRepository(gormDB)
+
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "notion_migrated_pages" JOIN shopping_memos .* WHERE notion_migrated_pages.household_book_id = \$1`).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+	mock.ExpectQuery(`SELECT COUNT\(shopping_amounts.id\) AS count, COALESCE\(SUM\(shopping_amounts.amount\), 0\) AS amount FROM "notion_migrated_pages" JOIN shopping_amounts .* WHERE notion_migrated_pages.household_book_id = \$1`).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"count", "amount"}).AddRow(2, 1500))
+
+	total, err := repo.SummarizeMigratedRecords(3)
+	assert.NoError(t, err)
+	assert.Equal(t, &domainmodel.NotionMigrationTotal{MemoCount: 4, AmountCount: 2, Amount: 1500}, total)
+}
@@ -3,55 +3,41 @@ package repository
 
 import (
 	"context"
+	domainmodel "echo-household-budget/internal/domain/model"
 	model "echo-household-budget/internal/model"
 	"log"
+	"strings"
 
 	"github.com/jomei/notionapi"
 )
 
+// notionQueryPageSize は Notion のデータベースの1回の問い合わせで取得するページ数の上限
+const notionQueryPageSize = 100
+
 type notionRepository struct {
 	client                         *notionapi.Client
-	query                          *notionapi.DatabaseQueryRequest
 	databaseKaimemoInputID         string
 	databaseKaimemoSummaryRecordID string
 }
 
-// FetchKaimemoAmount implements KaimemoRepository.
-func (k *notionRepository) FetchKaimemoAmountRecords(userID string) (*model.KaimemoAmountRecords, error) {
-	k.query.Filter = &notionapi.PropertyFilter{
-		Property: "tempUserID",
-		RichText: &notionapi.TextFilterCondition{
-			Contains: userID,
-		},
-	}
-
-	resp, err := k.client.Database.Query(context.Background(), notionapi.DatabaseID(k.databaseKaimemoSummaryRecordID), k.query)
-	if err != nil {
-		log.Printf("failed to notion query database: %v", err)
-		return nil, err
-	}
-
+// FetchKaimemoAmountRecords implements KaimemoRepository.
+func (k *notionRepository) FetchKaimemoAmountRecords(ctx context.Context, userID string) (*model.KaimemoAmountRecords, error) {
 	var kaimemoAmounts []model.KaimemoAmount
-	for _, result := range resp.Results {
-		properties := result.Properties
-
-		data := model.KaimemoAmount{}
-		data.ID = string(result.ID)
-		for _, property := range properties {
-			switch prop := property.(type) {
-			case *notionapi.TitleProperty:
-				for _, text := range prop.Title {
-					data.Date = text.Text.Content
-				}
-			case *notionapi.NumberProperty:
-				data.Amount = int(prop.Number)
-			case *notionapi.SelectProperty:
-				data.Tag = prop.Select.Name
-			default:
-				// Unhandled property type
-			}
+	err := k.queryAllPages(ctx, k.databaseKaimemoSummaryRecordID, tempUserIDFilter(userID), func(result notionapi.Page) {
+		data := model.KaimemoAmount{
+			ID:   string(result.ID),
+			Date: notionPlainText(result.Properties["date"]),
+		}
+		if prop, ok := result.Properties["amount"].(*notionapi.NumberProperty); ok {
+			data.Amount = int(prop.Number)
+		}
+		if prop, ok := result.Properties["tag"].(*notionapi.SelectProperty); ok {
+			data.Tag = prop.Select.Name
 		}
 		kaimemoAmounts = append(kaimemoAmounts, data)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &model.KaimemoAmountRecords{
@@ -60,8 +46,11 @@ func (k *notionRepository) FetchKaimemoAmountRecords(userID string) (*model.Kaim
 }
 
 // InsertKaimemoAmount implements KaimemoRepository.
-func (k *notionRepository) InsertKaimemoAmount(req model.CreateKaimemoAmountRequest) error {
-	_, err := k.client.Page.Create(context.Background(), &notionapi.PageCreateRequest{
+func (k *notionRepository) InsertKaimemoAmount(ctx context.Context, req model.CreateKaimemoAmountRequest) error {
+	ctx, cancel := withNotionTimeout(ctx)
+	defer cancel()
+
+	_, err := k.client.Page.Create(ctx, &notionapi.PageCreateRequest{
 		Parent: notionapi.Parent{
 			DatabaseID: notionapi.DatabaseID(k.databaseKaimemoSummaryRecordID),
 		},
@@ -103,62 +92,39 @@ func (k *notionRepository) InsertKaimemoAmount(req model.CreateKaimemoAmountRequ
 }
 
 // RemoveKaimemoAmount implements KaimemoRepository.
-func (k *notionRepository) RemoveKaimemoAmount(id string, userID string) error {
-	_, err := k.client.Page.Update(context.Background(), notionapi.PageID(id), &notionapi.PageUpdateRequest{
-		Archived: true,
-	})
-
-	if err != nil {
-		log.Printf("failed to notion update page: %v", err)
-		return err
-	}
-
-	return nil
+func (k *notionRepository) RemoveKaimemoAmount(ctx context.Context, id string, userID string) error {
+	return k.archivePage(ctx, id)
 }
 
 // FetchKaimemo implements KaimemoRepository.
-func (k *notionRepository) FetchKaimemo(userID string) ([]model.KaimemoResponse, error) {
-	k.query.Filter = &notionapi.PropertyFilter{
-		Property: "tempUserID",
-		RichText: &notionapi.TextFilterCondition{
-			Contains: userID,
-		},
-	}
-	resp, err := k.client.Database.Query(context.Background(), notionapi.DatabaseID(k.databaseKaimemoInputID), k.query)
-	if err != nil {
-		log.Printf("failed to notion query database: %v", err)
-		return nil, err
-	}
-
+func (k *notionRepository) FetchKaimemo(ctx context.Context, userID string) ([]model.KaimemoResponse, error) {
 	var kaimemoResponses []model.KaimemoResponse
-	for _, result := range resp.Results {
-		properties := result.Properties
-
-		data := model.KaimemoResponse{}
-		data.ID = string(result.ID)
-		for _, property := range properties {
-			switch prop := property.(type) {
-			case *notionapi.TitleProperty:
-				for _, text := range prop.Title {
-					data.Name = text.Text.Content
-				}
-			case *notionapi.SelectProperty:
-				data.Tag = prop.Select.Name
-			case *notionapi.CheckboxProperty:
-				data.Done = prop.Checkbox
-			default:
-				// fmt.Printf("  %s: Unhandled property type\n", key)
-			}
+	err := k.queryAllPages(ctx, k.databaseKaimemoInputID, tempUserIDFilter(userID), func(result notionapi.Page) {
+		data := model.KaimemoResponse{
+			ID:   string(result.ID),
+			Name: notionPlainText(result.Properties["name"]),
+		}
+		if prop, ok := result.Properties["tag"].(*notionapi.SelectProperty); ok {
+			data.Tag = prop.Select.Name
+		}
+		if prop, ok := result.Properties["done"].(*notionapi.CheckboxProperty); ok {
+			data.Done = prop.Checkbox
 		}
 		kaimemoResponses = append(kaimemoResponses, data)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return kaimemoResponses, nil
 }
 
 // InsertKaimemo implements KaimemoRepository.
-func (k *notionRepository) InsertKaimemo(req model.CreateKaimemoRequest) error {
-	_, err := k.client.Page.Create(context.Background(), &notionapi.PageCreateRequest{
+func (k *notionRepository) InsertKaimemo(ctx context.Context, req model.CreateKaimemoRequest) error {
+	ctx, cancel := withNotionTimeout(ctx)
+	defer cancel()
+
+	_, err := k.client.Page.Create(ctx, &notionapi.PageCreateRequest{
 		Parent: notionapi.Parent{
 			DatabaseID: notionapi.DatabaseID(k.databaseKaimemoInputID), // 既存のデータベースID
 		},
@@ -198,11 +164,159 @@ func (k *notionRepository) InsertKaimemo(req model.CreateKaimemoRequest) error {
 }
 
 // RemoveKaimemo implements KaimemoRepository.
-func (k *notionRepository) RemoveKaimemo(id string, userID string) error {
-	_, err := k.client.Page.Update(context.Background(), notionapi.PageID(id), &notionapi.PageUpdateRequest{
-		Archived: true,
+func (k *notionRepository) RemoveKaimemo(ctx context.Context, id string, userID string) error {
+	return k.archivePage(ctx, id)
+}
+
+// FetchKaimemoPages implements domainmodel.NotionKaimemoSource.
+func (k *notionRepository) FetchKaimemoPages(ctx context.Context) ([]*domainmodel.NotionKaimemoPage, error) {
+	pages := []*domainmodel.NotionKaimemoPage{}
+	err := k.queryAllPages(ctx, k.databaseKaimemoInputID, nil, func(result notionapi.Page) {
+		page := &domainmodel.NotionKaimemoPage{
+			PageID:      string(result.ID),
+			TempUserID:  notionPlainText(result.Properties["tempUserID"]),
+			Name:        notionPlainText(result.Properties["name"]),
+			CreatedTime: result.CreatedTime,
+		}
+		if prop, ok := result.Properties["tag"].(*notionapi.SelectProperty); ok {
+			page.Tag = prop.Select.Name
+		}
+		if prop, ok := result.Properties["done"].(*notionapi.CheckboxProperty); ok {
+			page.Done = prop.Checkbox
+		}
+		pages = append(pages, page)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pages, nil
+}
+
+// FetchKaimemoAmountPages implements domainmodel.NotionKaimemoSource.
+func (k *notionRepository) FetchKaimemoAmountPages(ctx context.Context) ([]*domainmodel.NotionKaimemoAmountPage, error) {
+	pages := []*domainmodel.NotionKaimemoAmountPage{}
+	err := k.queryAllPages(ctx, k.databaseKaimemoSummaryRecordID, nil, func(result notionapi.Page) {
+		page := &domainmodel.NotionKaimemoAmountPage{
+			PageID:      string(result.ID),
+			TempUserID:  notionPlainText(result.Properties["tempUserID"]),
+			Date:        notionPlainText(result.Properties["date"]),
+			CreatedTime: result.CreatedTime,
+		}
+		if prop, ok := result.Properties["tag"].(*notionapi.SelectProperty); ok {
+			page.Tag = prop.Select.Name
+		}
+		if prop, ok := result.Properties["amount"].(*notionapi.NumberProperty); ok {
+			page.Amount = int(prop.Number)
+		}
+		pages = append(pages, page)
 	})
+	if err != nil {
+		return nil, err
+	}
+	return pages, nil
+}
 
+// FetchPages implements domainmodel.NotionSyncClient.
+func (k *notionRepository) FetchPages(ctx context.Context, databaseID string) ([]*domainmodel.NotionSyncPage, error) {
+	pages := []*domainmodel.NotionSyncPage{}
+	err := k.queryAllPages(ctx, databaseID, nil, func(result notionapi.Page) {
+		page := &domainmodel.NotionSyncPage{
+			PageID: string(result.ID),
+			Values: domainmodel.NotionSyncValues{
+				Date: notionPlainText(result.Properties["date"]),
+				Memo: notionPlainText(result.Properties["memo"]),
+			},
+			LastEditedTime: result.LastEditedTime,
+		}
+		if prop, ok := result.Properties["amount"].(*notionapi.NumberProperty); ok {
+			page.Values.Amount = int(prop.Number)
+		}
+		if prop, ok := result.Properties["tag"].(*notionapi.SelectProperty); ok {
+			page.Values.Tag = prop.Select.Name
+		}
+		pages = append(pages, page)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pages, nil
+}
+
+// CreatePage implements domainmodel.NotionSyncClient.
+func (k *notionRepository) CreatePage(ctx context.Context, databaseID string, values domainmodel.NotionSyncValues) (string, error) {
+	ctx, cancel := withNotionTimeout(ctx)
+	defer cancel()
+
+	page, err := k.client.Page.Create(ctx, &notionapi.PageCreateRequest{
+		Parent: notionapi.Parent{
+			DatabaseID: notionapi.DatabaseID(databaseID),
+		},
+		Properties: notionSyncProperties(values),
+	})
+	if err != nil {
+		log.Printf("failed to notion create page: %v", err)
+		return "", err
+	}
+
+	return string(page.ID), nil
+}
+
+// UpdatePage implements domainmodel.NotionSyncClient.
+func (k *notionRepository) UpdatePage(ctx context.Context, pageID string, values domainmodel.NotionSyncValues) error {
+	ctx, cancel := withNotionTimeout(ctx)
+	defer cancel()
+
+	_, err := k.client.Page.Update(ctx, notionapi.PageID(pageID), &notionapi.PageUpdateRequest{
+		Properties: notionSyncProperties(values),
+	})
+	if err != nil {
+		log.Printf("failed to notion update page: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// ArchivePage implements domainmodel.NotionSyncClient.
+func (k *notionRepository) ArchivePage(ctx context.Context, pageID string) error {
+	return k.archivePage(ctx, pageID)
+}
+
+// queryAllPages はデータベースのページを、次のページがなくなるまで順に取得する
+// 問い合わせは呼び出しごとに作成するため、並行して呼び出してもよい
+func (k *notionRepository) queryAllPages(ctx context.Context, databaseID string, filter notionapi.Filter, handle func(result notionapi.Page)) error {
+	query := &notionapi.DatabaseQueryRequest{Filter: filter, PageSize: notionQueryPageSize}
+	for {
+		resp, err := k.queryPage(ctx, databaseID, query)
+		if err != nil {
+			log.Printf("failed to notion query database: %v", err)
+			return err
+		}
+		for _, result := range resp.Results {
+			handle(result)
+		}
+		if !resp.HasMore || resp.NextCursor == "" {
+			return nil
+		}
+		query.StartCursor = resp.NextCursor
+	}
+}
+
+func (k *notionRepository) queryPage(ctx context.Context, databaseID string, query *notionapi.DatabaseQueryRequest) (*notionapi.DatabaseQueryResponse, error) {
+	ctx, cancel := withNotionTimeout(ctx)
+	defer cancel()
+
+	return k.client.Database.Query(ctx, notionapi.DatabaseID(databaseID), query)
+}
+
+// archivePage は Notion のページをアーカイブする
+func (k *notionRepository) archivePage(ctx context.Context, id string) error {
+	ctx, cancel := withNotionTimeout(ctx)
+	defer cancel()
+
+	_, err := k.client.Page.Update(ctx, notionapi.PageID(id), &notionapi.PageUpdateRequest{
+		Archived: true,
+	})
 	if err != nil {
 		log.Printf("failed to notion update page: %v", err)
 		return err
@@ -211,18 +325,80 @@ func (k *notionRepository) RemoveKaimemo(id string, userID string) error {
 	return nil
 }
 
+// tempUserIDFilter は tempUserID のページに絞り込む条件
+func tempUserIDFilter(userID string) notionapi.Filter {
+	return &notionapi.PropertyFilter{
+		Property: "tempUserID",
+		RichText: &notionapi.TextFilterCondition{
+			Contains: userID,
+		},
+	}
+}
+
+// notionSyncProperties は同期する支出の値を Notion のページのプロパティにする
+func notionSyncProperties(values domainmodel.NotionSyncValues) notionapi.Properties {
+	properties := notionapi.Properties{
+		"date": &notionapi.TitleProperty{
+			Title: []notionapi.RichText{{Text: &notionapi.Text{Content: values.Date}}},
+		},
+		"amount": &notionapi.NumberProperty{
+			Number: float64(values.Amount),
+		},
+		"memo": &notionapi.RichTextProperty{
+			RichText: []notionapi.RichText{},
+		},
+	}
+	if values.Memo != "" {
+		properties["memo"] = &notionapi.RichTextProperty{
+			RichText: []notionapi.RichText{{Text: &notionapi.Text{Content: values.Memo}}},
+		}
+	}
+	// 空の名前のセレクトは作成できないため、タグがない場合は設定しない
+	if values.Tag != "" {
+		properties["tag"] = &notionapi.SelectProperty{Select: notionapi.Option{Name: values.Tag}}
+	}
+	return properties
+}
+
+// notionPlainText はタイトルまたはテキストのプロパティの文字列を返す
+func notionPlainText(property notionapi.Property) string {
+	var texts []notionapi.RichText
+	switch prop := property.(type) {
+	case *notionapi.TitleProperty:
+		texts = prop.Title
+	case *notionapi.RichTextProperty:
+		texts = prop.RichText
+	}
+	var builder strings.Builder
+	for _, text := range texts {
+		builder.WriteString(text.PlainText)
+	}
+	return builder.String()
+}
+
 type KaimemoRepository interface {
-	FetchKaimemo(userID string) ([]model.KaimemoResponse, error)
-	InsertKaimemo(req model.CreateKaimemoRequest) error
-	RemoveKaimemo(id string, userID string) error
-	FetchKaimemoAmountRecords(userID string) (*model.KaimemoAmountRecords, error)
-	InsertKaimemoAmount(req model.CreateKaimemoAmountRequest) error
-	RemoveKaimemoAmount(id string, userID string) error
+	FetchKaimemo(ctx context.Context, userID string) ([]model.KaimemoResponse, error)
+	InsertKaimemo(ctx context.Context, req model.CreateKaimemoRequest) error
+	RemoveKaimemo(ctx context.Context, id string, userID string) error
+	FetchKaimemoAmountRecords(ctx context.Context, userID string) (*model.KaimemoAmountRecords, error)
+	InsertKaimemoAmount(ctx context.Context, req model.CreateKaimemoAmountRequest) error
+	RemoveKaimemoAmount(ctx context.Context, id string, userID string) error
 }
 
 func NewNotionRepository(apiKey string, databaseKaimemoInputID string, databaseKaimemoSummaryRecordID string) KaimemoRepository {
-	client := notionapi.NewClient(notionapi.Token(apiKey))
-	query := &notionapi.DatabaseQueryRequest{}
+	client := newNotionClient(apiKey)
+
+	return &notionRepository{client: client, databaseKaimemoInputID: databaseKaimemoInputID, databaseKaimemoSummaryRecordID: databaseKaimemoSummaryRecordID}
+}
+
+// NewNotionKaimemoSource は Postgres への移行のために Notion の買い物メモを取得する
+func NewNotionKaimemoSource(apiKey string, databaseKaimemoInputID string, databaseKaimemoSummaryRecordID string) domainmodel.NotionKaimemoSource {
+	client := newNotionClient(apiKey)
+
+	return &notionRepository{client: client, databaseKaimemoInputID: databaseKaimemoInputID, databaseKaimemoSummaryRecordID: databaseKaimemoSummaryRecordID}
+}
 
-	return &notionRepository{client: client, databaseKaimemoInputID: databaseKaimemoInputID, databaseKaimemoSummaryRecordID: databaseKaimemoSummaryRecordID, query: query}
+// NewNotionSyncClient は家計簿の支出を同期する Notion のデータベースを読み書きする
+func NewNotionSyncClient(apiKey string) domainmodel.NotionSyncClient {
+	return &notionRepository{client: newNotionClient(apiKey)}
 }
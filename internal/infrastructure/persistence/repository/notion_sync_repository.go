@@ -0,0 +1,229 @@
+package repository
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotionSyncRepository struct {
+	db *gorm.DB
+}
+
+// notionSyncRecord は同期する支出とカテゴリ名の取得結果
+type notionSyncRecord struct {
+	ID        uint
+	Amount    int
+	Date      time.Time
+	Memo      string
+	Tag       string
+	UpdatedAt time.Time
+}
+
+// FindNotionSyncSetting implements domainmodel.NotionSyncRepository.
+func (r *NotionSyncRepository) FindNotionSyncSetting(houseHoldID domainmodel.HouseHoldID) (*domainmodel.NotionSyncSetting, error) {
+	model := &models.NotionSyncSetting{}
+	if err := r.db.Where("household_book_id = ?", houseHoldID).First(model).Error; err != nil {
+		return nil, err
+	}
+	return toNotionSyncSetting(model), nil
+}
+
+// FindEnabledNotionSyncSettings implements domainmodel.NotionSyncRepository.
+func (r *NotionSyncRepository) FindEnabledNotionSyncSettings() ([]*domainmodel.NotionSyncSetting, error) {
+	settings := []*models.NotionSyncSetting{}
+	if err := r.db.Where("enabled = ?", true).Order("household_book_id").Find(&settings).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*domainmodel.NotionSyncSetting, 0, len(settings))
+	for _, setting := range settings {
+		result = append(result, toNotionSyncSetting(setting))
+	}
+	return result, nil
+}
+
+// SaveNotionSyncSetting implements domainmodel.NotionSyncRepository.
+// 同期した日時は同期の結果としてのみ更新する
+func (r *NotionSyncRepository) SaveNotionSyncSetting(setting *domainmodel.NotionSyncSetting) error {
+	model := &models.NotionSyncSetting{
+		HouseholdBookID: uint(setting.HouseHoldID),
+		DatabaseID:      setting.DatabaseID,
+		ConflictPolicy:  string(setting.ConflictPolicy),
+		Enabled:         setting.Enabled,
+	}
+
+	return r.db.Omit("last_synced_at").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "household_book_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"database_id", "conflict_policy", "enabled", "updated_at"}),
+	}).Create(model).Error
+}
+
+// FindNotionSyncRecords implements domainmodel.NotionSyncRepository.
+func (r *NotionSyncRepository) FindNotionSyncRecords(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.NotionSyncRecord, error) {
+	records := []notionSyncRecord{}
+	if err := r.db.Model(&models.ShoppingAmount{}).
+		Select("shopping_amounts.id, shopping_amounts.amount, shopping_amounts.date, shopping_amounts.memo, shopping_amounts.updated_at, COALESCE(category_limits.name, categories.name) AS tag").
+		Joins("LEFT JOIN category_limits ON category_limits.category_id = shopping_amounts.category_id AND category_limits.household_book_id = shopping_amounts.household_book_id AND category_limits.deleted_at IS NULL").
+		Joins("LEFT JOIN categories ON categories.id = shopping_amounts.category_id").
+		Where("shopping_amounts.household_book_id = ?", houseHoldID).
+		Order("shopping_amounts.id").
+		Scan(&records).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*domainmodel.NotionSyncRecord, 0, len(records))
+	for _, record := range records {
+		result = append(result, &domainmodel.NotionSyncRecord{
+			ShoppingID: domainmodel.ShoppingID(record.ID),
+			Values: domainmodel.NotionSyncValues{
+				Date:   record.Date.Format("2006-01-02"),
+				Amount: record.Amount,
+				Tag:    record.Tag,
+				Memo:   record.Memo,
+			},
+			UpdatedAt: record.UpdatedAt,
+		})
+	}
+	return result, nil
+}
+
+// FindNotionSyncLinks implements domainmodel.NotionSyncRepository.
+func (r *NotionSyncRepository) FindNotionSyncLinks(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.NotionSyncLink, error) {
+	links := []*models.NotionSyncLink{}
+	if err := r.db.Where("household_book_id = ?", houseHoldID).Order("id").Find(&links).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*domainmodel.NotionSyncLink, 0, len(links))
+	for _, link := range links {
+		result = append(result, &domainmodel.NotionSyncLink{
+			ShoppingID: domainmodel.ShoppingID(link.ShoppingAmountID),
+			PageID:     link.NotionPageID,
+			Snapshot: domainmodel.NotionSyncValues{
+				Date:   link.SnapshotDate.Format("2006-01-02"),
+				Amount: link.SnapshotAmount,
+				Tag:    link.SnapshotTag,
+				Memo:   link.SnapshotMemo,
+			},
+		})
+	}
+	return result, nil
+}
+
+// ApplyNotionSync implements domainmodel.NotionSyncRepository.
+// Notion のページから作成した支出の対応もあわせて保存し、家計簿の対応はすべて置き換える
+func (r *NotionSyncRepository) ApplyNotionSync(houseHoldID domainmodel.HouseHoldID, plan *domainmodel.NotionSyncPlan, syncedAt time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		links := append([]*domainmodel.NotionSyncLink{}, plan.Links...)
+
+		for _, page := range plan.CreateRecords {
+			model, err := notionSyncShoppingAmount(tx, houseHoldID, page.Values)
+			if err != nil {
+				return err
+			}
+			if err := tx.Omit(clause.Associations).Create(model).Error; err != nil {
+				return err
+			}
+			links = append(links, &domainmodel.NotionSyncLink{
+				ShoppingID: domainmodel.ShoppingID(model.ID),
+				PageID:     page.PageID,
+				Snapshot:   page.Values,
+			})
+		}
+
+		for _, link := range plan.UpdateRecords {
+			model, err := notionSyncShoppingAmount(tx, houseHoldID, link.Snapshot)
+			if err != nil {
+				return err
+			}
+			if err := tx.Model(&models.ShoppingAmount{}).
+				Where("id = ? AND household_book_id = ?", link.ShoppingID, houseHoldID).
+				Updates(map[string]interface{}{
+					"category_id": model.CategoryID,
+					"amount":      model.Amount,
+					"date":        model.Date,
+					"memo":        model.Memo,
+				}).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(plan.DeleteRecords) > 0 {
+			// Notion で削除した記録は一度の削除操作としてゴミ箱に移す。メンバーの操作ではないため元に戻す対象にはしない
+			if err := softDelete(tx.Where("household_book_id = ? AND id IN ?", houseHoldID, plan.DeleteRecords), &models.ShoppingAmount{}, domainmodel.NewDeletion(nil)).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Where("household_book_id = ?", houseHoldID).Delete(&models.NotionSyncLink{}).Error; err != nil {
+			return err
+		}
+		if len(links) > 0 {
+			linkModels := make([]*models.NotionSyncLink, 0, len(links))
+			for _, link := range links {
+				date, err := time.Parse("2006-01-02", link.Snapshot.Date)
+				if err != nil {
+					return err
+				}
+				linkModels = append(linkModels, &models.NotionSyncLink{
+					HouseholdBookID:  uint(houseHoldID),
+					ShoppingAmountID: uint(link.ShoppingID),
+					NotionPageID:     link.PageID,
+					SnapshotDate:     date,
+					SnapshotAmount:   link.Snapshot.Amount,
+					SnapshotTag:      link.Snapshot.Tag,
+					SnapshotMemo:     link.Snapshot.Memo,
+					SyncedAt:         syncedAt,
+				})
+			}
+			if err := tx.Create(&linkModels).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&models.NotionSyncSetting{}).
+			Where("household_book_id = ?", houseHoldID).
+			Update("last_synced_at", syncedAt).Error
+	})
+}
+
+// notionSyncShoppingAmount は Notion の値から家計簿の支出を作成する。タグと同じ名前のカテゴリがない場合は作成する
+func notionSyncShoppingAmount(tx *gorm.DB, houseHoldID domainmodel.HouseHoldID, values domainmodel.NotionSyncValues) (*models.ShoppingAmount, error) {
+	date, err := time.Parse("2006-01-02", values.Date)
+	if err != nil {
+		return nil, err
+	}
+	categoryID, err := findOrCreateKaimemoCategory(tx, uint(houseHoldID), values.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ShoppingAmount{
+		HouseholdBookID: uint(houseHoldID),
+		CategoryID:      categoryID,
+		Amount:          values.Amount,
+		Date:            date,
+		Memo:            values.Memo,
+		SplitType:       string(domainmodel.SplitEqual),
+	}, nil
+}
+
+func toNotionSyncSetting(model *models.NotionSyncSetting) *domainmodel.NotionSyncSetting {
+	return &domainmodel.NotionSyncSetting{
+		HouseHoldID:    domainmodel.HouseHoldID(model.HouseholdBookID),
+		DatabaseID:     model.DatabaseID,
+		ConflictPolicy: domainmodel.NotionSyncConflictPolicy(model.ConflictPolicy),
+		Enabled:        model.Enabled,
+		LastSyncedAt:   model.LastSyncedAt,
+	}
+}
+
+func NewNotionSyncRepository(db *gorm.DB) domainmodel.NotionSyncRepository {
+	return &NotionSyncRepository{
+		db: db,
+	}
+}
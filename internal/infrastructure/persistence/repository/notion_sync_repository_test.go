@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+)
+
+func TestNotionSyncRepository_SaveNotionSyncSetting(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewNotionSyncRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "notion_sync_settings" \("household_book_id","database_id","conflict_policy","enabled","created_at","updated_at"\) VALUES \(\$1,\$2,\$3,\$4,\$5,\$6\) ON CONFLICT \("household_book_id"\) DO UPDATE SET "database_id"="excluded"."database_id","conflict_policy"="excluded"."conflict_policy","enabled"="excluded"."enabled","updated_at"="excluded"."updated_at"`).
+		WithArgs(3, "db", "notion", true, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.SaveNotionSyncSetting(&domainmodel.NotionSyncSetting{HouseHoldID: 3, DatabaseID: "db", ConflictPolicy: domainmodel.NotionSyncConflictNotion, Enabled: true})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotionSyncRepository_FindNotionSyncRecords(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewNotionSyncRepository(gormDB)
+
+	updatedAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`SELECT shopping_amounts.id, .*COALESCE\(category_limits.name, categories.name\) AS tag FROM "shopping_amounts" LEFT JOIN category_limits .* LEFT JOIN categories .* WHERE shopping_amounts.household_book_id = \$1 AND "shopping_amounts"."deleted_at" IS NULL ORDER BY shopping_amounts.id`).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "amount", "date", "memo", "updated_at", "tag"}).
+			AddRow(7, 1200, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), "スーパー", updatedAt, "食費"))
+
+	records, err := repo.FindNotionSyncRecords(3)
+	assert.NoError(t, err)
+	assert.Equal(t, []*domainmodel.NotionSyncRecord{{
+		ShoppingID: 7,
+		Values:     domainmodel.NotionSyncValues{Date: "2026-10-01", Amount: 1200, Tag: "食費", Memo: "スーパー"},
+		UpdatedAt:  updatedAt,
+	}}, records)
+}
+
+func TestNotionSyncRepository_ApplyNotionSync(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewNotionSyncRepository(gormDB)
+
+	syncedAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	values := domainmodel.NotionSyncValues{Date: "2026-10-01", Amount: 1200, Tag: "食費"}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "category_limits" WHERE \(household_book_id = \$1 AND name = \$2\) AND "category_limits"."deleted_at" IS NULL`).
+		WithArgs(3, "食費").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "household_book_id", "category_id", "name"}).AddRow(1, 3, 5, "食費"))
+	mock.ExpectExec(`UPDATE "shopping_amounts" SET "amount"=\$1,"category_id"=\$2,"date"=\$3,"memo"=\$4,"updated_at"=\$5 WHERE \(id = \$6 AND household_book_id = \$7\) AND "shopping_amounts"."deleted_at" IS NULL`).
+		WithArgs(1200, 5, sqlmock.AnyArg(), "", sqlmock.AnyArg(), 7, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// Notion で削除された記録はゴミ箱に移す
+	mock.ExpectExec(`UPDATE "shopping_amounts" SET "delete_batch_id"=\$1,"deleted_at"=\$2,"deleted_by"=\$3,"updated_at"=\$4 WHERE \(household_book_id = \$5 AND id IN \(\$6,\$7\)\) AND "shopping_amounts"."deleted_at" IS NULL`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, sqlmock.AnyArg(), 3, 8, 9).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`DELETE FROM "notion_sync_links" WHERE household_book_id = \$1`).
+		WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectQuery(`INSERT INTO "notion_sync_links" .* RETURNING "id"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec(`UPDATE "notion_sync_settings" SET "last_synced_at"=\$1,"updated_at"=\$2 WHERE household_book_id = \$3`).
+		WithArgs(syncedAt, sqlmock.AnyArg(), 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	link := &domainmodel.NotionSyncLink{ShoppingID: 7, PageID: "p7", Snapshot: values}
+	err := repo.ApplyNotionSync(3, &domainmodel.NotionSyncPlan{
+		UpdateRecords: []*domainmodel.NotionSyncLink{link},
+		DeleteRecords: []domainmodel.ShoppingID{8, 9},
+		Links:         []*domainmodel.NotionSyncLink{link},
+	}, syncedAt)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
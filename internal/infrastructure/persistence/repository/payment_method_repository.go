@@ -0,0 +1,241 @@
+package repository
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+	"errors"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type PaymentMethodRepository struct {
+	db *gorm.DB
+}
+
+// FindByHouseHoldID implements domainmodel.PaymentMethodRepository.
+func (r *PaymentMethodRepository) FindByHouseHoldID(houseHoldID domainmodel.HouseHoldID, includeArchived bool) ([]*domainmodel.PaymentMethod, error) {
+	query := r.db.Where("household_book_id = ?", houseHoldID)
+	if !includeArchived {
+		query = query.Where("archived_at IS NULL")
+	}
+
+	methods := []*models.PaymentMethod{}
+	if err := query.Order("id").Find(&methods).Error; err != nil {
+		return nil, err
+	}
+
+	output := make([]*domainmodel.PaymentMethod, len(methods))
+	for i, method := range methods {
+		output[i] = domainmodel.ConvertPaymentMethod(method)
+	}
+
+	return output, nil
+}
+
+// FindByID implements domainmodel.PaymentMethodRepository.
+func (r *PaymentMethodRepository) FindByID(houseHoldID domainmodel.HouseHoldID, id domainmodel.PaymentMethodID) (*domainmodel.PaymentMethod, error) {
+	model := &models.PaymentMethod{}
+	if err := r.db.Where("id = ? AND household_book_id = ?", id, houseHoldID).First(model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return domainmodel.ConvertPaymentMethod(model), nil
+}
+
+// Create implements domainmodel.PaymentMethodRepository.
+func (r *PaymentMethodRepository) Create(method *domainmodel.PaymentMethod) error {
+	model, err := newPaymentMethodModel(method)
+	if err != nil {
+		return err
+	}
+
+	if err := r.db.Create(model).Error; err != nil {
+		return err
+	}
+
+	method.ID = domainmodel.PaymentMethodID(model.ID)
+
+	return nil
+}
+
+// Update implements domainmodel.PaymentMethodRepository.
+// アーカイブの状態は Archive でのみ変更する
+func (r *PaymentMethodRepository) Update(method *domainmodel.PaymentMethod) error {
+	model, err := newPaymentMethodModel(method)
+	if err != nil {
+		return err
+	}
+
+	result := r.db.Model(&models.PaymentMethod{}).
+		Where("id = ? AND household_book_id = ?", method.ID, method.HouseHoldID).
+		Updates(map[string]interface{}{
+			"name":                  model.Name,
+			"type":                  model.Type,
+			"opening_balance":       model.OpeningBalance,
+			"opening_date":          model.OpeningDate,
+			"closing_day":           model.ClosingDay,
+			"payment_day":           model.PaymentDay,
+			"withdrawal_account_id": model.WithdrawalAccountID,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// Archive implements domainmodel.PaymentMethodRepository.
+// archivedAt に nil を指定するとアーカイブを解除する
+func (r *PaymentMethodRepository) Archive(houseHoldID domainmodel.HouseHoldID, id domainmodel.PaymentMethodID, archivedAt *time.Time) error {
+	result := r.db.Model(&models.PaymentMethod{}).
+		Where("id = ? AND household_book_id = ?", id, houseHoldID).
+		Update("archived_at", archivedAt)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// SummarizeDailyAmounts implements domainmodel.PaymentMethodRepository.
+func (r *PaymentMethodRepository) SummarizeDailyAmounts(houseHoldID domainmodel.HouseHoldID, until string) ([]*domainmodel.PaymentMethodDailyAmount, error) {
+	rows := []struct {
+		PaymentMethodID uint
+		Date            string
+		Amount          int
+	}{}
+
+	amounts := map[uint]map[string]*domainmodel.PaymentMethodDailyAmount{}
+	amountOf := func(paymentMethodID uint, date string) *domainmodel.PaymentMethodDailyAmount {
+		if _, ok := amounts[paymentMethodID]; !ok {
+			amounts[paymentMethodID] = map[string]*domainmodel.PaymentMethodDailyAmount{}
+		}
+		if _, ok := amounts[paymentMethodID][date]; !ok {
+			amounts[paymentMethodID][date] = &domainmodel.PaymentMethodDailyAmount{
+				PaymentMethodID: domainmodel.PaymentMethodID(paymentMethodID),
+				Date:            date,
+			}
+		}
+		return amounts[paymentMethodID][date]
+	}
+
+	if err := r.db.Model(&models.ShoppingAmount{}).
+		Select("payment_method_id, to_char(date, 'YYYY-MM-DD') AS date, SUM(amount) AS amount").
+		Where("household_book_id = ? AND payment_method_id IS NOT NULL AND date <= ?", houseHoldID, until).
+		Group("payment_method_id, to_char(date, 'YYYY-MM-DD')").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		amountOf(row.PaymentMethodID, row.Date).Expense += row.Amount
+	}
+
+	rows = rows[:0]
+	if err := r.db.Model(&models.Income{}).
+		Select("payment_method_id, to_char(date, 'YYYY-MM-DD') AS date, SUM(amount) AS amount").
+		Where("household_book_id = ? AND payment_method_id IS NOT NULL AND date <= ?", houseHoldID, until).
+		Group("payment_method_id, to_char(date, 'YYYY-MM-DD')").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		amountOf(row.PaymentMethodID, row.Date).Income += row.Amount
+	}
+
+	output := []*domainmodel.PaymentMethodDailyAmount{}
+	for _, daily := range amounts {
+		for _, amount := range daily {
+			output = append(output, amount)
+		}
+	}
+	sort.Slice(output, func(i, j int) bool {
+		if output[i].Date == output[j].Date {
+			return output[i].PaymentMethodID < output[j].PaymentMethodID
+		}
+		return output[i].Date < output[j].Date
+	})
+
+	return output, nil
+}
+
+// FindTransactions implements domainmodel.PaymentMethodRepository.
+func (r *PaymentMethodRepository) FindTransactions(houseHoldID domainmodel.HouseHoldID, id domainmodel.PaymentMethodID, from string, to string) ([]*domainmodel.PaymentMethodTransaction, error) {
+	shoppingAmounts := []*models.ShoppingAmount{}
+	if err := r.db.Where("household_book_id = ? AND payment_method_id = ? AND date >= ? AND date <= ?", houseHoldID, id, from, to).
+		Order("date, id").
+		Find(&shoppingAmounts).Error; err != nil {
+		return nil, err
+	}
+
+	incomes := []*models.Income{}
+	if err := r.db.Where("household_book_id = ? AND payment_method_id = ? AND date >= ? AND date <= ?", houseHoldID, id, from, to).
+		Order("date, id").
+		Find(&incomes).Error; err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*domainmodel.PaymentMethodTransaction, 0, len(shoppingAmounts)+len(incomes))
+	for _, shoppingAmount := range shoppingAmounts {
+		transactions = append(transactions, &domainmodel.PaymentMethodTransaction{
+			Type:   domainmodel.PaymentMethodTransactionExpense,
+			ID:     shoppingAmount.ID,
+			Date:   shoppingAmount.Date.Format("2006-01-02"),
+			Amount: shoppingAmount.Amount,
+			Memo:   shoppingAmount.Memo,
+		})
+	}
+	for _, income := range incomes {
+		transactions = append(transactions, &domainmodel.PaymentMethodTransaction{
+			Type:   domainmodel.PaymentMethodTransactionIncome,
+			ID:     income.ID,
+			Date:   income.Date.Format("2006-01-02"),
+			Amount: income.Amount,
+			Memo:   income.Memo,
+		})
+	}
+	sort.SliceStable(transactions, func(i, j int) bool { return transactions[i].Date < transactions[j].Date })
+
+	return transactions, nil
+}
+
+func newPaymentMethodModel(method *domainmodel.PaymentMethod) (*models.PaymentMethod, error) {
+	openingDate, err := time.Parse("2006-01-02", method.OpeningDate)
+	if err != nil {
+		return nil, err
+	}
+
+	model := &models.PaymentMethod{
+		HouseholdBookID: uint(method.HouseHoldID),
+		Name:            method.Name,
+		Type:            string(method.Type),
+		OpeningBalance:  method.OpeningBalance,
+		OpeningDate:     openingDate,
+		ClosingDay:      method.ClosingDay,
+		PaymentDay:      method.PaymentDay,
+	}
+	if method.WithdrawalAccountID != nil {
+		withdrawalAccountID := uint(*method.WithdrawalAccountID)
+		model.WithdrawalAccountID = &withdrawalAccountID
+	}
+
+	return model, nil
+}
+
+func NewPaymentMethodRepository(db *gorm.DB) domainmodel.PaymentMethodRepository {
+	return &PaymentMethodRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+)
+
+func TestPaymentMethodRepository_FindByHouseHoldID(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewPaymentMethodRepository(gormDB)
+
+	mock.ExpectQuery(`SELECT \* FROM "payment_methods" WHERE household_book_id = \$1 AND archived_at IS NULL ORDER BY id`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "household_book_id", "name", "type", "opening_balance", "opening_date", "closing_day", "payment_day", "withdrawal_account_id", "archived_at"}).
+			AddRow(1, 1, "銀行", "bank", 100000, time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC), nil, nil, nil, nil).
+			AddRow(2, 1, "カード", "credit_card", -5000, time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC), 15, 10, 1, nil))
+
+	methods, err := repo.FindByHouseHoldID(1, false)
+	assert.NoError(t, err)
+	closingDay, paymentDay := 15, 10
+	withdrawalAccountID := domainmodel.PaymentMethodID(1)
+	assert.Equal(t, []*domainmodel.PaymentMethod{
+		{ID: 1, HouseHoldID: 1, Name: "銀行", Type: domainmodel.PaymentMethodBank, OpeningBalance: 100000, OpeningDate: "2026-08-01"},
+		{
+			ID: 2, HouseHoldID: 1, Name: "カード", Type: domainmodel.PaymentMethodCreditCard, OpeningBalance: -5000, OpeningDate: "2026-08-01",
+			ClosingDay: &closingDay, PaymentDay: &paymentDay, WithdrawalAccountID: &withdrawalAccountID,
+		},
+	}, methods)
+}
+
+func TestPaymentMethodRepository_SummarizeDailyAmounts(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewPaymentMethodRepository(gormDB)
+
+	mock.ExpectQuery(`SELECT payment_method_id, to_char\(date, 'YYYY-MM-DD'\) AS date, SUM\(amount\) AS amount FROM "shopping_amounts" WHERE \(household_book_id = \$1 AND payment_method_id IS NOT NULL AND date <= \$2\) AND "shopping_amounts"."deleted_at" IS NULL GROUP BY payment_method_id, to_char\(date, 'YYYY-MM-DD'\)`).
+		WithArgs(1, "2026-10-31").
+		WillReturnRows(sqlmock.NewRows([]string{"payment_method_id", "date", "amount"}).
+			AddRow(2, "2026-10-05", 3000).
+			AddRow(1, "2026-10-01", 80000))
+	mock.ExpectQuery(`SELECT payment_method_id, to_char\(date, 'YYYY-MM-DD'\) AS date, SUM\(amount\) AS amount FROM "incomes" WHERE household_book_id = \$1 AND payment_method_id IS NOT NULL AND date <= \$2 GROUP BY payment_method_id, to_char\(date, 'YYYY-MM-DD'\)`).
+		WithArgs(1, "2026-10-31").
+		WillReturnRows(sqlmock.NewRows([]string{"payment_method_id", "date", "amount"}).
+			AddRow(1, "2026-10-01", 300000))
+
+	amounts, err := repo.SummarizeDailyAmounts(1, "2026-10-31")
+	assert.NoError(t, err)
+	assert.Equal(t, []*domainmodel.PaymentMethodDailyAmount{
+		{PaymentMethodID: 1, Date: "2026-10-01", Expense: 80000, Income: 300000},
+		{PaymentMethodID: 2, Date: "2026-10-05", Expense: 3000},
+	}, amounts)
+}
+
+func TestPaymentMethodRepository_Archive(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewPaymentMethodRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "payment_methods" SET "archived_at"=\$1,"updated_at"=\$2 WHERE id = \$3 AND household_book_id = \$4`).
+		WithArgs(nil, sqlmock.AnyArg(), 7, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err := repo.Archive(1, 7, nil)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
@@ -33,6 +33,7 @@ func (r *ReceiptRepository) FindReceiptAnalyzeByS3FilePath(s3FilePath string) (*
 		S3FilePath:      models.ImageURL,
 		HouseholdBookID: domainmodel.HouseHoldID(models.HouseholdBookID),
 		Items:           items,
+		Currency:        receiptCurrency(models.Currency),
 	}, nil
 }
 
@@ -68,6 +69,10 @@ func (r *ReceiptRepository) CreateReceiptAnalyzeResult(receiptAnalyze *domainmod
 			AnalyzeStatus: "finished",
 			Items:         items,
 		}
+		if receiptAnalyze.Currency != "" {
+			currency := string(receiptAnalyze.Currency)
+			model.Currency = &currency
+		}
 
 		if err := tx.Model(&models.ReceiptAnalyzes{}).Where("id = ?", receiptAnalyze.ID).Updates(&model).Error; err != nil {
 			return err
@@ -83,7 +88,7 @@ func (r *ReceiptRepository) CreateReceiptAnalyzeResult(receiptAnalyze *domainmod
 func (r *ReceiptRepository) FindByID(id domainmodel.HouseHoldID) (*domainmodel.ReceiptAnalyze, error) {
 	var models models.ReceiptAnalyzes
 	if err := r.db.Where("id = ?", id).
-		Preload("Items").
+		Preload("Items.Tags").
 		First(&models).Error; err != nil {
 		return nil, err
 	}
@@ -91,8 +96,10 @@ func (r *ReceiptRepository) FindByID(id domainmodel.HouseHoldID) (*domainmodel.R
 	var items []domainmodel.ReceiptAnalyzeItem
 	for _, item := range models.Items {
 		items = append(items, domainmodel.ReceiptAnalyzeItem{
+			ID:    uint(item.ID),
 			Name:  item.Name,
 			Price: uint(item.Price),
+			Tags:  domainmodel.ConvertTags(item.Tags),
 		})
 	}
 
@@ -101,9 +108,32 @@ func (r *ReceiptRepository) FindByID(id domainmodel.HouseHoldID) (*domainmodel.R
 		TotalPrice: uint(models.TotalPrice),
 		S3FilePath: models.ImageURL,
 		Items:      items,
+		Currency:   receiptCurrency(models.Currency),
 	}, nil
 }
 
+// DeleteReceiptAnalyze implements domainmodel.ReceiptAnalyzeRepository.
+// レシートから登録した支出は削除しない
+func (r *ReceiptRepository) DeleteReceiptAnalyze(houseHoldID domainmodel.HouseHoldID, id uint, deletion *domainmodel.Deletion) error {
+	result := softDelete(r.db.Where("id = ? AND household_book_id = ?", id, houseHoldID), &models.ReceiptAnalyzes{}, deletion)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// receiptCurrency はレシートの通貨を変換する。通貨を指定せずに分析したレシートは空を返す
+func receiptCurrency(currency *string) domainmodel.Currency {
+	if currency == nil {
+		return ""
+	}
+	return domainmodel.Currency(*currency)
+}
+
 func NewReceiptRepository(db *gorm.DB) domainmodel.ReceiptAnalyzeRepository {
 	return &ReceiptRepository{db: db}
 }
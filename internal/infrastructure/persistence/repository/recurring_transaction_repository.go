@@ -0,0 +1,250 @@
+package repository
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RecurringTransactionRepository struct {
+	db *gorm.DB
+}
+
+// FindByHouseHoldID implements domainmodel.RecurringTransactionRepository.
+func (r *RecurringTransactionRepository) FindByHouseHoldID(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.RecurringTransaction, error) {
+	recurringTransactions := []*models.RecurringTransaction{}
+	if err := r.db.Where("household_book_id = ?", houseHoldID).Order("id").Find(&recurringTransactions).Error; err != nil {
+		return nil, err
+	}
+
+	return convertRecurringTransactions(recurringTransactions), nil
+}
+
+// FindByID implements domainmodel.RecurringTransactionRepository.
+func (r *RecurringTransactionRepository) FindByID(houseHoldID domainmodel.HouseHoldID, id domainmodel.RecurringTransactionID) (*domainmodel.RecurringTransaction, error) {
+	model := &models.RecurringTransaction{}
+	if err := r.db.Where("id = ? AND household_book_id = ?", id, houseHoldID).First(model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return convertRecurringTransaction(model), nil
+}
+
+// Create implements domainmodel.RecurringTransactionRepository.
+func (r *RecurringTransactionRepository) Create(recurringTransaction *domainmodel.RecurringTransaction) error {
+	model, err := newRecurringTransactionModel(recurringTransaction)
+	if err != nil {
+		return err
+	}
+	if recurringTransaction.MaterializedUntil != nil {
+		materializedUntil, err := time.Parse(domainmodel.RecurringDateLayout, *recurringTransaction.MaterializedUntil)
+		if err != nil {
+			return err
+		}
+		model.MaterializedUntil = &materializedUntil
+	}
+
+	if err := r.db.Create(model).Error; err != nil {
+		return err
+	}
+
+	recurringTransaction.ID = domainmodel.RecurringTransactionID(model.ID)
+
+	return nil
+}
+
+// Update implements domainmodel.RecurringTransactionRepository.
+// 登録済みの日付はスケジューラーのみが更新するため変更しない
+func (r *RecurringTransactionRepository) Update(recurringTransaction *domainmodel.RecurringTransaction) error {
+	model, err := newRecurringTransactionModel(recurringTransaction)
+	if err != nil {
+		return err
+	}
+
+	result := r.db.Model(&models.RecurringTransaction{}).
+		Where("id = ? AND household_book_id = ?", recurringTransaction.ID, recurringTransaction.HouseHoldID).
+		Updates(map[string]interface{}{
+			"category_id":  model.CategoryID,
+			"amount":       model.Amount,
+			"memo":         model.Memo,
+			"frequency":    model.Frequency,
+			"interval":     model.Interval,
+			"day_of_month": model.DayOfMonth,
+			"start_date":   model.StartDate,
+			"end_date":     model.EndDate,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// Delete implements domainmodel.RecurringTransactionRepository.
+func (r *RecurringTransactionRepository) Delete(houseHoldID domainmodel.HouseHoldID, id domainmodel.RecurringTransactionID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND household_book_id = ?", id, houseHoldID).Delete(&models.RecurringTransaction{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Where("recurring_transaction_id = ?", id).Delete(&models.RecurringOccurrenceOverride{}).Error
+	})
+}
+
+// FindDue implements domainmodel.RecurringTransactionRepository.
+func (r *RecurringTransactionRepository) FindDue(today string) ([]*domainmodel.RecurringTransaction, error) {
+	recurringTransactions := []*models.RecurringTransaction{}
+	if err := r.db.Where("start_date <= ?", today).
+		Where("materialized_until IS NULL OR materialized_until < ?", today).
+		Where("end_date IS NULL OR materialized_until IS NULL OR end_date > materialized_until").
+		Order("id").
+		Find(&recurringTransactions).Error; err != nil {
+		return nil, err
+	}
+
+	return convertRecurringTransactions(recurringTransactions), nil
+}
+
+// AdvanceMaterializedUntil implements domainmodel.RecurringTransactionRepository.
+func (r *RecurringTransactionRepository) AdvanceMaterializedUntil(id domainmodel.RecurringTransactionID, from *string, until string) (bool, error) {
+	query := r.db.Model(&models.RecurringTransaction{}).Where("id = ?", id)
+	if from == nil {
+		query = query.Where("materialized_until IS NULL")
+	} else {
+		query = query.Where("materialized_until = ?", *from)
+	}
+
+	result := query.Update("materialized_until", until)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// FindOverrides implements domainmodel.RecurringTransactionRepository.
+func (r *RecurringTransactionRepository) FindOverrides(ids []domainmodel.RecurringTransactionID, from string, to string) ([]*domainmodel.RecurringOccurrenceOverride, error) {
+	if len(ids) == 0 {
+		return []*domainmodel.RecurringOccurrenceOverride{}, nil
+	}
+
+	overrides := []*models.RecurringOccurrenceOverride{}
+	if err := r.db.Where("recurring_transaction_id IN ? AND date >= ? AND date <= ?", ids, from, to).
+		Order("date, recurring_transaction_id").
+		Find(&overrides).Error; err != nil {
+		return nil, err
+	}
+
+	output := make([]*domainmodel.RecurringOccurrenceOverride, len(overrides))
+	for i, override := range overrides {
+		output[i] = &domainmodel.RecurringOccurrenceOverride{
+			RecurringTransactionID: domainmodel.RecurringTransactionID(override.RecurringTransactionID),
+			Date:                   override.Date.Format(domainmodel.RecurringDateLayout),
+			Skipped:                override.Skipped,
+			Amount:                 override.Amount,
+			Memo:                   override.Memo,
+		}
+	}
+
+	return output, nil
+}
+
+// SaveOverride implements domainmodel.RecurringTransactionRepository.
+func (r *RecurringTransactionRepository) SaveOverride(override *domainmodel.RecurringOccurrenceOverride) error {
+	date, err := time.Parse(domainmodel.RecurringDateLayout, override.Date)
+	if err != nil {
+		return err
+	}
+
+	model := &models.RecurringOccurrenceOverride{
+		RecurringTransactionID: uint(override.RecurringTransactionID),
+		Date:                   date,
+		Skipped:                override.Skipped,
+		Amount:                 override.Amount,
+		Memo:                   override.Memo,
+	}
+
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "recurring_transaction_id"}, {Name: "date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"skipped", "amount", "memo", "updated_at"}),
+	}).Create(model).Error
+}
+
+func newRecurringTransactionModel(recurringTransaction *domainmodel.RecurringTransaction) (*models.RecurringTransaction, error) {
+	startDate, err := time.Parse(domainmodel.RecurringDateLayout, recurringTransaction.StartDate)
+	if err != nil {
+		return nil, err
+	}
+
+	model := &models.RecurringTransaction{
+		HouseholdBookID: uint(recurringTransaction.HouseHoldID),
+		CategoryID:      uint(recurringTransaction.CategoryID),
+		Amount:          recurringTransaction.Amount,
+		Memo:            recurringTransaction.Memo,
+		Frequency:       string(recurringTransaction.Frequency),
+		Interval:        recurringTransaction.Interval,
+		DayOfMonth:      recurringTransaction.DayOfMonth,
+		StartDate:       startDate,
+	}
+	if recurringTransaction.EndDate != nil {
+		endDate, err := time.Parse(domainmodel.RecurringDateLayout, *recurringTransaction.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		model.EndDate = &endDate
+	}
+
+	return model, nil
+}
+
+func convertRecurringTransactions(recurringTransactions []*models.RecurringTransaction) []*domainmodel.RecurringTransaction {
+	output := make([]*domainmodel.RecurringTransaction, len(recurringTransactions))
+	for i, recurringTransaction := range recurringTransactions {
+		output[i] = convertRecurringTransaction(recurringTransaction)
+	}
+	return output
+}
+
+func convertRecurringTransaction(model *models.RecurringTransaction) *domainmodel.RecurringTransaction {
+	recurringTransaction := &domainmodel.RecurringTransaction{
+		ID:          domainmodel.RecurringTransactionID(model.ID),
+		HouseHoldID: domainmodel.HouseHoldID(model.HouseholdBookID),
+		CategoryID:  domainmodel.CategoryID(model.CategoryID),
+		Amount:      model.Amount,
+		Memo:        model.Memo,
+		Frequency:   domainmodel.RecurrenceFrequency(model.Frequency),
+		Interval:    model.Interval,
+		DayOfMonth:  model.DayOfMonth,
+		StartDate:   model.StartDate.Format(domainmodel.RecurringDateLayout),
+	}
+	if model.EndDate != nil {
+		endDate := model.EndDate.Format(domainmodel.RecurringDateLayout)
+		recurringTransaction.EndDate = &endDate
+	}
+	if model.MaterializedUntil != nil {
+		materializedUntil := model.MaterializedUntil.Format(domainmodel.RecurringDateLayout)
+		recurringTransaction.MaterializedUntil = &materializedUntil
+	}
+	return recurringTransaction
+}
+
+func NewRecurringTransactionRepository(db *gorm.DB) domainmodel.RecurringTransactionRepository {
+	return &RecurringTransactionRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+)
+
+var recurringTransactionColumns = []string{"id", "household_book_id", "category_id", "amount", "memo", "frequency", "interval", "day_of_month", "start_date", "end_date", "materialized_until"}
+
+func TestRecurringTransactionRepository_FindByID(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewRecurringTransactionRepository(gormDB)
+
+	mock.ExpectQuery(`SELECT \* FROM "recurring_transactions" WHERE id = \$1 AND household_book_id = \$2 ORDER BY "recurring_transactions"."id" LIMIT \$3`).
+		WithArgs(1, 10, 1).
+		WillReturnRows(sqlmock.NewRows(recurringTransactionColumns).
+			AddRow(1, 10, 2, 80000, "家賃", "monthly", 1, 27, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), nil, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)))
+
+	recurringTransaction, err := repo.FindByID(10, 1)
+	assert.NoError(t, err)
+	materializedUntil := "2026-10-17"
+	assert.Equal(t, &domainmodel.RecurringTransaction{
+		ID: 1, HouseHoldID: 10, CategoryID: 2, Amount: 80000, Memo: "家賃",
+		Frequency: domainmodel.RecurrenceMonthly, Interval: 1, DayOfMonth: 27,
+		StartDate: "2026-10-01", MaterializedUntil: &materializedUntil,
+	}, recurringTransaction)
+
+	// 他の家計簿の定期取引は取得できない
+	mock.ExpectQuery(`SELECT \* FROM "recurring_transactions"`).
+		WillReturnRows(sqlmock.NewRows(recurringTransactionColumns))
+	recurringTransaction, err = repo.FindByID(20, 1)
+	assert.NoError(t, err)
+	assert.Nil(t, recurringTransaction)
+}
+
+func TestRecurringTransactionRepository_Create(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewRecurringTransactionRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "recurring_transactions"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 10, 2, 1490, "動画配信", "monthly", 1, 27,
+			time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), nil, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	mock.ExpectCommit()
+
+	materializedUntil := "2026-10-17"
+	recurringTransaction := &domainmodel.RecurringTransaction{
+		HouseHoldID: 10, CategoryID: 2, Amount: 1490, Memo: "動画配信",
+		Frequency: domainmodel.RecurrenceMonthly, Interval: 1, DayOfMonth: 27,
+		StartDate: "2026-10-01", MaterializedUntil: &materializedUntil,
+	}
+	assert.NoError(t, repo.Create(recurringTransaction))
+	assert.Equal(t, domainmodel.RecurringTransactionID(3), recurringTransaction.ID)
+}
+
+func TestRecurringTransactionRepository_Delete(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewRecurringTransactionRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "recurring_transactions" WHERE id = \$1 AND household_book_id = \$2`).
+		WithArgs(1, 20).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	// 他の家計簿の定期取引は削除できない
+	assert.ErrorIs(t, repo.Delete(20, 1), gorm.ErrRecordNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecurringTransactionRepository_FindDue(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewRecurringTransactionRepository(gormDB)
+
+	mock.ExpectQuery(`SELECT \* FROM "recurring_transactions" WHERE start_date <= \$1 AND \(materialized_until IS NULL OR materialized_until < \$2\) AND \(end_date IS NULL OR materialized_until IS NULL OR end_date > materialized_until\) ORDER BY id`).
+		WithArgs("2026-10-18", "2026-10-18").
+		WillReturnRows(sqlmock.NewRows(recurringTransactionColumns).
+			AddRow(1, 10, 2, 80000, "家賃", "monthly", 1, 27, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), nil, nil))
+
+	recurringTransactions, err := repo.FindDue("2026-10-18")
+	assert.NoError(t, err)
+	assert.Len(t, recurringTransactions, 1)
+	assert.Nil(t, recurringTransactions[0].MaterializedUntil)
+}
+
+func TestRecurringTransactionRepository_AdvanceMaterializedUntil(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewRecurringTransactionRepository(gormDB)
+
+	from := "2026-10-17"
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "recurring_transactions" SET "materialized_until"=\$1,"updated_at"=\$2 WHERE id = \$3 AND materialized_until = \$4`).
+		WithArgs("2026-10-18", sqlmock.AnyArg(), 1, from).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	claimed, err := repo.AdvanceMaterializedUntil(1, &from, "2026-10-18")
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+
+	// 他のプロセスが先に進めていた場合は更新しない
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "recurring_transactions" SET "materialized_until"=\$1,"updated_at"=\$2 WHERE id = \$3 AND materialized_until IS NULL`).
+		WithArgs("2026-10-18", sqlmock.AnyArg(), 2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	claimed, err = repo.AdvanceMaterializedUntil(2, nil, "2026-10-18")
+	assert.NoError(t, err)
+	assert.False(t, claimed)
+}
+
+func TestRecurringTransactionRepository_SaveOverride(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewRecurringTransactionRepository(gormDB)
+
+	amount := 1990
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "recurring_occurrence_overrides" .* ON CONFLICT \("recurring_transaction_id","date"\) DO UPDATE SET "skipped"="excluded"."skipped","amount"="excluded"."amount","memo"="excluded"."memo","updated_at"="excluded"."updated_at" RETURNING "id"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1, time.Date(2026, 11, 27, 0, 0, 0, 0, time.UTC), false, 1990, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	err := repo.SaveOverride(&domainmodel.RecurringOccurrenceOverride{RecurringTransactionID: 1, Date: "2026-11-27", Amount: &amount})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,153 @@
+package repository
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type ReportRepository struct {
+	db *gorm.DB
+}
+
+// categoryTrendQuery は月・カテゴリごとの支出を、支出のない月を0円として補完した上でウィンドウ関数で集計する
+// 前年同月比と移動平均を求めるため、集計する期間の12か月前から系列を作成する
+const categoryTrendQuery = `
+WITH months AS (
+	SELECT to_char(m, 'YYYY-MM') AS month
+	FROM generate_series(CAST(? AS date), CAST(? AS date), interval '1 month') AS m
+),
+amounts AS (
+	SELECT to_char(date, 'YYYY-MM') AS month, category_id, SUM(amount) AS amount
+	FROM shopping_amounts
+	WHERE household_book_id = ? AND deleted_at IS NULL AND date >= CAST(? AS date) AND date < CAST(? AS date) + interval '1 month'
+	GROUP BY 1, 2
+),
+series_categories AS (
+	SELECT category_id FROM category_limits WHERE household_book_id = ? AND archived_at IS NULL AND deleted_at IS NULL
+	UNION
+	SELECT category_id FROM amounts
+),
+series AS (
+	SELECT months.month, series_categories.category_id, COALESCE(amounts.amount, 0) AS amount
+	FROM months
+	CROSS JOIN series_categories
+	LEFT JOIN amounts ON amounts.month = months.month AND amounts.category_id = series_categories.category_id
+),
+trends AS (
+	SELECT
+		month,
+		category_id,
+		amount,
+		amount - LAG(amount, 1) OVER w AS month_over_month,
+		amount - LAG(amount, 12) OVER w AS year_over_year,
+		ROUND(AVG(amount) OVER (PARTITION BY category_id ORDER BY month ROWS BETWEEN %d PRECEDING AND CURRENT ROW)) AS rolling_average
+	FROM series
+	WINDOW w AS (PARTITION BY category_id ORDER BY month)
+)
+SELECT trends.month, trends.category_id, categories.name AS category_name, categories.color AS category_color,
+	trends.amount, trends.month_over_month, trends.year_over_year, trends.rolling_average
+FROM trends
+LEFT JOIN categories ON categories.id = trends.category_id
+WHERE trends.month >= ? %s
+ORDER BY trends.category_id, trends.month`
+
+type categoryTrendRow struct {
+	Month          string
+	CategoryID     uint
+	CategoryName   string
+	CategoryColor  string
+	Amount         int
+	MonthOverMonth int
+	YearOverYear   int
+	RollingAverage int
+}
+
+// SummarizeCategoryTrends implements domainmodel.ReportRepository.
+func (r *ReportRepository) SummarizeCategoryTrends(condition *domainmodel.TrendCondition) ([]*domainmodel.CategoryMonthlyTrend, error) {
+	seriesFrom := condition.SeriesFrom() + "-01"
+	to := condition.To + "-01"
+	args := []interface{}{
+		seriesFrom, to,
+		condition.HouseHoldID, seriesFrom, to,
+		condition.HouseHoldID,
+		condition.From,
+	}
+	categoryFilter := ""
+	if len(condition.CategoryIDs) > 0 {
+		categoryFilter = "AND trends.category_id IN ?"
+		args = append(args, condition.CategoryIDs)
+	}
+
+	rows := []categoryTrendRow{}
+	// 移動平均の月数は検証済みの整数のため、フレームの指定に直接埋め込む
+	query := fmt.Sprintf(categoryTrendQuery, condition.Window-1, categoryFilter)
+	if err := r.db.Raw(query, args...).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	trends := make([]*domainmodel.CategoryMonthlyTrend, len(rows))
+	for i, row := range rows {
+		trends[i] = &domainmodel.CategoryMonthlyTrend{
+			Month: row.Month,
+			Category: domainmodel.Category{
+				ID:    domainmodel.CategoryID(row.CategoryID),
+				Name:  row.CategoryName,
+				Color: row.CategoryColor,
+			},
+			Amount:         row.Amount,
+			MonthOverMonth: row.MonthOverMonth,
+			YearOverYear:   row.YearOverYear,
+			RollingAverage: row.RollingAverage,
+		}
+	}
+	return trends, nil
+}
+
+// SummarizeDayOfMonthSplits implements domainmodel.ReportRepository.
+func (r *ReportRepository) SummarizeDayOfMonthSplits(houseHoldID domainmodel.HouseHoldID, fromMonth string, toMonth string, day int) ([]*domainmodel.DayOfMonthSplit, error) {
+	from, err := domainmodel.ParseBudgetMonth(fromMonth)
+	if err != nil {
+		return nil, err
+	}
+	to, err := domainmodel.ParseBudgetMonth(toMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []struct {
+		Month      string
+		CategoryID uint
+		Early      int
+		Late       int
+	}{}
+	if err := r.db.Model(&models.ShoppingAmount{}).
+		Select("to_char(date, 'YYYY-MM') AS month, category_id, "+
+			"SUM(CASE WHEN EXTRACT(DAY FROM date) <= ? THEN amount ELSE 0 END) AS early, "+
+			"SUM(CASE WHEN EXTRACT(DAY FROM date) > ? THEN amount ELSE 0 END) AS late", day, day).
+		Where("household_book_id = ? AND date >= ? AND date < ?", houseHoldID, from, to.AddDate(0, 1, 0)).
+		Group("month, category_id").
+		Order("month, category_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	splits := make([]*domainmodel.DayOfMonthSplit, len(rows))
+	for i, row := range rows {
+		splits[i] = &domainmodel.DayOfMonthSplit{
+			Month:      row.Month,
+			CategoryID: domainmodel.CategoryID(row.CategoryID),
+			Early:      row.Early,
+			Late:       row.Late,
+		}
+	}
+	return splits, nil
+}
+
+func NewReportRepository(db *gorm.DB) domainmodel.ReportRepository {
+	return &ReportRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+)
+
+func TestReportRepository_SummarizeCategoryTrends(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewReportRepository(gormDB)
+
+	condition := &domainmodel.TrendCondition{
+		HouseHoldID: 10,
+		From:        "2026-09",
+		To:          "2026-10",
+		CategoryIDs: []domainmodel.CategoryID{1},
+		Window:      3,
+	}
+
+	mock.ExpectQuery(`ROWS BETWEEN 2 PRECEDING AND CURRENT ROW.+WHERE trends.month >= \$7 AND trends.category_id IN \(\$8\)\s+ORDER BY trends.category_id, trends.month`).
+		WithArgs("2025-09-01", "2026-10-01", 10, "2025-09-01", "2026-10-01", 10, "2026-09", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"month", "category_id", "category_name", "category_color", "amount", "month_over_month", "year_over_year", "rolling_average"}).
+			AddRow("2026-09", 1, "食費", "#ff0000", 28000, -2000, 3000, 29000).
+			AddRow("2026-10", 1, "食費", "#ff0000", 32000, 4000, 1000, 30000))
+
+	trends, err := repo.SummarizeCategoryTrends(condition)
+	assert.NoError(t, err)
+	assert.Equal(t, []*domainmodel.CategoryMonthlyTrend{
+		{Month: "2026-09", Category: domainmodel.Category{ID: 1, Name: "食費", Color: "#ff0000"}, Amount: 28000, MonthOverMonth: -2000, YearOverYear: 3000, RollingAverage: 29000},
+		{Month: "2026-10", Category: domainmodel.Category{ID: 1, Name: "食費", Color: "#ff0000"}, Amount: 32000, MonthOverMonth: 4000, YearOverYear: 1000, RollingAverage: 30000},
+	}, trends)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReportRepository_SummarizeDayOfMonthSplits(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewReportRepository(gormDB)
+
+	mock.ExpectQuery(`SELECT to_char\(date, 'YYYY-MM'\) AS month, category_id, SUM\(CASE WHEN EXTRACT\(DAY FROM date\) <= \$1 THEN amount ELSE 0 END\) AS early, SUM\(CASE WHEN EXTRACT\(DAY FROM date\) > \$2 THEN amount ELSE 0 END\) AS late FROM "shopping_amounts" WHERE \(household_book_id = \$3 AND date >= \$4 AND date < \$5\) AND "shopping_amounts"."deleted_at" IS NULL GROUP BY month, category_id ORDER BY month, category_id`).
+		WithArgs(10, 10, 10, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"month", "category_id", "early", "late"}).
+			AddRow("2026-09", 2, 0, 80000))
+
+	splits, err := repo.SummarizeDayOfMonthSplits(10, "2026-07", "2026-09", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []*domainmodel.DayOfMonthSplit{{Month: "2026-09", CategoryID: 2, Early: 0, Late: 80000}}, splits)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
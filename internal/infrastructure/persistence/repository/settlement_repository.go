@@ -0,0 +1,82 @@
+package repository
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type SettlementRepository struct {
+	db *gorm.DB
+}
+
+// FindSettlements implements domainmodel.SettlementRepository.
+func (r *SettlementRepository) FindSettlements(houseHoldID domainmodel.HouseHoldID, from string, to string) ([]*domainmodel.Settlement, error) {
+	settlements := []*models.Settlement{}
+	if err := r.db.Where("household_book_id = ? AND date >= ? AND date <= ?", houseHoldID, from, to).
+		Order("date, id").
+		Find(&settlements).Error; err != nil {
+		return nil, err
+	}
+
+	output := make([]*domainmodel.Settlement, len(settlements))
+	for i, settlement := range settlements {
+		output[i] = &domainmodel.Settlement{
+			ID:          domainmodel.SettlementID(settlement.ID),
+			HouseHoldID: domainmodel.HouseHoldID(settlement.HouseholdBookID),
+			FromUserID:  domainmodel.UserID(settlement.FromUserID),
+			ToUserID:    domainmodel.UserID(settlement.ToUserID),
+			Amount:      settlement.Amount,
+			Date:        settlement.Date.Format("2006-01-02"),
+			Memo:        settlement.Memo,
+		}
+	}
+
+	return output, nil
+}
+
+// CreateSettlement implements domainmodel.SettlementRepository.
+func (r *SettlementRepository) CreateSettlement(settlement *domainmodel.Settlement) error {
+	date, err := time.Parse("2006-01-02", settlement.Date)
+	if err != nil {
+		return err
+	}
+
+	model := &models.Settlement{
+		HouseholdBookID: uint(settlement.HouseHoldID),
+		FromUserID:      uint(settlement.FromUserID),
+		ToUserID:        uint(settlement.ToUserID),
+		Amount:          settlement.Amount,
+		Date:            date,
+		Memo:            settlement.Memo,
+	}
+	if err := r.db.Create(model).Error; err != nil {
+		return err
+	}
+
+	settlement.ID = domainmodel.SettlementID(model.ID)
+
+	return nil
+}
+
+// DeleteSettlement implements domainmodel.SettlementRepository.
+func (r *SettlementRepository) DeleteSettlement(houseHoldID domainmodel.HouseHoldID, id domainmodel.SettlementID) error {
+	result := r.db.Where("id = ? AND household_book_id = ?", id, houseHoldID).Delete(&models.Settlement{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func NewSettlementRepository(db *gorm.DB) domainmodel.SettlementRepository {
+	return &SettlementRepository{
+		db: db,
+	}
+}
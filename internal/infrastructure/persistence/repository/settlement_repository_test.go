@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+)
+
+func TestSettlementRepository_FindSettlements(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewSettlementRepository(gormDB)
+
+	mock.ExpectQuery(`SELECT \* FROM "settlements" WHERE household_book_id = \$1 AND date >= \$2 AND date <= \$3 ORDER BY date, id`).
+		WithArgs(1, "2026-10-01", "2026-10-31").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "household_book_id", "from_user_id", "to_user_id", "amount", "date", "memo"}).
+			AddRow(1, 1, 2, 1, 1500, time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), "9月分"))
+
+	settlements, err := repo.FindSettlements(1, "2026-10-01", "2026-10-31")
+	assert.NoError(t, err)
+	assert.Equal(t, []*domainmodel.Settlement{
+		{ID: 1, HouseHoldID: 1, FromUserID: 2, ToUserID: 1, Amount: 1500, Date: "2026-10-18", Memo: "9月分"},
+	}, settlements)
+}
+
+func TestSettlementRepository_CreateSettlement(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewSettlementRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "settlements"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1, 2, 1, 1500, time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), "").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectCommit()
+
+	settlement := &domainmodel.Settlement{HouseHoldID: 1, FromUserID: 2, ToUserID: 1, Amount: 1500, Date: "2026-10-18"}
+	assert.NoError(t, repo.CreateSettlement(settlement))
+	assert.Equal(t, domainmodel.SettlementID(7), settlement.ID)
+}
+
+func TestSettlementRepository_DeleteSettlement(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewSettlementRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "settlements" WHERE id = \$1 AND household_book_id = \$2`).
+		WithArgs(7, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err := repo.DeleteSettlement(1, 7)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
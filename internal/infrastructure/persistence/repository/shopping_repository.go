@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type shoppingRepository struct {
@@ -17,15 +18,14 @@ func NewShoppingRepository(db *gorm.DB) domainmodel.ShoppingRepository {
 }
 
 // DeleteShoppingAmount implements domainmodel.ShoppingRepository.
-func (s *shoppingRepository) DeleteShoppingAmount(id domainmodel.ShoppingID) error {
-	model := models.ShoppingAmount{
-		Base: models.Base{
-			ID: uint(id),
-		},
+func (s *shoppingRepository) DeleteShoppingAmount(householdID domainmodel.HouseHoldID, id domainmodel.ShoppingID, deletion *domainmodel.Deletion) error {
+	result := softDelete(s.db.Where("id = ? AND household_book_id = ?", id, householdID), &models.ShoppingAmount{}, deletion)
+	if result.Error != nil {
+		return result.Error
 	}
 
-	if err := s.db.Delete(&model).Error; err != nil {
-		return err
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
 	}
 	return nil
 }
@@ -44,23 +44,109 @@ func (s *shoppingRepository) FetchShoppingAmountItemByHouseholdID(householdID do
 	// カテゴリについて、家計簿ごとに、上限金額を設定できるようにした上で、上限金額を取得するようにする
 	model := []*models.ShoppingAmount{}
 	if err := s.db.Debug().Where("household_book_id = ? AND date BETWEEN ? AND ?", householdID, startDateMonth, endDateMonth).Preload("Category").
-		Preload("Analyze.Items").Find(&model).Error; err != nil {
+		Preload("Analyze.Items.Tags").Preload("Splits").Preload("PaymentMethod").Preload("Tags").Find(&model).Error; err != nil {
 		return nil, err
 	}
 
 	return model, nil
 }
 
+// FindSharedShoppingAmounts implements domainmodel.ShoppingRepository.
+func (s *shoppingRepository) FindSharedShoppingAmounts(householdID domainmodel.HouseHoldID, from string, to string) (domainmodel.ShoppingAmounts, error) {
+	model := []*models.ShoppingAmount{}
+	if err := s.db.Where("household_book_id = ? AND date >= ? AND date <= ?", householdID, from, to).
+		Where("paid_by IS NOT NULL AND split_type <> ?", string(domainmodel.SplitPersonal)).
+		Preload("Splits").
+		Order("date, id").
+		Find(&model).Error; err != nil {
+		return nil, err
+	}
+
+	shoppingAmounts := domainmodel.ShoppingAmounts{}
+	for _, v := range model {
+		shoppingAmounts = append(shoppingAmounts, domainmodel.ConvertShoppingAmountsToShoppingAmount(v))
+	}
+	return shoppingAmounts, nil
+}
+
+// FindShoppingAmounts implements domainmodel.ShoppingRepository.
+func (s *shoppingRepository) FindShoppingAmounts(householdID domainmodel.HouseHoldID, from string, to string) (domainmodel.ShoppingAmounts, error) {
+	model := []*models.ShoppingAmount{}
+	if err := s.db.Where("household_book_id = ? AND date >= ? AND date <= ?", householdID, from, to).
+		Order("date, id").
+		Find(&model).Error; err != nil {
+		return nil, err
+	}
+
+	shoppingAmounts := domainmodel.ShoppingAmounts{}
+	for _, v := range model {
+		shoppingAmounts = append(shoppingAmounts, domainmodel.ConvertShoppingAmountsToShoppingAmount(v))
+	}
+	return shoppingAmounts, nil
+}
+
+// SearchShoppingAmounts implements domainmodel.ShoppingRepository.
+func (s *shoppingRepository) SearchShoppingAmounts(condition *domainmodel.ShoppingSearchCondition) (domainmodel.ShoppingAmounts, error) {
+	model := []*models.ShoppingAmount{}
+	if err := s.db.Scopes(newShoppingSearchQuery(condition).Scopes()...).
+		Preload("Category").
+		Preload("Analyze.Items.Tags").
+		Preload("Splits").
+		Preload("PaymentMethod").
+		Preload("Tags").
+		Find(&model).Error; err != nil {
+		return nil, err
+	}
+
+	shoppingAmounts := domainmodel.ShoppingAmounts{}
+	for _, v := range model {
+		shoppingAmounts = append(shoppingAmounts, domainmodel.ConvertShoppingAmountsToShoppingAmount(v))
+	}
+	return shoppingAmounts, nil
+}
+
+// SummarizeShoppingAmountByMonth implements domainmodel.ShoppingRepository.
+func (s *shoppingRepository) SummarizeShoppingAmountByMonth(householdID domainmodel.HouseHoldID, untilMonth string) ([]*domainmodel.MonthlyCategoryAmount, error) {
+	month, err := domainmodel.ParseBudgetMonth(untilMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []struct {
+		Month      string
+		CategoryID uint
+		Amount     int
+	}{}
+	if err := s.db.Model(&models.ShoppingAmount{}).
+		Select("to_char(date, 'YYYY-MM') AS month, category_id, SUM(amount) AS amount").
+		Where("household_book_id = ? AND date < ?", householdID, month.AddDate(0, 1, 0)).
+		Group("month, category_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	amounts := make([]*domainmodel.MonthlyCategoryAmount, len(rows))
+	for i, row := range rows {
+		amounts[i] = &domainmodel.MonthlyCategoryAmount{
+			Month:      row.Month,
+			CategoryID: domainmodel.CategoryID(row.CategoryID),
+			Amount:     row.Amount,
+		}
+	}
+
+	return amounts, nil
+}
+
 // DeleteShoppingMemo implements domainmodel.ShoppingRepository.
-func (s *shoppingRepository) DeleteShoppingMemo(id domainmodel.ShoppingID) error {
-	model := models.ShoppingMemo{
-		Base: models.Base{
-			ID: uint(id),
-		},
+// 買い物メモはゴミ箱に移し、保持期間を過ぎるまでは元に戻せる
+func (s *shoppingRepository) DeleteShoppingMemo(householdID domainmodel.HouseHoldID, id domainmodel.ShoppingID, deletion *domainmodel.Deletion) error {
+	result := softDelete(s.db.Where("id = ? AND household_book_id = ?", id, householdID), &models.ShoppingMemo{}, deletion)
+	if result.Error != nil {
+		return result.Error
 	}
 
-	if err := s.db.Model(&model).Update("is_completed", true).Error; err != nil {
-		return err
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
 	}
 	return nil
 }
@@ -91,24 +177,72 @@ func (s *shoppingRepository) FetchShoppingMemoItem(householdID domainmodel.House
 }
 
 // RegisterShoppingAmount implements domainmodel.ShoppingRepository.
+// タグは既存のタグへの紐付けのみ登録する
 func (s *shoppingRepository) RegisterShoppingAmount(shopping *models.ShoppingAmount) error {
-	if err := s.db.Create(shopping).Error; err != nil {
+	if err := s.db.Omit("Tags.*").Create(shopping).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
+// RegisterShoppingAmounts implements domainmodel.ShoppingRepository.
+func (s *shoppingRepository) RegisterShoppingAmounts(shoppings []*models.ShoppingAmount) error {
+	if len(shoppings) == 0 {
+		return nil
+	}
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		return tx.Omit(clause.Associations).CreateInBatches(shoppings, 500).Error
+	})
+}
+
 // UpdateShoppingAmount implements domainmodel.ShoppingRepository.
+// メンバーごとの負担とタグの紐付けは登録し直す
 func (s *shoppingRepository) UpdateShoppingAmount(shopping *models.ShoppingAmount) error {
-	if err := s.db.Model(shopping).Updates(map[string]interface{}{
-		"category_id": shopping.CategoryID,
-		"amount":      shopping.Amount,
-		"date":        shopping.Date,
-		"memo":        shopping.Memo,
-	}).Error; err != nil {
-		return err
-	}
-	return nil
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(shopping).Omit(clause.Associations).Where("household_book_id = ?", shopping.HouseholdBookID).Updates(map[string]interface{}{
+			"category_id":       shopping.CategoryID,
+			"amount":            shopping.Amount,
+			"date":              shopping.Date,
+			"memo":              shopping.Memo,
+			"paid_by":           shopping.PaidBy,
+			"split_type":        shopping.SplitType,
+			"payment_method_id": shopping.PaymentMethodID,
+			"original_amount":   shopping.OriginalAmount,
+			"original_currency": shopping.OriginalCurrency,
+			"exchange_rate":     shopping.ExchangeRate,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := tx.Where("shopping_amount_id = ?", shopping.ID).Delete(&models.ShoppingAmountSplit{}).Error; err != nil {
+			return err
+		}
+		if len(shopping.Splits) > 0 {
+			for i := range shopping.Splits {
+				shopping.Splits[i].ShoppingAmountID = shopping.ID
+			}
+			if err := tx.Create(&shopping.Splits).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Where("shopping_amount_id = ?", shopping.ID).Delete(&models.ShoppingAmountTag{}).Error; err != nil {
+			return err
+		}
+		if len(shopping.Tags) == 0 {
+			return nil
+		}
+		tags := make([]models.ShoppingAmountTag, len(shopping.Tags))
+		for i, tag := range shopping.Tags {
+			tags[i] = models.ShoppingAmountTag{ShoppingAmountID: shopping.ID, TagID: tag.ID}
+		}
+		return tx.Create(&tags).Error
+	})
 }
 
 // RegisterShoppingMemo implements domainmodel.ShoppingRepository.
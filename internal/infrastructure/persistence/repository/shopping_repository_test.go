@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+)
+
+func TestShoppingRepository_DeleteShoppingMemo(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewShoppingRepository(gormDB)
+
+	deletedBy := domainmodel.UserID(2)
+	deletion := &domainmodel.Deletion{BatchID: "batch-1", DeletedBy: &deletedBy, DeletedAt: time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)}
+
+	// 削除したメンバーと削除操作を記録してゴミ箱に移す
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "shopping_memos" SET "delete_batch_id"=\$1,"deleted_at"=\$2,"deleted_by"=\$3,"updated_at"=\$4 WHERE \(id = \$5 AND household_book_id = \$6\) AND "shopping_memos"."deleted_at" IS NULL`).
+		WithArgs("batch-1", deletion.DeletedAt, 2, sqlmock.AnyArg(), 5, 10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.DeleteShoppingMemo(10, 5, deletion))
+
+	// 他の家計簿の買い物メモは削除できない
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "shopping_memos" SET "delete_batch_id"=\$1,"deleted_at"=\$2,"deleted_by"=\$3,"updated_at"=\$4 WHERE \(id = \$5 AND household_book_id = \$6\) AND "shopping_memos"."deleted_at" IS NULL`).
+		WithArgs("batch-1", deletion.DeletedAt, 2, sqlmock.AnyArg(), 5, 20).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	assert.Equal(t, gorm.ErrRecordNotFound, repo.DeleteShoppingMemo(20, 5, deletion))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// shoppingSearchQuery は支出の検索条件を SQL の条件に組み立てる
+type shoppingSearchQuery struct {
+	condition *domainmodel.ShoppingSearchCondition
+}
+
+func newShoppingSearchQuery(condition *domainmodel.ShoppingSearchCondition) *shoppingSearchQuery {
+	return &shoppingSearchQuery{condition: condition}
+}
+
+// Scopes は検索条件・カーソル・並び順・件数のスコープを適用順に返す
+func (q *shoppingSearchQuery) Scopes() []func(*gorm.DB) *gorm.DB {
+	return []func(*gorm.DB) *gorm.DB{q.filter, q.after, q.order}
+}
+
+// filter は検索条件で絞り込む
+func (q *shoppingSearchQuery) filter(db *gorm.DB) *gorm.DB {
+	c := q.condition
+	db = db.Where("household_book_id = ?", c.HouseHoldID)
+	if c.From != "" {
+		db = db.Where("date >= ?", c.From)
+	}
+	if c.To != "" {
+		db = db.Where("date <= ?", c.To)
+	}
+	if len(c.CategoryIDs) > 0 {
+		db = db.Where("category_id IN ?", c.CategoryIDs)
+	}
+	if c.MinAmount != nil {
+		db = db.Where("amount >= ?", *c.MinAmount)
+	}
+	if c.MaxAmount != nil {
+		db = db.Where("amount <= ?", *c.MaxAmount)
+	}
+	if c.Memo != "" {
+		db = db.Where("memo ILIKE ?", "%"+escapeLike(c.Memo)+"%")
+	}
+	if c.HasReceipt != nil {
+		if *c.HasReceipt {
+			db = db.Where("analyze_id > 0")
+		} else {
+			db = db.Where("analyze_id IS NULL OR analyze_id = 0")
+		}
+	}
+	return db
+}
+
+// after はカーソルの支出より後ろの支出に絞り込む
+// 並び順の値が同じ支出は ID で順序を決める
+func (q *shoppingSearchQuery) after(db *gorm.DB) *gorm.DB {
+	cursor := q.condition.Cursor
+	if cursor == nil {
+		return db
+	}
+
+	operator := ">"
+	if q.condition.Sort.IsDesc() {
+		operator = "<"
+	}
+	switch q.condition.Sort {
+	case domainmodel.ShoppingSearchSortAmountDesc, domainmodel.ShoppingSearchSortAmountAsc:
+		return db.Where("(amount, id) "+operator+" (?, ?)", cursor.Amount, cursor.ID)
+	default:
+		return db.Where("(date, id) "+operator+" (?, ?)", cursor.Date, cursor.ID)
+	}
+}
+
+// order は並び順と、次のページの有無を判定するため上限より1件多い件数を指定する
+func (q *shoppingSearchQuery) order(db *gorm.DB) *gorm.DB {
+	direction := "ASC"
+	if q.condition.Sort.IsDesc() {
+		direction = "DESC"
+	}
+	column := "date"
+	if q.condition.Sort == domainmodel.ShoppingSearchSortAmountDesc || q.condition.Sort == domainmodel.ShoppingSearchSortAmountAsc {
+		column = "amount"
+	}
+	return db.Order(column + " " + direction + ", id " + direction).Limit(q.condition.Limit + 1)
+}
+
+// escapeLike は LIKE のワイルドカードを文字として扱うようエスケープする
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
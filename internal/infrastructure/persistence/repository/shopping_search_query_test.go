@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+)
+
+func TestShoppingSearchQuery(t *testing.T) {
+	gormDB, _ := setupTest(t)
+	hasReceipt := false
+	minAmount, maxAmount := 100, 5000
+
+	tests := []struct {
+		name     string
+		modify   func(c *domainmodel.ShoppingSearchCondition)
+		wantSQL  string
+		wantVars []interface{}
+	}{
+		{
+			name:     "条件を指定しない場合は日付の新しい順",
+			modify:   func(c *domainmodel.ShoppingSearchCondition) {},
+			wantSQL:  `SELECT * FROM "shopping_amounts" WHERE household_book_id = $1 AND "shopping_amounts"."deleted_at" IS NULL ORDER BY date DESC, id DESC LIMIT $2`,
+			wantVars: []interface{}{domainmodel.HouseHoldID(10), 51},
+		},
+		{
+			name: "すべての条件を指定する",
+			modify: func(c *domainmodel.ShoppingSearchCondition) {
+				c.From, c.To = "2026-01-01", "2026-12-31"
+				c.CategoryIDs = []domainmodel.CategoryID{1, 2}
+				c.MinAmount, c.MaxAmount = &minAmount, &maxAmount
+				c.Memo = "100%_off"
+				c.HasReceipt = &hasReceipt
+				c.Limit = 20
+			},
+			wantSQL: `SELECT * FROM "shopping_amounts" WHERE household_book_id = $1 AND date >= $2 AND date <= $3 AND category_id IN ($4,$5) ` +
+				`AND amount >= $6 AND amount <= $7 AND memo ILIKE $8 AND (analyze_id IS NULL OR analyze_id = 0) AND "shopping_amounts"."deleted_at" IS NULL ORDER BY date DESC, id DESC LIMIT $9`,
+			wantVars: []interface{}{domainmodel.HouseHoldID(10), "2026-01-01", "2026-12-31", domainmodel.CategoryID(1), domainmodel.CategoryID(2), 100, 5000, `%100\%\_off%`, 21},
+		},
+		{
+			name: "日付の新しい順のカーソル",
+			modify: func(c *domainmodel.ShoppingSearchCondition) {
+				c.Cursor = &domainmodel.ShoppingSearchCursor{Sort: domainmodel.ShoppingSearchSortDateDesc, Date: "2026-10-18", ID: 42}
+			},
+			wantSQL:  `SELECT * FROM "shopping_amounts" WHERE household_book_id = $1 AND (date, id) < ($2, $3) AND "shopping_amounts"."deleted_at" IS NULL ORDER BY date DESC, id DESC LIMIT $4`,
+			wantVars: []interface{}{domainmodel.HouseHoldID(10), "2026-10-18", domainmodel.ShoppingID(42), 51},
+		},
+		{
+			name: "金額の少ない順のカーソル",
+			modify: func(c *domainmodel.ShoppingSearchCondition) {
+				c.Sort = domainmodel.ShoppingSearchSortAmountAsc
+				c.Cursor = &domainmodel.ShoppingSearchCursor{Sort: domainmodel.ShoppingSearchSortAmountAsc, Amount: 1280, ID: 42}
+			},
+			wantSQL:  `SELECT * FROM "shopping_amounts" WHERE household_book_id = $1 AND (amount, id) > ($2, $3) AND "shopping_amounts"."deleted_at" IS NULL ORDER BY amount ASC, id ASC LIMIT $4`,
+			wantVars: []interface{}{domainmodel.HouseHoldID(10), 1280, domainmodel.ShoppingID(42), 51},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition := domainmodel.NewShoppingSearchCondition(10)
+			tt.modify(condition)
+
+			stmt := gormDB.Session(&gorm.Session{DryRun: true}).
+				Scopes(newShoppingSearchQuery(condition).Scopes()...).
+				Find(&[]models.ShoppingAmount{}).Statement
+			assert.Equal(t, tt.wantSQL, stmt.SQL.String())
+			assert.Equal(t, tt.wantVars, stmt.Vars)
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package repository
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TagRepository struct {
+	db *gorm.DB
+}
+
+// FindByHouseHoldID implements domainmodel.TagRepository.
+func (r *TagRepository) FindByHouseHoldID(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.Tag, error) {
+	tags := []models.Tag{}
+	if err := r.db.Where("household_book_id = ?", houseHoldID).Order("name, id").Find(&tags).Error; err != nil {
+		return nil, err
+	}
+
+	return domainmodel.ConvertTags(tags), nil
+}
+
+// FindByIDs implements domainmodel.TagRepository.
+func (r *TagRepository) FindByIDs(houseHoldID domainmodel.HouseHoldID, ids []domainmodel.TagID) ([]*domainmodel.Tag, error) {
+	if len(ids) == 0 {
+		return []*domainmodel.Tag{}, nil
+	}
+
+	tags := []models.Tag{}
+	if err := r.db.Where("household_book_id = ? AND id IN ?", houseHoldID, ids).Find(&tags).Error; err != nil {
+		return nil, err
+	}
+
+	return domainmodel.ConvertTags(tags), nil
+}
+
+// FindByName implements domainmodel.TagRepository.
+func (r *TagRepository) FindByName(houseHoldID domainmodel.HouseHoldID, name string) (*domainmodel.Tag, error) {
+	tag := models.Tag{}
+	if err := r.db.Where("household_book_id = ? AND name = ?", houseHoldID, name).First(&tag).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return domainmodel.ConvertTags([]models.Tag{tag})[0], nil
+}
+
+// Create implements domainmodel.TagRepository.
+func (r *TagRepository) Create(tag *domainmodel.Tag) error {
+	model := &models.Tag{
+		HouseholdBookID: uint(tag.HouseHoldID),
+		Name:            tag.Name,
+	}
+	if err := r.db.Create(model).Error; err != nil {
+		return err
+	}
+
+	tag.ID = domainmodel.TagID(model.ID)
+
+	return nil
+}
+
+// Rename implements domainmodel.TagRepository.
+func (r *TagRepository) Rename(tag *domainmodel.Tag) error {
+	result := r.db.Model(&models.Tag{}).
+		Where("id = ? AND household_book_id = ?", tag.ID, tag.HouseHoldID).
+		Update("name", tag.Name)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// Merge implements domainmodel.TagRepository.
+// 既に target のタグを付けている支出・品目は、紐付けを重複させない
+func (r *TagRepository) Merge(houseHoldID domainmodel.HouseHoldID, sourceID domainmodel.TagID, targetID domainmodel.TagID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		shoppingAmountIDs := []uint{}
+		if err := tx.Model(&models.ShoppingAmountTag{}).Where("tag_id = ?", sourceID).Pluck("shopping_amount_id", &shoppingAmountIDs).Error; err != nil {
+			return err
+		}
+		if len(shoppingAmountIDs) > 0 {
+			shoppingAmountTags := make([]models.ShoppingAmountTag, len(shoppingAmountIDs))
+			for i, shoppingAmountID := range shoppingAmountIDs {
+				shoppingAmountTags[i] = models.ShoppingAmountTag{ShoppingAmountID: shoppingAmountID, TagID: uint(targetID)}
+			}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&shoppingAmountTags).Error; err != nil {
+				return err
+			}
+		}
+
+		receiptItemIDs := []uint{}
+		if err := tx.Model(&models.ReceiptAnalyzeItemTag{}).Where("tag_id = ?", sourceID).Pluck("receipt_analyze_item_id", &receiptItemIDs).Error; err != nil {
+			return err
+		}
+		if len(receiptItemIDs) > 0 {
+			receiptItemTags := make([]models.ReceiptAnalyzeItemTag, len(receiptItemIDs))
+			for i, receiptItemID := range receiptItemIDs {
+				receiptItemTags[i] = models.ReceiptAnalyzeItemTag{ReceiptAnalyzeItemID: receiptItemID, TagID: uint(targetID)}
+			}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&receiptItemTags).Error; err != nil {
+				return err
+			}
+		}
+
+		return deleteTag(tx, houseHoldID, sourceID)
+	})
+}
+
+// Delete implements domainmodel.TagRepository.
+func (r *TagRepository) Delete(houseHoldID domainmodel.HouseHoldID, id domainmodel.TagID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return deleteTag(tx, houseHoldID, id)
+	})
+}
+
+// deleteTag は支出・品目への紐付けとともにタグを削除する
+func deleteTag(tx *gorm.DB, houseHoldID domainmodel.HouseHoldID, id domainmodel.TagID) error {
+	if err := tx.Where("tag_id = ?", id).Delete(&models.ShoppingAmountTag{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("tag_id = ?", id).Delete(&models.ReceiptAnalyzeItemTag{}).Error; err != nil {
+		return err
+	}
+
+	result := tx.Where("id = ? AND household_book_id = ?", id, houseHoldID).Delete(&models.Tag{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// ReplaceReceiptItemTags implements domainmodel.TagRepository.
+// 家計簿のレシートの品目でない場合は gorm.ErrRecordNotFound を返す
+func (r *TagRepository) ReplaceReceiptItemTags(houseHoldID domainmodel.HouseHoldID, receiptItemID uint, tagIDs []domainmodel.TagID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&models.ReceiptAnalyzeItems{}).
+			Joins("JOIN receipt_analyzes ON receipt_analyzes.id = receipt_analyze_items.receipt_analyze_id AND receipt_analyzes.deleted_at IS NULL").
+			Where("receipt_analyze_items.id = ? AND receipt_analyzes.household_book_id = ?", receiptItemID, houseHoldID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := tx.Where("receipt_analyze_item_id = ?", receiptItemID).Delete(&models.ReceiptAnalyzeItemTag{}).Error; err != nil {
+			return err
+		}
+		if len(tagIDs) == 0 {
+			return nil
+		}
+		tags := make([]models.ReceiptAnalyzeItemTag, len(tagIDs))
+		for i, tagID := range tagIDs {
+			tags[i] = models.ReceiptAnalyzeItemTag{ReceiptAnalyzeItemID: receiptItemID, TagID: uint(tagID)}
+		}
+		return tx.Create(&tags).Error
+	})
+}
+
+// FindShoppingAmounts implements domainmodel.TagRepository.
+func (r *TagRepository) FindShoppingAmounts(houseHoldID domainmodel.HouseHoldID, id domainmodel.TagID, from string, to string) (domainmodel.ShoppingAmounts, error) {
+	taggedIDs := r.db.Model(&models.ShoppingAmountTag{}).Select("shopping_amount_id").Where("tag_id = ?", id)
+
+	model := []*models.ShoppingAmount{}
+	if err := r.db.Where("household_book_id = ? AND date >= ? AND date <= ? AND id IN (?)", houseHoldID, from, to, taggedIDs).
+		Preload("Category").
+		Preload("PaymentMethod").
+		Preload("Tags").
+		Order("date, id").
+		Find(&model).Error; err != nil {
+		return nil, err
+	}
+
+	shoppingAmounts := domainmodel.ShoppingAmounts{}
+	for _, v := range model {
+		shoppingAmounts = append(shoppingAmounts, domainmodel.ConvertShoppingAmountsToShoppingAmount(v))
+	}
+	return shoppingAmounts, nil
+}
+
+// SummarizeShoppingAmounts implements domainmodel.TagRepository.
+func (r *TagRepository) SummarizeShoppingAmounts(houseHoldID domainmodel.HouseHoldID, from string, to string) ([]*domainmodel.TagTotal, error) {
+	rows := []tagTotalRow{}
+	if err := r.db.Model(&models.ShoppingAmountTag{}).
+		Select("shopping_amount_tags.tag_id, SUM(shopping_amounts.amount) AS amount, COUNT(*) AS count").
+		Joins("JOIN shopping_amounts ON shopping_amounts.id = shopping_amount_tags.shopping_amount_id AND shopping_amounts.deleted_at IS NULL").
+		Where("shopping_amounts.household_book_id = ? AND shopping_amounts.date >= ? AND shopping_amounts.date <= ?", houseHoldID, from, to).
+		Group("shopping_amount_tags.tag_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	return convertTagTotals(rows), nil
+}
+
+// SummarizeReceiptItems implements domainmodel.TagRepository.
+// 品目の日付には、レシートから登録した支出の日付を用いる
+func (r *TagRepository) SummarizeReceiptItems(houseHoldID domainmodel.HouseHoldID, from string, to string) ([]*domainmodel.TagTotal, error) {
+	rows := []tagTotalRow{}
+	if err := r.db.Model(&models.ReceiptAnalyzeItemTag{}).
+		Select("receipt_analyze_item_tags.tag_id, SUM(receipt_analyze_items.price) AS amount, COUNT(*) AS count").
+		Joins("JOIN receipt_analyze_items ON receipt_analyze_items.id = receipt_analyze_item_tags.receipt_analyze_item_id").
+		Joins("JOIN receipt_analyzes ON receipt_analyzes.id = receipt_analyze_items.receipt_analyze_id AND receipt_analyzes.deleted_at IS NULL").
+		Joins("JOIN shopping_amounts ON shopping_amounts.analyze_id = receipt_analyze_items.receipt_analyze_id AND shopping_amounts.deleted_at IS NULL").
+		Where("shopping_amounts.household_book_id = ? AND shopping_amounts.date >= ? AND shopping_amounts.date <= ?", houseHoldID, from, to).
+		Where("NOT EXISTS (SELECT 1 FROM shopping_amount_tags WHERE shopping_amount_tags.shopping_amount_id = shopping_amounts.id AND shopping_amount_tags.tag_id = receipt_analyze_item_tags.tag_id)").
+		Group("receipt_analyze_item_tags.tag_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	return convertTagTotals(rows), nil
+}
+
+type tagTotalRow struct {
+	TagID  uint
+	Amount int
+	Count  int
+}
+
+func convertTagTotals(rows []tagTotalRow) []*domainmodel.TagTotal {
+	totals := make([]*domainmodel.TagTotal, len(rows))
+	for i, row := range rows {
+		totals[i] = &domainmodel.TagTotal{
+			TagID:  domainmodel.TagID(row.TagID),
+			Amount: row.Amount,
+			Count:  row.Count,
+		}
+	}
+	return totals
+}
+
+func NewTagRepository(db *gorm.DB) domainmodel.TagRepository {
+	return &TagRepository{
+		db: db,
+	}
+}
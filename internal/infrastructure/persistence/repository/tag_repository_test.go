@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+)
+
+func TestTagRepository_FindByIDs(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewTagRepository(gormDB)
+
+	mock.ExpectQuery(`SELECT \* FROM "tags" WHERE household_book_id = \$1 AND id IN \(\$2,\$3\)`).
+		WithArgs(10, 1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "household_book_id", "name"}).
+			AddRow(2, 10, "旅行2026").
+			AddRow(1, 10, "子ども"))
+
+	tags, err := repo.FindByIDs(10, []domainmodel.TagID{1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []*domainmodel.Tag{
+		{ID: 1, HouseHoldID: 10, Name: "子ども"},
+		{ID: 2, HouseHoldID: 10, Name: "旅行2026"},
+	}, tags)
+
+	// IDを指定しない場合はクエリを発行しない
+	tags, err = repo.FindByIDs(10, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, tags)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTagRepository_Merge(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewTagRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "shopping_amount_id" FROM "shopping_amount_tags" WHERE tag_id = \$1`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"shopping_amount_id"}).AddRow(5).AddRow(6))
+	mock.ExpectExec(`INSERT INTO "shopping_amount_tags" \("shopping_amount_id","tag_id"\) VALUES \(\$1,\$2\),\(\$3,\$4\) ON CONFLICT DO NOTHING`).
+		WithArgs(5, 2, 6, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT "receipt_analyze_item_id" FROM "receipt_analyze_item_tags" WHERE tag_id = \$1`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"receipt_analyze_item_id"}))
+	mock.ExpectExec(`DELETE FROM "shopping_amount_tags" WHERE tag_id = \$1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`DELETE FROM "receipt_analyze_item_tags" WHERE tag_id = \$1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM "tags" WHERE id = \$1 AND household_book_id = \$2`).
+		WithArgs(1, 10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.Merge(10, 1, 2))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTagRepository_ReplaceReceiptItemTags(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewTagRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "receipt_analyze_items" JOIN receipt_analyzes ON receipt_analyzes.id = receipt_analyze_items.receipt_analyze_id AND receipt_analyzes.deleted_at IS NULL WHERE receipt_analyze_items.id = \$1 AND receipt_analyzes.household_book_id = \$2`).
+		WithArgs(7, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectRollback()
+
+	err := repo.ReplaceReceiptItemTags(10, 7, []domainmodel.TagID{2})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTagRepository_SummarizeReceiptItems(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewTagRepository(gormDB)
+
+	mock.ExpectQuery(`SELECT receipt_analyze_item_tags.tag_id, SUM\(receipt_analyze_items.price\) AS amount, COUNT\(\*\) AS count FROM "receipt_analyze_item_tags" JOIN receipt_analyze_items ON receipt_analyze_items.id = receipt_analyze_item_tags.receipt_analyze_item_id JOIN receipt_analyzes ON receipt_analyzes.id = receipt_analyze_items.receipt_analyze_id AND receipt_analyzes.deleted_at IS NULL JOIN shopping_amounts ON shopping_amounts.analyze_id = receipt_analyze_items.receipt_analyze_id AND shopping_amounts.deleted_at IS NULL WHERE \(shopping_amounts.household_book_id = \$1 AND shopping_amounts.date >= \$2 AND shopping_amounts.date <= \$3\) AND \(NOT EXISTS \(SELECT 1 FROM shopping_amount_tags WHERE shopping_amount_tags.shopping_amount_id = shopping_amounts.id AND shopping_amount_tags.tag_id = receipt_analyze_item_tags.tag_id\)\) GROUP BY "receipt_analyze_item_tags"."tag_id"`).
+		WithArgs(10, "2026-01-01", "2026-12-31").
+		WillReturnRows(sqlmock.NewRows([]string{"tag_id", "amount", "count"}).AddRow(2, 1280, 3))
+
+	totals, err := repo.SummarizeReceiptItems(10, "2026-01-01", "2026-12-31")
+	assert.NoError(t, err)
+	assert.Equal(t, []*domainmodel.TagTotal{{TagID: 2, Amount: 1280, Count: 3}}, totals)
+}
@@ -0,0 +1,236 @@
+package repository
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/infrastructure/persistence/models"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type TrashRepository struct {
+	db *gorm.DB
+}
+
+// FindTrashItems implements domainmodel.TrashRepository.
+func (r *TrashRepository) FindTrashItems(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.TrashItem, error) {
+	items := []*domainmodel.TrashItem{}
+
+	shoppingAmounts := []*models.ShoppingAmount{}
+	if err := r.db.Unscoped().
+		Where("household_book_id = ? AND deleted_at IS NOT NULL", houseHoldID).
+		Preload("Category").
+		Find(&shoppingAmounts).Error; err != nil {
+		return nil, err
+	}
+	for _, model := range shoppingAmounts {
+		title := model.Memo
+		if title == "" {
+			title = model.Category.Name
+		}
+		items = append(items, &domainmodel.TrashItem{
+			Type:      domainmodel.TrashItemTypeShoppingAmount,
+			ID:        model.ID,
+			Title:     title,
+			Amount:    model.Amount,
+			Date:      model.Date.Format("2006-01-02"),
+			DeletedBy: deletedByDomain(model.DeletedBy),
+			BatchID:   deleteBatchIDDomain(model.DeleteBatchID),
+			DeletedAt: model.DeletedAt.Time,
+		})
+	}
+
+	shoppingMemos := []*models.ShoppingMemo{}
+	if err := r.db.Unscoped().
+		Where("household_book_id = ? AND deleted_at IS NOT NULL", houseHoldID).
+		Find(&shoppingMemos).Error; err != nil {
+		return nil, err
+	}
+	for _, model := range shoppingMemos {
+		items = append(items, &domainmodel.TrashItem{
+			Type:      domainmodel.TrashItemTypeShoppingMemo,
+			ID:        model.ID,
+			Title:     model.Title,
+			DeletedBy: deletedByDomain(model.DeletedBy),
+			BatchID:   deleteBatchIDDomain(model.DeleteBatchID),
+			DeletedAt: model.DeletedAt.Time,
+		})
+	}
+
+	categoryLimits := []*models.CategoryLimit{}
+	if err := r.db.Unscoped().
+		Where("household_book_id = ? AND deleted_at IS NOT NULL", houseHoldID).
+		Find(&categoryLimits).Error; err != nil {
+		return nil, err
+	}
+	for _, model := range categoryLimits {
+		items = append(items, &domainmodel.TrashItem{
+			Type:      domainmodel.TrashItemTypeCategory,
+			ID:        model.ID,
+			Title:     model.Name,
+			Amount:    model.LimitAmount,
+			DeletedBy: deletedByDomain(model.DeletedBy),
+			BatchID:   deleteBatchIDDomain(model.DeleteBatchID),
+			DeletedAt: model.DeletedAt.Time,
+		})
+	}
+
+	receipts := []*models.ReceiptAnalyzes{}
+	if err := r.db.Unscoped().
+		Where("household_book_id = ? AND deleted_at IS NOT NULL", houseHoldID).
+		Find(&receipts).Error; err != nil {
+		return nil, err
+	}
+	for _, model := range receipts {
+		items = append(items, &domainmodel.TrashItem{
+			Type:      domainmodel.TrashItemTypeReceipt,
+			ID:        uint(model.ID),
+			Title:     model.ImageURL,
+			Amount:    model.TotalPrice,
+			DeletedBy: deletedByDomain(model.DeletedBy),
+			BatchID:   deleteBatchIDDomain(model.DeleteBatchID),
+			DeletedAt: model.DeletedAt.Time,
+		})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DeletedAt.After(items[j].DeletedAt)
+	})
+
+	return items, nil
+}
+
+// RestoreTrashItem implements domainmodel.TrashRepository.
+func (r *TrashRepository) RestoreTrashItem(houseHoldID domainmodel.HouseHoldID, itemType domainmodel.TrashItemType, id uint) error {
+	model, err := trashItemModel(itemType)
+	if err != nil {
+		return err
+	}
+
+	result := restore(r.db.Where("id = ? AND household_book_id = ? AND deleted_at IS NOT NULL", id, houseHoldID), model)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// RestoreDeleteBatch implements domainmodel.TrashRepository.
+func (r *TrashRepository) RestoreDeleteBatch(houseHoldID domainmodel.HouseHoldID, batchID domainmodel.DeleteBatchID) (int64, error) {
+	var restored int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for _, model := range trashModels() {
+			result := restore(tx.Where("household_book_id = ? AND delete_batch_id = ? AND deleted_at IS NOT NULL", houseHoldID, string(batchID)), model)
+			if result.Error != nil {
+				return result.Error
+			}
+			restored += result.RowsAffected
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return restored, nil
+}
+
+// PurgeTrashItems implements domainmodel.TrashRepository.
+// 支出の負担・タグとレシートの品目のタグは外部キーで合わせて削除される
+func (r *TrashRepository) PurgeTrashItems(deletedBefore time.Time) (int64, error) {
+	var purged int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		tx = tx.Unscoped().Session(&gorm.Session{})
+
+		// レシートの品目は外部キーで削除されないため、レシートより先に削除する
+		receiptIDs := tx.Model(&models.ReceiptAnalyzes{}).Select("id").Where("deleted_at < ?", deletedBefore)
+		if err := tx.Where("receipt_analyze_id IN (?)", receiptIDs).Delete(&models.ReceiptAnalyzeItems{}).Error; err != nil {
+			return err
+		}
+
+		for _, model := range trashModels() {
+			result := tx.Where("deleted_at < ?", deletedBefore).Delete(model)
+			if result.Error != nil {
+				return result.Error
+			}
+			purged += result.RowsAffected
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, nil
+}
+
+// softDelete は db の条件に一致する記録をゴミ箱に移し、削除したメンバーと削除操作を記録する
+func softDelete(db *gorm.DB, model interface{}, deletion *domainmodel.Deletion) *gorm.DB {
+	return db.Model(model).Updates(map[string]interface{}{
+		"deleted_at":      deletion.DeletedAt,
+		"deleted_by":      deletedByModel(deletion.DeletedBy),
+		"delete_batch_id": string(deletion.BatchID),
+	})
+}
+
+// restore は db の条件に一致するゴミ箱の記録を元に戻す
+func restore(db *gorm.DB, model interface{}) *gorm.DB {
+	return db.Unscoped().Model(model).Updates(map[string]interface{}{
+		"deleted_at":      nil,
+		"deleted_by":      nil,
+		"delete_batch_id": nil,
+	})
+}
+
+// trashModels はゴミ箱に移せる記録のモデルを返す
+func trashModels() []interface{} {
+	return []interface{}{&models.ShoppingAmount{}, &models.ShoppingMemo{}, &models.CategoryLimit{}, &models.ReceiptAnalyzes{}}
+}
+
+func deletedByModel(userID *domainmodel.UserID) *uint {
+	if userID == nil {
+		return nil
+	}
+	id := uint(*userID)
+	return &id
+}
+
+func deletedByDomain(userID *uint) *domainmodel.UserID {
+	if userID == nil {
+		return nil
+	}
+	id := domainmodel.UserID(*userID)
+	return &id
+}
+
+func deleteBatchIDDomain(batchID *string) domainmodel.DeleteBatchID {
+	if batchID == nil {
+		return ""
+	}
+	return domainmodel.DeleteBatchID(*batchID)
+}
+
+// trashItemModel はゴミ箱の記録の種類に対応するモデルを返す
+func trashItemModel(itemType domainmodel.TrashItemType) (interface{}, error) {
+	switch itemType {
+	case domainmodel.TrashItemTypeShoppingAmount:
+		return &models.ShoppingAmount{}, nil
+	case domainmodel.TrashItemTypeShoppingMemo:
+		return &models.ShoppingMemo{}, nil
+	case domainmodel.TrashItemTypeCategory:
+		return &models.CategoryLimit{}, nil
+	case domainmodel.TrashItemTypeReceipt:
+		return &models.ReceiptAnalyzes{}, nil
+	default:
+		return nil, domainmodel.ErrInvalidTrashItemType
+	}
+}
+
+func NewTrashRepository(db *gorm.DB) domainmodel.TrashRepository {
+	return &TrashRepository{db: db}
+}
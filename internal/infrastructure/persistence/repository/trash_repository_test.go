@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	domainmodel "echo-household-budget/internal/domain/model"
+)
+
+func TestTrashRepository_RestoreTrashItem(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewTrashRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "shopping_amounts" SET "delete_batch_id"=\$1,"deleted_at"=\$2,"deleted_by"=\$3,"updated_at"=\$4 WHERE id = \$5 AND household_book_id = \$6 AND deleted_at IS NOT NULL`).
+		WithArgs(nil, nil, nil, sqlmock.AnyArg(), 1, 10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.RestoreTrashItem(10, domainmodel.TrashItemTypeShoppingAmount, 1))
+
+	// 他の家計簿の記録やゴミ箱にない記録は戻せない
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "receipt_analyzes" SET "delete_batch_id"=\$1,"deleted_at"=\$2,"deleted_by"=\$3 WHERE id = \$4 AND household_book_id = \$5 AND deleted_at IS NOT NULL`).
+		WithArgs(nil, nil, nil, 2, 10).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	assert.Equal(t, gorm.ErrRecordNotFound, repo.RestoreTrashItem(10, domainmodel.TrashItemTypeReceipt, 2))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTrashRepository_RestoreDeleteBatch(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewTrashRepository(gormDB)
+
+	// 同じ削除操作でゴミ箱に移した記録をまとめて戻す
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "shopping_amounts" SET "delete_batch_id"=\$1,"deleted_at"=\$2,"deleted_by"=\$3,"updated_at"=\$4 WHERE household_book_id = \$5 AND delete_batch_id = \$6 AND deleted_at IS NOT NULL`).
+		WithArgs(nil, nil, nil, sqlmock.AnyArg(), 10, "batch-1").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`UPDATE "shopping_memos" SET "delete_batch_id"=\$1,"deleted_at"=\$2,"deleted_by"=\$3,"updated_at"=\$4 WHERE household_book_id = \$5 AND delete_batch_id = \$6 AND deleted_at IS NOT NULL`).
+		WithArgs(nil, nil, nil, sqlmock.AnyArg(), 10, "batch-1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`UPDATE "category_limits" SET "delete_batch_id"=\$1,"deleted_at"=\$2,"deleted_by"=\$3,"updated_at"=\$4 WHERE household_book_id = \$5 AND delete_batch_id = \$6 AND deleted_at IS NOT NULL`).
+		WithArgs(nil, nil, nil, sqlmock.AnyArg(), 10, "batch-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "receipt_analyzes" SET "delete_batch_id"=\$1,"deleted_at"=\$2,"deleted_by"=\$3 WHERE household_book_id = \$4 AND delete_batch_id = \$5 AND deleted_at IS NOT NULL`).
+		WithArgs(nil, nil, nil, 10, "batch-1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	restored, err := repo.RestoreDeleteBatch(10, "batch-1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), restored)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTrashRepository_PurgeTrashItems(t *testing.T) {
+	gormDB, mock := setupTest(t)
+	repo := NewTrashRepository(gormDB)
+
+	deletedBefore := time.Date(2026, 9, 18, 0, 0, 0, 0, time.UTC)
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "receipt_analyze_items" WHERE receipt_analyze_id IN \(SELECT "id" FROM "receipt_analyzes" WHERE deleted_at < \$1\)`).
+		WithArgs(deletedBefore).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`DELETE FROM "shopping_amounts" WHERE deleted_at < \$1`).
+		WithArgs(deletedBefore).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`DELETE FROM "shopping_memos" WHERE deleted_at < \$1`).
+		WithArgs(deletedBefore).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "category_limits" WHERE deleted_at < \$1`).
+		WithArgs(deletedBefore).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM "receipt_analyzes" WHERE deleted_at < \$1`).
+		WithArgs(deletedBefore).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// レシートの品目は件数に含めない
+	purged, err := repo.PurgeTrashItems(deletedBefore)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), purged)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
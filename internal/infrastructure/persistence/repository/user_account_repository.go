@@ -17,8 +17,9 @@ func (r *UserAccountRepository) fetchUserAccount(condition string, args ...inter
 	var userAccount models.UserAccount
 	if err := r.db.
 		Debug().
-		Preload("HouseholdBooks.CategoryLimits").
-		Preload("HouseholdBooks.CategoryLimits.Category").
+		Preload("HouseholdBooks.CategoryLimits", func(db *gorm.DB) *gorm.DB {
+			return db.Scopes(activeCategoryLimits).Order("sort_order, id")
+		}).
 		Where(condition, args...).
 		First(&userAccount).Error; err != nil {
 		return nil, err
@@ -36,29 +37,27 @@ func (r *UserAccountRepository) fetchUserAccount(condition string, args ...inter
 
 		var categoryLimits []*domainmodel.CategoryLimit
 		// HouseholdBookのCategoryLimitsを追加
-		for _, cl := range hb.CategoryLimits {
-			categoryLimits = append(categoryLimits, &domainmodel.CategoryLimit{
-				ID:              domainmodel.CategoryLimitID(cl.ID),
-				HouseholdBookID: domainmodel.HouseHoldID(cl.HouseholdBookID),
-				Category: domainmodel.Category{
-					ID:    domainmodel.CategoryID(cl.Category.ID),
-					Name:  cl.Category.Name,
-					Color: cl.Category.Color,
-				},
-				LimitAmount: cl.LimitAmount,
-			})
+		for j := range hb.CategoryLimits {
+			categoryLimits = append(categoryLimits, convertCategoryLimit(&hb.CategoryLimits[j]))
 		}
 
 		householdBooks[i].CategoryLimit = categoryLimits
 	}
 
-	return &domainmodel.UserAccount{
+	account := &domainmodel.UserAccount{
 		ID:             domainmodel.UserID(userAccount.ID),
 		UserID:         domainmodel.LINEUserID(userAccount.UserID),
 		Name:           userAccount.Name,
 		PictureURL:     userAccount.PictureURL,
 		HouseholdBooks: householdBooks,
-	}, nil
+	}
+	if userAccount.DefaultHouseholdID != nil {
+		defaultHouseholdID := domainmodel.HouseHoldID(*userAccount.DefaultHouseholdID)
+		account.DefaultHouseholdID = &defaultHouseholdID
+	}
+	account.ResolveDefaultHouseHold()
+
+	return account, nil
 }
 
 // FetchMe implements domainmodel.UserAccountRepository.
@@ -133,6 +132,22 @@ func (r *UserAccountRepository) Create(userAccount *domainmodel.UserAccount) err
 	return nil
 }
 
+// UpdateDefaultHouseHold は既定の家計簿を更新します
+func (r *UserAccountRepository) UpdateDefaultHouseHold(userID domainmodel.UserID, houseHoldID domainmodel.HouseHoldID) error {
+	result := r.db.Model(&models.UserAccount{}).
+		Where("id = ?", userID).
+		Update("default_household_id", houseHoldID)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
 // Delete は指定されたIDのユーザーアカウントを削除します
 func (r *UserAccountRepository) Delete(id domainmodel.UserID) error {
 	result := r.db.Delete(&models.UserAccount{}, id)
@@ -28,11 +28,11 @@ func TestUserAccountRepository_Create(t *testing.T) {
 	// SQLクエリのモック
 	mock.ExpectBegin()
 	mock.ExpectQuery(`INSERT INTO "user_accounts"`).
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), userAccount.UserID, userAccount.Name, userAccount.PictureURL).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), userAccount.UserID, userAccount.Name, userAccount.PictureURL, nil).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 
 	mock.ExpectQuery(`INSERT INTO "household_books"`).
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), householdBook.Title, sqlmock.AnyArg()).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), householdBook.Title, sqlmock.AnyArg(), "JPY").
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 	mock.ExpectCommit()
 
@@ -121,6 +121,8 @@ func TestUserAccountRepository_FindByLINEUserID(t *testing.T) {
 	assert.Len(t, foundAccount.HouseholdBooks, 1)
 	assert.Equal(t, "テスト家計簿", foundAccount.HouseholdBooks[0].Title)
 	assert.Equal(t, "テスト用", foundAccount.HouseholdBooks[0].Description)
+	// 既定の家計簿が未設定の場合は所属している家計簿が既定になる
+	assert.Equal(t, domainmodel.HouseHoldID(1), *foundAccount.DefaultHouseholdID)
 
 	assert.Len(t, foundAccount.HouseholdBooks[0].CategoryLimit, 1)
 	assert.Equal(t, domainmodel.HouseHoldID(1), foundAccount.HouseholdBooks[0].CategoryLimit[0].HouseholdBookID)
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"echo-household-budget/internal/domain/repository"
@@ -53,6 +54,26 @@ func (s *S3FileStorage) GetFileURL(fileName string) (string, error) {
 	return presignedURL.URL, nil
 }
 
+func (s *S3FileStorage) DownloadFile(fileName string) ([]byte, error) {
+	ctx := context.Background()
+
+	output, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(fileName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to download file from S3: %w", err)
+	}
+	defer output.Body.Close()
+
+	data, err := io.ReadAll(output.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file from S3: %w", err)
+	}
+
+	return data, nil
+}
+
 func (s *S3FileStorage) DeleteFile(fileName string) error {
 	ctx := context.Background()
 
@@ -81,3 +81,17 @@ func (mr *MockReceiptAnalyzeHandlerMockRecorder) FindByID(c any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockReceiptAnalyzeHandler)(nil).FindByID), c)
 }
+
+// RemoveReceiptAnalyze mocks base method.
+func (m *MockReceiptAnalyzeHandler) RemoveReceiptAnalyze(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReceiptAnalyze", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveReceiptAnalyze indicates an expected call of RemoveReceiptAnalyze.
+func (mr *MockReceiptAnalyzeHandlerMockRecorder) RemoveReceiptAnalyze(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReceiptAnalyze", reflect.TypeOf((*MockReceiptAnalyzeHandler)(nil).RemoveReceiptAnalyze), c)
+}
@@ -10,6 +10,7 @@
 package mock
 
 import (
+	context "context"
 	model "echo-household-budget/internal/model"
 	reflect "reflect"
 
@@ -41,87 +42,87 @@ func (m *MockKaimemoRepository) EXPECT() *MockKaimemoRepositoryMockRecorder {
 }
 
 // FetchKaimemo mocks base method.
-func (m *MockKaimemoRepository) FetchKaimemo(userID string) ([]model.KaimemoResponse, error) {
+func (m *MockKaimemoRepository) FetchKaimemo(ctx context.Context, userID string) ([]model.KaimemoResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchKaimemo", userID)
+	ret := m.ctrl.Call(m, "FetchKaimemo", ctx, userID)
 	ret0, _ := ret[0].([]model.KaimemoResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FetchKaimemo indicates an expected call of FetchKaimemo.
-func (mr *MockKaimemoRepositoryMockRecorder) FetchKaimemo(userID any) *gomock.Call {
+func (mr *MockKaimemoRepositoryMockRecorder) FetchKaimemo(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchKaimemo", reflect.TypeOf((*MockKaimemoRepository)(nil).FetchKaimemo), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchKaimemo", reflect.TypeOf((*MockKaimemoRepository)(nil).FetchKaimemo), ctx, userID)
 }
 
 // FetchKaimemoAmountRecords mocks base method.
-func (m *MockKaimemoRepository) FetchKaimemoAmountRecords(userID string) (*model.KaimemoAmountRecords, error) {
+func (m *MockKaimemoRepository) FetchKaimemoAmountRecords(ctx context.Context, userID string) (*model.KaimemoAmountRecords, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchKaimemoAmountRecords", userID)
+	ret := m.ctrl.Call(m, "FetchKaimemoAmountRecords", ctx, userID)
 	ret0, _ := ret[0].(*model.KaimemoAmountRecords)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FetchKaimemoAmountRecords indicates an expected call of FetchKaimemoAmountRecords.
-func (mr *MockKaimemoRepositoryMockRecorder) FetchKaimemoAmountRecords(userID any) *gomock.Call {
+func (mr *MockKaimemoRepositoryMockRecorder) FetchKaimemoAmountRecords(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchKaimemoAmountRecords", reflect.TypeOf((*MockKaimemoRepository)(nil).FetchKaimemoAmountRecords), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchKaimemoAmountRecords", reflect.TypeOf((*MockKaimemoRepository)(nil).FetchKaimemoAmountRecords), ctx, userID)
 }
 
 // InsertKaimemo mocks base method.
-func (m *MockKaimemoRepository) InsertKaimemo(req model.CreateKaimemoRequest) error {
+func (m *MockKaimemoRepository) InsertKaimemo(ctx context.Context, req model.CreateKaimemoRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InsertKaimemo", req)
+	ret := m.ctrl.Call(m, "InsertKaimemo", ctx, req)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // InsertKaimemo indicates an expected call of InsertKaimemo.
-func (mr *MockKaimemoRepositoryMockRecorder) InsertKaimemo(req any) *gomock.Call {
+func (mr *MockKaimemoRepositoryMockRecorder) InsertKaimemo(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertKaimemo", reflect.TypeOf((*MockKaimemoRepository)(nil).InsertKaimemo), req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertKaimemo", reflect.TypeOf((*MockKaimemoRepository)(nil).InsertKaimemo), ctx, req)
 }
 
 // InsertKaimemoAmount mocks base method.
-func (m *MockKaimemoRepository) InsertKaimemoAmount(req model.CreateKaimemoAmountRequest) error {
+func (m *MockKaimemoRepository) InsertKaimemoAmount(ctx context.Context, req model.CreateKaimemoAmountRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InsertKaimemoAmount", req)
+	ret := m.ctrl.Call(m, "InsertKaimemoAmount", ctx, req)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // InsertKaimemoAmount indicates an expected call of InsertKaimemoAmount.
-func (mr *MockKaimemoRepositoryMockRecorder) InsertKaimemoAmount(req any) *gomock.Call {
+func (mr *MockKaimemoRepositoryMockRecorder) InsertKaimemoAmount(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertKaimemoAmount", reflect.TypeOf((*MockKaimemoRepository)(nil).InsertKaimemoAmount), req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertKaimemoAmount", reflect.TypeOf((*MockKaimemoRepository)(nil).InsertKaimemoAmount), ctx, req)
 }
 
 // RemoveKaimemo mocks base method.
-func (m *MockKaimemoRepository) RemoveKaimemo(id, userID string) error {
+func (m *MockKaimemoRepository) RemoveKaimemo(ctx context.Context, id, userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveKaimemo", id, userID)
+	ret := m.ctrl.Call(m, "RemoveKaimemo", ctx, id, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RemoveKaimemo indicates an expected call of RemoveKaimemo.
-func (mr *MockKaimemoRepositoryMockRecorder) RemoveKaimemo(id, userID any) *gomock.Call {
+func (mr *MockKaimemoRepositoryMockRecorder) RemoveKaimemo(ctx, id, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveKaimemo", reflect.TypeOf((*MockKaimemoRepository)(nil).RemoveKaimemo), id, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveKaimemo", reflect.TypeOf((*MockKaimemoRepository)(nil).RemoveKaimemo), ctx, id, userID)
 }
 
 // RemoveKaimemoAmount mocks base method.
-func (m *MockKaimemoRepository) RemoveKaimemoAmount(id, userID string) error {
+func (m *MockKaimemoRepository) RemoveKaimemoAmount(ctx context.Context, id, userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveKaimemoAmount", id, userID)
+	ret := m.ctrl.Call(m, "RemoveKaimemoAmount", ctx, id, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RemoveKaimemoAmount indicates an expected call of RemoveKaimemoAmount.
-func (mr *MockKaimemoRepositoryMockRecorder) RemoveKaimemoAmount(id, userID any) *gomock.Call {
+func (mr *MockKaimemoRepositoryMockRecorder) RemoveKaimemoAmount(ctx, id, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveKaimemoAmount", reflect.TypeOf((*MockKaimemoRepository)(nil).RemoveKaimemoAmount), id, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveKaimemoAmount", reflect.TypeOf((*MockKaimemoRepository)(nil).RemoveKaimemoAmount), ctx, id, userID)
 }
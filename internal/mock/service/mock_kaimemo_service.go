@@ -10,6 +10,7 @@
 package mock
 
 import (
+	context "context"
 	model "echo-household-budget/internal/model"
 	reflect "reflect"
 
@@ -41,87 +42,87 @@ func (m *MockKaimemoService) EXPECT() *MockKaimemoServiceMockRecorder {
 }
 
 // CreateKaimemo mocks base method.
-func (m *MockKaimemoService) CreateKaimemo(req model.CreateKaimemoRequest) error {
+func (m *MockKaimemoService) CreateKaimemo(ctx context.Context, req model.CreateKaimemoRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateKaimemo", req)
+	ret := m.ctrl.Call(m, "CreateKaimemo", ctx, req)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateKaimemo indicates an expected call of CreateKaimemo.
-func (mr *MockKaimemoServiceMockRecorder) CreateKaimemo(req any) *gomock.Call {
+func (mr *MockKaimemoServiceMockRecorder) CreateKaimemo(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKaimemo", reflect.TypeOf((*MockKaimemoService)(nil).CreateKaimemo), req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKaimemo", reflect.TypeOf((*MockKaimemoService)(nil).CreateKaimemo), ctx, req)
 }
 
 // CreateKaimemoAmount mocks base method.
-func (m *MockKaimemoService) CreateKaimemoAmount(req model.CreateKaimemoAmountRequest) error {
+func (m *MockKaimemoService) CreateKaimemoAmount(ctx context.Context, req model.CreateKaimemoAmountRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateKaimemoAmount", req)
+	ret := m.ctrl.Call(m, "CreateKaimemoAmount", ctx, req)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateKaimemoAmount indicates an expected call of CreateKaimemoAmount.
-func (mr *MockKaimemoServiceMockRecorder) CreateKaimemoAmount(req any) *gomock.Call {
+func (mr *MockKaimemoServiceMockRecorder) CreateKaimemoAmount(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKaimemoAmount", reflect.TypeOf((*MockKaimemoService)(nil).CreateKaimemoAmount), req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKaimemoAmount", reflect.TypeOf((*MockKaimemoService)(nil).CreateKaimemoAmount), ctx, req)
 }
 
 // FetchKaimemo mocks base method.
-func (m *MockKaimemoService) FetchKaimemo(userID string) ([]model.KaimemoResponse, error) {
+func (m *MockKaimemoService) FetchKaimemo(ctx context.Context, userID string) ([]model.KaimemoResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchKaimemo", userID)
+	ret := m.ctrl.Call(m, "FetchKaimemo", ctx, userID)
 	ret0, _ := ret[0].([]model.KaimemoResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FetchKaimemo indicates an expected call of FetchKaimemo.
-func (mr *MockKaimemoServiceMockRecorder) FetchKaimemo(userID any) *gomock.Call {
+func (mr *MockKaimemoServiceMockRecorder) FetchKaimemo(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchKaimemo", reflect.TypeOf((*MockKaimemoService)(nil).FetchKaimemo), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchKaimemo", reflect.TypeOf((*MockKaimemoService)(nil).FetchKaimemo), ctx, userID)
 }
 
 // FetchKaimemoSummaryRecord mocks base method.
-func (m *MockKaimemoService) FetchKaimemoSummaryRecord(userID string) (model.KaimemoSummaryResponse, error) {
+func (m *MockKaimemoService) FetchKaimemoSummaryRecord(ctx context.Context, userID string) (model.KaimemoSummaryResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchKaimemoSummaryRecord", userID)
+	ret := m.ctrl.Call(m, "FetchKaimemoSummaryRecord", ctx, userID)
 	ret0, _ := ret[0].(model.KaimemoSummaryResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FetchKaimemoSummaryRecord indicates an expected call of FetchKaimemoSummaryRecord.
-func (mr *MockKaimemoServiceMockRecorder) FetchKaimemoSummaryRecord(userID any) *gomock.Call {
+func (mr *MockKaimemoServiceMockRecorder) FetchKaimemoSummaryRecord(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchKaimemoSummaryRecord", reflect.TypeOf((*MockKaimemoService)(nil).FetchKaimemoSummaryRecord), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchKaimemoSummaryRecord", reflect.TypeOf((*MockKaimemoService)(nil).FetchKaimemoSummaryRecord), ctx, userID)
 }
 
 // RemoveKaimemo mocks base method.
-func (m *MockKaimemoService) RemoveKaimemo(id, userID string) error {
+func (m *MockKaimemoService) RemoveKaimemo(ctx context.Context, id, userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveKaimemo", id, userID)
+	ret := m.ctrl.Call(m, "RemoveKaimemo", ctx, id, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RemoveKaimemo indicates an expected call of RemoveKaimemo.
-func (mr *MockKaimemoServiceMockRecorder) RemoveKaimemo(id, userID any) *gomock.Call {
+func (mr *MockKaimemoServiceMockRecorder) RemoveKaimemo(ctx, id, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveKaimemo", reflect.TypeOf((*MockKaimemoService)(nil).RemoveKaimemo), id, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveKaimemo", reflect.TypeOf((*MockKaimemoService)(nil).RemoveKaimemo), ctx, id, userID)
 }
 
 // RemoveKaimemoAmount mocks base method.
-func (m *MockKaimemoService) RemoveKaimemoAmount(id, userID string) error {
+func (m *MockKaimemoService) RemoveKaimemoAmount(ctx context.Context, id, userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveKaimemoAmount", id, userID)
+	ret := m.ctrl.Call(m, "RemoveKaimemoAmount", ctx, id, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RemoveKaimemoAmount indicates an expected call of RemoveKaimemoAmount.
-func (mr *MockKaimemoServiceMockRecorder) RemoveKaimemoAmount(id, userID any) *gomock.Call {
+func (mr *MockKaimemoServiceMockRecorder) RemoveKaimemoAmount(ctx, id, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveKaimemoAmount", reflect.TypeOf((*MockKaimemoService)(nil).RemoveKaimemoAmount), id, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveKaimemoAmount", reflect.TypeOf((*MockKaimemoService)(nil).RemoveKaimemoAmount), ctx, id, userID)
 }
@@ -82,3 +82,17 @@ func (mr *MockReceiptAnalyzeUsecaseMockRecorder) FindByID(id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockReceiptAnalyzeUsecase)(nil).FindByID), id)
 }
+
+// RemoveReceiptAnalyze mocks base method.
+func (m *MockReceiptAnalyzeUsecase) RemoveReceiptAnalyze(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReceiptAnalyze", houseHoldID, operatorID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveReceiptAnalyze indicates an expected call of RemoveReceiptAnalyze.
+func (mr *MockReceiptAnalyzeUsecaseMockRecorder) RemoveReceiptAnalyze(houseHoldID, operatorID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReceiptAnalyze", reflect.TypeOf((*MockReceiptAnalyzeUsecase)(nil).RemoveReceiptAnalyze), houseHoldID, operatorID, id)
+}
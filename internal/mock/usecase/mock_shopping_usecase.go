@@ -55,17 +55,17 @@ func (mr *MockShoppingUsecaseMockRecorder) CreateShopping(shopping any) *gomock.
 }
 
 // DeleteShopping mocks base method.
-func (m *MockShoppingUsecase) DeleteShopping(id domainmodel.ShoppingID) error {
+func (m *MockShoppingUsecase) DeleteShopping(householdID domainmodel.HouseHoldID, userID domainmodel.UserID, id domainmodel.ShoppingID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteShopping", id)
+	ret := m.ctrl.Call(m, "DeleteShopping", householdID, userID, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteShopping indicates an expected call of DeleteShopping.
-func (mr *MockShoppingUsecaseMockRecorder) DeleteShopping(id any) *gomock.Call {
+func (mr *MockShoppingUsecaseMockRecorder) DeleteShopping(householdID, userID, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShopping", reflect.TypeOf((*MockShoppingUsecase)(nil).DeleteShopping), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShopping", reflect.TypeOf((*MockShoppingUsecase)(nil).DeleteShopping), householdID, userID, id)
 }
 
 // FetchShopping mocks base method.
@@ -24,7 +24,7 @@ type TelegraphRequest struct {
 	ID              *int       `json:"id"`
 	Tag             *int       `json:"tag"`
 	Name            *string    `json:"name"`
-	HouseholdBookID *int       `json:"householdBookID"`
+	HouseholdBookID *int       `json:"householdBookID"` // 使用しない。買い物メモは接続した家計簿に作成する
 }
 
 type MethodType string
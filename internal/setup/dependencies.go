@@ -10,44 +10,83 @@ import (
 	"echo-household-budget/internal/infrastructure/persistence/repository"
 	"echo-household-budget/internal/infrastructure/storage/s3"
 	"echo-household-budget/internal/usecase"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"gorm.io/gorm"
 )
 
 // Dependencies はアプリケーションの依存関係を管理する構造体
 type Dependencies struct {
 	// Repositories
-	KaimemoRepository         repository.KaimemoRepository
-	LineRepository            repository.LineRepository
-	UserAccountRepository     domainmodel.UserAccountRepository
-	CategoryRepository        domainmodel.CategoryRepository
-	HouseHoldRepository       domainmodel.HouseHoldRepository
-	ShoppingRepository        domainmodel.ShoppingRepository
-	ReceiptAnalyzeRepository  domainmodel.ReceiptAnalyzeRepository
-	InformationRepository     domainRepository.InformationRepository
-	UserInformationRepository domainRepository.UserInformationRepository
-	ChatMessageRepository     domainRepository.ChatMessageRepository
-	FileStorageRepository     domainRepository.FileStorageRepository
+	KaimemoRepository              repository.KaimemoRepository
+	NotionKaimemoSource            domainmodel.NotionKaimemoSource
+	LineRepository                 repository.LineRepository
+	UserAccountRepository          domainmodel.UserAccountRepository
+	CategoryRepository             domainmodel.CategoryRepository
+	HouseHoldRepository            domainmodel.HouseHoldRepository
+	ShoppingRepository             domainmodel.ShoppingRepository
+	MonthlyBudgetRepository        domainmodel.MonthlyBudgetRepository
+	BudgetAlertRepository          domainmodel.BudgetAlertRepository
+	IncomeRepository               domainmodel.IncomeRepository
+	RecurringTransactionRepository domainmodel.RecurringTransactionRepository
+	SettlementRepository           domainmodel.SettlementRepository
+	PaymentMethodRepository        domainmodel.PaymentMethodRepository
+	TagRepository                  domainmodel.TagRepository
+	ExchangeRateRepository         domainmodel.ExchangeRateRepository
+	TrashRepository                domainmodel.TrashRepository
+	ReportRepository               domainmodel.ReportRepository
+	ImportPresetRepository         domainmodel.ImportPresetRepository
+	BackupRepository               domainmodel.BackupRepository
+	NotionMigrationRepository      domainmodel.NotionMigrationRepository
+	NotionSyncRepository           domainmodel.NotionSyncRepository
+	NotionSyncClient               domainmodel.NotionSyncClient
+	ReceiptAnalyzeRepository       domainmodel.ReceiptAnalyzeRepository
+	InformationRepository          domainRepository.InformationRepository
+	UserInformationRepository      domainRepository.UserInformationRepository
+	ChatMessageRepository          domainRepository.ChatMessageRepository
+	FileStorageRepository          domainRepository.FileStorageRepository
+	InvitationRepository           domainRepository.HouseHoldInvitationRepository
 
 	// Services
-	UserAccountService domainService.UserAccountService
-	HouseHoldService   domainService.HouseHoldService
+	UserAccountService          domainService.UserAccountService
+	HouseHoldService            domainService.HouseHoldService
+	BudgetAlertService          domainService.BudgetAlertService
+	IncomeService               domainService.IncomeService
+	RecurringTransactionService domainService.RecurringTransactionService
+	SettlementService           domainService.SettlementService
+	PaymentMethodService        domainService.PaymentMethodService
+	TagService                  domainService.TagService
+	CurrencyService             domainService.CurrencyService
+	TrashService                domainService.TrashService
+	ReportService               domainService.ReportService
+	ForecastService             domainService.ForecastService
+	ExportService               domainService.ExportService
+	ImportService               domainService.ImportService
 
 	// Use Cases
-	SessionManager              usecase.SessionManager
-	KaimemoService              usecase.KaimemoService
-	ShoppingUsecase             usecase.ShoppingUsecase
-	LineAuthService             usecase.LineAuthService
-	ReceiptAnalyzeUsecase       usecase.ReceiptAnalyzeUsecase
-	CreateInformationUsecase    usecase.CreateInformationUsecase
-	FetchInformationUsecase     usecase.FetchInformationUsecase
-	PublishInformationUsecase   usecase.PublishInformationUsecase
-	FetchUserInformationUsecase usecase.FetchUserInformationUsecase
-	RegisterChatMessageUsecase  usecase.RegisterChatMessageUsecase
-	FetchChatMessageUsecase     usecase.FetchChatMessageUsecase
+	SessionManager                usecase.SessionManager
+	KaimemoService                usecase.KaimemoService
+	ShoppingUsecase               usecase.ShoppingUsecase
+	LineAuthService               usecase.LineAuthService
+	ReceiptAnalyzeUsecase         usecase.ReceiptAnalyzeUsecase
+	CreateInformationUsecase      usecase.CreateInformationUsecase
+	FetchInformationUsecase       usecase.FetchInformationUsecase
+	PublishInformationUsecase     usecase.PublishInformationUsecase
+	FetchUserInformationUsecase   usecase.FetchUserInformationUsecase
+	RegisterChatMessageUsecase    usecase.RegisterChatMessageUsecase
+	FetchChatMessageUsecase       usecase.FetchChatMessageUsecase
+	HouseHoldInvitationUsecase    usecase.HouseHoldInvitationUsecase
+	HouseHoldBackupUsecase        usecase.HouseHoldBackupUsecase
+	NotionMigrationUsecase        usecase.NotionMigrationUsecase
+	NotionSyncUsecase             usecase.NotionSyncUsecase
+	NotionSyncScheduler           usecase.NotionSyncScheduler
+	RecurringTransactionScheduler usecase.RecurringTransactionScheduler
+	TrashPurgeScheduler           usecase.TrashPurgeScheduler
+	ToolRegistry                  *usecase.ToolRegistry
 
 	// Handlers
 	KaimemoHandler                   handler.KaimemoHandler
@@ -64,6 +103,19 @@ type Dependencies struct {
 	DeleteInformationHandler         handler.DeleteInformationHandler
 	FetchInformationDetailHandler    handler.FetchInformationDetailHandler
 	PutInformationHandler            handler.PutInformationHandler
+	HouseHoldInvitationHandler       handler.HouseHoldInvitationHandler
+	IncomeHandler                    handler.IncomeHandler
+	RecurringTransactionHandler      handler.RecurringTransactionHandler
+	SettlementHandler                handler.SettlementHandler
+	PaymentMethodHandler             handler.PaymentMethodHandler
+	TagHandler                       handler.TagHandler
+	ReportHandler                    handler.ReportHandler
+	ExportHandler                    handler.ExportHandler
+	ImportHandler                    handler.ImportHandler
+	HouseHoldBackupHandler           handler.HouseHoldBackupHandler
+	NotionSyncHandler                handler.NotionSyncHandler
+	CurrencyHandler                  handler.CurrencyHandler
+	TrashHandler                     handler.TrashHandler
 }
 
 // NewDependencies は依存関係を初期化して返す
@@ -90,7 +142,8 @@ func NewDependencies(appConfig *config.AppConfig) *Dependencies {
 
 	// リポジトリの初期化
 	deps := &Dependencies{}
-	deps.KaimemoRepository = repository.NewNotionRepository(
+	deps.KaimemoRepository = newKaimemoRepository(appConfig, db)
+	deps.NotionKaimemoSource = repository.NewNotionKaimemoSource(
 		appConfig.NotionAPIKey,
 		appConfig.NotionKaimemoDatabaseInputID,
 		appConfig.NotionKaimemoDatabaseSummaryRecordID,
@@ -100,15 +153,43 @@ func NewDependencies(appConfig *config.AppConfig) *Dependencies {
 	deps.CategoryRepository = repository.NewCategoryRepository(db)
 	deps.HouseHoldRepository = repository.NewHouseHoldRepository(db)
 	deps.ShoppingRepository = repository.NewShoppingRepository(db)
+	deps.MonthlyBudgetRepository = repository.NewMonthlyBudgetRepository(db)
+	deps.BudgetAlertRepository = repository.NewBudgetAlertRepository(db)
+	deps.IncomeRepository = repository.NewIncomeRepository(db)
+	deps.RecurringTransactionRepository = repository.NewRecurringTransactionRepository(db)
+	deps.SettlementRepository = repository.NewSettlementRepository(db)
+	deps.PaymentMethodRepository = repository.NewPaymentMethodRepository(db)
+	deps.TagRepository = repository.NewTagRepository(db)
+	deps.ExchangeRateRepository = repository.NewExchangeRateRepository(db)
+	deps.TrashRepository = repository.NewTrashRepository(db)
+	deps.ReportRepository = repository.NewReportRepository(db)
+	deps.ImportPresetRepository = repository.NewImportPresetRepository(db)
+	deps.BackupRepository = repository.NewBackupRepository(db)
+	deps.NotionMigrationRepository = repository.NewNotionMigrationRepository(db)
+	deps.NotionSyncRepository = repository.NewNotionSyncRepository(db)
+	deps.NotionSyncClient = repository.NewNotionSyncClient(appConfig.NotionAPIKey)
 	deps.ReceiptAnalyzeRepository = repository.NewReceiptRepository(db)
 	deps.InformationRepository = repository.NewInformationRepository(db)
 	deps.UserInformationRepository = repository.NewUserInformationRepository(db)
 	deps.ChatMessageRepository = repository.NewChatMessageRepository(db)
 	deps.FileStorageRepository = s3.NewS3FileStorage(s3Client, appConfig.S3Config.BucketName)
+	deps.InvitationRepository = repository.NewHouseHoldInvitationRepository(db)
 
 	// サービスの初期化
 	deps.UserAccountService = domainService.NewUserAccountService(deps.UserAccountRepository, deps.CategoryRepository, deps.HouseHoldRepository)
-	deps.HouseHoldService = domainService.NewHouseHoldService(deps.HouseHoldRepository, deps.ShoppingRepository, deps.CategoryRepository)
+	deps.BudgetAlertService = domainService.NewBudgetAlertService(deps.BudgetAlertRepository, handler.NewBudgetAlertNotifier(deps.ChatMessageRepository), appConfig.BudgetAlertThresholds)
+	deps.HouseHoldService = domainService.NewHouseHoldService(deps.HouseHoldRepository, deps.ShoppingRepository, deps.CategoryRepository, deps.MonthlyBudgetRepository, deps.BudgetAlertService, deps.IncomeRepository, deps.PaymentMethodRepository, deps.TagRepository, deps.ExchangeRateRepository, deps.FileStorageRepository)
+	deps.IncomeService = domainService.NewIncomeService(deps.IncomeRepository, deps.HouseHoldRepository, deps.PaymentMethodRepository)
+	deps.RecurringTransactionService = domainService.NewRecurringTransactionService(deps.RecurringTransactionRepository, deps.CategoryRepository, deps.HouseHoldService)
+	deps.SettlementService = domainService.NewSettlementService(deps.SettlementRepository, deps.ShoppingRepository, deps.HouseHoldRepository)
+	deps.PaymentMethodService = domainService.NewPaymentMethodService(deps.PaymentMethodRepository)
+	deps.TagService = domainService.NewTagService(deps.TagRepository)
+	deps.CurrencyService = domainService.NewCurrencyService(deps.ExchangeRateRepository, deps.HouseHoldRepository)
+	deps.TrashService = domainService.NewTrashService(deps.TrashRepository, appConfig.TrashRetention)
+	deps.ReportService = domainService.NewReportService(deps.ReportRepository, deps.ShoppingRepository, deps.CategoryRepository, deps.MonthlyBudgetRepository)
+	deps.ForecastService = domainService.NewForecastService(deps.ReportRepository, deps.ShoppingRepository, deps.CategoryRepository, deps.MonthlyBudgetRepository)
+	deps.ExportService = domainService.NewExportService(deps.ShoppingRepository, deps.CategoryRepository, deps.HouseHoldRepository)
+	deps.ImportService = domainService.NewImportService(deps.ImportPresetRepository, deps.ShoppingRepository, deps.CategoryRepository)
 
 	// ユースケースの初期化
 	deps.SessionManager = usecase.NewSessionManager()
@@ -122,6 +203,14 @@ func NewDependencies(appConfig *config.AppConfig) *Dependencies {
 	deps.FetchUserInformationUsecase = usecase.NewFetchUserInformationUsecase(deps.UserInformationRepository)
 	deps.RegisterChatMessageUsecase = usecase.NewRegisterChatMessageUsecase(deps.ChatMessageRepository)
 	deps.FetchChatMessageUsecase = usecase.NewFetchChatMessageUsecase(deps.ChatMessageRepository)
+	deps.HouseHoldInvitationUsecase = usecase.NewHouseHoldInvitationUsecase(deps.InvitationRepository, deps.HouseHoldRepository)
+	deps.HouseHoldBackupUsecase = usecase.NewHouseHoldBackupUsecase(deps.BackupRepository, deps.FileStorageRepository)
+	deps.NotionMigrationUsecase = usecase.NewNotionMigrationUsecase(deps.NotionKaimemoSource, deps.NotionMigrationRepository, deps.UserAccountRepository, deps.HouseHoldRepository, deps.CategoryRepository, deps.HouseHoldService)
+	deps.NotionSyncUsecase = usecase.NewNotionSyncUsecase(deps.NotionSyncRepository, deps.NotionSyncClient)
+	deps.NotionSyncScheduler = usecase.NewNotionSyncScheduler(deps.NotionSyncUsecase, usecase.NotionSyncSchedulerInterval)
+	deps.RecurringTransactionScheduler = usecase.NewRecurringTransactionScheduler(deps.RecurringTransactionService, usecase.RecurringTransactionSchedulerInterval)
+	deps.TrashPurgeScheduler = usecase.NewTrashPurgeScheduler(deps.TrashService, usecase.TrashPurgeSchedulerInterval)
+	deps.ToolRegistry = usecase.NewToolRegistry(usecase.NewPredictionTool(deps.ForecastService))
 
 	// ハンドラーの初期化
 	deps.KaimemoHandler = handler.NewKaimemoHandler(deps.KaimemoService, deps.ShoppingUsecase)
@@ -138,6 +227,35 @@ func NewDependencies(appConfig *config.AppConfig) *Dependencies {
 	deps.DeleteInformationHandler = handler.NewDeleteInformationHandler()
 	deps.FetchInformationDetailHandler = handler.NewFetchInformationDetailHandler()
 	deps.PutInformationHandler = handler.NewPutInformationHandler()
+	deps.HouseHoldInvitationHandler = handler.NewHouseHoldInvitationHandler(deps.HouseHoldInvitationUsecase, appConfig.InvitationURL)
+	deps.IncomeHandler = handler.NewIncomeHandler(deps.IncomeService)
+	deps.RecurringTransactionHandler = handler.NewRecurringTransactionHandler(deps.RecurringTransactionService)
+	deps.SettlementHandler = handler.NewSettlementHandler(deps.SettlementService)
+	deps.PaymentMethodHandler = handler.NewPaymentMethodHandler(deps.PaymentMethodService)
+	deps.TagHandler = handler.NewTagHandler(deps.TagService)
+	deps.ReportHandler = handler.NewReportHandler(deps.ReportService, deps.ForecastService)
+	deps.ExportHandler = handler.NewExportHandler(deps.ExportService)
+	deps.ImportHandler = handler.NewImportHandler(deps.ImportService)
+	deps.HouseHoldBackupHandler = handler.NewHouseHoldBackupHandler(deps.HouseHoldBackupUsecase)
+	deps.NotionSyncHandler = handler.NewNotionSyncHandler(deps.NotionSyncUsecase)
+	deps.CurrencyHandler = handler.NewCurrencyHandler(deps.CurrencyService)
+	deps.TrashHandler = handler.NewTrashHandler(deps.TrashService)
 
 	return deps
 }
+
+// newKaimemoRepository は設定に応じて買い物メモの保存先を選択する。未知の値の場合は起動を中止する
+func newKaimemoRepository(appConfig *config.AppConfig, db *gorm.DB) repository.KaimemoRepository {
+	switch appConfig.KaimemoRepository {
+	case config.KaimemoRepositoryPostgres:
+		return repository.NewKaimemoRepository(db)
+	case config.KaimemoRepositoryNotion:
+		return repository.NewNotionRepository(
+			appConfig.NotionAPIKey,
+			appConfig.NotionKaimemoDatabaseInputID,
+			appConfig.NotionKaimemoDatabaseSummaryRecordID,
+		)
+	default:
+		panic(fmt.Sprintf("unknown KAIMEMO_REPOSITORY: %s", appConfig.KaimemoRepository))
+	}
+}
@@ -10,6 +10,8 @@ const (
 	ErrorCodeInvalidInput    ErrorCode = "INVALID_INPUT"
 	ErrorCodeNotFound        ErrorCode = "NOT_FOUND"
 	ErrorCodeUnauthorized    ErrorCode = "UNAUTHORIZED"
+	ErrorCodeForbidden       ErrorCode = "FORBIDDEN"
+	ErrorCodeConflict        ErrorCode = "CONFLICT"
 	ErrorCodeInternalError   ErrorCode = "INTERNAL_ERROR"
 	ErrorCodeDatabaseError   ErrorCode = "DATABASE_ERROR"
 	ErrorCodeExternalService ErrorCode = "EXTERNAL_SERVICE_ERROR"
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"sort"
+)
+
+// Tool はAIアシスタントが Function Calling で呼び出すツール
+type Tool interface {
+	Name() string
+	Description() string
+	// Parameters は引数の JSON Schema
+	Parameters() map[string]interface{}
+	// Execute はチャットの家計簿を対象にツールを実行する
+	// 他の家計簿のデータを参照できないよう、家計簿はAIに指定させずに呼び出し側で渡す
+	Execute(houseHoldID domainmodel.HouseHoldID, params map[string]interface{}) (interface{}, error)
+}
+
+// ToolDefinition はAIサービスに渡すツールの定義
+type ToolDefinition struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Parameters  map[string]interface{} `json:"parameters"`
+}
+
+// ToolRegistry はAIアシスタントが呼び出せるツールを管理する
+type ToolRegistry struct {
+	tools map[string]Tool
+}
+
+func NewToolRegistry(tools ...Tool) *ToolRegistry {
+	registry := &ToolRegistry{tools: make(map[string]Tool)}
+	for _, tool := range tools {
+		registry.Register(tool)
+	}
+	return registry
+}
+
+// Register はツールを登録する。同じ名前のツールは上書きする
+func (r *ToolRegistry) Register(tool Tool) {
+	r.tools[tool.Name()] = tool
+}
+
+// Definitions は登録済みのツールの定義を名前順で返す
+func (r *ToolRegistry) Definitions() []ToolDefinition {
+	definitions := make([]ToolDefinition, 0, len(r.tools))
+	for _, tool := range r.tools {
+		definitions = append(definitions, ToolDefinition{
+			Name:        tool.Name(),
+			Description: tool.Description(),
+			Parameters:  tool.Parameters(),
+		})
+	}
+	sort.Slice(definitions, func(i, j int) bool { return definitions[i].Name < definitions[j].Name })
+	return definitions
+}
+
+// Execute は名前を指定してツールを実行する
+func (r *ToolRegistry) Execute(houseHoldID domainmodel.HouseHoldID, name string, params map[string]interface{}) (interface{}, error) {
+	tool, ok := r.tools[name]
+	if !ok {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeNotFound, "tool not found: "+name, nil)
+	}
+	return tool.Execute(houseHoldID, params)
+}
@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/domain/repository"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"path"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type (
+	HouseHoldBackupUsecase interface {
+		// ExportBackup は家計簿のカテゴリ、予算、支出、レシートとその画像、チャット、メンバーを zip で書き出す
+		ExportBackup(houseHoldID domainmodel.HouseHoldID, w io.Writer) error
+		// RestoreBackup はバックアップの zip から新しい家計簿を作成する。常に複製として作成し、既存の家計簿への復元には対応しない
+		RestoreBackup(r io.ReaderAt, size int64, input *domainmodel.RestoreBackupInput) (*domainmodel.RestoreBackupResult, error)
+	}
+
+	houseHoldBackupUsecase struct {
+		backupRepository domainmodel.BackupRepository
+		fileStorage      repository.FileStorageRepository
+	}
+)
+
+// ExportBackup implements HouseHoldBackupUsecase.
+// ストレージから取得できないレシート画像は、画像なしのレシートとしてマニフェストに記録する
+func (u *houseHoldBackupUsecase) ExportBackup(houseHoldID domainmodel.HouseHoldID, w io.Writer) error {
+	backup, err := u.backupRepository.FindHouseHoldBackup(houseHoldID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "household not found", err)
+		}
+		return err
+	}
+
+	writer := domainmodel.NewBackupWriter(w)
+	written := map[string]bool{}
+	for _, receipt := range backup.Receipts {
+		if receipt.ImageFile == "" {
+			continue
+		}
+		imageFile := path.Base(receipt.ImageFile)
+		if written[imageFile] {
+			receipt.ImageFile = imageFile
+			continue
+		}
+
+		data, err := u.fileStorage.DownloadFile(receipt.ImageFile)
+		if err != nil {
+			log.Printf("バックアップするレシート画像 %s の取得に失敗しました: %v", receipt.ImageFile, err)
+			backup.Manifest.MissingImages = append(backup.Manifest.MissingImages, receipt.ImageFile)
+			receipt.ImageFile = ""
+			continue
+		}
+		if err := writer.WriteImage(imageFile, data); err != nil {
+			return err
+		}
+		written[imageFile] = true
+		receipt.ImageFile = imageFile
+	}
+
+	return writer.Close(backup)
+}
+
+// RestoreBackup implements HouseHoldBackupUsecase.
+// レシート画像は新しいファイル名でストレージに保存してから家計簿を作成し、作成に失敗した場合は削除する
+func (u *houseHoldBackupUsecase) RestoreBackup(r io.ReaderAt, size int64, input *domainmodel.RestoreBackupInput) (*domainmodel.RestoreBackupResult, error) {
+	archive, err := domainmodel.ReadBackupArchive(r, size)
+	if err != nil {
+		if errors.Is(err, domainmodel.ErrInvalidBackup) || errors.Is(err, domainmodel.ErrUnsupportedBackupVersion) {
+			return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+		}
+		return nil, err
+	}
+
+	images := map[string]string{}
+	missingImages := []string{}
+	for _, receipt := range archive.Backup.Receipts {
+		if receipt.ImageFile == "" || images[receipt.ImageFile] != "" {
+			continue
+		}
+		data, err := archive.Image(receipt.ImageFile)
+		if err != nil {
+			if errors.Is(err, domainmodel.ErrBackupImageNotFound) {
+				missingImages = append(missingImages, receipt.ImageFile)
+				continue
+			}
+			u.deleteImages(images)
+			return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+		}
+
+		fileName := fmt.Sprintf("%s-restored%s", uuid.New().String(), path.Ext(receipt.ImageFile))
+		if _, err := u.fileStorage.UploadFile(data, fileName); err != nil {
+			u.deleteImages(images)
+			return nil, err
+		}
+		images[receipt.ImageFile] = fileName
+	}
+
+	result, err := u.backupRepository.RestoreHouseHoldBackup(archive.Backup, input, images)
+	if err != nil {
+		u.deleteImages(images)
+		return nil, err
+	}
+
+	result.MissingImages = missingImages
+	return result, nil
+}
+
+// deleteImages は復元のために保存したレシート画像を削除する。削除に失敗しても復元のエラーを優先して返す
+func (u *houseHoldBackupUsecase) deleteImages(images map[string]string) {
+	for _, fileName := range images {
+		if err := u.fileStorage.DeleteFile(fileName); err != nil {
+			log.Printf("復元のために保存したレシート画像 %s の削除に失敗しました: %v", fileName, err)
+		}
+	}
+}
+
+func NewHouseHoldBackupUsecase(backupRepository domainmodel.BackupRepository, fileStorage repository.FileStorageRepository) HouseHoldBackupUsecase {
+	return &houseHoldBackupUsecase{
+		backupRepository: backupRepository,
+		fileStorage:      fileStorage,
+	}
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/mock/gomock"
+
+	mockDomainModel "echo-household-budget/internal/domain/mock/domainmodel"
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+)
+
+func newBackupForTest() *domainmodel.HouseHoldBackup {
+	return &domainmodel.HouseHoldBackup{
+		Manifest:   domainmodel.BackupManifest{HouseHoldID: 1, Title: "我が家"},
+		Categories: []*domainmodel.BackupCategory{{ID: 1, Name: "食費"}},
+		Receipts: []*domainmodel.BackupReceipt{
+			{ID: 1, ImageFile: "a.jpg", AnalyzeStatus: "finished"},
+			{ID: 2, ImageFile: "b.jpg", AnalyzeStatus: "finished"},
+		},
+	}
+}
+
+func TestHouseHoldBackupUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// 画像 b.jpg はストレージから削除されている
+	mockRepo := mockDomainModel.NewMockBackupRepository(ctrl)
+	mockStorage := new(MockFileStorageRepository)
+	mockRepo.EXPECT().FindHouseHoldBackup(domainmodel.HouseHoldID(1)).Return(newBackupForTest(), nil)
+	mockStorage.On("DownloadFile", "a.jpg").Return([]byte("image-a"), nil)
+	mockStorage.On("DownloadFile", "b.jpg").Return(nil, errors.New("NoSuchKey"))
+
+	u := NewHouseHoldBackupUsecase(mockRepo, mockStorage)
+	buf := &bytes.Buffer{}
+	assert.NoError(t, u.ExportBackup(1, buf))
+
+	archive, err := domainmodel.ReadBackupArchive(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b.jpg"}, archive.Backup.Manifest.MissingImages)
+	assert.Equal(t, "", archive.Backup.Receipts[1].ImageFile)
+
+	t.Run("画像を新しいファイル名で保存して復元する", func(t *testing.T) {
+		mockRepo := mockDomainModel.NewMockBackupRepository(ctrl)
+		mockStorage := new(MockFileStorageRepository)
+		mockStorage.On("UploadFile", []byte("image-a"), mock.AnythingOfType("string")).Return("", nil)
+
+		input := &domainmodel.RestoreBackupInput{UserID: 3}
+		mockRepo.EXPECT().RestoreHouseHoldBackup(gomock.Any(), input, gomock.Any()).
+			DoAndReturn(func(backup *domainmodel.HouseHoldBackup, input *domainmodel.RestoreBackupInput, images map[string]string) (*domainmodel.RestoreBackupResult, error) {
+				assert.Len(t, images, 1)
+				assert.True(t, strings.HasSuffix(images["a.jpg"], "-restored.jpg"))
+				return &domainmodel.RestoreBackupResult{HouseHoldID: 20}, nil
+			})
+
+		u := NewHouseHoldBackupUsecase(mockRepo, mockStorage)
+		result, err := u.RestoreBackup(bytes.NewReader(buf.Bytes()), int64(buf.Len()), input)
+		assert.NoError(t, err)
+		assert.Equal(t, domainmodel.HouseHoldID(20), result.HouseHoldID)
+		mockStorage.AssertExpectations(t)
+	})
+
+	t.Run("復元に失敗した場合は保存した画像を削除する", func(t *testing.T) {
+		mockRepo := mockDomainModel.NewMockBackupRepository(ctrl)
+		mockStorage := new(MockFileStorageRepository)
+		mockStorage.On("UploadFile", []byte("image-a"), mock.AnythingOfType("string")).Return("", nil)
+		mockStorage.On("DeleteFile", mock.MatchedBy(func(fileName string) bool { return strings.HasSuffix(fileName, "-restored.jpg") })).Return(nil)
+		mockRepo.EXPECT().RestoreHouseHoldBackup(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		u := NewHouseHoldBackupUsecase(mockRepo, mockStorage)
+		_, err := u.RestoreBackup(bytes.NewReader(buf.Bytes()), int64(buf.Len()), &domainmodel.RestoreBackupInput{UserID: 3})
+		assert.EqualError(t, err, "db error")
+		mockStorage.AssertExpectations(t)
+	})
+
+	t.Run("バックアップではないファイル", func(t *testing.T) {
+		u := NewHouseHoldBackupUsecase(nil, nil)
+		data := []byte("not a zip")
+		_, err := u.RestoreBackup(bytes.NewReader(data), int64(len(data)), &domainmodel.RestoreBackupInput{UserID: 3})
+		appErr, ok := apperrors.GetAppError(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperrors.ErrorCodeInvalidInput, appErr.Code)
+	})
+}
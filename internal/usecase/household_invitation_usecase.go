@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	"echo-household-budget/internal/domain/repository"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultInvitationMaxUses は使用回数の上限が指定されなかった場合の上限。既定では 1 回限りの招待とする
+	DefaultInvitationMaxUses = 1
+	// DefaultInvitationExpiresIn は有効期限が指定されなかった場合の招待の有効期間
+	DefaultInvitationExpiresIn = 72 * time.Hour
+	// MaxInvitationExpiresIn は招待に設定できる最長の有効期間
+	MaxInvitationExpiresIn = 30 * 24 * time.Hour
+)
+
+type (
+	CreateHouseHoldInvitationInput struct {
+		HouseHoldID domainmodel.HouseHoldID
+		UserID      domainmodel.UserID
+		MaxUses     *int // 0は無制限。nil の場合は DefaultInvitationMaxUses
+		ExpiresIn   time.Duration
+	}
+
+	FetchInvitationOutput struct {
+		Invitation     *domainmodel.HouseHoldInvitation
+		HouseHoldTitle string
+		Status         domainmodel.InvitationStatus
+	}
+
+	HouseHoldInvitationUsecase interface {
+		CreateInvitation(input CreateHouseHoldInvitationInput) (*domainmodel.HouseHoldInvitation, error)
+		FetchPendingInvitations(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.HouseHoldInvitation, error)
+		RevokeInvitation(houseHoldID domainmodel.HouseHoldID, invitationID domainmodel.HouseHoldInvitationID) error
+		FetchInvitation(code string) (*FetchInvitationOutput, error)
+		AcceptInvitation(code string, userID domainmodel.UserID) (domainmodel.HouseHoldID, error)
+		DeclineInvitation(code string, userID domainmodel.UserID) error
+	}
+
+	houseHoldInvitationUsecase struct {
+		invitationRepository repository.HouseHoldInvitationRepository
+		houseHoldRepository  domainmodel.HouseHoldRepository
+	}
+)
+
+// CreateInvitation implements HouseHoldInvitationUsecase.
+func (u *houseHoldInvitationUsecase) CreateInvitation(input CreateHouseHoldInvitationInput) (*domainmodel.HouseHoldInvitation, error) {
+	maxUses := DefaultInvitationMaxUses
+	if input.MaxUses != nil {
+		maxUses = *input.MaxUses
+	}
+	if maxUses < 0 {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, "maxUses must be 0 or greater", nil)
+	}
+
+	expiresIn := input.ExpiresIn
+	if expiresIn == 0 {
+		expiresIn = DefaultInvitationExpiresIn
+	}
+	if expiresIn < 0 || expiresIn > MaxInvitationExpiresIn {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, "expiresIn is out of range", nil)
+	}
+
+	code := strings.ReplaceAll(uuid.New().String(), "-", "")
+	invitation := domainmodel.NewHouseHoldInvitation(input.HouseHoldID, input.UserID, code, maxUses, time.Now().Add(expiresIn))
+	if err := u.invitationRepository.Create(invitation); err != nil {
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+// FetchPendingInvitations implements HouseHoldInvitationUsecase.
+func (u *houseHoldInvitationUsecase) FetchPendingInvitations(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.HouseHoldInvitation, error) {
+	return u.invitationRepository.FindPendingByHouseHoldID(houseHoldID, time.Now())
+}
+
+// RevokeInvitation implements HouseHoldInvitationUsecase.
+func (u *houseHoldInvitationUsecase) RevokeInvitation(houseHoldID domainmodel.HouseHoldID, invitationID domainmodel.HouseHoldInvitationID) error {
+	if err := u.invitationRepository.Revoke(houseHoldID, invitationID, time.Now()); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "invitation not found", err)
+		}
+		return err
+	}
+	return nil
+}
+
+// FetchInvitation implements HouseHoldInvitationUsecase.
+func (u *houseHoldInvitationUsecase) FetchInvitation(code string) (*FetchInvitationOutput, error) {
+	invitation, err := u.findInvitation(code)
+	if err != nil {
+		return nil, err
+	}
+
+	houseHold, err := u.houseHoldRepository.FindByHouseHoldID(invitation.HouseHoldID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FetchInvitationOutput{
+		Invitation:     invitation,
+		HouseHoldTitle: houseHold.Title,
+		Status:         invitation.Status(time.Now()),
+	}, nil
+}
+
+// AcceptInvitation implements HouseHoldInvitationUsecase.
+func (u *houseHoldInvitationUsecase) AcceptInvitation(code string, userID domainmodel.UserID) (domainmodel.HouseHoldID, error) {
+	invitation, err := u.findInvitation(code)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := invitation.Validate(time.Now()); err != nil {
+		return 0, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+
+	// 検証後に期限が切れた場合も承諾しないよう、承諾する時点の時刻で期限を確認する
+	if err := u.invitationRepository.Accept(invitation, userID, time.Now()); err != nil {
+		switch {
+		case errors.Is(err, domainmodel.ErrAlreadyMember):
+			return 0, apperrors.NewAppError(apperrors.ErrorCodeConflict, err.Error(), err)
+		case errors.Is(err, domainmodel.ErrInvitationUsedUp), errors.Is(err, domainmodel.ErrInvitationExpired):
+			return 0, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+		}
+		return 0, err
+	}
+
+	return invitation.HouseHoldID, nil
+}
+
+// DeclineInvitation implements HouseHoldInvitationUsecase.
+func (u *houseHoldInvitationUsecase) DeclineInvitation(code string, userID domainmodel.UserID) error {
+	invitation, err := u.findInvitation(code)
+	if err != nil {
+		return err
+	}
+
+	return u.invitationRepository.Decline(invitation, userID)
+}
+
+// findInvitation は招待コードから招待を取得し、存在しなければ NotFound を返す
+func (u *houseHoldInvitationUsecase) findInvitation(code string) (*domainmodel.HouseHoldInvitation, error) {
+	invitation, err := u.invitationRepository.FindByCode(code)
+	if err != nil {
+		return nil, err
+	}
+	if invitation == nil {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeNotFound, "invitation not found", nil)
+	}
+	return invitation, nil
+}
+
+func NewHouseHoldInvitationUsecase(invitationRepository repository.HouseHoldInvitationRepository, houseHoldRepository domainmodel.HouseHoldRepository) HouseHoldInvitationUsecase {
+	return &houseHoldInvitationUsecase{
+		invitationRepository: invitationRepository,
+		houseHoldRepository:  houseHoldRepository,
+	}
+}
@@ -2,17 +2,18 @@
 package usecase
 
 import (
+	"context"
 	"echo-household-budget/internal/infrastructure/persistence/repository"
 	"echo-household-budget/internal/model"
 )
 
 type KaimemoService interface {
-	FetchKaimemo(userID string) ([]model.KaimemoResponse, error)
-	CreateKaimemo(req model.CreateKaimemoRequest) error
-	RemoveKaimemo(id string, userID string) error
-	FetchKaimemoSummaryRecord(userID string) (model.KaimemoSummaryResponse, error)
-	CreateKaimemoAmount(req model.CreateKaimemoAmountRequest) error
-	RemoveKaimemoAmount(id string, userID string) error
+	FetchKaimemo(ctx context.Context, userID string) ([]model.KaimemoResponse, error)
+	CreateKaimemo(ctx context.Context, req model.CreateKaimemoRequest) error
+	RemoveKaimemo(ctx context.Context, id string, userID string) error
+	FetchKaimemoSummaryRecord(ctx context.Context, userID string) (model.KaimemoSummaryResponse, error)
+	CreateKaimemoAmount(ctx context.Context, req model.CreateKaimemoAmountRequest) error
+	RemoveKaimemoAmount(ctx context.Context, id string, userID string) error
 }
 
 func NewKaimemoService(repo repository.KaimemoRepository) KaimemoService {
@@ -25,13 +26,13 @@ type kaimemoService struct {
 }
 
 // CreateKaimemoAmount implements KaimemoService.
-func (k *kaimemoService) CreateKaimemoAmount(req model.CreateKaimemoAmountRequest) error {
-	return k.repo.InsertKaimemoAmount(req)
+func (k *kaimemoService) CreateKaimemoAmount(ctx context.Context, req model.CreateKaimemoAmountRequest) error {
+	return k.repo.InsertKaimemoAmount(ctx, req)
 }
 
 // FetchKaimemoSummaryRecord implements KaimemoService.
-func (k *kaimemoService) FetchKaimemoSummaryRecord(userID string) (model.KaimemoSummaryResponse, error) {
-	res, err := k.repo.FetchKaimemoAmountRecords(userID)
+func (k *kaimemoService) FetchKaimemoSummaryRecord(ctx context.Context, userID string) (model.KaimemoSummaryResponse, error) {
+	res, err := k.repo.FetchKaimemoAmountRecords(ctx, userID)
 	if err != nil {
 		return model.KaimemoSummaryResponse{
 			MonthlySummaries: []model.MonthlySummary{},
@@ -46,21 +47,21 @@ func (k *kaimemoService) FetchKaimemoSummaryRecord(userID string) (model.Kaimemo
 }
 
 // RemoveKaimemoAmount implements KaimemoService.
-func (k *kaimemoService) RemoveKaimemoAmount(id string, userID string) error {
-	return k.repo.RemoveKaimemoAmount(id, userID)
+func (k *kaimemoService) RemoveKaimemoAmount(ctx context.Context, id string, userID string) error {
+	return k.repo.RemoveKaimemoAmount(ctx, id, userID)
 }
 
 // CreateKaimemo implements KaimemoService.
-func (k *kaimemoService) CreateKaimemo(req model.CreateKaimemoRequest) error {
-	return k.repo.InsertKaimemo(req)
+func (k *kaimemoService) CreateKaimemo(ctx context.Context, req model.CreateKaimemoRequest) error {
+	return k.repo.InsertKaimemo(ctx, req)
 }
 
 // FetchKaimemo implements KaimemoService.
-func (k *kaimemoService) FetchKaimemo(userID string) ([]model.KaimemoResponse, error) {
-	return k.repo.FetchKaimemo(userID)
+func (k *kaimemoService) FetchKaimemo(ctx context.Context, userID string) ([]model.KaimemoResponse, error) {
+	return k.repo.FetchKaimemo(ctx, userID)
 }
 
 // RemoveKaimemo implements KaimemoService.
-func (k *kaimemoService) RemoveKaimemo(id string, userID string) error {
-	return k.repo.RemoveKaimemo(id, userID)
+func (k *kaimemoService) RemoveKaimemo(ctx context.Context, id string, userID string) error {
+	return k.repo.RemoveKaimemo(ctx, id, userID)
 }
@@ -0,0 +1,338 @@
+package usecase
+
+import (
+	"context"
+	domainmodel "echo-household-budget/internal/domain/model"
+	domainservice "echo-household-budget/internal/domain/service"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+type (
+	NotionMigrationUsecase interface {
+		// Migrate は Notion の買い物メモと支出を Postgres の家計簿に移行する。
+		// 移行済みのページは移行しないため、何度実行してもよい。dryRun の場合は書き込まずに結果のみを返す
+		Migrate(ctx context.Context, mapping *domainmodel.NotionMigrationMapping, dryRun bool) (*domainmodel.NotionMigrationReport, error)
+	}
+
+	notionMigrationUsecase struct {
+		source                    domainmodel.NotionKaimemoSource
+		notionMigrationRepository domainmodel.NotionMigrationRepository
+		userAccountRepository     domainmodel.UserAccountRepository
+		houseHoldRepository       domainmodel.HouseHoldRepository
+		categoryRepository        domainmodel.CategoryRepository
+		houseHoldService          domainservice.HouseHoldService
+	}
+
+	// notionMigration は1回の移行の状態
+	notionMigration struct {
+		*notionMigrationUsecase
+		mapping  *domainmodel.NotionMigrationMapping
+		dryRun   bool
+		report   *domainmodel.NotionMigrationReport
+		migrated map[string]bool
+		// targets は tempUserID ごとの移行先。移行先が見つからない場合は nil
+		targets    map[string]*domainmodel.KaimemoUserMapping
+		unmapped   map[string]*domainmodel.NotionUnmappedUser
+		categories map[domainmodel.HouseHoldID]map[string]domainmodel.CategoryID
+		totals     map[domainmodel.HouseHoldID]*domainmodel.NotionMigrationTotal
+	}
+)
+
+// Migrate implements NotionMigrationUsecase.
+func (u *notionMigrationUsecase) Migrate(ctx context.Context, mapping *domainmodel.NotionMigrationMapping, dryRun bool) (*domainmodel.NotionMigrationReport, error) {
+	memoPages, err := u.source.FetchKaimemoPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	amountPages, err := u.source.FetchKaimemoAmountPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	migrated, err := u.notionMigrationRepository.FindMigratedPageIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	m := &notionMigration{
+		notionMigrationUsecase: u,
+		mapping:                mapping,
+		dryRun:                 dryRun,
+		report: &domainmodel.NotionMigrationReport{
+			DryRun:            dryRun,
+			UnmappedUsers:     []*domainmodel.NotionUnmappedUser{},
+			CreatedCategories: []*domainmodel.NotionMigrationCategory{},
+			Failures:          []*domainmodel.NotionMigrationFailure{},
+			HouseHolds:        []*domainmodel.NotionMigrationReconciliation{},
+		},
+		migrated:   migrated,
+		targets:    map[string]*domainmodel.KaimemoUserMapping{},
+		unmapped:   map[string]*domainmodel.NotionUnmappedUser{},
+		categories: map[domainmodel.HouseHoldID]map[string]domainmodel.CategoryID{},
+		totals:     map[domainmodel.HouseHoldID]*domainmodel.NotionMigrationTotal{},
+	}
+
+	m.report.Memos.Fetched = len(memoPages)
+	for _, page := range memoPages {
+		if err := m.migrateMemo(page); err != nil {
+			return nil, err
+		}
+	}
+	m.report.Amounts.Fetched = len(amountPages)
+	for _, page := range amountPages {
+		if err := m.migrateAmount(page); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := m.reconcile(); err != nil {
+		return nil, err
+	}
+
+	return m.report, nil
+}
+
+// migrateMemo は買い物メモのページを移行する
+func (m *notionMigration) migrateMemo(page *domainmodel.NotionKaimemoPage) error {
+	target, err := m.resolveTarget(page.TempUserID)
+	if err != nil {
+		return err
+	}
+	if target == nil {
+		m.report.Memos.Skipped++
+		return nil
+	}
+	m.totals[target.HouseHoldID].MemoCount++
+
+	if m.migrated[page.PageID] {
+		m.report.Memos.AlreadyMigrated++
+		return nil
+	}
+	if page.Name == "" {
+		m.fail(page.PageID, domainmodel.NotionMigrationSourceKaimemo, domainmodel.ErrEmptyNotionKaimemoName)
+		m.report.Memos.Skipped++
+		return nil
+	}
+
+	categoryID, err := m.resolveCategory(target.HouseHoldID, page.Tag)
+	if err != nil {
+		return err
+	}
+
+	memo := domainmodel.NewShoppingMemo(target.HouseHoldID, categoryID, page.Name, "")
+	memo.IsCompleted = domainmodel.IsCompleted(page.Done)
+	if !m.dryRun {
+		if err := m.notionMigrationRepository.RegisterMigratedShoppingMemo(page.PageID, memo); err != nil {
+			log.Printf("Notion の買い物メモ %s の移行に失敗しました: %v", page.PageID, err)
+			m.fail(page.PageID, domainmodel.NotionMigrationSourceKaimemo, err)
+			m.report.Memos.Skipped++
+			return nil
+		}
+	}
+	m.report.Memos.Migrated++
+
+	return nil
+}
+
+// migrateAmount は支出のページを移行する
+func (m *notionMigration) migrateAmount(page *domainmodel.NotionKaimemoAmountPage) error {
+	target, err := m.resolveTarget(page.TempUserID)
+	if err != nil {
+		return err
+	}
+	if target == nil {
+		m.report.Amounts.Skipped++
+		return nil
+	}
+	total := m.totals[target.HouseHoldID]
+	total.AmountCount++
+	total.Amount += page.Amount
+
+	if m.migrated[page.PageID] {
+		m.report.Amounts.AlreadyMigrated++
+		return nil
+	}
+	date, err := page.ShoppingDate()
+	if err == nil && page.Amount < 0 {
+		err = domainmodel.ErrInvalidNotionKaimemoAmount
+	}
+	if err != nil {
+		m.fail(page.PageID, domainmodel.NotionMigrationSourceKaimemoAmount, err)
+		m.report.Amounts.Skipped++
+		return nil
+	}
+
+	categoryID, err := m.resolveCategory(target.HouseHoldID, page.Tag)
+	if err != nil {
+		return err
+	}
+
+	amount := domainmodel.NewShoppingAmount(target.HouseHoldID, categoryID, page.Amount, date, "", 0)
+	if !m.dryRun {
+		if err := m.notionMigrationRepository.RegisterMigratedShoppingAmount(page.PageID, amount); err != nil {
+			log.Printf("Notion の支出 %s の移行に失敗しました: %v", page.PageID, err)
+			m.fail(page.PageID, domainmodel.NotionMigrationSourceKaimemoAmount, err)
+			m.report.Amounts.Skipped++
+			return nil
+		}
+	}
+	m.report.Amounts.Migrated++
+
+	return nil
+}
+
+// resolveTarget は tempUserID の移行先のユーザーと家計簿を返す。見つからない場合は nil を返し、結果に記録する
+func (m *notionMigration) resolveTarget(tempUserID string) (*domainmodel.KaimemoUserMapping, error) {
+	if target, ok := m.targets[tempUserID]; ok {
+		if target == nil {
+			m.unmapped[tempUserID].Pages++
+		}
+		return target, nil
+	}
+
+	target, reason, err := m.findTarget(tempUserID)
+	if err != nil {
+		return nil, err
+	}
+	m.targets[tempUserID] = target
+	if target == nil {
+		unmapped := &domainmodel.NotionUnmappedUser{TempUserID: tempUserID, Pages: 1, Reason: reason}
+		m.unmapped[tempUserID] = unmapped
+		m.report.UnmappedUsers = append(m.report.UnmappedUsers, unmapped)
+		return nil, nil
+	}
+
+	if !m.dryRun {
+		if err := m.notionMigrationRepository.SaveKaimemoUserMapping(target); err != nil {
+			return nil, err
+		}
+	}
+	if m.totals[target.HouseHoldID] == nil {
+		m.totals[target.HouseHoldID] = &domainmodel.NotionMigrationTotal{}
+	}
+
+	return target, nil
+}
+
+// findTarget は対応表と登録済みのユーザーから移行先を探す。見つからない場合は理由を返す
+func (m *notionMigration) findTarget(tempUserID string) (*domainmodel.KaimemoUserMapping, string, error) {
+	if tempUserID == "" {
+		return nil, "tempUserID is empty", nil
+	}
+
+	user := m.mapping.User(tempUserID)
+	account, err := m.userAccountRepository.FindByLINEUserID(user.LINEUserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Sprintf("user account %s not found", user.LINEUserID), nil
+		}
+		return nil, "", err
+	}
+
+	if user.HouseHoldID == nil {
+		if account.DefaultHouseholdID == nil {
+			return nil, fmt.Sprintf("user account %s has no household", user.LINEUserID), nil
+		}
+		return &domainmodel.KaimemoUserMapping{TempUserID: tempUserID, UserID: account.ID, HouseHoldID: *account.DefaultHouseholdID}, "", nil
+	}
+
+	userHouseHold, err := m.houseHoldRepository.FindUserHouseHold(account.ID, *user.HouseHoldID)
+	if err != nil {
+		return nil, "", err
+	}
+	if userHouseHold == nil {
+		return nil, fmt.Sprintf("user account %s is not a member of household %d", user.LINEUserID, *user.HouseHoldID), nil
+	}
+
+	return &domainmodel.KaimemoUserMapping{TempUserID: tempUserID, UserID: account.ID, HouseHoldID: *user.HouseHoldID}, "", nil
+}
+
+// resolveCategory はタグの移行先のカテゴリを返す。家計簿に同じ名前のカテゴリがない場合は作成する
+// アーカイブされたカテゴリは、同じ名前の有効なカテゴリがない場合に限り移行先とする
+func (m *notionMigration) resolveCategory(houseHoldID domainmodel.HouseHoldID, tag string) (domainmodel.CategoryID, error) {
+	categories, ok := m.categories[houseHoldID]
+	if !ok {
+		categoryLimits, err := m.categoryRepository.FindHouseHoldCategories(houseHoldID, true)
+		if err != nil {
+			return 0, err
+		}
+		categories = map[string]domainmodel.CategoryID{}
+		for _, categoryLimit := range categoryLimits {
+			if _, exists := categories[categoryLimit.Category.Name]; !exists || !categoryLimit.IsArchived() {
+				categories[categoryLimit.Category.Name] = categoryLimit.Category.ID
+			}
+		}
+		m.categories[houseHoldID] = categories
+	}
+
+	name := m.mapping.CategoryName(tag)
+	if categoryID, ok := categories[name]; ok {
+		return categoryID, nil
+	}
+
+	categoryLimit := &domainmodel.CategoryLimit{
+		HouseholdBookID: houseHoldID,
+		Category: domainmodel.Category{
+			Name:  name,
+			Color: domainmodel.KaimemoCategoryColor,
+		},
+	}
+	if !m.dryRun {
+		if err := m.houseHoldService.AddHouseHoldCategory(categoryLimit); err != nil {
+			return 0, err
+		}
+	}
+	categories[name] = categoryLimit.Category.ID
+	m.report.CreatedCategories = append(m.report.CreatedCategories, &domainmodel.NotionMigrationCategory{HouseHoldID: houseHoldID, Name: name})
+
+	return categoryLimit.Category.ID, nil
+}
+
+// reconcile は家計簿ごとに Notion のページと移行した記録の件数と金額を突き合わせる
+func (m *notionMigration) reconcile() error {
+	houseHoldIDs := make([]domainmodel.HouseHoldID, 0, len(m.totals))
+	for houseHoldID := range m.totals {
+		houseHoldIDs = append(houseHoldIDs, houseHoldID)
+	}
+	sort.Slice(houseHoldIDs, func(i, j int) bool { return houseHoldIDs[i] < houseHoldIDs[j] })
+
+	for _, houseHoldID := range houseHoldIDs {
+		migrated, err := m.notionMigrationRepository.SummarizeMigratedRecords(houseHoldID)
+		if err != nil {
+			return err
+		}
+		m.report.HouseHolds = append(m.report.HouseHolds, &domainmodel.NotionMigrationReconciliation{
+			HouseHoldID: houseHoldID,
+			Notion:      *m.totals[houseHoldID],
+			Migrated:    *migrated,
+		})
+	}
+
+	return nil
+}
+
+func (m *notionMigration) fail(pageID string, source string, err error) {
+	m.report.Failures = append(m.report.Failures, &domainmodel.NotionMigrationFailure{PageID: pageID, Source: source, Reason: err.Error()})
+}
+
+func NewNotionMigrationUsecase(
+	source domainmodel.NotionKaimemoSource,
+	notionMigrationRepository domainmodel.NotionMigrationRepository,
+	userAccountRepository domainmodel.UserAccountRepository,
+	houseHoldRepository domainmodel.HouseHoldRepository,
+	categoryRepository domainmodel.CategoryRepository,
+	houseHoldService domainservice.HouseHoldService,
+) NotionMigrationUsecase {
+	return &notionMigrationUsecase{
+		source:                    source,
+		notionMigrationRepository: notionMigrationRepository,
+		userAccountRepository:     userAccountRepository,
+		houseHoldRepository:       houseHoldRepository,
+		categoryRepository:        categoryRepository,
+		houseHoldService:          houseHoldService,
+	}
+}
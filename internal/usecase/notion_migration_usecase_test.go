@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	mockDomainModel "echo-household-budget/internal/domain/mock/domainmodel"
+	domainmodel "echo-household-budget/internal/domain/model"
+)
+
+func TestNotionMigrationUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	defaultHouseHoldID := domainmodel.HouseHoldID(10)
+	archivedAt := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	mapping, err := domainmodel.ParseNotionMigrationMapping([]byte(`{"categories": {"食材": "食費"}}`))
+	assert.NoError(t, err)
+
+	mockSource := mockDomainModel.NewMockNotionKaimemoSource(ctrl)
+	mockRepo := mockDomainModel.NewMockNotionMigrationRepository(ctrl)
+	mockUserRepo := mockDomainModel.NewMockUserAccountRepository(ctrl)
+	mockHouseHoldRepo := mockDomainModel.NewMockHouseHoldRepository(ctrl)
+	mockCategoryRepo := mockDomainModel.NewMockCategoryRepository(ctrl)
+	mockService := new(MockHouseHoldService)
+
+	mockSource.EXPECT().FetchKaimemoPages(gomock.Any()).Return([]*domainmodel.NotionKaimemoPage{
+		{PageID: "memo-1", TempUserID: "U1", Name: "牛乳", Tag: "食材", Done: true},
+		{PageID: "memo-2", TempUserID: "U1", Name: "洗剤", Tag: "日用品"},
+		{PageID: "memo-3", TempUserID: "unknown", Name: "卵", Tag: "食材"},
+	}, nil)
+	mockSource.EXPECT().FetchKaimemoAmountPages(gomock.Any()).Return([]*domainmodel.NotionKaimemoAmountPage{
+		{PageID: "amount-1", TempUserID: "U1", Date: "2024/05/01", Tag: "食材", Amount: 1200},
+		{PageID: "amount-2", TempUserID: "U1", Date: "2024-05-02", Tag: "", Amount: 300},
+		{PageID: "amount-3", TempUserID: "U1", Date: "5月3日", Tag: "食材", Amount: 500},
+	}, nil)
+	mockRepo.EXPECT().FindMigratedPageIDs().Return(map[string]bool{"amount-2": true}, nil)
+
+	mockUserRepo.EXPECT().FindByLINEUserID(domainmodel.LINEUserID("U1")).
+		Return(&domainmodel.UserAccount{ID: 1, DefaultHouseholdID: &defaultHouseHoldID}, nil)
+	mockUserRepo.EXPECT().FindByLINEUserID(domainmodel.LINEUserID("unknown")).Return(nil, gorm.ErrRecordNotFound)
+	mockRepo.EXPECT().SaveKaimemoUserMapping(&domainmodel.KaimemoUserMapping{TempUserID: "U1", UserID: 1, HouseHoldID: 10}).Return(nil)
+
+	// 「日用品」は家計簿にないため作成し、アーカイブされた「食費」より有効な「食費」を優先する
+	mockCategoryRepo.EXPECT().FindHouseHoldCategories(defaultHouseHoldID, true).Return([]*domainmodel.CategoryLimit{
+		{Category: domainmodel.Category{ID: 1, Name: "食費"}},
+		{Category: domainmodel.Category{ID: 5, Name: "食費"}, ArchivedAt: &archivedAt},
+	}, nil)
+	mockService.On("AddHouseHoldCategory", mock.MatchedBy(func(categoryLimit *domainmodel.CategoryLimit) bool {
+		return categoryLimit.Category.Name == "日用品" && categoryLimit.Category.Color == domainmodel.KaimemoCategoryColor
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*domainmodel.CategoryLimit).Category.ID = 7
+	}).Return(nil)
+
+	mockRepo.EXPECT().RegisterMigratedShoppingMemo("memo-1", gomock.Any()).DoAndReturn(func(pageID string, memo *domainmodel.ShoppingMemo) error {
+		assert.Equal(t, domainmodel.CategoryID(1), memo.CategoryID)
+		assert.Equal(t, domainmodel.Done, memo.IsCompleted)
+		return nil
+	})
+	mockRepo.EXPECT().RegisterMigratedShoppingMemo("memo-2", gomock.Any()).DoAndReturn(func(pageID string, memo *domainmodel.ShoppingMemo) error {
+		assert.Equal(t, domainmodel.CategoryID(7), memo.CategoryID)
+		return nil
+	})
+	mockRepo.EXPECT().RegisterMigratedShoppingAmount("amount-1", gomock.Any()).DoAndReturn(func(pageID string, amount *domainmodel.ShoppingAmount) error {
+		assert.Equal(t, "2024-05-01", amount.Date)
+		assert.Equal(t, 1200, amount.Amount)
+		return nil
+	})
+	mockRepo.EXPECT().SummarizeMigratedRecords(defaultHouseHoldID).
+		Return(&domainmodel.NotionMigrationTotal{MemoCount: 2, AmountCount: 2, Amount: 1500}, nil)
+
+	u := NewNotionMigrationUsecase(mockSource, mockRepo, mockUserRepo, mockHouseHoldRepo, mockCategoryRepo, mockService)
+	report, err := u.Migrate(context.Background(), mapping, false)
+	assert.NoError(t, err)
+
+	assert.Equal(t, domainmodel.NotionMigrationCount{Fetched: 3, Migrated: 2, Skipped: 1}, report.Memos)
+	assert.Equal(t, domainmodel.NotionMigrationCount{Fetched: 3, Migrated: 1, AlreadyMigrated: 1, Skipped: 1}, report.Amounts)
+	assert.Equal(t, []*domainmodel.NotionUnmappedUser{{TempUserID: "unknown", Pages: 1, Reason: "user account unknown not found"}}, report.UnmappedUsers)
+	assert.Equal(t, []*domainmodel.NotionMigrationCategory{{HouseHoldID: 10, Name: "日用品"}}, report.CreatedCategories)
+	assert.Len(t, report.Failures, 1)
+	assert.Equal(t, "amount-3", report.Failures[0].PageID)
+
+	// 日付が不正な支出を移行できなかったため、件数と金額が一致しない
+	assert.Len(t, report.HouseHolds, 1)
+	assert.Equal(t, domainmodel.NotionMigrationTotal{MemoCount: 2, AmountCount: 3, Amount: 2000}, report.HouseHolds[0].Notion)
+	assert.False(t, report.HouseHolds[0].Matched())
+	assert.False(t, report.Reconciled())
+	mockService.AssertExpectations(t)
+
+	t.Run("dry-run では書き込まない", func(t *testing.T) {
+		houseHoldID := domainmodel.HouseHoldID(3)
+		mapping, err := domainmodel.ParseNotionMigrationMapping([]byte(`{"users": {"temp-1": {"lineUserID": "U2", "householdID": 3}}}`))
+		assert.NoError(t, err)
+
+		mockSource := mockDomainModel.NewMockNotionKaimemoSource(ctrl)
+		mockRepo := mockDomainModel.NewMockNotionMigrationRepository(ctrl)
+		mockUserRepo := mockDomainModel.NewMockUserAccountRepository(ctrl)
+		mockHouseHoldRepo := mockDomainModel.NewMockHouseHoldRepository(ctrl)
+		mockCategoryRepo := mockDomainModel.NewMockCategoryRepository(ctrl)
+		mockService := new(MockHouseHoldService)
+
+		mockSource.EXPECT().FetchKaimemoPages(gomock.Any()).Return([]*domainmodel.NotionKaimemoPage{}, nil)
+		mockSource.EXPECT().FetchKaimemoAmountPages(gomock.Any()).Return([]*domainmodel.NotionKaimemoAmountPage{
+			{PageID: "amount-1", TempUserID: "temp-1", Date: "2024-05-01", Tag: "", Amount: 800},
+		}, nil)
+		mockRepo.EXPECT().FindMigratedPageIDs().Return(map[string]bool{}, nil)
+		mockUserRepo.EXPECT().FindByLINEUserID(domainmodel.LINEUserID("U2")).Return(&domainmodel.UserAccount{ID: 2}, nil)
+		mockHouseHoldRepo.EXPECT().FindUserHouseHold(domainmodel.UserID(2), houseHoldID).
+			Return(&domainmodel.UserHouseHold{UserID: 2, HouseHoldID: houseHoldID}, nil)
+		mockCategoryRepo.EXPECT().FindHouseHoldCategories(houseHoldID, true).Return([]*domainmodel.CategoryLimit{}, nil)
+		mockRepo.EXPECT().SummarizeMigratedRecords(houseHoldID).Return(&domainmodel.NotionMigrationTotal{}, nil)
+
+		u := NewNotionMigrationUsecase(mockSource, mockRepo, mockUserRepo, mockHouseHoldRepo, mockCategoryRepo, mockService)
+		report, err := u.Migrate(context.Background(), mapping, true)
+		assert.NoError(t, err)
+
+		assert.True(t, report.DryRun)
+		assert.Equal(t, 1, report.Amounts.Migrated)
+		assert.Equal(t, []*domainmodel.NotionMigrationCategory{{HouseHoldID: 3, Name: domainmodel.DefaultKaimemoCategory}}, report.CreatedCategories)
+		mockService.AssertNotCalled(t, "AddHouseHoldCategory", mock.Anything)
+	})
+
+	t.Run("所属していない家計簿には移行しない", func(t *testing.T) {
+		houseHoldID := domainmodel.HouseHoldID(4)
+		mapping, err := domainmodel.ParseNotionMigrationMapping([]byte(`{"users": {"temp-1": {"lineUserID": "U2", "householdID": 4}}}`))
+		assert.NoError(t, err)
+
+		mockSource := mockDomainModel.NewMockNotionKaimemoSource(ctrl)
+		mockRepo := mockDomainModel.NewMockNotionMigrationRepository(ctrl)
+		mockUserRepo := mockDomainModel.NewMockUserAccountRepository(ctrl)
+		mockHouseHoldRepo := mockDomainModel.NewMockHouseHoldRepository(ctrl)
+
+		mockSource.EXPECT().FetchKaimemoPages(gomock.Any()).Return([]*domainmodel.NotionKaimemoPage{
+			{PageID: "memo-1", TempUserID: "temp-1", Name: "牛乳"},
+			{PageID: "memo-2", TempUserID: "temp-1", Name: "卵"},
+		}, nil)
+		mockSource.EXPECT().FetchKaimemoAmountPages(gomock.Any()).Return([]*domainmodel.NotionKaimemoAmountPage{}, nil)
+		mockRepo.EXPECT().FindMigratedPageIDs().Return(map[string]bool{}, nil)
+		mockUserRepo.EXPECT().FindByLINEUserID(domainmodel.LINEUserID("U2")).Return(&domainmodel.UserAccount{ID: 2}, nil)
+		mockHouseHoldRepo.EXPECT().FindUserHouseHold(domainmodel.UserID(2), houseHoldID).Return(nil, nil)
+
+		u := NewNotionMigrationUsecase(mockSource, mockRepo, mockUserRepo, mockHouseHoldRepo, nil, nil)
+		report, err := u.Migrate(context.Background(), mapping, false)
+		assert.NoError(t, err)
+
+		assert.Equal(t, 2, report.Memos.Skipped)
+		assert.Len(t, report.UnmappedUsers, 1)
+		assert.Equal(t, 2, report.UnmappedUsers[0].Pages)
+		assert.Empty(t, report.HouseHolds)
+	})
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"time"
+)
+
+// NotionSyncSchedulerInterval は Notion との同期を行う間隔
+const NotionSyncSchedulerInterval = 15 * time.Minute
+
+// NotionSyncScheduler は同期が有効な家計簿を一定間隔ごとに Notion と同期する
+type NotionSyncScheduler interface {
+	// Start は一定間隔ごとに同期する。ctx がキャンセルされるまでバックグラウンドで動作する
+	Start(ctx context.Context)
+}
+
+type notionSyncScheduler struct {
+	usecase  NotionSyncUsecase
+	interval time.Duration
+}
+
+// Start implements NotionSyncScheduler.
+func (s *notionSyncScheduler) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.usecase.SyncAll(ctx)
+			}
+		}
+	}()
+}
+
+func NewNotionSyncScheduler(usecase NotionSyncUsecase, interval time.Duration) NotionSyncScheduler {
+	return &notionSyncScheduler{
+		usecase:  usecase,
+		interval: interval,
+	}
+}
@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type (
+	NotionSyncUsecase interface {
+		// FetchSetting は家計簿の同期の設定を返す。設定がない場合は同期しない既定の設定を返す
+		FetchSetting(houseHoldID domainmodel.HouseHoldID) (*domainmodel.NotionSyncSetting, error)
+		SaveSetting(setting *domainmodel.NotionSyncSetting) error
+		// Sync は家計簿の支出と Notion のデータベースのページを双方向に同期する
+		Sync(ctx context.Context, houseHoldID domainmodel.HouseHoldID) (*domainmodel.NotionSyncResult, error)
+		// SyncAll は同期が有効なすべての家計簿を同期する。同期に失敗した家計簿は記録のみ行い、次の家計簿の同期を続ける
+		SyncAll(ctx context.Context)
+	}
+
+	notionSyncUsecase struct {
+		notionSyncRepository domainmodel.NotionSyncRepository
+		client               domainmodel.NotionSyncClient
+
+		mu sync.Mutex
+		// running は同期中の家計簿。同じ家計簿を並行して同期するとページが重複して作成されるため、同時に1つに限る
+		running map[domainmodel.HouseHoldID]bool
+	}
+)
+
+// FetchSetting implements NotionSyncUsecase.
+func (u *notionSyncUsecase) FetchSetting(houseHoldID domainmodel.HouseHoldID) (*domainmodel.NotionSyncSetting, error) {
+	setting, err := u.notionSyncRepository.FindNotionSyncSetting(houseHoldID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domainmodel.NewNotionSyncSetting(houseHoldID), nil
+		}
+		return nil, err
+	}
+	return setting, nil
+}
+
+// SaveSetting implements NotionSyncUsecase.
+func (u *notionSyncUsecase) SaveSetting(setting *domainmodel.NotionSyncSetting) error {
+	if err := setting.Validate(); err != nil {
+		return apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, err.Error(), err)
+	}
+	return u.notionSyncRepository.SaveNotionSyncSetting(setting)
+}
+
+// Sync implements NotionSyncUsecase.
+func (u *notionSyncUsecase) Sync(ctx context.Context, houseHoldID domainmodel.HouseHoldID) (*domainmodel.NotionSyncResult, error) {
+	setting, err := u.notionSyncRepository.FindNotionSyncSetting(houseHoldID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if setting == nil || !setting.Enabled {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, "notion sync is not enabled", nil)
+	}
+
+	if !u.lock(houseHoldID) {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeConflict, "notion sync is already running", nil)
+	}
+	defer u.unlock(houseHoldID)
+
+	return u.sync(ctx, setting)
+}
+
+// SyncAll implements NotionSyncUsecase.
+func (u *notionSyncUsecase) SyncAll(ctx context.Context) {
+	settings, err := u.notionSyncRepository.FindEnabledNotionSyncSettings()
+	if err != nil {
+		log.Printf("Notion との同期の設定の取得に失敗しました: %v", err)
+		return
+	}
+
+	for _, setting := range settings {
+		if ctx.Err() != nil {
+			return
+		}
+		// 手動で同期している家計簿は次回に同期する
+		if !u.lock(setting.HouseHoldID) {
+			continue
+		}
+		result, err := u.sync(ctx, setting)
+		u.unlock(setting.HouseHoldID)
+		if err != nil {
+			log.Printf("家計簿 %d の Notion との同期に失敗しました: %v", setting.HouseHoldID, err)
+			continue
+		}
+		if len(result.Failures) > 0 {
+			log.Printf("家計簿 %d の Notion との同期で %d 件を同期できませんでした", setting.HouseHoldID, len(result.Failures))
+		}
+	}
+}
+
+// sync は Notion のページを変更した後に家計簿の変更と対応をまとめて保存する
+// Notion のページの変更に失敗した支出は前回の同期の時点の対応を残し、次回の同期で再び反映する
+func (u *notionSyncUsecase) sync(ctx context.Context, setting *domainmodel.NotionSyncSetting) (*domainmodel.NotionSyncResult, error) {
+	pages, err := u.client.FetchPages(ctx, setting.DatabaseID)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.ErrorCodeExternalService, "failed to fetch notion pages", err)
+	}
+	records, err := u.notionSyncRepository.FindNotionSyncRecords(setting.HouseHoldID)
+	if err != nil {
+		return nil, err
+	}
+	links, err := u.notionSyncRepository.FindNotionSyncLinks(setting.HouseHoldID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := domainmodel.PlanNotionSync(records, pages, links, setting.ConflictPolicy)
+	result := &domainmodel.NotionSyncResult{
+		HouseHoldID: setting.HouseHoldID,
+		Conflicts:   append([]*domainmodel.NotionSyncConflict{}, plan.Conflicts...),
+		Failures:    append([]*domainmodel.NotionSyncFailure{}, plan.Failures...),
+	}
+	fail := func(shoppingID domainmodel.ShoppingID, pageID string, err error) {
+		result.Failures = append(result.Failures, &domainmodel.NotionSyncFailure{ShoppingID: shoppingID, PageID: pageID, Reason: err.Error()})
+	}
+
+	for _, record := range plan.CreatePages {
+		pageID, err := u.client.CreatePage(ctx, setting.DatabaseID, record.Values)
+		if err != nil {
+			fail(record.ShoppingID, "", err)
+			continue
+		}
+		plan.Links = append(plan.Links, &domainmodel.NotionSyncLink{ShoppingID: record.ShoppingID, PageID: pageID, Snapshot: record.Values})
+		result.PagesCreated++
+	}
+	for _, update := range plan.UpdatePages {
+		if err := u.client.UpdatePage(ctx, update.Link.PageID, update.Values); err != nil {
+			fail(update.Link.ShoppingID, update.Link.PageID, err)
+			plan.Links = append(plan.Links, update.Link)
+			continue
+		}
+		plan.Links = append(plan.Links, &domainmodel.NotionSyncLink{ShoppingID: update.Link.ShoppingID, PageID: update.Link.PageID, Snapshot: update.Values})
+		result.PagesUpdated++
+	}
+	for _, link := range plan.ArchivePages {
+		if err := u.client.ArchivePage(ctx, link.PageID); err != nil {
+			fail(link.ShoppingID, link.PageID, err)
+			plan.Links = append(plan.Links, link)
+			continue
+		}
+		result.PagesArchived++
+	}
+
+	result.SyncedAt = time.Now()
+	if err := u.notionSyncRepository.ApplyNotionSync(setting.HouseHoldID, plan, result.SyncedAt); err != nil {
+		return nil, err
+	}
+	result.RecordsCreated = len(plan.CreateRecords)
+	result.RecordsUpdated = len(plan.UpdateRecords)
+	result.RecordsDeleted = len(plan.DeleteRecords)
+
+	return result, nil
+}
+
+func (u *notionSyncUsecase) lock(houseHoldID domainmodel.HouseHoldID) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.running[houseHoldID] {
+		return false
+	}
+	u.running[houseHoldID] = true
+	return true
+}
+
+func (u *notionSyncUsecase) unlock(houseHoldID domainmodel.HouseHoldID) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	delete(u.running, houseHoldID)
+}
+
+func NewNotionSyncUsecase(notionSyncRepository domainmodel.NotionSyncRepository, client domainmodel.NotionSyncClient) NotionSyncUsecase {
+	return &notionSyncUsecase{
+		notionSyncRepository: notionSyncRepository,
+		client:               client,
+		running:              map[domainmodel.HouseHoldID]bool{},
+	}
+}
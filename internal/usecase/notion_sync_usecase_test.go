@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	mockDomainModel "echo-household-budget/internal/domain/mock/domainmodel"
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+)
+
+func TestNotionSyncUsecase_Sync(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	houseHoldID := domainmodel.HouseHoldID(1)
+	base := domainmodel.NotionSyncValues{Date: "2026-10-01", Amount: 1000, Tag: "食費"}
+	edited := domainmodel.NotionSyncValues{Date: "2026-10-01", Amount: 1200, Tag: "食費"}
+	setting := &domainmodel.NotionSyncSetting{HouseHoldID: houseHoldID, DatabaseID: "db", ConflictPolicy: domainmodel.NotionSyncConflictLatest, Enabled: true}
+
+	mockRepo := mockDomainModel.NewMockNotionSyncRepository(ctrl)
+	mockClient := mockDomainModel.NewMockNotionSyncClient(ctrl)
+
+	mockRepo.EXPECT().FindNotionSyncSetting(houseHoldID).Return(setting, nil)
+	mockClient.EXPECT().FetchPages(gomock.Any(), "db").Return([]*domainmodel.NotionSyncPage{
+		{PageID: "p2", Values: base},
+		{PageID: "p3", Values: base},
+		{PageID: "p4", Values: domainmodel.NotionSyncValues{Date: "2026-10-04", Amount: 400, Tag: "日用品"}},
+	}, nil)
+	mockRepo.EXPECT().FindNotionSyncRecords(houseHoldID).Return([]*domainmodel.NotionSyncRecord{
+		{ShoppingID: 1, Values: base},
+		{ShoppingID: 2, Values: edited},
+		{ShoppingID: 3, Values: edited},
+	}, nil)
+	mockRepo.EXPECT().FindNotionSyncLinks(houseHoldID).Return([]*domainmodel.NotionSyncLink{
+		{ShoppingID: 2, PageID: "p2", Snapshot: base},
+		{ShoppingID: 3, PageID: "p3", Snapshot: base},
+		{ShoppingID: 5, PageID: "p5", Snapshot: base},
+	}, nil)
+	mockClient.EXPECT().CreatePage(gomock.Any(), "db", base).Return("p1", nil)
+	mockClient.EXPECT().UpdatePage(gomock.Any(), "p2", edited).Return(nil)
+	// 更新に失敗したページは前回の対応を残し、次回の同期で再び更新する
+	mockClient.EXPECT().UpdatePage(gomock.Any(), "p3", edited).Return(errors.New("notion error"))
+	mockRepo.EXPECT().ApplyNotionSync(houseHoldID, gomock.Any(), gomock.Any()).DoAndReturn(func(_ domainmodel.HouseHoldID, plan *domainmodel.NotionSyncPlan, _ time.Time) error {
+		assert.Len(t, plan.CreateRecords, 1)
+		assert.Equal(t, "p4", plan.CreateRecords[0].PageID)
+		assert.Equal(t, []*domainmodel.NotionSyncLink{
+			{ShoppingID: 1, PageID: "p1", Snapshot: base},
+			{ShoppingID: 2, PageID: "p2", Snapshot: edited},
+			{ShoppingID: 3, PageID: "p3", Snapshot: base},
+		}, plan.Links)
+		return nil
+	})
+
+	usecase := NewNotionSyncUsecase(mockRepo, mockClient)
+	result, err := usecase.Sync(context.Background(), houseHoldID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.PagesCreated)
+	assert.Equal(t, 1, result.PagesUpdated)
+	assert.Equal(t, 1, result.RecordsCreated)
+	assert.Equal(t, []*domainmodel.NotionSyncFailure{{ShoppingID: 3, PageID: "p3", Reason: "notion error"}}, result.Failures)
+	assert.Empty(t, result.Conflicts)
+}
+
+func TestNotionSyncUsecase_SyncErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	houseHoldID := domainmodel.HouseHoldID(1)
+	mockRepo := mockDomainModel.NewMockNotionSyncRepository(ctrl)
+	mockClient := mockDomainModel.NewMockNotionSyncClient(ctrl)
+	usecase := NewNotionSyncUsecase(mockRepo, mockClient)
+
+	t.Run("同期が有効でない場合は同期しない", func(t *testing.T) {
+		mockRepo.EXPECT().FindNotionSyncSetting(houseHoldID).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := usecase.Sync(context.Background(), houseHoldID)
+
+		var appErr *apperrors.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, apperrors.ErrorCodeInvalidInput, appErr.Code)
+	})
+
+	t.Run("同じ家計簿を同期している間は同期しない", func(t *testing.T) {
+		mockRepo.EXPECT().FindNotionSyncSetting(houseHoldID).Return(&domainmodel.NotionSyncSetting{HouseHoldID: houseHoldID, DatabaseID: "db", Enabled: true}, nil)
+		assert.True(t, usecase.(*notionSyncUsecase).lock(houseHoldID))
+		defer usecase.(*notionSyncUsecase).unlock(houseHoldID)
+
+		_, err := usecase.Sync(context.Background(), houseHoldID)
+
+		var appErr *apperrors.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, apperrors.ErrorCodeConflict, appErr.Code)
+	})
+
+	t.Run("Notion から取得できない場合は家計簿を変更しない", func(t *testing.T) {
+		notionErr := errors.New("timeout")
+		mockRepo.EXPECT().FindNotionSyncSetting(houseHoldID).Return(&domainmodel.NotionSyncSetting{HouseHoldID: houseHoldID, DatabaseID: "db", Enabled: true}, nil)
+		mockClient.EXPECT().FetchPages(gomock.Any(), "db").Return(nil, notionErr)
+
+		_, err := usecase.Sync(context.Background(), houseHoldID)
+
+		var appErr *apperrors.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, apperrors.ErrorCodeExternalService, appErr.Code)
+		assert.Equal(t, notionErr, appErr.Err)
+	})
+}
+
+func TestNotionSyncUsecase_SaveSetting(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockDomainModel.NewMockNotionSyncRepository(ctrl)
+	usecase := NewNotionSyncUsecase(mockRepo, mockDomainModel.NewMockNotionSyncClient(ctrl))
+
+	mockRepo.EXPECT().SaveNotionSyncSetting(&domainmodel.NotionSyncSetting{HouseHoldID: 1, DatabaseID: "db", ConflictPolicy: domainmodel.NotionSyncConflictLatest, Enabled: true}).Return(nil)
+	assert.NoError(t, usecase.SaveSetting(&domainmodel.NotionSyncSetting{HouseHoldID: 1, DatabaseID: " db ", Enabled: true}))
+
+	err := usecase.SaveSetting(&domainmodel.NotionSyncSetting{HouseHoldID: 1, Enabled: true})
+	var appErr *apperrors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, apperrors.ErrorCodeInvalidInput, appErr.Code)
+
+	mockRepo.EXPECT().FindNotionSyncSetting(domainmodel.HouseHoldID(2)).Return(nil, gorm.ErrRecordNotFound)
+	setting, err := usecase.FetchSetting(2)
+	assert.NoError(t, err)
+	assert.Equal(t, domainmodel.NewNotionSyncSetting(2), setting)
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	domainmodel "echo-household-budget/internal/domain/model"
+	domainservice "echo-household-budget/internal/domain/service"
+	apperrors "echo-household-budget/internal/shared/errors"
+	"time"
+)
+
+// PredictionTool は月末の支出の予測を返すツール
+type PredictionTool struct {
+	service domainservice.ForecastService
+	now     func() time.Time
+}
+
+func NewPredictionTool(service domainservice.ForecastService) *PredictionTool {
+	return &PredictionTool{service: service, now: time.Now}
+}
+
+// Name implements Tool.
+func (t *PredictionTool) Name() string {
+	return "predict_monthly_expenses"
+}
+
+// Description implements Tool.
+func (t *PredictionTool) Description() string {
+	return "現在の支出ペースと過去の月で月末に集中した支出から、月末のカテゴリごとの支出と予算の残額を予測する"
+}
+
+// Parameters implements Tool.
+func (t *PredictionTool) Parameters() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"current_date": map[string]interface{}{
+				"type":        "string",
+				"description": "予測の基準日（YYYY-MM-DD形式）。基準日の月の月末を予測する。省略した場合は今日",
+			},
+		},
+	}
+}
+
+// Execute implements Tool.
+func (t *PredictionTool) Execute(houseHoldID domainmodel.HouseHoldID, params map[string]interface{}) (interface{}, error) {
+	asOf := t.now()
+	if value, ok := params["current_date"]; ok && value != nil {
+		date, ok := value.(string)
+		if !ok {
+			return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrInvalidForecastDate.Error(), domainmodel.ErrInvalidForecastDate)
+		}
+		parsed, err := time.ParseInLocation("2006-01-02", date, asOf.Location())
+		if err != nil {
+			return nil, apperrors.NewAppError(apperrors.ErrorCodeInvalidInput, domainmodel.ErrInvalidForecastDate.Error(), err)
+		}
+		asOf = parsed
+	}
+
+	return t.service.ForecastMonthEnd(houseHoldID, asOf)
+}
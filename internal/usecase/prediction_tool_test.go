@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mockDomainService "echo-household-budget/internal/domain/mock/domainservice"
+	domainmodel "echo-household-budget/internal/domain/model"
+	apperrors "echo-household-budget/internal/shared/errors"
+)
+
+func TestPredictionTool_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.Local)
+	mockService := mockDomainService.NewMockForecastService(ctrl)
+	tool := NewPredictionTool(mockService)
+	tool.now = func() time.Time { return now }
+	registry := NewToolRegistry(tool)
+
+	forecast := &domainmodel.SpendingForecast{Month: "2026-10"}
+	mockService.EXPECT().ForecastMonthEnd(domainmodel.HouseHoldID(10), now).Return(forecast, nil)
+	mockService.EXPECT().ForecastMonthEnd(domainmodel.HouseHoldID(10), time.Date(2026, 9, 15, 0, 0, 0, 0, time.Local)).Return(forecast, nil)
+
+	// 基準日を省略した場合は今日
+	result, err := registry.Execute(10, "predict_monthly_expenses", map[string]interface{}{})
+	assert.NoError(t, err)
+	assert.Equal(t, forecast, result)
+
+	_, err = registry.Execute(10, "predict_monthly_expenses", map[string]interface{}{"current_date": "2026-09-15"})
+	assert.NoError(t, err)
+
+	_, err = registry.Execute(10, "predict_monthly_expenses", map[string]interface{}{"current_date": "2026/09/15"})
+	appErr, ok := apperrors.GetAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, apperrors.ErrorCodeInvalidInput, appErr.Code)
+
+	_, err = registry.Execute(10, "unknown_tool", nil)
+	appErr, ok = apperrors.GetAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, apperrors.ErrorCodeNotFound, appErr.Code)
+
+	definitions := registry.Definitions()
+	assert.Len(t, definitions, 1)
+	assert.Equal(t, "predict_monthly_expenses", definitions[0].Name)
+}
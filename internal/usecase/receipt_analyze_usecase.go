@@ -5,12 +5,15 @@ import (
 	domainmodel "echo-household-budget/internal/domain/model"
 	"echo-household-budget/internal/domain/repository"
 	domainservice "echo-household-budget/internal/domain/service"
+	apperrors "echo-household-budget/internal/shared/errors"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type receiptAnalyzeUsecase struct {
@@ -45,14 +48,25 @@ func (r *receiptAnalyzeUsecase) CreateReceiptAnalyzeResult(receipt *domainmodel.
 		return err
 	}
 
+	// 別の家計簿で受け付けたレシートには結果を書き込ませない
+	if receiptAnalyze.HouseholdBookID != receipt.HouseholdBookID {
+		return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "receipt analyze not found in household", nil)
+	}
+
 	receiptAnalyze.TotalPrice = receipt.TotalPrice
 	receiptAnalyze.Items = receipt.Items
+	receiptAnalyze.Currency = receipt.Currency
 
 	if err := r.repo.CreateReceiptAnalyzeResult(receiptAnalyze); err != nil {
 		return err
 	}
 
 	shoppingAmount := domainmodel.NewShoppingAmount(receiptAnalyze.HouseholdBookID, receipt.CategoryID, int(receiptAnalyze.TotalPrice), time.Now().Format("2006-01-02"), "aiによるレシート分析", int(receiptAnalyze.ID))
+	// 通貨を指定したレシートは、支出日のレートで基準通貨に換算して登録する
+	if receiptAnalyze.Currency != "" {
+		original := domainmodel.NewMoney(int64(receiptAnalyze.TotalPrice), receiptAnalyze.Currency)
+		shoppingAmount.Original = &original
+	}
 	if err := r.houseHoldService.CreateShoppingAmount(shoppingAmount); err != nil {
 		return err
 	}
@@ -65,10 +79,24 @@ func (r *receiptAnalyzeUsecase) FindByID(id domainmodel.HouseHoldID) (*domainmod
 	return r.repo.FindByID(id)
 }
 
+// RemoveReceiptAnalyze implements ReceiptAnalyzeUsecase.
+func (r *receiptAnalyzeUsecase) RemoveReceiptAnalyze(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID, id uint) error {
+	if err := r.repo.DeleteReceiptAnalyze(houseHoldID, id, domainmodel.NewDeletion(&operatorID)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NewAppError(apperrors.ErrorCodeNotFound, "receipt analyze not found in household", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
 type ReceiptAnalyzeUsecase interface {
 	CreateReceiptAnalyzeReception(receipt *domainmodel.ReceiptAnalyzeReception) error
 	CreateReceiptAnalyzeResult(receipt *domainmodel.ReceiptAnalyze) error
 	FindByID(id domainmodel.HouseHoldID) (*domainmodel.ReceiptAnalyze, error)
+	// RemoveReceiptAnalyze はレシートを operatorID のメンバーの削除としてゴミ箱に移す
+	RemoveReceiptAnalyze(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID, id uint) error
 }
 
 func NewReceiptAnalyzeUsecase(repo domainmodel.ReceiptAnalyzeRepository, fileStorage repository.FileStorageRepository, houseHoldService domainservice.HouseHoldService) ReceiptAnalyzeUsecase {
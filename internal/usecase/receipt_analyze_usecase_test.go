@@ -42,6 +42,11 @@ func (m *MockReceiptAnalyzeRepository) FindReceiptAnalyzeByS3FilePath(s3FilePath
 	return args.Get(0).(*domainmodel.ReceiptAnalyze), args.Error(1)
 }
 
+func (m *MockReceiptAnalyzeRepository) DeleteReceiptAnalyze(houseHoldID domainmodel.HouseHoldID, id uint, deletion *domainmodel.Deletion) error {
+	args := m.Called(houseHoldID, id, deletion)
+	return args.Error(0)
+}
+
 // MockFileStorageRepository is a mock of FileStorageRepository
 type MockFileStorageRepository struct {
 	mock.Mock
@@ -62,6 +67,14 @@ func (m *MockFileStorageRepository) GetFileURL(fileName string) (string, error)
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockFileStorageRepository) DownloadFile(fileName string) ([]byte, error) {
+	args := m.Called(fileName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
 // MockHouseHoldServiceの定義
 type MockHouseHoldService struct {
 	mock.Mock
@@ -72,11 +85,6 @@ func (m *MockHouseHoldService) FetchHouseHold(houseHoldID domainmodel.HouseHoldI
 	return args.Get(0).(*domainmodel.HouseHold), args.Error(1)
 }
 
-func (m *MockHouseHoldService) ShareHouseHold(houseHoldID domainmodel.HouseHoldID, inviteUserID domainmodel.UserID) error {
-	args := m.Called(houseHoldID, inviteUserID)
-	return args.Error(0)
-}
-
 func (m *MockHouseHoldService) FetchShoppingAmount(input domainservice.FetchShoppingRecordInput) ([]*domainmodel.ShoppingAmount, error) {
 	args := m.Called(input)
 	return args.Get(0).([]*domainmodel.ShoppingAmount), args.Error(1)
@@ -92,8 +100,43 @@ func (m *MockHouseHoldService) UpdateShoppingAmount(shoppingAmount *domainmodel.
 	return args.Error(0)
 }
 
-func (m *MockHouseHoldService) AddHouseHoldCategory(houseHoldID domainmodel.HouseHoldID, categoryName string, categoryLimitAmount int) error {
-	args := m.Called(houseHoldID, categoryName, categoryLimitAmount)
+func (m *MockHouseHoldService) FetchHouseHoldCategories(houseHoldID domainmodel.HouseHoldID, includeArchived bool) ([]*domainmodel.CategoryLimit, error) {
+	args := m.Called(houseHoldID, includeArchived)
+	return args.Get(0).([]*domainmodel.CategoryLimit), args.Error(1)
+}
+
+func (m *MockHouseHoldService) FetchMonthlyBudgets(houseHoldID domainmodel.HouseHoldID, month string) (domainmodel.CategoryBudgets, error) {
+	args := m.Called(houseHoldID, month)
+	return args.Get(0).(domainmodel.CategoryBudgets), args.Error(1)
+}
+
+func (m *MockHouseHoldService) SetMonthlyBudget(budget *domainmodel.MonthlyBudget) error {
+	args := m.Called(budget)
+	return args.Error(0)
+}
+
+func (m *MockHouseHoldService) AddHouseHoldCategory(categoryLimit *domainmodel.CategoryLimit) error {
+	args := m.Called(categoryLimit)
+	return args.Error(0)
+}
+
+func (m *MockHouseHoldService) UpdateHouseHoldCategory(categoryLimit *domainmodel.CategoryLimit) error {
+	args := m.Called(categoryLimit)
+	return args.Error(0)
+}
+
+func (m *MockHouseHoldService) ReorderHouseHoldCategories(houseHoldID domainmodel.HouseHoldID, categoryLimitIDs []domainmodel.CategoryLimitID) error {
+	args := m.Called(houseHoldID, categoryLimitIDs)
+	return args.Error(0)
+}
+
+func (m *MockHouseHoldService) ArchiveHouseHoldCategory(houseHoldID domainmodel.HouseHoldID, categoryLimitID domainmodel.CategoryLimitID, archived bool) error {
+	args := m.Called(houseHoldID, categoryLimitID, archived)
+	return args.Error(0)
+}
+
+func (m *MockHouseHoldService) RemoveHouseHoldCategory(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID, categoryLimitID domainmodel.CategoryLimitID) error {
+	args := m.Called(houseHoldID, operatorID, categoryLimitID)
 	return args.Error(0)
 }
 
@@ -102,8 +145,49 @@ func (m *MockHouseHoldService) CreateShoppingAmount(shoppingAmount *domainmodel.
 	return args.Error(0)
 }
 
-func (m *MockHouseHoldService) RemoveShoppingAmount(shoppingAmountID domainmodel.ShoppingID) error {
-	args := m.Called(shoppingAmountID)
+func (m *MockHouseHoldService) ChangeMemberRole(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID, targetUserID domainmodel.UserID, role domainmodel.HouseHoldRole) error {
+	args := m.Called(houseHoldID, operatorID, targetUserID, role)
+	return args.Error(0)
+}
+
+func (m *MockHouseHoldService) TransferOwnership(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID, newOwnerID domainmodel.UserID) error {
+	args := m.Called(houseHoldID, operatorID, newOwnerID)
+	return args.Error(0)
+}
+
+func (m *MockHouseHoldService) FetchUserHouseHolds(userID domainmodel.UserID) ([]*domainmodel.BelongingHouseHold, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainmodel.BelongingHouseHold), args.Error(1)
+}
+
+func (m *MockHouseHoldService) FetchMembers(houseHoldID domainmodel.HouseHoldID) ([]*domainmodel.HouseHoldMember, error) {
+	args := m.Called(houseHoldID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainmodel.HouseHoldMember), args.Error(1)
+}
+
+func (m *MockHouseHoldService) LeaveHouseHold(houseHoldID domainmodel.HouseHoldID, userID domainmodel.UserID) error {
+	args := m.Called(houseHoldID, userID)
+	return args.Error(0)
+}
+
+func (m *MockHouseHoldService) RemoveMember(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID, targetUserID domainmodel.UserID) error {
+	args := m.Called(houseHoldID, operatorID, targetUserID)
+	return args.Error(0)
+}
+
+func (m *MockHouseHoldService) DeleteHouseHold(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID) error {
+	args := m.Called(houseHoldID, operatorID)
+	return args.Error(0)
+}
+
+func (m *MockHouseHoldService) RemoveShoppingAmount(houseHoldID domainmodel.HouseHoldID, operatorID domainmodel.UserID, shoppingAmountID domainmodel.ShoppingID) error {
+	args := m.Called(houseHoldID, operatorID, shoppingAmountID)
 	return args.Error(0)
 }
 
@@ -112,6 +196,11 @@ func (m *MockHouseHoldService) SummarizeShoppingAmount(input domainservice.Fetch
 	return args.Get(0).(*domainmodel.SummarizeShoppingAmounts), args.Error(1)
 }
 
+func (m *MockHouseHoldService) SearchShoppingAmount(condition *domainmodel.ShoppingSearchCondition) (*domainmodel.ShoppingSearchResult, error) {
+	args := m.Called(condition)
+	return args.Get(0).(*domainmodel.ShoppingSearchResult), args.Error(1)
+}
+
 func TestCreateReceiptAnalyzeReception(t *testing.T) {
 	// テストケース
 	tests := []struct {
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	domainservice "echo-household-budget/internal/domain/service"
+	"log"
+	"time"
+)
+
+// RecurringTransactionSchedulerInterval は定期取引の登録を確認する間隔
+const RecurringTransactionSchedulerInterval = time.Hour
+
+// RecurringTransactionScheduler は発生日を迎えた定期取引を支出として登録する
+type RecurringTransactionScheduler interface {
+	// Start は起動時と一定間隔ごとに定期取引を登録する。ctx がキャンセルされるまでバックグラウンドで動作する
+	Start(ctx context.Context)
+	RunOnce(now time.Time)
+}
+
+type recurringTransactionScheduler struct {
+	service  domainservice.RecurringTransactionService
+	interval time.Duration
+}
+
+// Start implements RecurringTransactionScheduler.
+func (s *recurringTransactionScheduler) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		s.RunOnce(time.Now())
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				s.RunOnce(now)
+			}
+		}
+	}()
+}
+
+// RunOnce implements RecurringTransactionScheduler.
+func (s *recurringTransactionScheduler) RunOnce(now time.Time) {
+	materialized, err := s.service.MaterializeDueOccurrences(now)
+	if err != nil {
+		log.Printf("定期取引の登録に失敗しました: %v", err)
+		return
+	}
+	if materialized > 0 {
+		log.Printf("定期取引を%d件登録しました", materialized)
+	}
+}
+
+func NewRecurringTransactionScheduler(service domainservice.RecurringTransactionService, interval time.Duration) RecurringTransactionScheduler {
+	return &recurringTransactionScheduler{
+		service:  service,
+		interval: interval,
+	}
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	mockDomainService "echo-household-budget/internal/domain/mock/domainservice"
+)
+
+func TestRecurringTransactionScheduler_RunOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	mockService := mockDomainService.NewMockRecurringTransactionService(ctrl)
+	gomock.InOrder(
+		mockService.EXPECT().MaterializeDueOccurrences(now).Return(2, nil),
+		// 登録に失敗しても次回の実行に影響しない
+		mockService.EXPECT().MaterializeDueOccurrences(now).Return(0, errors.New("db error")),
+	)
+
+	scheduler := NewRecurringTransactionScheduler(mockService, time.Hour)
+	scheduler.RunOnce(now)
+	scheduler.RunOnce(now)
+}
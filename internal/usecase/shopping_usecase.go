@@ -23,14 +23,15 @@ func (s *shoppingUsecase) CreateShopping(shopping *domainmodel.ShoppingMemo) err
 }
 
 // DeleteShopping implements ShoppingUsecase.
-func (s *shoppingUsecase) DeleteShopping(id domainmodel.ShoppingID) error {
-	return s.repo.DeleteShoppingMemo(id)
+func (s *shoppingUsecase) DeleteShopping(householdID domainmodel.HouseHoldID, userID domainmodel.UserID, id domainmodel.ShoppingID) error {
+	return s.repo.DeleteShoppingMemo(householdID, id, domainmodel.NewDeletion(&userID))
 }
 
 type ShoppingUsecase interface {
 	CreateShopping(shopping *domainmodel.ShoppingMemo) error
 	FetchShopping(householdID domainmodel.HouseHoldID) ([]*domainmodel.ShoppingMemo, error)
-	DeleteShopping(id domainmodel.ShoppingID) error
+	// DeleteShopping は買い物メモを userID のメンバーの削除としてゴミ箱に移す
+	DeleteShopping(householdID domainmodel.HouseHoldID, userID domainmodel.UserID, id domainmodel.ShoppingID) error
 }
 
 func NewShoppingUsecase(repo domainmodel.ShoppingRepository) ShoppingUsecase {
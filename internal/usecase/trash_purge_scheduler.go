@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	domainservice "echo-household-budget/internal/domain/service"
+	"log"
+	"time"
+)
+
+// TrashPurgeSchedulerInterval は保持期間を過ぎたゴミ箱の記録を確認する間隔
+const TrashPurgeSchedulerInterval = time.Hour
+
+// TrashPurgeScheduler は保持期間を過ぎたゴミ箱の記録を物理削除する
+type TrashPurgeScheduler interface {
+	// Start は起動時と一定間隔ごとにゴミ箱を空にする。ctx がキャンセルされるまでバックグラウンドで動作する
+	Start(ctx context.Context)
+	RunOnce(now time.Time)
+}
+
+type trashPurgeScheduler struct {
+	service  domainservice.TrashService
+	interval time.Duration
+}
+
+// Start implements TrashPurgeScheduler.
+func (s *trashPurgeScheduler) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		s.RunOnce(time.Now())
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				s.RunOnce(now)
+			}
+		}
+	}()
+}
+
+// RunOnce implements TrashPurgeScheduler.
+func (s *trashPurgeScheduler) RunOnce(now time.Time) {
+	purged, err := s.service.PurgeExpiredTrashItems(now)
+	if err != nil {
+		log.Printf("ゴミ箱の記録の削除に失敗しました: %v", err)
+		return
+	}
+	if purged > 0 {
+		log.Printf("保持期間を過ぎたゴミ箱の記録を%d件削除しました", purged)
+	}
+}
+
+func NewTrashPurgeScheduler(service domainservice.TrashService, interval time.Duration) TrashPurgeScheduler {
+	return &trashPurgeScheduler{
+		service:  service,
+		interval: interval,
+	}
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	mockDomainService "echo-household-budget/internal/domain/mock/domainservice"
+)
+
+func TestTrashPurgeScheduler_RunOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	mockService := mockDomainService.NewMockTrashService(ctrl)
+	gomock.InOrder(
+		mockService.EXPECT().PurgeExpiredTrashItems(now).Return(int64(3), nil),
+		// 削除に失敗しても次回の実行に影響しない
+		mockService.EXPECT().PurgeExpiredTrashItems(now).Return(int64(0), errors.New("db error")),
+	)
+
+	scheduler := NewTrashPurgeScheduler(mockService, time.Hour)
+	scheduler.RunOnce(now)
+	scheduler.RunOnce(now)
+}
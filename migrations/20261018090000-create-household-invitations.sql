@@ -0,0 +1,43 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS household_invitations (
+    id SERIAL PRIMARY KEY,
+    household_id INTEGER NOT NULL,
+    code VARCHAR(64) NOT NULL,
+    created_by INTEGER NOT NULL,
+    -- 0は無制限
+    max_uses INTEGER NOT NULL DEFAULT 1,
+    used_count INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (household_id) REFERENCES household_books(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES user_accounts(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_household_invitations_code ON household_invitations(code);
+CREATE INDEX idx_household_invitations_household_id ON household_invitations(household_id);
+
+CREATE TABLE IF NOT EXISTS household_invitation_responses (
+    id SERIAL PRIMARY KEY,
+    invitation_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (invitation_id) REFERENCES household_invitations(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES user_accounts(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_household_invitation_responses_invitation_user ON household_invitation_responses(invitation_id, user_id);
+
+-- 既存の重複した所属を除去した上で、同じ家計簿へ重複して参加できないようにする
+DELETE FROM user_households a USING user_households b
+WHERE a.id > b.id AND a.user_id = b.user_id AND a.household_id = b.household_id;
+
+CREATE UNIQUE INDEX idx_user_households_user_household ON user_households(user_id, household_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_user_households_user_household;
+DROP TABLE IF EXISTS household_invitation_responses;
+DROP TABLE IF EXISTS household_invitations;
@@ -0,0 +1,17 @@
+-- +migrate Up
+ALTER TABLE user_households ADD COLUMN role VARCHAR(16) NOT NULL DEFAULT 'editor';
+
+-- 既存の家計簿は作成したユーザーを所有者とする
+-- household_books は作成したユーザーを保持していないが、家計簿の作成（ユーザー登録時の既定の家計簿と AddUserHouseHold）は
+-- 家計簿を作成した直後に作成したユーザーの所属を登録するため、家計簿ごとの最初の所属が作成したユーザーとなる
+-- 作成したユーザーが既に脱退している場合は、残っているメンバーのうち最初に所属したユーザーを所有者とする
+UPDATE user_households SET role = 'owner'
+WHERE id IN (
+    SELECT MIN(id) FROM user_households GROUP BY household_id
+);
+
+ALTER TABLE user_households ADD CONSTRAINT chk_user_households_role CHECK (role IN ('owner', 'editor', 'viewer'));
+
+-- +migrate Down
+ALTER TABLE user_households DROP CONSTRAINT IF EXISTS chk_user_households_role;
+ALTER TABLE user_households DROP COLUMN IF EXISTS role;
@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE user_accounts ADD COLUMN default_household_id INTEGER REFERENCES household_books(id) ON DELETE SET NULL;
+
+-- +migrate Down
+ALTER TABLE user_accounts DROP COLUMN IF EXISTS default_household_id;
@@ -0,0 +1,40 @@
+-- +migrate Up
+-- 名前・色・アイコン・並び順・アーカイブ状態を家計簿ごとに持つ
+ALTER TABLE category_limits
+    ADD COLUMN name VARCHAR(255),
+    ADD COLUMN color VARCHAR(7),
+    ADD COLUMN icon VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN sort_order INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN archived_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE category_limits
+SET name = categories.name, color = COALESCE(categories.color, '#000000')
+FROM categories
+WHERE categories.id = category_limits.category_id;
+
+UPDATE category_limits
+SET sort_order = ordered.sort_order
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY household_book_id ORDER BY id) AS sort_order
+    FROM category_limits
+) AS ordered
+WHERE ordered.id = category_limits.id;
+
+ALTER TABLE category_limits ALTER COLUMN name SET NOT NULL;
+ALTER TABLE category_limits ALTER COLUMN color SET NOT NULL;
+
+-- カテゴリを削除しても過去の買い物記録が消えないよう、参照中のカテゴリは削除できないようにする
+ALTER TABLE shopping_amounts DROP CONSTRAINT IF EXISTS shopping_amounts_category_id_fkey;
+ALTER TABLE shopping_amounts DROP CONSTRAINT IF EXISTS shopping_amounts_category_id_fkey1;
+ALTER TABLE shopping_amounts ADD CONSTRAINT fk_shopping_amounts_category_id FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE RESTRICT;
+
+-- +migrate Down
+ALTER TABLE shopping_amounts DROP CONSTRAINT IF EXISTS fk_shopping_amounts_category_id;
+ALTER TABLE shopping_amounts ADD CONSTRAINT shopping_amounts_category_id_fkey FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE CASCADE;
+
+ALTER TABLE category_limits
+    DROP COLUMN IF EXISTS archived_at,
+    DROP COLUMN IF EXISTS sort_order,
+    DROP COLUMN IF EXISTS icon,
+    DROP COLUMN IF EXISTS color,
+    DROP COLUMN IF EXISTS name;
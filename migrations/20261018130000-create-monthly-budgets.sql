@@ -0,0 +1,26 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS monthly_budgets (
+    id SERIAL PRIMARY KEY,
+    household_book_id INTEGER NOT NULL,
+    category_id INTEGER NOT NULL,
+    -- YYYY-MM
+    month CHAR(7) NOT NULL,
+    amount INTEGER NOT NULL DEFAULT 0,
+    -- 残額（超過額）を翌月に繰り越すか
+    rollover BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (household_book_id) REFERENCES household_books(id) ON DELETE CASCADE,
+    FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE RESTRICT
+);
+
+CREATE UNIQUE INDEX idx_monthly_budgets_household_category_month ON monthly_budgets(household_book_id, category_id, month);
+
+-- 既存のカテゴリ上限金額を今月の予算として登録する
+INSERT INTO monthly_budgets (household_book_id, category_id, month, amount)
+SELECT household_book_id, category_id, to_char(CURRENT_DATE, 'YYYY-MM'), limit_amount
+FROM category_limits
+ON CONFLICT DO NOTHING;
+
+-- +migrate Down
+DROP TABLE IF EXISTS monthly_budgets;
@@ -0,0 +1,26 @@
+-- +migrate Up notransaction
+-- 予算アラートをチャットに投稿するため、システムメッセージの種別を追加する
+ALTER TYPE message_type ADD VALUE IF NOT EXISTS 'system';
+
+CREATE TABLE IF NOT EXISTS budget_alerts (
+    id SERIAL PRIMARY KEY,
+    household_book_id INTEGER NOT NULL,
+    category_id INTEGER NOT NULL,
+    -- YYYY-MM
+    month CHAR(7) NOT NULL,
+    -- 予算に対する消化率（%）
+    threshold INTEGER NOT NULL,
+    budget INTEGER NOT NULL,
+    actual INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (household_book_id) REFERENCES household_books(id) ON DELETE CASCADE,
+    FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_budget_alerts_household_category_month_threshold ON budget_alerts(household_book_id, category_id, month, threshold);
+
+-- +migrate Down
+-- 列挙型の値は削除できないため、システムメッセージのみ削除する
+DELETE FROM chat_messages WHERE message_type = 'system';
+DROP TABLE IF EXISTS budget_alerts;
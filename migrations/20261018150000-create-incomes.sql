@@ -0,0 +1,35 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS income_categories (
+    id SERIAL PRIMARY KEY,
+    household_book_id INTEGER NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    color VARCHAR(7) NOT NULL,
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (household_book_id) REFERENCES household_books(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_income_categories_household_book_id ON income_categories(household_book_id);
+
+CREATE TABLE IF NOT EXISTS incomes (
+    id SERIAL PRIMARY KEY,
+    household_book_id INTEGER NOT NULL,
+    income_category_id INTEGER NOT NULL,
+    -- 収入を得たメンバー（家計簿全体の収入の場合はNULL）
+    user_id INTEGER,
+    amount INTEGER NOT NULL,
+    date DATE NOT NULL,
+    memo TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (household_book_id) REFERENCES household_books(id) ON DELETE CASCADE,
+    FOREIGN KEY (income_category_id) REFERENCES income_categories(id) ON DELETE RESTRICT,
+    FOREIGN KEY (user_id) REFERENCES user_accounts(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_incomes_household_book_id_date ON incomes(household_book_id, date);
+
+-- +migrate Down
+DROP TABLE IF EXISTS incomes;
+DROP TABLE IF EXISTS income_categories;
@@ -0,0 +1,43 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS recurring_transactions (
+    id SERIAL PRIMARY KEY,
+    household_book_id INTEGER NOT NULL,
+    category_id INTEGER NOT NULL,
+    amount INTEGER NOT NULL,
+    memo TEXT,
+    -- monthly, yearly, weekly
+    frequency VARCHAR(16) NOT NULL,
+    interval INTEGER NOT NULL DEFAULT 1,
+    -- 毎月の支払日（monthly のみ）
+    day_of_month INTEGER NOT NULL DEFAULT 0,
+    start_date DATE NOT NULL,
+    end_date DATE,
+    -- 支出として登録済みの日付
+    materialized_until DATE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (household_book_id) REFERENCES household_books(id) ON DELETE CASCADE,
+    FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE RESTRICT
+);
+
+CREATE INDEX idx_recurring_transactions_household_book_id ON recurring_transactions(household_book_id);
+
+CREATE TABLE IF NOT EXISTS recurring_occurrence_overrides (
+    id SERIAL PRIMARY KEY,
+    recurring_transaction_id INTEGER NOT NULL,
+    -- 変更対象の発生日
+    date DATE NOT NULL,
+    skipped BOOLEAN NOT NULL DEFAULT false,
+    -- 変更後の金額・メモ（NULLの場合は定義の値を用いる）
+    amount INTEGER,
+    memo TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (recurring_transaction_id) REFERENCES recurring_transactions(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_recurring_occurrence_overrides_transaction_date ON recurring_occurrence_overrides(recurring_transaction_id, date);
+
+-- +migrate Down
+DROP TABLE IF EXISTS recurring_occurrence_overrides;
+DROP TABLE IF EXISTS recurring_transactions;
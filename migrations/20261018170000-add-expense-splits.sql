@@ -0,0 +1,42 @@
+-- +migrate Up
+-- 支払ったメンバー（家計簿の共通の財布から支払った場合はNULL）
+ALTER TABLE shopping_amounts ADD COLUMN paid_by INTEGER REFERENCES user_accounts(id) ON DELETE SET NULL;
+-- equal, percentage, fixed, personal
+ALTER TABLE shopping_amounts ADD COLUMN split_type VARCHAR(16) NOT NULL DEFAULT 'equal';
+
+CREATE TABLE IF NOT EXISTS shopping_amount_splits (
+    id SERIAL PRIMARY KEY,
+    shopping_amount_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    -- 負担の割合（%）または金額
+    value INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (shopping_amount_id) REFERENCES shopping_amounts(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES user_accounts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_shopping_amount_splits_shopping_amount_id ON shopping_amount_splits(shopping_amount_id);
+
+CREATE TABLE IF NOT EXISTS settlements (
+    id SERIAL PRIMARY KEY,
+    household_book_id INTEGER NOT NULL,
+    from_user_id INTEGER NOT NULL,
+    to_user_id INTEGER NOT NULL,
+    amount INTEGER NOT NULL,
+    date DATE NOT NULL,
+    memo TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (household_book_id) REFERENCES household_books(id) ON DELETE CASCADE,
+    FOREIGN KEY (from_user_id) REFERENCES user_accounts(id) ON DELETE CASCADE,
+    FOREIGN KEY (to_user_id) REFERENCES user_accounts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_settlements_household_book_id_date ON settlements(household_book_id, date);
+
+-- +migrate Down
+DROP TABLE IF EXISTS settlements;
+DROP TABLE IF EXISTS shopping_amount_splits;
+ALTER TABLE shopping_amounts DROP COLUMN IF EXISTS split_type;
+ALTER TABLE shopping_amounts DROP COLUMN IF EXISTS paid_by;
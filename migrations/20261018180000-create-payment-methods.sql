@@ -0,0 +1,36 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS payment_methods (
+    id SERIAL PRIMARY KEY,
+    household_book_id INTEGER NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    -- cash, credit_card, bank, e_money
+    type VARCHAR(16) NOT NULL,
+    -- 開始日時点の残高（クレジットカードは未払いの利用額を負の値で保持する）
+    opening_balance INTEGER NOT NULL DEFAULT 0,
+    opening_date DATE NOT NULL,
+    -- クレジットカードの締め日と翌月の引き落とし日
+    closing_day INTEGER,
+    payment_day INTEGER,
+    -- クレジットカードの引き落とし口座
+    withdrawal_account_id INTEGER,
+    archived_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (household_book_id) REFERENCES household_books(id) ON DELETE CASCADE,
+    FOREIGN KEY (withdrawal_account_id) REFERENCES payment_methods(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_payment_methods_household_book_id ON payment_methods(household_book_id);
+
+ALTER TABLE shopping_amounts ADD COLUMN payment_method_id INTEGER REFERENCES payment_methods(id) ON DELETE SET NULL;
+ALTER TABLE incomes ADD COLUMN payment_method_id INTEGER REFERENCES payment_methods(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_shopping_amounts_payment_method_id_date ON shopping_amounts(payment_method_id, date);
+CREATE INDEX idx_incomes_payment_method_id_date ON incomes(payment_method_id, date);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_incomes_payment_method_id_date;
+DROP INDEX IF EXISTS idx_shopping_amounts_payment_method_id_date;
+ALTER TABLE incomes DROP COLUMN IF EXISTS payment_method_id;
+ALTER TABLE shopping_amounts DROP COLUMN IF EXISTS payment_method_id;
+DROP TABLE IF EXISTS payment_methods;
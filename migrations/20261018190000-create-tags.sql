@@ -0,0 +1,36 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS tags (
+    id SERIAL PRIMARY KEY,
+    household_book_id INTEGER NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (household_book_id) REFERENCES household_books(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_tags_household_book_id_name ON tags(household_book_id, name);
+
+CREATE TABLE IF NOT EXISTS shopping_amount_tags (
+    shopping_amount_id INTEGER NOT NULL,
+    tag_id INTEGER NOT NULL,
+    PRIMARY KEY (shopping_amount_id, tag_id),
+    FOREIGN KEY (shopping_amount_id) REFERENCES shopping_amounts(id) ON DELETE CASCADE,
+    FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_shopping_amount_tags_tag_id ON shopping_amount_tags(tag_id);
+
+CREATE TABLE IF NOT EXISTS receipt_analyze_item_tags (
+    receipt_analyze_item_id INTEGER NOT NULL,
+    tag_id INTEGER NOT NULL,
+    PRIMARY KEY (receipt_analyze_item_id, tag_id),
+    FOREIGN KEY (receipt_analyze_item_id) REFERENCES receipt_analyze_items(id) ON DELETE CASCADE,
+    FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_receipt_analyze_item_tags_tag_id ON receipt_analyze_item_tags(tag_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS receipt_analyze_item_tags;
+DROP TABLE IF EXISTS shopping_amount_tags;
+DROP TABLE IF EXISTS tags;
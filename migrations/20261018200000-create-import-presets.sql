@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS import_presets (
+    id SERIAL PRIMARY KEY,
+    household_book_id INTEGER NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    mapping JSONB NOT NULL,
+    category_mappings JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (household_book_id) REFERENCES household_books(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_import_presets_household_book_id_name ON import_presets(household_book_id, name);
+
+-- +migrate Down
+DROP TABLE IF EXISTS import_presets;
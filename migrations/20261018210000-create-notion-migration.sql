@@ -0,0 +1,29 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS kaimemo_user_mappings (
+    temp_user_id VARCHAR(255) PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    household_book_id INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES user_accounts(id) ON DELETE CASCADE,
+    FOREIGN KEY (household_book_id) REFERENCES household_books(id) ON DELETE CASCADE
+);
+
+-- 移行した記録を削除しても再実行で移行し直さないよう、ページの記録は残す
+CREATE TABLE IF NOT EXISTS notion_migrated_pages (
+    notion_page_id VARCHAR(64) PRIMARY KEY,
+    source VARCHAR(16) NOT NULL,
+    household_book_id INTEGER NOT NULL,
+    shopping_memo_id INTEGER,
+    shopping_amount_id INTEGER,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (household_book_id) REFERENCES household_books(id) ON DELETE CASCADE,
+    FOREIGN KEY (shopping_memo_id) REFERENCES shopping_memos(id) ON DELETE SET NULL,
+    FOREIGN KEY (shopping_amount_id) REFERENCES shopping_amounts(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_notion_migrated_pages_household_book_id ON notion_migrated_pages(household_book_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS notion_migrated_pages;
+DROP TABLE IF EXISTS kaimemo_user_mappings;
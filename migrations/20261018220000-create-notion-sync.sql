@@ -0,0 +1,33 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS notion_sync_settings (
+    household_book_id INTEGER PRIMARY KEY,
+    database_id VARCHAR(64) NOT NULL,
+    conflict_policy VARCHAR(16) NOT NULL DEFAULT 'latest',
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    last_synced_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (household_book_id) REFERENCES household_books(id) ON DELETE CASCADE
+);
+
+-- 家計簿側で削除された支出を検出するため、shopping_amount_id には外部キーを設定しない
+-- snapshot_* は前回の同期の時点の値で、家計簿と Notion のどちらで変更されたかの判定に使う
+CREATE TABLE IF NOT EXISTS notion_sync_links (
+    id SERIAL PRIMARY KEY,
+    household_book_id INTEGER NOT NULL,
+    shopping_amount_id INTEGER NOT NULL,
+    notion_page_id VARCHAR(64) NOT NULL UNIQUE,
+    snapshot_date DATE NOT NULL,
+    snapshot_amount INTEGER NOT NULL,
+    snapshot_tag VARCHAR(255) NOT NULL,
+    snapshot_memo TEXT NOT NULL DEFAULT '',
+    synced_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (household_book_id) REFERENCES household_books(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_notion_sync_links_household_book_id ON notion_sync_links(household_book_id);
+CREATE UNIQUE INDEX idx_notion_sync_links_shopping_amount_id ON notion_sync_links(shopping_amount_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS notion_sync_links;
+DROP TABLE IF EXISTS notion_sync_settings;
@@ -0,0 +1,32 @@
+-- +migrate Up
+-- 金額は通貨の補助単位の整数で保持する。既存の家計簿と支出は円とみなす
+ALTER TABLE household_books ADD COLUMN base_currency VARCHAR(3) NOT NULL DEFAULT 'JPY';
+
+-- amount は家計簿の基準通貨に換算した金額。基準通貨以外で記録した場合のみ、記録した通貨の金額と換算レートを保持する
+ALTER TABLE shopping_amounts ADD COLUMN original_amount BIGINT;
+ALTER TABLE shopping_amounts ADD COLUMN original_currency VARCHAR(3);
+ALTER TABLE shopping_amounts ADD COLUMN exchange_rate NUMERIC(20, 10);
+
+-- レシートの通貨。NULL の場合は家計簿の基準通貨
+ALTER TABLE receipt_analyzes ADD COLUMN currency VARCHAR(3);
+
+-- rate は 1 単位の currency が家計簿の基準通貨の何単位にあたるか
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    id SERIAL PRIMARY KEY,
+    household_book_id INTEGER NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    rate NUMERIC(20, 10) NOT NULL,
+    effective_date DATE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (household_book_id) REFERENCES household_books(id) ON DELETE CASCADE,
+    UNIQUE (household_book_id, currency, effective_date)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS exchange_rates;
+ALTER TABLE receipt_analyzes DROP COLUMN IF EXISTS currency;
+ALTER TABLE shopping_amounts DROP COLUMN IF EXISTS exchange_rate;
+ALTER TABLE shopping_amounts DROP COLUMN IF EXISTS original_currency;
+ALTER TABLE shopping_amounts DROP COLUMN IF EXISTS original_amount;
+ALTER TABLE household_books DROP COLUMN IF EXISTS base_currency;
@@ -0,0 +1,29 @@
+-- +migrate Up
+-- 削除した支出・買い物メモ・カテゴリ・レシートはゴミ箱に移し、保持期間を過ぎてから物理削除する
+ALTER TABLE shopping_amounts ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE shopping_memos ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE category_limits ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE receipt_analyzes ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_shopping_amounts_deleted_at ON shopping_amounts(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_shopping_memos_deleted_at ON shopping_memos(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_category_limits_deleted_at ON category_limits(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_receipt_analyzes_deleted_at ON receipt_analyzes(deleted_at);
+
+-- +migrate Down
+-- ゴミ箱の記録は戻せないため削除してから列を落とす
+DELETE FROM receipt_analyze_items WHERE receipt_analyze_id IN (SELECT id FROM receipt_analyzes WHERE deleted_at IS NOT NULL);
+DELETE FROM receipt_analyzes WHERE deleted_at IS NOT NULL;
+DELETE FROM category_limits WHERE deleted_at IS NOT NULL;
+DELETE FROM shopping_memos WHERE deleted_at IS NOT NULL;
+DELETE FROM shopping_amounts WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_receipt_analyzes_deleted_at;
+DROP INDEX IF EXISTS idx_category_limits_deleted_at;
+DROP INDEX IF EXISTS idx_shopping_memos_deleted_at;
+DROP INDEX IF EXISTS idx_shopping_amounts_deleted_at;
+
+ALTER TABLE receipt_analyzes DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE category_limits DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE shopping_memos DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE shopping_amounts DROP COLUMN IF EXISTS deleted_at;
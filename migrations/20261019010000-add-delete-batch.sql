@@ -0,0 +1,30 @@
+-- +migrate Up
+-- ゴミ箱に移した記録に、削除したメンバーと削除操作を記録する。「最後の削除を元に戻す」は自分の最後の削除操作のみを戻す
+ALTER TABLE shopping_amounts ADD COLUMN deleted_by INTEGER REFERENCES user_accounts(id) ON DELETE SET NULL;
+ALTER TABLE shopping_amounts ADD COLUMN delete_batch_id VARCHAR(36);
+ALTER TABLE shopping_memos ADD COLUMN deleted_by INTEGER REFERENCES user_accounts(id) ON DELETE SET NULL;
+ALTER TABLE shopping_memos ADD COLUMN delete_batch_id VARCHAR(36);
+ALTER TABLE category_limits ADD COLUMN deleted_by INTEGER REFERENCES user_accounts(id) ON DELETE SET NULL;
+ALTER TABLE category_limits ADD COLUMN delete_batch_id VARCHAR(36);
+ALTER TABLE receipt_analyzes ADD COLUMN deleted_by INTEGER REFERENCES user_accounts(id) ON DELETE SET NULL;
+ALTER TABLE receipt_analyzes ADD COLUMN delete_batch_id VARCHAR(36);
+
+CREATE INDEX IF NOT EXISTS idx_shopping_amounts_delete_batch_id ON shopping_amounts(delete_batch_id);
+CREATE INDEX IF NOT EXISTS idx_shopping_memos_delete_batch_id ON shopping_memos(delete_batch_id);
+CREATE INDEX IF NOT EXISTS idx_category_limits_delete_batch_id ON category_limits(delete_batch_id);
+CREATE INDEX IF NOT EXISTS idx_receipt_analyzes_delete_batch_id ON receipt_analyzes(delete_batch_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_receipt_analyzes_delete_batch_id;
+DROP INDEX IF EXISTS idx_category_limits_delete_batch_id;
+DROP INDEX IF EXISTS idx_shopping_memos_delete_batch_id;
+DROP INDEX IF EXISTS idx_shopping_amounts_delete_batch_id;
+
+ALTER TABLE receipt_analyzes DROP COLUMN IF EXISTS delete_batch_id;
+ALTER TABLE receipt_analyzes DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE category_limits DROP COLUMN IF EXISTS delete_batch_id;
+ALTER TABLE category_limits DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE shopping_memos DROP COLUMN IF EXISTS delete_batch_id;
+ALTER TABLE shopping_memos DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE shopping_amounts DROP COLUMN IF EXISTS delete_batch_id;
+ALTER TABLE shopping_amounts DROP COLUMN IF EXISTS deleted_by;
@@ -0,0 +1,15 @@
+-- +migrate Up
+-- 参加者を指定しない均等の負担は、精算時の家計簿のメンバーで按分していたため、後からメンバーが加入・脱退すると過去の支出の負担者が変わっていた
+-- 登録時点のメンバーを参加者として保存するようにしたため、登録済みの支出には現在のメンバーを参加者として保存する
+INSERT INTO shopping_amount_splits (shopping_amount_id, user_id, value)
+SELECT shopping_amounts.id, user_households.user_id, 0
+FROM shopping_amounts
+JOIN user_households ON user_households.household_id = shopping_amounts.household_book_id
+WHERE shopping_amounts.split_type = 'equal'
+  AND shopping_amounts.paid_by IS NOT NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM shopping_amount_splits WHERE shopping_amount_splits.shopping_amount_id = shopping_amounts.id
+  );
+
+-- +migrate Down
+-- 保存した参加者は支出の登録時に指定した参加者と区別できないため、戻さない